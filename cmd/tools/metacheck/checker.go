@@ -0,0 +1,369 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// issueDroppedCollectionSegment is a segment not dropped whose collection is dropped or doesn't exist.
+	issueDroppedCollectionSegment = "segment_of_dropped_collection"
+	// issueMissingBinlog is a binlog recorded by a segment but missing in the object storage.
+	issueMissingBinlog = "missing_binlog"
+	// issueOrphanIndexFiles is the index files of a build id no segment index or snapshot refers to.
+	issueOrphanIndexFiles = "orphan_index_files"
+	// issueChannelCheckpointBehind is a channel checkpoint behind the positions of its flushed segments.
+	issueChannelCheckpointBehind = "channel_checkpoint_behind"
+	// issueOrphanPartition is a loaded partition whose collection is not loaded or doesn't exist. It's repairable only
+	// if the partition is dropped in rootcoord as well and datacoord has no segment of it.
+	issueOrphanPartition = "partition_without_collection"
+)
+
+// issue is an inconsistency found in the meta, the repairable ones are fixed by the repair mode.
+type issue struct {
+	Type         string   `json:"type"`
+	CollectionID int64    `json:"collection_id,omitempty"`
+	PartitionID  int64    `json:"partition_id,omitempty"`
+	SegmentID    int64    `json:"segment_id,omitempty"`
+	BuildID      int64    `json:"build_id,omitempty"`
+	Channel      string   `json:"channel,omitempty"`
+	Path         string   `json:"path,omitempty"`
+	Files        []string `json:"files,omitempty"`
+	Detail       string   `json:"detail"`
+	Repairable   bool     `json:"repairable"`
+	Repaired     bool     `json:"repaired"`
+	RepairError  string   `json:"repair_error,omitempty"`
+
+	segment *datapb.SegmentInfo
+}
+
+type report struct {
+	Summary map[string]int `json:"summary"`
+	Issues  []*issue       `json:"issues"`
+}
+
+func (r *report) add(i *issue) {
+	r.Summary[i.Type]++
+	r.Issues = append(r.Issues, i)
+}
+
+// checker loads the catalogs of rootcoord, datacoord and querycoord, and cross-checks them with each other and
+// with the object storage.
+type checker struct {
+	rootCatalog  metastore.RootCoordCatalog
+	dataCatalog  metastore.DataCoordCatalog
+	queryCatalog metastore.QueryCoordCatalog
+	// the object storage checks are skipped if it's nil.
+	cm storage.ChunkManager
+	// the orphan index files are only deleted if it's enabled, they're reported otherwise.
+	deleteIndexFiles bool
+
+	collections map[int64]*model.Collection
+	segments    []*datapb.SegmentInfo
+}
+
+func newChecker(rootCatalog metastore.RootCoordCatalog, dataCatalog metastore.DataCoordCatalog,
+	queryCatalog metastore.QueryCoordCatalog, cm storage.ChunkManager,
+) *checker {
+	return &checker{
+		rootCatalog:  rootCatalog,
+		dataCatalog:  dataCatalog,
+		queryCatalog: queryCatalog,
+		cm:           cm,
+	}
+}
+
+func (c *checker) load(ctx context.Context) error {
+	dbs, err := c.rootCatalog.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return fmt.Errorf("failed to list databases: %w", err)
+	}
+	c.collections = make(map[int64]*model.Collection)
+	for _, db := range dbs {
+		colls, err := c.rootCatalog.ListCollections(ctx, db.ID, typeutil.MaxTimestamp)
+		if err != nil {
+			return fmt.Errorf("failed to list collections of database %s: %w", db.Name, err)
+		}
+		for _, coll := range colls {
+			c.collections[coll.CollectionID] = coll
+		}
+	}
+
+	if c.segments, err = c.dataCatalog.ListSegments(ctx); err != nil {
+		return fmt.Errorf("failed to list segments: %w", err)
+	}
+	return nil
+}
+
+func (c *checker) check(ctx context.Context) (*report, error) {
+	if err := c.load(ctx); err != nil {
+		return nil, err
+	}
+	r := &report{Summary: make(map[string]int), Issues: make([]*issue, 0)}
+	checks := []func(context.Context, *report) error{
+		c.checkDroppedCollectionSegments,
+		c.checkMissingBinlogs,
+		c.checkOrphanIndexFiles,
+		c.checkChannelCheckpoints,
+		c.checkOrphanPartitions,
+	}
+	for _, check := range checks {
+		if err := check(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// collectionDropped returns whether the collection is dropped, the ones being dropped or in the recycle bin are
+// still handled by the coordinators.
+func (c *checker) collectionDropped(collectionID int64) bool {
+	coll, ok := c.collections[collectionID]
+	return !ok || coll.State == pb.CollectionState_CollectionDropped
+}
+
+func (c *checker) checkDroppedCollectionSegments(ctx context.Context, r *report) error {
+	for _, segment := range c.segments {
+		if segment.GetState() == commonpb.SegmentState_Dropped || !c.collectionDropped(segment.GetCollectionID()) {
+			continue
+		}
+		r.add(&issue{
+			Type:         issueDroppedCollectionSegment,
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			SegmentID:    segment.GetID(),
+			Channel:      segment.GetInsertChannel(),
+			Detail:       fmt.Sprintf("segment in state %s, its collection is dropped", segment.GetState()),
+			Repairable:   true,
+			segment:      segment,
+		})
+	}
+	return nil
+}
+
+func (c *checker) checkMissingBinlogs(ctx context.Context, r *report) error {
+	if c.cm == nil {
+		return nil
+	}
+	for _, segment := range c.segments {
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		fieldBinlogs := make([]*datapb.FieldBinlog, 0)
+		fieldBinlogs = append(fieldBinlogs, segment.GetBinlogs()...)
+		fieldBinlogs = append(fieldBinlogs, segment.GetStatslogs()...)
+		fieldBinlogs = append(fieldBinlogs, segment.GetDeltalogs()...)
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				exist, err := c.cm.Exist(ctx, binlog.GetLogPath())
+				if err != nil {
+					return fmt.Errorf("failed to check binlog %s: %w", binlog.GetLogPath(), err)
+				}
+				if exist {
+					continue
+				}
+				r.add(&issue{
+					Type:         issueMissingBinlog,
+					CollectionID: segment.GetCollectionID(),
+					PartitionID:  segment.GetPartitionID(),
+					SegmentID:    segment.GetID(),
+					Path:         binlog.GetLogPath(),
+					Detail:       fmt.Sprintf("binlog of field %d is missing in the object storage", fieldBinlog.GetFieldID()),
+				})
+			}
+		}
+	}
+	return nil
+}
+
+// referredBuildIDs returns the build ids referred by the segment indexes and the snapshots, the index files of the
+// cloned segments and the snapshots are shared from the source build.
+func (c *checker) referredBuildIDs(ctx context.Context) (typeutil.UniqueSet, error) {
+	segmentIndexes, err := c.dataCatalog.ListSegmentIndexes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list segment indexes: %w", err)
+	}
+	snapshots, err := c.dataCatalog.ListSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	referred := typeutil.NewUniqueSet()
+	for _, segIdx := range segmentIndexes {
+		referred.Insert(segIdx.BuildID, segIdx.SourceBuildID)
+	}
+	for _, snapshot := range snapshots {
+		for _, segIdx := range snapshot.GetSegmentIndexes() {
+			referred.Insert(segIdx.GetBuildID(), segIdx.GetSourceBuildID())
+		}
+	}
+	return referred, nil
+}
+
+func (c *checker) checkOrphanIndexFiles(ctx context.Context, r *report) error {
+	if c.cm == nil {
+		return nil
+	}
+	referred, err := c.referredBuildIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	prefix := path.Join(c.cm.RootPath(), common.SegmentIndexPath) + "/"
+	keys, _, err := c.cm.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		return fmt.Errorf("failed to list index files: %w", err)
+	}
+	for _, key := range keys {
+		buildID, err := strconv.ParseInt(path.Base(strings.TrimSuffix(key, "/")), 10, 64)
+		if err != nil || referred.Contain(buildID) {
+			continue
+		}
+		// the exact files are deleted by the repair, the ones written after the check are kept.
+		files, _, err := c.cm.ListWithPrefix(ctx, key, true)
+		if err != nil {
+			return fmt.Errorf("failed to list index files of build %d: %w", buildID, err)
+		}
+		r.add(&issue{
+			Type:       issueOrphanIndexFiles,
+			BuildID:    buildID,
+			Path:       key,
+			Files:      files,
+			Detail:     "no segment index or snapshot refers to the index files",
+			Repairable: c.deleteIndexFiles,
+		})
+	}
+	return nil
+}
+
+func (c *checker) checkChannelCheckpoints(ctx context.Context, r *report) error {
+	checkpoints, err := c.dataCatalog.ListChannelCheckpoint(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list channel checkpoints: %w", err)
+	}
+	// the latest position of the flushed segments of every channel.
+	latest := make(map[string]*datapb.SegmentInfo)
+	for _, segment := range c.segments {
+		if segment.GetState() != commonpb.SegmentState_Flushed {
+			continue
+		}
+		channel := segment.GetInsertChannel()
+		if cur, ok := latest[channel]; !ok || segment.GetDmlPosition().GetTimestamp() > cur.GetDmlPosition().GetTimestamp() {
+			latest[channel] = segment
+		}
+	}
+
+	channels := make([]string, 0, len(checkpoints))
+	for channel := range checkpoints {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	for _, channel := range channels {
+		segment, ok := latest[channel]
+		if !ok || checkpoints[channel].GetTimestamp() >= segment.GetDmlPosition().GetTimestamp() {
+			continue
+		}
+		r.add(&issue{
+			Type:         issueChannelCheckpointBehind,
+			CollectionID: segment.GetCollectionID(),
+			SegmentID:    segment.GetID(),
+			Channel:      channel,
+			Detail: fmt.Sprintf("checkpoint ts %d is behind the dml position ts %d of the flushed segment",
+				checkpoints[channel].GetTimestamp(), segment.GetDmlPosition().GetTimestamp()),
+		})
+	}
+	return nil
+}
+
+// partitionAlive returns whether the partition exists in rootcoord, or datacoord has any segment not dropped of it.
+func (c *checker) partitionAlive(collectionID, partitionID int64) bool {
+	if coll, ok := c.collections[collectionID]; ok {
+		for _, partition := range coll.Partitions {
+			if partition.PartitionID == partitionID && partition.State != pb.PartitionState_PartitionDropped {
+				return true
+			}
+		}
+	}
+	for _, segment := range c.segments {
+		if segment.GetCollectionID() == collectionID && segment.GetPartitionID() == partitionID &&
+			segment.GetState() != commonpb.SegmentState_Dropped {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) checkOrphanPartitions(ctx context.Context, r *report) error {
+	collections, err := c.queryCatalog.GetCollections()
+	if err != nil {
+		return fmt.Errorf("failed to list loaded collections: %w", err)
+	}
+	partitions, err := c.queryCatalog.GetPartitions()
+	if err != nil {
+		return fmt.Errorf("failed to list loaded partitions: %w", err)
+	}
+	loaded := typeutil.NewUniqueSet()
+	for _, coll := range collections {
+		loaded.Insert(coll.GetCollectionID())
+	}
+
+	collectionIDs := make([]int64, 0, len(partitions))
+	for collectionID := range partitions {
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+	for _, collectionID := range collectionIDs {
+		detail := ""
+		if c.collectionDropped(collectionID) {
+			detail = "the collection of the loaded partition is dropped"
+		} else if !loaded.Contain(collectionID) {
+			detail = "the collection of the loaded partition is not loaded"
+		} else {
+			continue
+		}
+		for _, partition := range partitions[collectionID] {
+			// the partition still in use is left to the operator, releasing it breaks the searches on it.
+			alive := c.partitionAlive(collectionID, partition.GetPartitionID())
+			partitionDetail := detail
+			if alive {
+				partitionDetail += ", but the partition exists in rootcoord or has segments in datacoord"
+			}
+			r.add(&issue{
+				Type:         issueOrphanPartition,
+				CollectionID: collectionID,
+				PartitionID:  partition.GetPartitionID(),
+				Detail:       partitionDetail,
+				Repairable:   !alive,
+			})
+		}
+	}
+	return nil
+}
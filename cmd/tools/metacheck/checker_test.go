@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	storagemocks "github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+type checkerMocks struct {
+	root  *mocks.RootCoordCatalog
+	data  *mocks.DataCoordCatalog
+	query *mocks.QueryCoordCatalog
+	cm    *storagemocks.ChunkManager
+}
+
+func newTestChecker(t *testing.T) (*checker, *checkerMocks) {
+	m := &checkerMocks{
+		root:  mocks.NewRootCoordCatalog(t),
+		data:  mocks.NewDataCoordCatalog(t),
+		query: mocks.NewQueryCoordCatalog(t),
+		cm:    storagemocks.NewChunkManager(t),
+	}
+	return newChecker(m.root, m.data, m.query, m.cm), m
+}
+
+func binlogs(paths ...string) []*datapb.FieldBinlog {
+	fieldBinlog := &datapb.FieldBinlog{FieldID: 100}
+	for _, p := range paths {
+		fieldBinlog.Binlogs = append(fieldBinlog.Binlogs, &datapb.Binlog{LogPath: p})
+	}
+	return []*datapb.FieldBinlog{fieldBinlog}
+}
+
+func (m *checkerMocks) expectMeta() {
+	m.root.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return([]*model.Database{model.NewDefaultDatabase()}, nil)
+	m.root.EXPECT().ListCollections(mock.Anything, mock.Anything, mock.Anything).Return([]*model.Collection{
+		{CollectionID: 1, State: pb.CollectionState_CollectionCreated},
+		{CollectionID: 2, State: pb.CollectionState_CollectionRecycled, Partitions: []*model.Partition{
+			{PartitionID: 201, State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 203, State: pb.PartitionState_PartitionDropped},
+		}},
+		{CollectionID: 3, State: pb.CollectionState_CollectionDropped},
+	}, nil)
+	m.data.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
+		{
+			ID: 10, CollectionID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed,
+			Binlogs: binlogs("files/insert_log/1/10/100/1", "files/insert_log/1/10/100/2"), DmlPosition: &msgpb.MsgPosition{Timestamp: 200},
+		},
+		{ID: 11, CollectionID: 1, InsertChannel: "ch2", State: commonpb.SegmentState_Flushed, DmlPosition: &msgpb.MsgPosition{Timestamp: 200}},
+		// the collection in the recycle bin is still handled by the coordinators.
+		{ID: 20, CollectionID: 2, PartitionID: 202, InsertChannel: "ch3", State: commonpb.SegmentState_Flushed},
+		{ID: 30, CollectionID: 3, InsertChannel: "ch4", State: commonpb.SegmentState_Flushed},
+		{ID: 40, CollectionID: 4, InsertChannel: "ch5", State: commonpb.SegmentState_Growing},
+		{ID: 41, CollectionID: 4, InsertChannel: "ch5", State: commonpb.SegmentState_Dropped, Binlogs: binlogs("files/insert_log/4/41/100/1")},
+	}, nil)
+	m.data.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{
+		{SegmentID: 10, BuildID: 1000},
+		{SegmentID: 11, BuildID: 1001, SourceBuildID: 1002},
+	}, nil)
+	m.data.EXPECT().ListSnapshots(mock.Anything).Return([]*datapb.CollectionSnapshot{
+		{SegmentIndexes: []*indexpb.SegmentIndex{{BuildID: 1003}}},
+	}, nil)
+	m.data.EXPECT().ListChannelCheckpoint(mock.Anything).Return(map[string]*msgpb.MsgPosition{
+		"ch1": {Timestamp: 100},
+		"ch2": {Timestamp: 300},
+	}, nil)
+	m.query.EXPECT().GetCollections().Return([]*querypb.CollectionLoadInfo{{CollectionID: 1}}, nil)
+	m.query.EXPECT().GetPartitions().Return(map[int64][]*querypb.PartitionLoadInfo{
+		1: {{CollectionID: 1, PartitionID: 101}},
+		2: {{CollectionID: 2, PartitionID: 201}, {CollectionID: 2, PartitionID: 202}, {CollectionID: 2, PartitionID: 203}},
+		3: {{CollectionID: 3, PartitionID: 301}},
+	}, nil)
+	m.cm.EXPECT().Exist(mock.Anything, "files/insert_log/1/10/100/1").Return(true, nil)
+	m.cm.EXPECT().Exist(mock.Anything, "files/insert_log/1/10/100/2").Return(false, nil)
+	m.cm.EXPECT().RootPath().Return("files")
+	m.cm.EXPECT().ListWithPrefix(mock.Anything, "files/index_files/", false).Return([]string{
+		"files/index_files/1000/", "files/index_files/1002/", "files/index_files/1003/", "files/index_files/1004/", "files/index_files/invalid/",
+	}, nil, nil)
+	m.cm.EXPECT().ListWithPrefix(mock.Anything, "files/index_files/1004/", true).Return([]string{
+		"files/index_files/1004/1/1/100/1/index", "files/index_files/1004/1/1/100/1/meta",
+	}, nil, nil)
+}
+
+func TestChecker_check(t *testing.T) {
+	c, m := newTestChecker(t)
+	m.expectMeta()
+
+	r, err := c.check(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{
+		issueDroppedCollectionSegment: 2,
+		issueMissingBinlog:            1,
+		issueOrphanIndexFiles:         1,
+		issueChannelCheckpointBehind:  1,
+		issueOrphanPartition:          4,
+	}, r.Summary)
+
+	byType := make(map[string][]*issue)
+	for _, i := range r.Issues {
+		byType[i.Type] = append(byType[i.Type], i)
+	}
+	assert.Equal(t, int64(30), byType[issueDroppedCollectionSegment][0].SegmentID)
+	assert.Equal(t, int64(40), byType[issueDroppedCollectionSegment][1].SegmentID)
+	assert.Equal(t, "files/insert_log/1/10/100/2", byType[issueMissingBinlog][0].Path)
+	assert.False(t, byType[issueMissingBinlog][0].Repairable)
+	assert.Equal(t, int64(1004), byType[issueOrphanIndexFiles][0].BuildID)
+	assert.Len(t, byType[issueOrphanIndexFiles][0].Files, 2)
+	// the index files are only deleted if it's enabled.
+	assert.False(t, byType[issueOrphanIndexFiles][0].Repairable)
+	assert.Equal(t, "ch1", byType[issueChannelCheckpointBehind][0].Channel)
+	// the partitions existing in rootcoord or having segments in datacoord are not released.
+	orphanPartitions := lo.Map(byType[issueOrphanPartition], func(i *issue, _ int) []interface{} {
+		return []interface{}{i.PartitionID, i.Repairable}
+	})
+	assert.Equal(t, [][]interface{}{{int64(201), false}, {int64(202), false}, {int64(203), true}, {int64(301), true}}, orphanPartitions)
+}
+
+func TestChecker_check_failed(t *testing.T) {
+	t.Run("failed to list databases", func(t *testing.T) {
+		c, m := newTestChecker(t)
+		m.root.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+		_, err := c.check(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to list segments", func(t *testing.T) {
+		c, m := newTestChecker(t)
+		m.root.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, nil)
+		m.data.EXPECT().ListSegments(mock.Anything).Return(nil, errors.New("mock"))
+		_, err := c.check(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to check binlogs", func(t *testing.T) {
+		c, m := newTestChecker(t)
+		m.root.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, nil)
+		m.data.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
+			{ID: 10, State: commonpb.SegmentState_Flushed, Binlogs: binlogs("files/insert_log/1/10/100/1")},
+		}, nil)
+		m.cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(false, errors.New("mock"))
+		_, err := c.check(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestChecker_skipStorage(t *testing.T) {
+	c, m := newTestChecker(t)
+	c.cm = nil
+	m.root.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(nil, nil)
+	m.data.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
+		{ID: 10, State: commonpb.SegmentState_Flushed, Binlogs: binlogs("files/insert_log/1/10/100/1")},
+	}, nil)
+	m.data.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
+	m.query.EXPECT().GetCollections().Return(nil, nil)
+	m.query.EXPECT().GetPartitions().Return(nil, nil)
+
+	r, err := c.check(context.Background())
+	assert.NoError(t, err)
+	// the segment of the collection not found.
+	assert.Equal(t, map[string]int{issueDroppedCollectionSegment: 1}, r.Summary)
+}
+
+func TestChecker_repair(t *testing.T) {
+	c, m := newTestChecker(t)
+	c.deleteIndexFiles = true
+	m.expectMeta()
+	r, err := c.check(context.Background())
+	assert.NoError(t, err)
+
+	m.data.EXPECT().AlterSegments(mock.Anything, mock.Anything).
+		Run(func(ctx context.Context, segments []*datapb.SegmentInfo, _ ...metastore.BinlogsIncrement) {
+			assert.Len(t, segments, 1)
+			assert.Equal(t, commonpb.SegmentState_Dropped, segments[0].GetState())
+			assert.NotZero(t, segments[0].GetDroppedAt())
+		}).Return(nil).Times(2)
+	m.cm.EXPECT().MultiRemove(mock.Anything, []string{
+		"files/index_files/1004/1/1/100/1/index", "files/index_files/1004/1/1/100/1/meta",
+	}).Return(nil)
+	m.query.EXPECT().ReleasePartition(int64(2), int64(203)).Return(nil)
+	m.query.EXPECT().ReleasePartition(int64(3), int64(301)).Return(errors.New("mock"))
+	c.repair(context.Background(), r)
+
+	for _, i := range r.Issues {
+		switch {
+		case !i.Repairable:
+			assert.False(t, i.Repaired)
+		case i.PartitionID == 301:
+			assert.False(t, i.Repaired)
+			assert.Equal(t, "mock", i.RepairError)
+		default:
+			assert.True(t, i.Repaired)
+		}
+	}
+	// the meta of the segment is not changed in place.
+	assert.Equal(t, commonpb.SegmentState_Flushed, c.segments[3].GetState())
+}
+
+func TestChecker_repairIndexFilesReferred(t *testing.T) {
+	c, m := newTestChecker(t)
+	c.deleteIndexFiles = true
+	r := &report{Summary: make(map[string]int)}
+	r.add(&issue{Type: issueOrphanIndexFiles, BuildID: 1004, Files: []string{"files/index_files/1004/1/1/100/1/index"}, Repairable: true})
+
+	// the build is referred by the segment index created after the check.
+	m.data.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{{SegmentID: 12, BuildID: 1004}}, nil)
+	m.data.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	c.repair(context.Background(), r)
+	assert.False(t, r.Issues[0].Repaired)
+	assert.NotEmpty(t, r.Issues[0].RepairError)
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// metacheck cross-checks the meta of rootcoord, datacoord and querycoord and the object storage, and reports the
+// inconsistencies in json. The config is loaded from milvus.yaml the same way as the milvus components.
+//
+// The repairable issues are fixed with --repair, which is refused while any coordinator is online, since the
+// coordinators would overwrite the repaired meta with their memory. The orphan index files are only deleted with
+// --delete-index-files as well.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var (
+	repair           = flag.Bool("repair", false, "Repair the repairable issues, refused while any coordinator is online")
+	deleteIndexFiles = flag.Bool("delete-index-files", false, "Delete the orphan index files in the repair, they're only reported otherwise")
+	skipStorage      = flag.Bool("skip-storage", false, "Skip the checks against the object storage")
+	output           = flag.String("output", "", "File to write the json report to, stdout by default")
+)
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	paramtable.Init()
+	params := paramtable.Get()
+	if params.MetaStoreCfg.MetaStoreType.GetValue() != util.MetaStoreTypeEtcd {
+		log.Fatal("only etcd meta store is supported", zap.String("metaType", params.MetaStoreCfg.MetaStoreType.GetValue()))
+	}
+
+	etcdCli, err := etcd.GetEtcdClient(
+		params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		params.EtcdCfg.Endpoints.GetAsStrings(),
+		params.EtcdCfg.EtcdTLSCert.GetValue(),
+		params.EtcdCfg.EtcdTLSKey.GetValue(),
+		params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	defer etcdCli.Close()
+
+	metaRootPath := params.EtcdCfg.MetaRootPath.GetValue()
+	if *repair {
+		if err := checkCoordinatorsOffline(ctx, etcdCli, metaRootPath); err != nil {
+			log.Fatal("refuse to repair", zap.Error(err))
+		}
+	}
+
+	metaKV := etcdkv.NewEtcdKV(etcdCli, metaRootPath)
+	ss, err := rootcoord.NewSuffixSnapshot(metaKV, rootcoord.SnapshotsSep, metaRootPath, rootcoord.SnapshotPrefix)
+	if err != nil {
+		log.Fatal("failed to create snapshot kv", zap.Error(err))
+	}
+	rootCatalog := &rootcoord.Catalog{Txn: metaKV, Snapshot: ss}
+
+	var cm storage.ChunkManager
+	chunkManagerRootPath := params.MinioCfg.RootPath.GetValue()
+	if params.CommonCfg.StorageType.GetValue() == "local" {
+		chunkManagerRootPath = params.LocalStorageCfg.Path.GetValue()
+	}
+	if !*skipStorage {
+		cm, err = storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			log.Fatal("failed to connect to the object storage", zap.Error(err))
+		}
+		chunkManagerRootPath = cm.RootPath()
+	}
+	dataCatalog := datacoord.NewCatalog(metaKV, chunkManagerRootPath, metaRootPath)
+	queryCatalog := querycoord.NewCatalog(metaKV)
+
+	c := newChecker(rootCatalog, dataCatalog, queryCatalog, cm)
+	c.deleteIndexFiles = *deleteIndexFiles
+	r, err := c.check(ctx)
+	if err != nil {
+		log.Fatal("failed to check meta", zap.Error(err))
+	}
+	if *repair {
+		c.repair(ctx, r)
+	}
+
+	if err := writeReport(r); err != nil {
+		log.Fatal("failed to write report", zap.Error(err))
+	}
+	for _, i := range r.Issues {
+		if !i.Repaired {
+			// exit with a non-zero code if any issue is left, so that it could be used by the scripts.
+			os.Exit(2)
+		}
+	}
+}
+
+// checkCoordinatorsOffline returns error if any coordinator holds its session.
+func checkCoordinatorsOffline(ctx context.Context, etcdCli *clientv3.Client, metaRootPath string) error {
+	for _, role := range []string{typeutil.RootCoordRole, typeutil.DataCoordRole, typeutil.QueryCoordRole} {
+		key := path.Join(metaRootPath, sessionutil.DefaultServiceRoot, role)
+		resp, err := etcdCli.Get(ctx, key, clientv3.WithPrefix(), clientv3.WithCountOnly())
+		if err != nil {
+			return err
+		}
+		if resp.Count > 0 {
+			return fmt.Errorf("%s is online, stop the coordinators before repairing", role)
+		}
+	}
+	return nil
+}
+
+func writeReport(r *report) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	if *output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	return os.WriteFile(*output, data, 0o644)
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// repair fixes the repairable issues, which are the ones the coordinators would fix the same way:
+//   - the segments of the dropped collections are marked dropped, the garbage collector of datacoord removes them.
+//   - the orphan index files are deleted if it's enabled, the build is checked against the current meta again
+//     and only the files listed by the check are deleted.
+//   - the loaded partitions without collections are released if they're dropped in rootcoord and datacoord.
+//
+// The others need an operator to look into, they're only reported.
+func (c *checker) repair(ctx context.Context, r *report) {
+	var referred typeutil.UniqueSet
+	for _, i := range r.Issues {
+		if !i.Repairable {
+			continue
+		}
+		var err error
+		switch i.Type {
+		case issueDroppedCollectionSegment:
+			segment := proto.Clone(i.segment).(*datapb.SegmentInfo)
+			segment.State = commonpb.SegmentState_Dropped
+			segment.DroppedAt = uint64(time.Now().UnixNano())
+			err = c.dataCatalog.AlterSegments(ctx, []*datapb.SegmentInfo{segment})
+		case issueOrphanIndexFiles:
+			if referred == nil {
+				if referred, err = c.referredBuildIDs(ctx); err != nil {
+					break
+				}
+			}
+			if referred.Contain(i.BuildID) {
+				err = fmt.Errorf("build %d is referred now", i.BuildID)
+				break
+			}
+			err = c.cm.MultiRemove(ctx, i.Files)
+		case issueOrphanPartition:
+			err = c.queryCatalog.ReleasePartition(i.CollectionID, i.PartitionID)
+		default:
+			continue
+		}
+		if err != nil {
+			i.RepairError = err.Error()
+			continue
+		}
+		i.Repaired = true
+	}
+}
@@ -46,6 +46,19 @@ Collection::Collection(const void* schema_proto, const int64_t length) {
     schema_ = Schema::ParseFrom(collection_schema);
 }
 
+void
+Collection::UpdateSchema(const void* schema_proto, const int64_t length) {
+    Assert(schema_proto != nullptr);
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc = collection_schema.ParseFromArray(schema_proto, length);
+    AssertInfo(suc, "unmarshal schema string failed");
+
+    auto schema = Schema::ParseFrom(collection_schema);
+    std::lock_guard<std::mutex> lock(schema_mutex_);
+    replaced_schemas_.push_back(schema_);
+    schema_ = schema;
+}
+
 void
 Collection::parseIndexMeta(const void* index_proto, const int64_t length) {
     Assert(index_proto != nullptr);
@@ -12,7 +12,9 @@
 #pragma once
 
 #include <memory>
+#include <mutex>
 #include <string>
+#include <vector>
 
 #include "common/Schema.h"
 #include "common/IndexMeta.h"
@@ -28,9 +30,15 @@ class Collection {
     void
     parseIndexMeta(const void* index_meta_proto_blob, const int64_t length);
 
+    // replace the schema after fields added to the collection,
+    // only the segments created after it see the new fields
+    void
+    UpdateSchema(const void* schema_proto_blob, const int64_t length);
+
  public:
-    SchemaPtr&
+    SchemaPtr
     get_schema() {
+        std::lock_guard<std::mutex> lock(schema_mutex_);
         return schema_;
     }
 
@@ -46,7 +54,11 @@ class Collection {
 
  private:
     std::string collection_name_;
+    std::mutex schema_mutex_;
     SchemaPtr schema_;
+    // the plans refer to the schema they are created with,
+    // so the replaced schemas are kept until the collection released
+    std::vector<SchemaPtr> replaced_schemas_;
     IndexMetaPtr index_meta_;
 };
 
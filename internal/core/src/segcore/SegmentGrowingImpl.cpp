@@ -106,6 +106,15 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
         if (field_id.get() < START_USER_FIELDID) {
             continue;
         }
+        if (!field_id_to_offset.count(field_id) &&
+            added_fields_.count(field_id)) {
+            // the insert data produced before the field added lacks it
+            auto field_data = CreateDefaultFieldData(
+                field_meta, added_fields_.at(field_id), num_rows);
+            insert_record_.get_field_data_base(field_id)->set_data_raw(
+                reserved_offset, {field_data});
+            continue;
+        }
         AssertInfo(field_id_to_offset.count(field_id),
                    fmt::format("can't find field {}", field_id.get()));
         auto data_offset = field_id_to_offset[field_id];
@@ -230,6 +239,74 @@ SegmentGrowingImpl::LoadFieldData(const LoadFieldDataInfo& infos) {
                                              reserved_offset + num_rows);
 }
 
+void
+SegmentGrowingImpl::AddField(const SchemaPtr& schema,
+                             FieldId field_id,
+                             const proto::schema::ValueField& default_value) {
+    if (schema_->get_fields().count(field_id)) {
+        return;
+    }
+    auto& field_meta = (*schema)[field_id];
+    auto size_per_chunk = segcore_config_.get_chunk_rows();
+    switch (field_meta.get_data_type()) {
+        case DataType::BOOL:
+            insert_record_.append_field_data<bool>(field_id, size_per_chunk);
+            break;
+        case DataType::INT8:
+            insert_record_.append_field_data<int8_t>(field_id, size_per_chunk);
+            break;
+        case DataType::INT16:
+            insert_record_.append_field_data<int16_t>(field_id,
+                                                      size_per_chunk);
+            break;
+        case DataType::INT32:
+            insert_record_.append_field_data<int32_t>(field_id,
+                                                      size_per_chunk);
+            break;
+        case DataType::INT64:
+            insert_record_.append_field_data<int64_t>(field_id,
+                                                      size_per_chunk);
+            break;
+        case DataType::FLOAT:
+            insert_record_.append_field_data<float>(field_id, size_per_chunk);
+            break;
+        case DataType::DOUBLE:
+            insert_record_.append_field_data<double>(field_id, size_per_chunk);
+            break;
+        case DataType::VARCHAR:
+            insert_record_.append_field_data<std::string>(field_id,
+                                                          size_per_chunk);
+            break;
+        default:
+            PanicInfo(DataTypeInvalid,
+                      fmt::format("unsupported type {} of the added field {}",
+                                  field_meta.get_data_type(),
+                                  field_id.get()));
+    }
+
+    // the caller blocks the inserts, so all the reserved rows are inserted
+    auto num_rows = insert_record_.reserved.load();
+    if (num_rows > 0) {
+        auto field_data =
+            CreateDefaultFieldData(field_meta, default_value, num_rows);
+        insert_record_.get_field_data_base(field_id)->set_data_raw(
+            0, {field_data});
+        if (datatype_is_variable(field_meta.get_data_type())) {
+            SegmentInternalInterface::set_field_avg_size(
+                field_id,
+                num_rows,
+                storage::GetByteSizeOfFieldDatas({field_data}));
+        }
+    }
+
+    // the other fields of the schema are added by their own calls
+    auto new_schema = std::make_shared<Schema>(*schema_);
+    new_schema->AddField(FieldMeta(field_meta));
+    added_fields_.emplace(field_id, default_value);
+    replaced_schemas_.push_back(schema_);
+    schema_ = new_schema;
+}
+
 SegcoreError
 SegmentGrowingImpl::Delete(int64_t reserved_begin,
                            int64_t size,
@@ -68,6 +68,11 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     LoadFieldData(const LoadFieldDataInfo& info) override;
 
+    void
+    AddField(const SchemaPtr& schema,
+             FieldId field_id,
+             const proto::schema::ValueField& default_value) override;
+
     std::string
     debug() const override;
 
@@ -294,6 +299,12 @@ class SegmentGrowingImpl : public SegmentGrowing {
     // deleted pks
     mutable DeletedRecord deleted_record_;
 
+    // the indexing record refers to the schema the segment created with,
+    // so the schemas replaced by the added fields are kept
+    std::vector<SchemaPtr> replaced_schemas_;
+    // default values of the added fields, for the insert data lacking them
+    std::unordered_map<FieldId, proto::schema::ValueField> added_fields_;
+
     int64_t id_;
 };
 
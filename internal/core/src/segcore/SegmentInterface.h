@@ -100,6 +100,13 @@ class SegmentInterface {
     virtual void
     LoadFieldData(const LoadFieldDataInfo& info) = 0;
 
+    // add the field appended to the schema after the segment created,
+    // the rows of the segment get the default value of the field
+    virtual void
+    AddField(const SchemaPtr& schema,
+             FieldId field_id,
+             const proto::schema::ValueField& default_value) = 0;
+
     virtual int64_t
     get_segment_id() const = 0;
 
@@ -504,6 +504,49 @@ SegmentSealedImpl::MapFieldData(const FieldId field_id, FieldDataInfo& data) {
     set_bit(field_data_ready_bitset_, field_id, true);
 }
 
+void
+SegmentSealedImpl::AddField(const SchemaPtr& schema,
+                            FieldId field_id,
+                            const proto::schema::ValueField& default_value) {
+    std::optional<int64_t> num_rows;
+    {
+        std::unique_lock lck(mutex_);
+        if (!schema_->get_fields().count(field_id)) {
+            auto new_schema = std::make_shared<Schema>(*schema_);
+            new_schema->AddField(FieldMeta((*schema)[field_id]));
+            // the bitsets are indexed by the field id
+            auto bits =
+                static_cast<size_t>(field_id.get() - START_USER_FIELDID + 1);
+            if (field_data_ready_bitset_.size() < bits) {
+                field_data_ready_bitset_.resize(bits);
+                index_ready_bitset_.resize(bits);
+                binlog_index_bitset_.resize(bits);
+            }
+            replaced_schemas_.push_back(schema_);
+            schema_ = new_schema;
+        }
+        if (get_bit(field_data_ready_bitset_, field_id) ||
+            get_bit(index_ready_bitset_, field_id)) {
+            return;
+        }
+        num_rows = num_rows_;
+    }
+
+    // the row count is unknown until the binlogs loaded,
+    // the loader adds the field again after that
+    if (!num_rows.has_value()) {
+        return;
+    }
+    auto field_data = CreateDefaultFieldData(
+        (*schema_)[field_id], default_value, num_rows.value());
+    auto channel = std::make_shared<storage::FieldDataChannel>();
+    channel->push(field_data);
+    channel->close();
+    auto field_data_info = FieldDataInfo(
+        field_id.get(), static_cast<size_t>(num_rows.value()), channel);
+    LoadFieldData(field_id, field_data_info);
+}
+
 void
 SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
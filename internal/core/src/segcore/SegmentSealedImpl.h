@@ -75,6 +75,10 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     AddFieldDataInfoForSealed(
         const LoadFieldDataInfo& field_data_info) override;
+    void
+    AddField(const SchemaPtr& schema,
+             FieldId field_id,
+             const proto::schema::ValueField& default_value) override;
 
     int64_t
     get_segment_id() const override {
@@ -281,6 +285,8 @@ class SegmentSealedImpl : public SegmentSealed {
     LoadFieldDataInfo field_data_info_;
 
     SchemaPtr schema_;
+    // the schemas replaced by the added fields, kept for the references to them
+    std::vector<SchemaPtr> replaced_schemas_;
     int64_t id_;
     std::unordered_map<FieldId, std::shared_ptr<ColumnBase>> fields_;
 
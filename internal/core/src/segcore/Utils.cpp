@@ -746,6 +746,64 @@ LoadFieldDatasFromRemote(std::vector<std::string>& remote_files,
     }
 }
 
+template <typename T>
+void
+FillDefaultFieldData(const storage::FieldDataPtr& field_data,
+                     const T& value,
+                     int64_t row_count) {
+    auto data = std::make_unique<T[]>(row_count);
+    std::fill_n(data.get(), row_count, value);
+    field_data->FillFieldData(data.get(), row_count);
+}
+
+storage::FieldDataPtr
+CreateDefaultFieldData(const FieldMeta& field_meta,
+                       const proto::schema::ValueField& default_value,
+                       int64_t row_count) {
+    auto data_type = field_meta.get_data_type();
+    auto field_data = storage::CreateFieldData(data_type);
+    switch (data_type) {
+        case DataType::BOOL:
+            FillDefaultFieldData<bool>(
+                field_data, default_value.bool_data(), row_count);
+            break;
+        case DataType::INT8:
+            FillDefaultFieldData<int8_t>(
+                field_data, default_value.int_data(), row_count);
+            break;
+        case DataType::INT16:
+            FillDefaultFieldData<int16_t>(
+                field_data, default_value.int_data(), row_count);
+            break;
+        case DataType::INT32:
+            FillDefaultFieldData<int32_t>(
+                field_data, default_value.int_data(), row_count);
+            break;
+        case DataType::INT64:
+            FillDefaultFieldData<int64_t>(
+                field_data, default_value.long_data(), row_count);
+            break;
+        case DataType::FLOAT:
+            FillDefaultFieldData<float>(
+                field_data, default_value.float_data(), row_count);
+            break;
+        case DataType::DOUBLE:
+            FillDefaultFieldData<double>(
+                field_data, default_value.double_data(), row_count);
+            break;
+        case DataType::VARCHAR:
+        case DataType::STRING:
+            FillDefaultFieldData<std::string>(
+                field_data, default_value.string_data(), row_count);
+            break;
+        default:
+            PanicInfo(
+                DataTypeInvalid,
+                fmt::format("unsupported default value of type {}", data_type));
+    }
+    return field_data;
+}
+
 int64_t
 upper_bound(const ConcurrentVector<Timestamp>& timestamps,
             int64_t first,
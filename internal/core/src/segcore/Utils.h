@@ -159,6 +159,13 @@ void
 LoadFieldDatasFromRemote(std::vector<std::string>& remote_files,
                          storage::FieldDataChannelPtr channel);
 
+// create the field data of row_count rows filled with the default value,
+// for the rows written before the field added to the collection
+storage::FieldDataPtr
+CreateDefaultFieldData(const FieldMeta& field_meta,
+                       const proto::schema::ValueField& default_value,
+                       int64_t row_count);
+
 /**
  * Returns an index pointing to the first element in the range [first, last) such that `value < element` is true
  * (i.e. that is strictly greater than value), or last if no such element is found.
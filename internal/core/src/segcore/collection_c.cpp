@@ -14,6 +14,7 @@
 #endif
 
 #include <iostream>
+#include "common/EasyAssert.h"
 #include "segcore/collection_c.h"
 #include "segcore/Collection.h"
 
@@ -32,6 +33,19 @@ SetIndexMeta(CCollection collection,
     col->parseIndexMeta(proto_blob, length);
 }
 
+CStatus
+UpdateSchema(CCollection collection,
+             const void* schema_proto_blob,
+             const int64_t length) {
+    try {
+        auto col = (milvus::segcore::Collection*)collection;
+        col->UpdateSchema(schema_proto_blob, length);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 void
 DeleteCollection(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
@@ -13,6 +13,8 @@
 
 #include <stdint.h>
 
+#include "common/type_c.h"
+
 #ifdef __cplusplus
 extern "C" {
 #endif
@@ -27,6 +29,11 @@ SetIndexMeta(CCollection collection,
              const void* proto_blob,
              const int64_t length);
 
+CStatus
+UpdateSchema(CCollection collection,
+             const void* schema_proto_blob,
+             const int64_t length);
+
 void
 DeleteCollection(CCollection collection);
 
@@ -282,6 +282,30 @@ LoadFieldRawData(CSegmentInterface c_segment,
     }
 }
 
+CStatus
+LoadFieldDefaultData(CSegmentInterface c_segment,
+                     CCollection c_collection,
+                     int64_t field_id,
+                     const void* default_value_blob,
+                     int64_t blob_size) {
+    try {
+        auto segment =
+            reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto collection =
+            reinterpret_cast<milvus::segcore::Collection*>(c_collection);
+        milvus::proto::schema::ValueField default_value;
+        auto suc = default_value.ParseFromArray(default_value_blob, blob_size);
+        AssertInfo(suc, "unmarshal default value failed");
+
+        segment->AddField(collection->get_schema(),
+                          milvus::FieldId(field_id),
+                          default_value);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info) {
@@ -95,6 +95,15 @@ LoadFieldRawData(CSegmentInterface c_segment,
                  const void* data,
                  int64_t row_count);
 
+// add the field appended to the schema of the collection to the segment,
+// and fill the rows written before with the default value of the field
+CStatus
+LoadFieldDefaultData(CSegmentInterface c_segment,
+                     CCollection c_collection,
+                     int64_t field_id,
+                     const void* default_value_blob,
+                     int64_t blob_size);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info);
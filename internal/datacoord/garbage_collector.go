@@ -136,6 +136,9 @@ func (gc *garbageCollector) scan() {
 				filesMap.Insert(log.GetLogPath())
 			}
 		}
+		for _, log := range getSnapshotLogs(gc.meta) {
+			filesMap.Insert(log.GetLogPath())
+		}
 		return segmentMap, filesMap
 	}
 
@@ -252,7 +255,17 @@ func (gc *garbageCollector) clearEtcd() {
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
 	channels := typeutil.NewSet[string]()
+	// the binlogs are shared by the cloned segments, the count of the segments referring
+	// to each binlog makes sure it's removed only when no segment uses it.
+	logRefs := make(map[string]int)
+	// the binlogs referred by the snapshots are never removed until the snapshots are dropped.
+	for _, l := range getSnapshotLogs(gc.meta) {
+		logRefs[l.GetLogPath()]++
+	}
 	for _, segment := range all {
+		for _, l := range getLogs(segment) {
+			logRefs[l.GetLogPath()]++
+		}
 		if segment.GetState() == commonpb.SegmentState_Dropped {
 			drops[segment.GetID()] = segment
 			channels.Insert(segment.GetInsertChannel())
@@ -300,13 +313,20 @@ func (gc *garbageCollector) clearEtcd() {
 		}
 
 		logs := getLogs(segment)
-		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()))
-		if gc.removeLogs(logs) {
+		unsharedLogs := lo.Filter(logs, func(l *datapb.Binlog, _ int) bool {
+			return logRefs[l.GetLogPath()] <= 1
+		})
+		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()),
+			zap.Int("sharedLogs", len(logs)-len(unsharedLogs)))
+		if gc.removeLogs(unsharedLogs) {
 			err := gc.meta.DropSegment(segment.GetID())
 			if err != nil {
 				log.Info("GC segment meta failed to drop segment", zap.Int64("segment id", segment.GetID()), zap.Error(err))
 			} else {
 				log.Info("GC segment meta drop semgent", zap.Int64("segment id", segment.GetID()))
+				for _, l := range logs {
+					logRefs[l.GetLogPath()]--
+				}
 			}
 		}
 		if segList := gc.meta.GetSegmentsByChannel(segInsertChannel); len(segList) == 0 &&
@@ -340,6 +360,17 @@ func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 	return logs
 }
 
+// getSnapshotLogs returns the binlogs of the segments recorded by the snapshots.
+func getSnapshotLogs(m *meta) []*datapb.Binlog {
+	var logs []*datapb.Binlog
+	for _, snapshot := range m.GetSnapshots() {
+		for _, segment := range snapshot.GetSegments() {
+			logs = append(logs, getLogs(NewSegmentInfo(segment))...)
+		}
+	}
+	return logs
+}
+
 func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -757,6 +757,65 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_clearETCDSharedLogs(t *testing.T) {
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ChannelExists(mock.Anything, mock.Anything).Return(false)
+	catalog.EXPECT().DropChannelCheckpoint(mock.Anything, mock.Anything).Return(nil).Maybe()
+	catalog.EXPECT().DropSegment(mock.Anything, mock.Anything).Return(nil)
+
+	logs := func(paths ...string) []*datapb.FieldBinlog {
+		binlogs := make([]*datapb.Binlog, 0, len(paths))
+		for _, path := range paths {
+			binlogs = append(binlogs, &datapb.Binlog{LogPath: path})
+		}
+		return []*datapb.FieldBinlog{{FieldID: 1, Binlogs: binlogs}}
+	}
+	source := &datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		InsertChannel: "ch0",
+		State:         commonpb.SegmentState_Dropped,
+		Binlogs:       logs("shared", "own"),
+	}
+	cloned := &datapb.SegmentInfo{
+		ID:            2,
+		CollectionID:  200,
+		InsertChannel: "ch1",
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs:       logs("shared"),
+		ClonedFrom:    1,
+	}
+	m := &meta{
+		catalog: catalog,
+		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			source.GetID(): NewSegmentInfo(source),
+			cloned.GetID(): NewSegmentInfo(cloned),
+		}},
+	}
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Remove(mock.Anything, "own").Return(nil).Once()
+	gc := &garbageCollector{
+		option: GcOption{
+			cli:           cm,
+			dropTolerance: 1,
+		},
+		meta:    m,
+		handler: newMockHandlerWithMeta(m),
+	}
+
+	// the binlog shared by the cloned segment is kept
+	gc.clearEtcd()
+	assert.Nil(t, m.GetSegment(source.GetID()))
+
+	// removed once no segment uses it
+	cloned.State = commonpb.SegmentState_Dropped
+	m.segments.SetSegment(cloned.GetID(), NewSegmentInfo(cloned))
+	cm.EXPECT().Remove(mock.Anything, "shared").Return(nil).Once()
+	gc.clearEtcd()
+	assert.Nil(t, m.GetSegment(cloned.GetID()))
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
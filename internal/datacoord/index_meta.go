@@ -676,6 +676,20 @@ func (m *meta) CleanSegmentIndex(buildID UniqueID) (bool, *model.SegmentIndex) {
 		}
 		return false, model.CloneSegmentIndex(segIndex)
 	}
+	// the index files are still shared by the cloned segments
+	for _, segIndex := range m.buildID2SegmentIndex {
+		if segIndex.SourceBuildID == buildID {
+			return false, nil
+		}
+	}
+	// the index files are still referred by the snapshots
+	for _, snapshot := range m.snapshots {
+		for _, segIndex := range snapshot.GetSegmentIndexes() {
+			if segIndex.GetSourceBuildID() == buildID {
+				return false, nil
+			}
+		}
+	}
 	return true, nil
 }
 
@@ -62,6 +62,11 @@ type meta struct {
 	// buildID2Meta records the meta information of the segment
 	// buildID -> segmentIndex
 	buildID2SegmentIndex map[UniqueID]*model.SegmentIndex
+
+	// snapshots records the segments and indexes of the collection snapshots,
+	// the binlogs and index files they refer to are protected from gc.
+	// snapshotID -> snapshot
+	snapshots map[UniqueID]*datapb.CollectionSnapshot
 }
 
 // A local cache of segment metric update. Must call commit() to take effect.
@@ -91,6 +96,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		chunkManager:         chunkManager,
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
+		snapshots:            make(map[UniqueID]*datapb.CollectionSnapshot),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -164,6 +170,14 @@ func (m *meta) reloadFromKV() error {
 		m.updateSegmentIndex(segIdx)
 		metrics.FlushedSegmentFileNum.WithLabelValues(metrics.IndexFileLabel).Observe(float64(len(segIdx.IndexFileKeys)))
 	}
+	snapshots, err := m.catalog.ListSnapshots(m.ctx)
+	if err != nil {
+		log.Error("DataCoord meta reloadFromKV load snapshots fail", zap.Error(err))
+		return err
+	}
+	for _, snapshot := range snapshots {
+		m.snapshots[snapshot.GetSnapshotID()] = snapshot
+	}
 	log.Info("DataCoord meta reloadFromKV done", zap.Duration("duration", record.ElapseSpan()))
 	return nil
 }
@@ -303,6 +317,85 @@ func (m *meta) AddSegment(ctx context.Context, segment *SegmentInfo) error {
 	return nil
 }
 
+// AddClonedSegments records the segments cloned from other collection along with their segment indexes,
+// the segment indexes are persisted first so that the cloned segments are never seen unindexed.
+func (m *meta) AddClonedSegments(ctx context.Context, segments []*SegmentInfo, segIndexes []*model.SegmentIndex) error {
+	log := log.Ctx(ctx)
+	m.Lock()
+	defer m.Unlock()
+	if len(segIndexes) > 0 {
+		if err := m.catalog.AlterSegmentIndexes(m.ctx, segIndexes); err != nil {
+			log.Warn("meta update: adding cloned segment indexes failed", zap.Error(err))
+			return err
+		}
+	}
+	infos := make([]*datapb.SegmentInfo, 0, len(segments))
+	binlogs := make([]metastore.BinlogsIncrement, 0, len(segments))
+	for _, segment := range segments {
+		infos = append(infos, segment.SegmentInfo)
+		binlogs = append(binlogs, metastore.BinlogsIncrement{Segment: segment.SegmentInfo})
+	}
+	if err := m.catalog.AlterSegments(m.ctx, infos, binlogs...); err != nil {
+		log.Warn("meta update: adding cloned segments failed", zap.Error(err))
+		return err
+	}
+	for _, segment := range segments {
+		m.segments.SetSegment(segment.GetID(), segment)
+		metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String(), segment.GetLevel().String()).Inc()
+	}
+	for _, segIdx := range segIndexes {
+		m.updateSegmentIndex(segIdx)
+	}
+	log.Info("meta update: adding cloned segments - complete", zap.Int("segments", len(segments)),
+		zap.Int("segmentIndexes", len(segIndexes)))
+	return nil
+}
+
+// AddSnapshot records the snapshot of the collection
+func (m *meta) AddSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", snapshot.GetSnapshotID()))
+	m.Lock()
+	defer m.Unlock()
+	if err := m.catalog.SaveSnapshot(m.ctx, snapshot); err != nil {
+		log.Warn("meta update: adding snapshot failed", zap.Error(err))
+		return err
+	}
+	m.snapshots[snapshot.GetSnapshotID()] = snapshot
+	log.Info("meta update: adding snapshot - complete", zap.Int("segments", len(snapshot.GetSegments())),
+		zap.Int("segmentIndexes", len(snapshot.GetSegmentIndexes())))
+	return nil
+}
+
+// GetSnapshot returns the snapshot by id, nil if not found.
+// The returned snapshot is shared and should be treated as read-only.
+func (m *meta) GetSnapshot(snapshotID UniqueID) *datapb.CollectionSnapshot {
+	m.RLock()
+	defer m.RUnlock()
+	return m.snapshots[snapshotID]
+}
+
+// GetSnapshots returns all snapshots, the returned snapshots are shared and should be treated as read-only.
+func (m *meta) GetSnapshots() []*datapb.CollectionSnapshot {
+	m.RLock()
+	defer m.RUnlock()
+	return lo.Values(m.snapshots)
+}
+
+// DropSnapshot removes the snapshot, the binlogs and index files it refers to are garbage collected later
+// if no segment uses them.
+func (m *meta) DropSnapshot(ctx context.Context, snapshotID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", snapshotID))
+	m.Lock()
+	defer m.Unlock()
+	if err := m.catalog.DropSnapshot(m.ctx, snapshotID); err != nil {
+		log.Warn("meta update: dropping snapshot failed", zap.Error(err))
+		return err
+	}
+	delete(m.snapshots, snapshotID)
+	log.Info("meta update: dropping snapshot - complete")
+	return nil
+}
+
 // DropSegment remove segment with provided id, etcd persistence also removed
 func (m *meta) DropSegment(segmentID UniqueID) error {
 	log.Debug("meta update: dropping segment", zap.Int64("segmentID", segmentID))
@@ -103,6 +103,19 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.Error(err)
 	})
 
+	suite.Run("ListSnapshots_fails", func() {
+		defer suite.resetMock()
+
+		suite.catalog.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{}, nil)
+		suite.catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(map[string]*msgpb.MsgPosition{}, nil)
+		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
+		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, errors.New("mock"))
+
+		_, err := newMeta(ctx, suite.catalog, nil)
+		suite.Error(err)
+	})
+
 	suite.Run("ok", func() {
 		defer suite.resetMock()
 
@@ -136,10 +149,17 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 				IndexID:   1,
 			},
 		}, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return([]*datapb.CollectionSnapshot{
+			{
+				SnapshotID:   10,
+				CollectionID: 1,
+			},
+		}, nil)
 
 		meta, err := newMeta(ctx, suite.catalog, nil)
 		suite.NoError(err)
 		suite.NotNil(meta)
+		suite.NotNil(meta.GetSnapshot(10))
 
 		suite.MetricsEqual(metrics.DataCoordNumSegments.WithLabelValues(metrics.FlushedSegmentLabel, datapb.SegmentLevel_Legacy.String()), 1)
 	})
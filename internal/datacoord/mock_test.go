@@ -315,6 +315,50 @@ func (m *mockRootCoordClient) RenameCollection(ctx context.Context, req *milvusp
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListDroppedCollections(ctx context.Context, req *internalpb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) UndropCollection(ctx context.Context, req *internalpb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) UndropPartition(ctx context.Context, req *internalpb.UndropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -419,6 +463,18 @@ func (m *mockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.Li
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordClient) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) BatchAlterAliases(ctx context.Context, in *internalpb.BatchAlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -730,6 +786,34 @@ func (m *mockRootCoordClient) GetCredential(ctx context.Context, req *rootcoordp
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) CreateAPIKey(ctx context.Context, req *internalpb.APIKeyInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ReportLoginFailure(ctx context.Context, req *internalpb.ReportLoginFailureRequest, opts ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("implement me")
 }
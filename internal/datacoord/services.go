@@ -19,11 +19,13 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
@@ -32,7 +34,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -1610,10 +1614,261 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	if req.GetSchema() != nil && len(req.GetSchema().GetFields()) > len(clonedColl.Schema.GetFields()) {
+		// fields are added to the schema, seal the growing segments so that each segment
+		// contains the rows of the same schema, the binlogs of the sealed ones lack the new fields.
+		if _, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil, false); err != nil {
+			log.Warn("failed to seal segments after adding fields",
+				zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return merr.Success(), nil
 }
 
+// CloneCollection creates the segments of the cloned collection, which share the binlogs and index files of the
+// flushed segments of the source collection at the timestamp. The deltalogs flushed after the timestamp are excluded,
+// the rows still buffered in the datanodes at the timestamp aren't cloned.
+// The segments are cloned from the snapshot instead if the snapshot is specified.
+func (s *Server) CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("sourceCollectionID", req.GetSourceCollectionID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("snapshotID", req.GetSnapshotID()),
+		zap.Uint64("ts", req.GetTimestamp()),
+	)
+	log.Info("receive clone collection request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	sourceIndexes, sources, sourceSegIndexes, err := s.getCloneSources(req)
+	if err != nil {
+		log.Warn("failed to get the sources to clone", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	indexIDs, err := s.cloneCollectionIndexes(ctx, req, sourceIndexes)
+	if err != nil {
+		log.Warn("failed to clone indexes", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	// the request is retried by rootcoord, skip the segments cloned before
+	cloned := typeutil.NewUniqueSet()
+	for _, segment := range s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == req.GetCollectionID() && segment.GetClonedFrom() != 0
+	}) {
+		cloned.Insert(segment.GetClonedFrom())
+	}
+
+	segments := make([]*SegmentInfo, 0, len(sources))
+	segIndexes := make([]*model.SegmentIndex, 0)
+	for _, source := range sources {
+		if cloned.Contain(source.GetID()) {
+			continue
+		}
+		partitionID, ok := req.GetPartitionIDs()[source.GetPartitionID()]
+		if !ok {
+			// the partition is dropped
+			continue
+		}
+		channel, ok := req.GetChannels()[source.GetInsertChannel()]
+		if !ok {
+			err := merr.WrapErrChannelNotFound(source.GetInsertChannel(), "no channel of the cloned collection maps to it")
+			log.Warn("failed to clone segment", zap.Int64("segmentID", source.GetID()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		segmentID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return merr.Status(err), nil
+		}
+		// the deletes are consumed from the channel of the cloned collection since it's created
+		position := toMsgPosition(channel, req.GetStartPositions())
+		if position != nil {
+			position.Timestamp = req.GetCreateTimestamp()
+		}
+		segments = append(segments, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:             segmentID,
+			CollectionID:   req.GetCollectionID(),
+			PartitionID:    partitionID,
+			InsertChannel:  channel,
+			NumOfRows:      source.GetNumOfRows(),
+			State:          commonpb.SegmentState_Flushed,
+			MaxRowNum:      source.GetMaxRowNum(),
+			LastExpireTime: source.GetLastExpireTime(),
+			StartPosition:  position,
+			DmlPosition:    position,
+			Binlogs:        cloneFieldBinlogs(source.GetBinlogs(), math.MaxUint64),
+			Statslogs:      cloneFieldBinlogs(source.GetStatslogs(), math.MaxUint64),
+			Deltalogs:      cloneFieldBinlogs(source.GetDeltalogs(), req.GetTimestamp()),
+			Level:          source.GetLevel(),
+			StorageVersion: source.GetStorageVersion(),
+			ClonedFrom:     source.GetID(),
+		}))
+
+		for _, segIdx := range sourceSegIndexes[source.GetID()] {
+			indexID, ok := indexIDs[segIdx.IndexID]
+			if !ok || segIdx.IndexState != commonpb.IndexState_Finished {
+				continue
+			}
+			buildID, err := s.allocator.allocID(ctx)
+			if err != nil {
+				return merr.Status(err), nil
+			}
+			clonedIdx := model.CloneSegmentIndex(segIdx)
+			clonedIdx.SourceBuildID, clonedIdx.SourcePartitionID, clonedIdx.SourceSegmentID = segIdx.FilesBuildID()
+			clonedIdx.SegmentID = segmentID
+			clonedIdx.CollectionID = req.GetCollectionID()
+			clonedIdx.PartitionID = partitionID
+			clonedIdx.IndexID = indexID
+			clonedIdx.BuildID = buildID
+			segIndexes = append(segIndexes, clonedIdx)
+		}
+	}
+
+	if err := s.meta.AddClonedSegments(ctx, segments, segIndexes); err != nil {
+		log.Warn("failed to add cloned segments", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("clone collection done", zap.Int("segments", len(segments)), zap.Int("segmentIndexes", len(segIndexes)))
+	return merr.Success(), nil
+}
+
+// getCloneSources returns the indexes, the segments and the segment indexes to clone, which come from
+// the snapshot if it's specified, otherwise from the flushed segments of the source collection at the timestamp.
+func (s *Server) getCloneSources(req *datapb.CloneCollectionRequest) ([]*model.Index, []*SegmentInfo, map[UniqueID][]*model.SegmentIndex, error) {
+	segIndexes := make(map[UniqueID][]*model.SegmentIndex)
+	if req.GetSnapshotID() != 0 {
+		snapshot := s.meta.GetSnapshot(req.GetSnapshotID())
+		if snapshot == nil {
+			return nil, nil, nil, merr.WrapErrParameterInvalidMsg("snapshot %d not found", req.GetSnapshotID())
+		}
+		indexes := lo.Map(snapshot.GetIndexes(), func(index *indexpb.FieldIndex, _ int) *model.Index {
+			return model.UnmarshalIndexModel(index)
+		})
+		segments := lo.Map(snapshot.GetSegments(), func(segment *datapb.SegmentInfo, _ int) *SegmentInfo {
+			return NewSegmentInfo(segment)
+		})
+		for _, segIdx := range snapshot.GetSegmentIndexes() {
+			segIndexes[segIdx.GetSegmentID()] = append(segIndexes[segIdx.GetSegmentID()], model.UnmarshalSegmentIndexModel(segIdx))
+		}
+		return indexes, segments, segIndexes, nil
+	}
+
+	segments := s.selectSnapshotSegments(req.GetSourceCollectionID(), req.GetTimestamp())
+	for _, segment := range segments {
+		segIndexes[segment.GetID()] = s.meta.GetSegmentIndexes(segment.GetID())
+	}
+	return s.meta.GetIndexesForCollection(req.GetSourceCollectionID(), ""), segments, segIndexes, nil
+}
+
+// selectSnapshotSegments selects the segments of the collection flushed at the timestamp.
+func (s *Server) selectSnapshotSegments(collectionID UniqueID, ts Timestamp) []*SegmentInfo {
+	return s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting() && !segment.GetIsFake() &&
+			segment.GetDmlPosition().GetTimestamp() <= ts
+	})
+}
+
+// cloneCollectionIndexes creates the source indexes on the cloned collection,
+// returns the mapping from the source index id to the cloned one.
+func (s *Server) cloneCollectionIndexes(ctx context.Context, req *datapb.CloneCollectionRequest, sourceIndexes []*model.Index) (map[UniqueID]UniqueID, error) {
+	existed := lo.SliceToMap(s.meta.GetIndexesForCollection(req.GetCollectionID(), ""), func(index *model.Index) (string, UniqueID) {
+		return index.IndexName, index.IndexID
+	})
+	indexIDs := make(map[UniqueID]UniqueID)
+	for _, index := range sourceIndexes {
+		if indexID, ok := existed[index.IndexName]; ok {
+			indexIDs[index.IndexID] = indexID
+			continue
+		}
+		indexID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cloned := model.CloneIndex(index)
+		cloned.CollectionID = req.GetCollectionID()
+		cloned.IndexID = indexID
+		cloned.CreateTime = req.GetCreateTimestamp()
+		if err := s.meta.CreateIndex(cloned); err != nil {
+			return nil, err
+		}
+		indexIDs[index.IndexID] = indexID
+	}
+	return indexIDs, nil
+}
+
+// CreateSnapshot records the flushed segments of the collection at the timestamp and the indexes built on them.
+// The binlogs and index files the snapshot refers to are protected from gc until the snapshot is dropped.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("snapshotID", req.GetSnapshotID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Uint64("ts", req.GetTimestamp()),
+	)
+	log.Info("receive create snapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if s.meta.GetSnapshot(req.GetSnapshotID()) != nil {
+		// the request is retried by rootcoord
+		log.Info("snapshot already exists")
+		return merr.Success(), nil
+	}
+
+	snapshot := &datapb.CollectionSnapshot{
+		SnapshotID:   req.GetSnapshotID(),
+		CollectionID: req.GetCollectionID(),
+		Timestamp:    req.GetTimestamp(),
+		Indexes: lo.Map(s.meta.GetIndexesForCollection(req.GetCollectionID(), ""), func(index *model.Index, _ int) *indexpb.FieldIndex {
+			return model.MarshalIndexModel(index)
+		}),
+	}
+	for _, segment := range s.selectSnapshotSegments(req.GetCollectionID(), req.GetTimestamp()) {
+		info := proto.Clone(segment.SegmentInfo).(*datapb.SegmentInfo)
+		info.Deltalogs = cloneFieldBinlogs(segment.GetDeltalogs(), req.GetTimestamp())
+		snapshot.Segments = append(snapshot.Segments, info)
+		for _, segIdx := range s.meta.GetSegmentIndexes(segment.GetID()) {
+			if segIdx.IndexState != commonpb.IndexState_Finished {
+				continue
+			}
+			// the snapshot always refers to the segment index owning the index files
+			segIdx.SourceBuildID, segIdx.SourcePartitionID, segIdx.SourceSegmentID = segIdx.FilesBuildID()
+			snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, model.MarshalSegmentIndexModel(segIdx))
+		}
+	}
+
+	if err := s.meta.AddSnapshot(ctx, snapshot); err != nil {
+		log.Warn("failed to add snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("create snapshot done", zap.Int("segments", len(snapshot.GetSegments())),
+		zap.Int("segmentIndexes", len(snapshot.GetSegmentIndexes())))
+	return merr.Success(), nil
+}
+
+// DropSnapshot removes the snapshot, the binlogs and index files only referred by it are garbage collected later.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", req.GetSnapshotID()))
+	log.Info("receive drop snapshot request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.DropSnapshot(ctx, req.GetSnapshotID()); err != nil {
+		log.Warn("failed to drop snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("drop snapshot done")
+	return merr.Success(), nil
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -62,6 +63,21 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
 	})
+
+	t.Run("test add field", func(t *testing.T) {
+		m := &meta{collections: map[UniqueID]*collectionInfo{
+			1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+		}}
+		s := &Server{meta: m, segmentManager: &SegmentManager{meta: m}}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}}},
+		}
+		resp, err := s.BroadcastAlteredCollection(context.Background(), req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Len(t, s.meta.collections[1].Schema.GetFields(), 2)
+	})
 }
 
 func TestServer_GcConfirm(t *testing.T) {
@@ -96,6 +112,217 @@ func TestServer_GcConfirm(t *testing.T) {
 	})
 }
 
+func TestServer_CloneCollection(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.CloneCollection(context.TODO(), &datapb.CloneCollectionRequest{SourceCollectionID: 100, CollectionID: 200})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.meta.CreateIndex(&model.Index{CollectionID: 100, FieldID: 2, IndexID: 1000, IndexName: "idx"})
+		assert.NoError(t, err)
+		for _, info := range []*datapb.SegmentInfo{
+			{
+				ID:            1,
+				CollectionID:  100,
+				PartitionID:   10,
+				InsertChannel: "ch0",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				DmlPosition:   &msgpb.MsgPosition{Timestamp: 100},
+				Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 100, LogPath: metautil.BuildInsertLogPath("a", 100, 10, 1, 1, 901)},
+				}}},
+				Deltalogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 1, TimestampTo: 150, LogPath: metautil.BuildDeltaLogPath("a", 100, 10, 1, 902)},
+					{EntriesNum: 1, TimestampTo: 250, LogPath: metautil.BuildDeltaLogPath("a", 100, 10, 1, 903)},
+				}}},
+			},
+			// flushed after the timestamp
+			{
+				ID:            2,
+				CollectionID:  100,
+				PartitionID:   10,
+				InsertChannel: "ch0",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				DmlPosition:   &msgpb.MsgPosition{Timestamp: 300},
+			},
+			{
+				ID:            3,
+				CollectionID:  100,
+				PartitionID:   10,
+				InsertChannel: "ch0",
+				State:         commonpb.SegmentState_Growing,
+			},
+		} {
+			err = svr.meta.AddSegment(context.TODO(), NewSegmentInfo(info))
+			assert.NoError(t, err)
+		}
+		err = svr.meta.AddSegmentIndex(&model.SegmentIndex{SegmentID: 1, CollectionID: 100, PartitionID: 10, IndexID: 1000, BuildID: 2000})
+		assert.NoError(t, err)
+		err = svr.meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: 2000, State: commonpb.IndexState_Finished, IndexFileKeys: []string{"file"}})
+		assert.NoError(t, err)
+
+		req := &datapb.CloneCollectionRequest{
+			SourceCollectionID: 100,
+			CollectionID:       200,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch0": "ch1"},
+			Timestamp:          200,
+		}
+		// retried requests clone the segments once
+		for i := 0; i < 2; i++ {
+			resp, err := svr.CloneCollection(context.TODO(), req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		}
+
+		segments := svr.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetCollectionID() == 200 })
+		require.Equal(t, 1, len(segments))
+		segment := segments[0]
+		assert.Equal(t, int64(1), segment.GetClonedFrom())
+		assert.Equal(t, int64(20), segment.GetPartitionID())
+		assert.Equal(t, "ch1", segment.GetInsertChannel())
+		assert.Equal(t, req.GetCollectionID(), segment.GetCollectionID())
+		assert.Equal(t, metautil.BuildInsertLogPath("a", 100, 10, 1, 1, 901), segment.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, 1, len(segment.GetDeltalogs()[0].GetBinlogs()))
+
+		indexes := svr.meta.GetIndexesForCollection(200, "")
+		require.Equal(t, 1, len(indexes))
+		segIndexes := svr.meta.GetSegmentIndexes(segment.GetID())
+		require.Equal(t, 1, len(segIndexes))
+		assert.Equal(t, indexes[0].IndexID, segIndexes[0].IndexID)
+		assert.Equal(t, commonpb.IndexState_Finished, segIndexes[0].IndexState)
+		buildID, partitionID, segmentID := segIndexes[0].FilesBuildID()
+		assert.Equal(t, int64(2000), buildID)
+		assert.Equal(t, int64(10), partitionID)
+		assert.Equal(t, int64(1), segmentID)
+
+		// the index files are still shared by the cloned segment after the source one is removed
+		err = svr.meta.RemoveSegmentIndex(100, 10, 1, 1000, 2000)
+		assert.NoError(t, err)
+		canRecycle, segIdx := svr.meta.CleanSegmentIndex(2000)
+		assert.False(t, canRecycle)
+		assert.Nil(t, segIdx)
+	})
+}
+
+func TestServer_Snapshot(t *testing.T) {
+	t.Run("closed server", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.CreateSnapshot(context.TODO(), &datapb.CreateSnapshotRequest{SnapshotID: 1, CollectionID: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		resp, err = s.DropSnapshot(context.TODO(), &datapb.DropSnapshotRequest{SnapshotID: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		err := svr.meta.CreateIndex(&model.Index{CollectionID: 100, FieldID: 2, IndexID: 1000, IndexName: "idx"})
+		assert.NoError(t, err)
+		for _, info := range []*datapb.SegmentInfo{
+			{
+				ID:            1,
+				CollectionID:  100,
+				PartitionID:   10,
+				InsertChannel: "ch0",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				DmlPosition:   &msgpb.MsgPosition{Timestamp: 100},
+				Binlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 100, LogPath: metautil.BuildInsertLogPath("a", 100, 10, 1, 1, 901)},
+				}}},
+				Deltalogs: []*datapb.FieldBinlog{{FieldID: 0, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 1, TimestampTo: 150, LogPath: metautil.BuildDeltaLogPath("a", 100, 10, 1, 902)},
+					{EntriesNum: 1, TimestampTo: 250, LogPath: metautil.BuildDeltaLogPath("a", 100, 10, 1, 903)},
+				}}},
+			},
+			// flushed after the timestamp
+			{
+				ID:            2,
+				CollectionID:  100,
+				PartitionID:   10,
+				InsertChannel: "ch0",
+				NumOfRows:     100,
+				State:         commonpb.SegmentState_Flushed,
+				DmlPosition:   &msgpb.MsgPosition{Timestamp: 300},
+			},
+		} {
+			err = svr.meta.AddSegment(context.TODO(), NewSegmentInfo(info))
+			assert.NoError(t, err)
+		}
+		err = svr.meta.AddSegmentIndex(&model.SegmentIndex{SegmentID: 1, CollectionID: 100, PartitionID: 10, IndexID: 1000, BuildID: 2000})
+		assert.NoError(t, err)
+		err = svr.meta.FinishTask(&indexpb.IndexTaskInfo{BuildID: 2000, State: commonpb.IndexState_Finished, IndexFileKeys: []string{"file"}})
+		assert.NoError(t, err)
+
+		// retried requests create the snapshot once
+		for i := 0; i < 2; i++ {
+			resp, err := svr.CreateSnapshot(context.TODO(), &datapb.CreateSnapshotRequest{SnapshotID: 1, CollectionID: 100, Timestamp: 200})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		}
+		snapshot := svr.meta.GetSnapshot(1)
+		require.NotNil(t, snapshot)
+		require.Equal(t, 1, len(snapshot.GetSegments()))
+		assert.Equal(t, int64(1), snapshot.GetSegments()[0].GetID())
+		assert.Equal(t, 1, len(snapshot.GetSegments()[0].GetDeltalogs()[0].GetBinlogs()))
+		assert.Equal(t, 1, len(snapshot.GetIndexes()))
+		require.Equal(t, 1, len(snapshot.GetSegmentIndexes()))
+		assert.Equal(t, int64(2000), snapshot.GetSegmentIndexes()[0].GetSourceBuildID())
+
+		// the source collection is dropped, the binlogs and index files are protected by the snapshot
+		err = svr.meta.RemoveSegmentIndex(100, 10, 1, 1000, 2000)
+		assert.NoError(t, err)
+		canRecycle, _ := svr.meta.CleanSegmentIndex(2000)
+		assert.False(t, canRecycle)
+		logs := getSnapshotLogs(svr.meta)
+		assert.Equal(t, 2, len(logs))
+
+		// restore the snapshot
+		resp, err := svr.CloneCollection(context.TODO(), &datapb.CloneCollectionRequest{
+			SourceCollectionID: 100,
+			CollectionID:       200,
+			SnapshotID:         1,
+			PartitionIDs:       map[int64]int64{10: 20},
+			Channels:           map[string]string{"ch0": "ch1"},
+			Timestamp:          200,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		segments := svr.meta.SelectSegments(func(segment *SegmentInfo) bool { return segment.GetCollectionID() == 200 })
+		require.Equal(t, 1, len(segments))
+		assert.Equal(t, int64(1), segments[0].GetClonedFrom())
+		assert.Equal(t, int64(20), segments[0].GetPartitionID())
+		segIndexes := svr.meta.GetSegmentIndexes(segments[0].GetID())
+		require.Equal(t, 1, len(segIndexes))
+		buildID, _, _ := segIndexes[0].FilesBuildID()
+		assert.Equal(t, int64(2000), buildID)
+
+		resp, err = svr.CloneCollection(context.TODO(), &datapb.CloneCollectionRequest{SnapshotID: 2, CollectionID: 300})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+
+		resp, err = svr.DropSnapshot(context.TODO(), &datapb.DropSnapshotRequest{SnapshotID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Nil(t, svr.meta.GetSnapshot(1))
+		assert.Empty(t, getSnapshotLogs(svr.meta))
+	})
+}
+
 func TestGetRecoveryInfoV2(t *testing.T) {
 	t.Run("test get recovery info with no segments", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -218,6 +219,24 @@ func mergeFieldBinlogs(currentBinlogs []*datapb.FieldBinlog, newBinlogs []*datap
 	return currentBinlogs
 }
 
+// cloneFieldBinlogs deep copies the binlogs which end before or at the timestamp.
+func cloneFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog, ts Timestamp) []*datapb.FieldBinlog {
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		cloned := &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID()}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampTo() > ts {
+				continue
+			}
+			cloned.Binlogs = append(cloned.Binlogs, proto.Clone(binlog).(*datapb.Binlog))
+		}
+		if len(cloned.GetBinlogs()) > 0 {
+			ret = append(ret, cloned)
+		}
+	}
+	return ret
+}
+
 func calculateL0SegmentSize(fields []*datapb.FieldBinlog) float64 {
 	size := int64(0)
 	for _, field := range fields {
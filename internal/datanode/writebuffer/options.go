@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/broker"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -26,6 +27,7 @@ type writeBufferOption struct {
 
 	pkStatsFactory metacache.PkStatsFactory
 	metaWriter     syncmgr.MetaWriter
+	broker         broker.Broker
 }
 
 func defaultWBOption() *writeBufferOption {
@@ -63,6 +65,13 @@ func WithMetaWriter(writer syncmgr.MetaWriter) WriteBufferOption {
 	}
 }
 
+// WithBroker sets the broker to fetch the latest schema after fields added to the collection.
+func WithBroker(broker broker.Broker) WriteBufferOption {
+	return func(opt *writeBufferOption) {
+		opt.broker = broker
+	}
+}
+
 func WithSyncPolicy(policy SyncPolicy) WriteBufferOption {
 	return func(opt *writeBufferOption) {
 		opt.syncPolicies = append(opt.syncPolicies, policy)
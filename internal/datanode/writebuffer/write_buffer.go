@@ -85,6 +85,9 @@ type writeBufferBase struct {
 	syncMgr    syncmgr.SyncManager
 	broker     broker.Broker
 	buffers    map[int64]*segmentBuffer // segmentID => segmentBuffer
+	// the schemas of the segments created before fields added to the collection,
+	// all the binlogs of a segment are written with the same schema
+	segmentSchemas map[int64]*schemapb.CollectionSchema
 
 	syncPolicies   []SyncPolicy
 	checkpoint     *msgpb.MsgPosition
@@ -104,7 +107,9 @@ func newWriteBufferBase(channel string, metacache metacache.MetaCache, storageV2
 		collSchema:     metacache.Schema(),
 		syncMgr:        syncMgr,
 		metaWriter:     option.metaWriter,
+		broker:         option.broker,
 		buffers:        make(map[int64]*segmentBuffer),
+		segmentSchemas: make(map[int64]*schemapb.CollectionSchema),
 		metaCache:      metacache,
 		syncPolicies:   option.syncPolicies,
 		flushTimestamp: flushTs,
@@ -222,7 +227,7 @@ func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64) *segmentBuffer {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
 		var err error
-		buffer, err = newSegmentBuffer(segmentID, wb.collSchema)
+		buffer, err = newSegmentBuffer(segmentID, wb.segmentSchema(segmentID))
 		if err != nil {
 			// TODO avoid panic here
 			panic(err)
@@ -233,6 +238,56 @@ func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64) *segmentBuffer {
 	return buffer
 }
 
+// segmentSchema returns the schema the segment is written with.
+func (wb *writeBufferBase) segmentSchema(segmentID int64) *schemapb.CollectionSchema {
+	if schema, ok := wb.segmentSchemas[segmentID]; ok {
+		return schema
+	}
+	return wb.collSchema
+}
+
+// refreshSchema fetches the latest schema of the collection if the insert messages contain
+// the fields added after the write buffer created. The existing segments keep the old schema.
+// **NOTE** shall be invoked within mutex protection
+func (wb *writeBufferBase) refreshSchema(insertMsgs []*msgstream.InsertMsg) error {
+	fieldIDs := typeutil.NewSet(lo.Map(wb.collSchema.GetFields(), func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})...)
+	hasNewField := lo.ContainsBy(insertMsgs, func(msg *msgstream.InsertMsg) bool {
+		return lo.ContainsBy(msg.GetFieldsData(), func(fieldData *schemapb.FieldData) bool {
+			return !fieldIDs.Contain(fieldData.GetFieldId())
+		})
+	})
+	if !hasNewField || wb.broker == nil {
+		return nil
+	}
+
+	resp, err := wb.broker.DescribeCollection(context.Background(), wb.collectionID, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to fetch the latest schema", zap.Int64("collectionID", wb.collectionID), zap.Error(err))
+		return err
+	}
+	if len(resp.GetSchema().GetFields()) <= len(wb.collSchema.GetFields()) {
+		return nil
+	}
+
+	existing := typeutil.NewSet(wb.metaCache.GetSegmentIDsBy()...)
+	for segmentID := range wb.segmentSchemas {
+		if !existing.Contain(segmentID) {
+			delete(wb.segmentSchemas, segmentID)
+		}
+	}
+	for segmentID := range existing {
+		if _, ok := wb.segmentSchemas[segmentID]; !ok {
+			wb.segmentSchemas[segmentID] = wb.collSchema
+		}
+	}
+	log.Info("schema of write buffer updated", zap.String("channel", wb.channelName),
+		zap.Int("oldFieldNum", len(wb.collSchema.GetFields())), zap.Int("newFieldNum", len(resp.GetSchema().GetFields())))
+	wb.collSchema = resp.GetSchema()
+	return nil
+}
+
 func (wb *writeBufferBase) yieldBuffer(segmentID int64) (*storage.InsertData, *storage.DeleteData, *msgpb.MsgPosition) {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
@@ -252,6 +307,9 @@ func (wb *writeBufferBase) bufferInsert(insertMsgs []*msgstream.InsertMsg, start
 	insertGroups := lo.GroupBy(insertMsgs, func(msg *msgstream.InsertMsg) int64 { return msg.GetSegmentID() })
 	segmentPKData := make(map[int64][]storage.FieldData)
 	segmentPartition := lo.SliceToMap(insertMsgs, func(msg *msgstream.InsertMsg) (int64, int64) { return msg.GetSegmentID(), msg.GetPartitionID() })
+	if err := wb.refreshSchema(insertMsgs); err != nil {
+		return nil, err
+	}
 
 	for segmentID, msgs := range insertGroups {
 		_, ok := wb.metaCache.GetSegmentByID(segmentID)
@@ -344,7 +402,7 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) syn
 	var syncTask syncmgr.Task
 	if params.Params.CommonCfg.EnableStorageV2.GetAsBool() {
 		arrowSchema := wb.storagev2Cache.ArrowSchema()
-		space, err := wb.storagev2Cache.GetOrCreateSpace(segmentID, SpaceCreatorFunc(segmentID, wb.collSchema, arrowSchema))
+		space, err := wb.storagev2Cache.GetOrCreateSpace(segmentID, SpaceCreatorFunc(segmentID, wb.segmentSchema(segmentID), arrowSchema))
 		if err != nil {
 			log.Warn("failed to get or create space", zap.Error(err))
 			return nil
@@ -360,7 +418,7 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) syn
 			WithStartPosition(startPos).
 			WithLevel(segmentInfo.Level()).
 			WithCheckpoint(wb.checkpoint).
-			WithSchema(wb.collSchema).
+			WithSchema(wb.segmentSchema(segmentID)).
 			WithBatchSize(batchSize).
 			WithMetaCache(wb.metaCache).
 			WithMetaWriter(wb.metaWriter).
@@ -385,7 +443,7 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) syn
 			WithStartPosition(startPos).
 			WithLevel(segmentInfo.Level()).
 			WithCheckpoint(wb.checkpoint).
-			WithSchema(wb.collSchema).
+			WithSchema(wb.segmentSchema(segmentID)).
 			WithBatchSize(batchSize).
 			WithMetaCache(wb.metaCache).
 			WithMetaWriter(wb.metaWriter).
@@ -185,6 +185,21 @@ func Test_NewClient(t *testing.T) {
 			retCheck(retNotNil, ret, err)
 		}
 
+		{
+			ret, err := client.CloneCollection(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.CreateSnapshot(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
+		{
+			ret, err := client.DropSnapshot(ctx, nil)
+			retCheck(retNotNil, ret, err)
+		}
+
 		{
 			ret, err := client.CheckHealth(ctx, nil)
 			retCheck(retNotNil, ret, err)
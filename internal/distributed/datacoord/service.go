@@ -423,6 +423,21 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, request *datapb
 	return s.dataCoord.BroadcastAlteredCollection(ctx, request)
 }
 
+// CloneCollection creates the segments of the cloned collection.
+func (s *Server) CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CloneCollection(ctx, req)
+}
+
+// CreateSnapshot records the segments and indexes of the collection snapshot.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CreateSnapshot(ctx, req)
+}
+
+// DropSnapshot removes the collection snapshot.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropSnapshot(ctx, req)
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.dataCoord.CheckHealth(ctx, req)
 }
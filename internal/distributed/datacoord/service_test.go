@@ -75,6 +75,9 @@ type MockDataCoord struct {
 	unsetIsImportingStateResp *commonpb.Status
 	markSegmentsDroppedResp   *commonpb.Status
 	broadCastResp             *commonpb.Status
+	cloneCollectionResp       *commonpb.Status
+	createSnapshotResp        *commonpb.Status
+	dropSnapshotResp          *commonpb.Status
 
 	createIndexResp           *commonpb.Status
 	describeIndexResp         *indexpb.DescribeIndexResponse
@@ -252,6 +255,18 @@ func (m *MockDataCoord) BroadcastAlteredCollection(ctx context.Context, req *dat
 	return m.broadCastResp, m.err
 }
 
+func (m *MockDataCoord) CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return m.cloneCollectionResp, m.err
+}
+
+func (m *MockDataCoord) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return m.createSnapshotResp, m.err
+}
+
+func (m *MockDataCoord) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return m.dropSnapshotResp, m.err
+}
+
 func (m *MockDataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -596,6 +611,33 @@ func Test_NewServer(t *testing.T) {
 			assert.NotNil(t, resp)
 		})
 
+		t.Run("clone collection", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				cloneCollectionResp: &commonpb.Status{},
+			}
+			resp, err := server.CloneCollection(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+
+		t.Run("CreateSnapshot", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				createSnapshotResp: &commonpb.Status{},
+			}
+			resp, err := server.CreateSnapshot(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+
+		t.Run("DropSnapshot", func(t *testing.T) {
+			server.dataCoord = &MockDataCoord{
+				dropSnapshotResp: &commonpb.Status{},
+			}
+			resp, err := server.DropSnapshot(ctx, nil)
+			assert.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+
 		t.Run("CheckHealth", func(t *testing.T) {
 			server.dataCoord = &MockDataCoord{}
 			ret, err := server.CheckHealth(ctx, nil)
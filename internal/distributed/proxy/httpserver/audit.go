@@ -0,0 +1,239 @@
+package httpserver
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// auditedProxy writes the audit records of the restful requests, which call the proxy without passing through
+// the grpc interceptors. Only the methods of the audited event classes are wrapped.
+type auditedProxy struct {
+	types.ProxyComponent
+}
+
+func audit[Resp any](ctx context.Context, method string, req interface{}, resp Resp, err error) (Resp, error) {
+	username, _ := proxy.GetCurUserFromContext(ctx)
+	accesslog.PrintAuditInfo(ctx, username, req, resp, err, method)
+	return resp, err
+}
+
+// auditDenied writes the audit record of the restful request denied by the privilege check, the method is named
+// after the request.
+func auditDenied(ctx context.Context, req interface{}, err error) {
+	method := strings.TrimSuffix(reflect.Indirect(reflect.ValueOf(req)).Type().Name(), "Request")
+	audit[interface{}](ctx, method, req, nil, err)
+}
+
+func (p *auditedProxy) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.AlterAlias(ctx, req)
+	return audit(ctx, "AlterAlias", req, resp, err)
+}
+
+func (p *auditedProxy) CreateAPIKey(ctx context.Context, req *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+	resp, err := p.ProxyComponent.CreateAPIKey(ctx, req)
+	return audit(ctx, "CreateAPIKey", req, resp, err)
+}
+
+func (p *auditedProxy) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateAlias(ctx, req)
+	return audit(ctx, "CreateAlias", req, resp, err)
+}
+
+func (p *auditedProxy) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateCollection(ctx, req)
+	return audit(ctx, "CreateCollection", req, resp, err)
+}
+
+func (p *auditedProxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateCredential(ctx, req)
+	return audit(ctx, "CreateCredential", req, resp, err)
+}
+
+func (p *auditedProxy) CreateIndex(ctx context.Context, req *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateIndex(ctx, req)
+	return audit(ctx, "CreateIndex", req, resp, err)
+}
+
+func (p *auditedProxy) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreatePartition(ctx, req)
+	return audit(ctx, "CreatePartition", req, resp, err)
+}
+
+func (p *auditedProxy) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateResourceGroup(ctx, req)
+	return audit(ctx, "CreateResourceGroup", req, resp, err)
+}
+
+func (p *auditedProxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.CreateRole(ctx, req)
+	return audit(ctx, "CreateRole", req, resp, err)
+}
+
+func (p *auditedProxy) Delete(ctx context.Context, req *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	resp, err := p.ProxyComponent.Delete(ctx, req)
+	return audit(ctx, "Delete", req, resp, err)
+}
+
+func (p *auditedProxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DeleteCredential(ctx, req)
+	return audit(ctx, "DeleteCredential", req, resp, err)
+}
+
+func (p *auditedProxy) DropAlias(ctx context.Context, req *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropAlias(ctx, req)
+	return audit(ctx, "DropAlias", req, resp, err)
+}
+
+func (p *auditedProxy) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropCollection(ctx, req)
+	return audit(ctx, "DropCollection", req, resp, err)
+}
+
+func (p *auditedProxy) DropIndex(ctx context.Context, req *milvuspb.DropIndexRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropIndex(ctx, req)
+	return audit(ctx, "DropIndex", req, resp, err)
+}
+
+func (p *auditedProxy) DropPartition(ctx context.Context, req *milvuspb.DropPartitionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropPartition(ctx, req)
+	return audit(ctx, "DropPartition", req, resp, err)
+}
+
+func (p *auditedProxy) DropResourceGroup(ctx context.Context, req *milvuspb.DropResourceGroupRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropResourceGroup(ctx, req)
+	return audit(ctx, "DropResourceGroup", req, resp, err)
+}
+
+func (p *auditedProxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.DropRole(ctx, req)
+	return audit(ctx, "DropRole", req, resp, err)
+}
+
+func (p *auditedProxy) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.GrantRowPolicy(ctx, req)
+	return audit(ctx, "GrantRowPolicy", req, resp, err)
+}
+
+func (p *auditedProxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	resp, err := p.ProxyComponent.Import(ctx, req)
+	return audit(ctx, "Import", req, resp, err)
+}
+
+func (p *auditedProxy) Insert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	resp, err := p.ProxyComponent.Insert(ctx, req)
+	return audit(ctx, "Insert", req, resp, err)
+}
+
+func (p *auditedProxy) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	resp, err := p.ProxyComponent.ListAPIKeys(ctx, req)
+	return audit(ctx, "ListAPIKeys", req, resp, err)
+}
+
+func (p *auditedProxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
+	resp, err := p.ProxyComponent.ListCredUsers(ctx, req)
+	return audit(ctx, "ListCredUsers", req, resp, err)
+}
+
+func (p *auditedProxy) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	resp, err := p.ProxyComponent.ListRowPolicies(ctx, req)
+	return audit(ctx, "ListRowPolicies", req, resp, err)
+}
+
+func (p *auditedProxy) LoadCollection(ctx context.Context, req *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.LoadCollection(ctx, req)
+	return audit(ctx, "LoadCollection", req, resp, err)
+}
+
+func (p *auditedProxy) LoadPartitions(ctx context.Context, req *milvuspb.LoadPartitionsRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.LoadPartitions(ctx, req)
+	return audit(ctx, "LoadPartitions", req, resp, err)
+}
+
+func (p *auditedProxy) OperatePrivilege(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.OperatePrivilege(ctx, req)
+	return audit(ctx, "OperatePrivilege", req, resp, err)
+}
+
+func (p *auditedProxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.OperateUserRole(ctx, req)
+	return audit(ctx, "OperateUserRole", req, resp, err)
+}
+
+func (p *auditedProxy) Query(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	resp, err := p.ProxyComponent.Query(ctx, req)
+	return audit(ctx, "Query", req, resp, err)
+}
+
+func (p *auditedProxy) ReleaseCollection(ctx context.Context, req *milvuspb.ReleaseCollectionRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.ReleaseCollection(ctx, req)
+	return audit(ctx, "ReleaseCollection", req, resp, err)
+}
+
+func (p *auditedProxy) ReleasePartitions(ctx context.Context, req *milvuspb.ReleasePartitionsRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.ReleasePartitions(ctx, req)
+	return audit(ctx, "ReleasePartitions", req, resp, err)
+}
+
+func (p *auditedProxy) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.RevokeAPIKey(ctx, req)
+	return audit(ctx, "RevokeAPIKey", req, resp, err)
+}
+
+func (p *auditedProxy) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.RevokeRowPolicy(ctx, req)
+	return audit(ctx, "RevokeRowPolicy", req, resp, err)
+}
+
+func (p *auditedProxy) Search(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	resp, err := p.ProxyComponent.Search(ctx, req)
+	return audit(ctx, "Search", req, resp, err)
+}
+
+func (p *auditedProxy) SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
+	resp, err := p.ProxyComponent.SelectGrant(ctx, req)
+	return audit(ctx, "SelectGrant", req, resp, err)
+}
+
+func (p *auditedProxy) SelectRole(ctx context.Context, req *milvuspb.SelectRoleRequest) (*milvuspb.SelectRoleResponse, error) {
+	resp, err := p.ProxyComponent.SelectRole(ctx, req)
+	return audit(ctx, "SelectRole", req, resp, err)
+}
+
+func (p *auditedProxy) SelectUser(ctx context.Context, req *milvuspb.SelectUserRequest) (*milvuspb.SelectUserResponse, error) {
+	resp, err := p.ProxyComponent.SelectUser(ctx, req)
+	return audit(ctx, "SelectUser", req, resp, err)
+}
+
+func (p *auditedProxy) TransferNode(ctx context.Context, req *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.TransferNode(ctx, req)
+	return audit(ctx, "TransferNode", req, resp, err)
+}
+
+func (p *auditedProxy) TransferReplica(ctx context.Context, req *milvuspb.TransferReplicaRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.TransferReplica(ctx, req)
+	return audit(ctx, "TransferReplica", req, resp, err)
+}
+
+func (p *auditedProxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	resp, err := p.ProxyComponent.UpdateCredential(ctx, req)
+	return audit(ctx, "UpdateCredential", req, resp, err)
+}
+
+func (p *auditedProxy) Upsert(ctx context.Context, req *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	resp, err := p.ProxyComponent.Upsert(ctx, req)
+	return audit(ctx, "Upsert", req, resp, err)
+}
+
+func (p *auditedProxy) QueryPages(ctx context.Context, req *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+	err := p.ProxyComponent.QueryPages(ctx, req, cursor, pageSize, send)
+	_, err = audit[interface{}](ctx, "QueryStream", req, nil, err)
+	return err
+}
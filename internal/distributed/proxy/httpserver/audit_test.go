@@ -0,0 +1,34 @@
+package httpserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestAuditedProxy(t *testing.T) {
+	ctx := context.Background()
+	mp := mocks.NewMockProxy(t)
+	p := &auditedProxy{ProxyComponent: mp}
+
+	req := &milvuspb.DropCollectionRequest{CollectionName: "coll1"}
+	mp.EXPECT().DropCollection(mock.Anything, req).Return(merr.Success(), nil).Once()
+	resp, err := p.DropCollection(ctx, req)
+	assert.NoError(t, merr.CheckRPCCall(resp, err))
+
+	mp.EXPECT().QueryPages(mock.Anything, mock.Anything, "", int64(10), mock.Anything).Return(merr.ErrServiceNotReady).Once()
+	err = p.QueryPages(ctx, &milvuspb.QueryRequest{}, "", 10, nil)
+	assert.ErrorIs(t, err, merr.ErrServiceNotReady)
+
+	// the methods not audited are called directly
+	mp.EXPECT().HasCollection(mock.Anything, mock.Anything).Return(&milvuspb.BoolResponse{Status: merr.Success(), Value: true}, nil).Once()
+	hasResp, err := p.HasCollection(ctx, &milvuspb.HasCollectionRequest{})
+	assert.NoError(t, merr.CheckRPCCall(hasResp, err))
+	assert.True(t, hasResp.GetValue())
+}
@@ -1,5 +1,12 @@
 package httpserver
 
+const (
+	// URIPrefixV1 is the route prefix of the RESTful api v1.
+	URIPrefixV1 = "/v1"
+	// ContextRequestBody is the key of the request body decoded by the request validator.
+	ContextRequestBody = "requestBody"
+)
+
 const (
 	ContextUsername               = "username"
 	VectorCollectionsPath         = "/vector/collections"
@@ -11,8 +18,66 @@ const (
 	VectorSearchPath              = "/vector/search"
 	VectorGetPath                 = "/vector/get"
 	VectorQueryPath               = "/vector/query"
+	VectorQueryStreamPath         = "/vector/query/stream"
 	VectorDeletePath              = "/vector/delete"
 
+	VectorCollectionsLoadPath      = "/vector/collections/load"
+	VectorCollectionsReleasePath   = "/vector/collections/release"
+	VectorCollectionsLoadStatePath = "/vector/collections/load_state"
+
+	VectorPartitionsPath        = "/vector/partitions"
+	VectorPartitionsHasPath     = "/vector/partitions/has"
+	VectorPartitionsCreatePath  = "/vector/partitions/create"
+	VectorPartitionsDropPath    = "/vector/partitions/drop"
+	VectorPartitionsLoadPath    = "/vector/partitions/load"
+	VectorPartitionsReleasePath = "/vector/partitions/release"
+
+	VectorIndexesCreatePath   = "/vector/indexes/create"
+	VectorIndexesDescribePath = "/vector/indexes/describe"
+	VectorIndexesDropPath     = "/vector/indexes/drop"
+
+	VectorAliasesPath         = "/vector/aliases"
+	VectorAliasesDescribePath = "/vector/aliases/describe"
+	VectorAliasesCreatePath   = "/vector/aliases/create"
+	VectorAliasesDropPath     = "/vector/aliases/drop"
+	VectorAliasesAlterPath    = "/vector/aliases/alter"
+
+	VectorUsersPath               = "/vector/users"
+	VectorUsersDescribePath       = "/vector/users/describe"
+	VectorUsersCreatePath         = "/vector/users/create"
+	VectorUsersUpdatePasswordPath = "/vector/users/update_password"
+	VectorUsersDropPath           = "/vector/users/drop"
+	VectorUsersGrantRolePath      = "/vector/users/grant_role"
+	VectorUsersRevokeRolePath     = "/vector/users/revoke_role"
+
+	VectorAPIKeysPath       = "/vector/api_keys"
+	VectorAPIKeysCreatePath = "/vector/api_keys/create"
+	VectorAPIKeysRevokePath = "/vector/api_keys/revoke"
+
+	VectorRowPoliciesPath       = "/vector/row_policies"
+	VectorRowPoliciesGrantPath  = "/vector/row_policies/grant"
+	VectorRowPoliciesRevokePath = "/vector/row_policies/revoke"
+
+	VectorRolesPath                = "/vector/roles"
+	VectorRolesDescribePath        = "/vector/roles/describe"
+	VectorRolesCreatePath          = "/vector/roles/create"
+	VectorRolesDropPath            = "/vector/roles/drop"
+	VectorRolesGrantPrivilegePath  = "/vector/roles/grant_privilege"
+	VectorRolesRevokePrivilegePath = "/vector/roles/revoke_privilege"
+
+	VectorResourceGroupsPath                = "/vector/resource_groups"
+	VectorResourceGroupsDescribePath        = "/vector/resource_groups/describe"
+	VectorResourceGroupsCreatePath          = "/vector/resource_groups/create"
+	VectorResourceGroupsDropPath            = "/vector/resource_groups/drop"
+	VectorResourceGroupsTransferNodePath    = "/vector/resource_groups/transfer_node"
+	VectorResourceGroupsTransferReplicaPath = "/vector/resource_groups/transfer_replica"
+
+	VectorImportPath      = "/vector/import"
+	VectorImportStatePath = "/vector/import/state"
+	VectorImportListPath  = "/vector/import/list"
+
+	OpenAPIPath = "/openapi.json"
+
 	ShardNumDefault = 1
 
 	EnableDynamic = true
@@ -21,9 +86,19 @@ const (
 
 	HTTPCollectionName   = "collectionName"
 	HTTPDbName           = "dbName"
+	HTTPPartitionName    = "partitionName"
+	HTTPPartitionNames   = "partitionNames"
+	HTTPFieldName        = "fieldName"
+	HTTPIndexName        = "indexName"
+	HTTPAliasName        = "aliasName"
+	HTTPUserName         = "userName"
+	HTTPRoleName         = "roleName"
+	HTTPResourceGroup    = "resourceGroup"
+	HTTPTaskID           = "taskId"
 	DefaultDbName        = "default"
 	DefaultIndexName     = "vector_idx"
 	DefaultOutputFields  = "*"
+	DefaultBatchSize     = 1000
 	HTTPHeaderAllowInt64 = "Accept-Type-Allow-Int64"
 	HTTPReturnCode       = "code"
 	HTTPReturnMessage    = "message"
@@ -41,6 +116,8 @@ const (
 
 	HTTPReturnDistance = "distance"
 
+	HTTPContentTypeNDJSON = "application/x-ndjson"
+
 	DefaultMetricType       = "L2"
 	DefaultPrimaryFieldName = "id"
 	DefaultVectorFieldName  = "vector"
@@ -15,10 +15,10 @@ type Handlers struct {
 	proxy types.ProxyComponent
 }
 
-// NewHandlers creates a new Handlers
+// NewHandlers creates a new Handlers, the requests calling the proxy are audited like the grpc ones
 func NewHandlers(proxy types.ProxyComponent) *Handlers {
 	return &Handlers{
-		proxy: proxy,
+		proxy: &auditedProxy{ProxyComponent: proxy},
 	}
 }
 
@@ -7,7 +7,6 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
-	"github.com/gin-gonic/gin/binding"
 	"github.com/golang/protobuf/proto"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
@@ -30,6 +29,7 @@ func checkAuthorization(ctx context.Context, c *gin.Context, req interface{}) er
 		}
 		_, authErr := proxy.PrivilegeInterceptor(ctx, req)
 		if authErr != nil {
+			auditDenied(ctx, req, authErr)
 			c.JSON(http.StatusForbidden, gin.H{HTTPReturnCode: merr.Code(authErr), HTTPReturnMessage: authErr.Error()})
 			return authErr
 		}
@@ -104,16 +104,19 @@ func (h *Handlers) hasCollection(ctx context.Context, c *gin.Context, dbName str
 }
 
 func (h *Handlers) RegisterRoutesToV1(router gin.IRouter) {
+	h.registerOpenAPI(router, v1Operations)
 	router.GET(VectorCollectionsPath, h.listCollections)
 	router.POST(VectorCollectionsCreatePath, h.createCollection)
 	router.GET(VectorCollectionsDescribePath, h.getCollectionDetails)
 	router.POST(VectorCollectionsDropPath, h.dropCollection)
 	router.POST(VectorQueryPath, h.query)
+	router.POST(VectorQueryStreamPath, h.queryStream)
 	router.POST(VectorGetPath, h.get)
 	router.POST(VectorDeletePath, h.delete)
 	router.POST(VectorInsertPath, h.insert)
 	router.POST(VectorUpsertPath, h.upsert)
 	router.POST(VectorSearchPath, h.search)
+	h.registerManageRoutesToV1(router)
 }
 
 func (h *Handlers) listCollections(c *gin.Context) {
@@ -153,7 +156,7 @@ func (h *Handlers) createCollection(c *gin.Context) {
 		PrimaryField: DefaultPrimaryFieldName,
 		VectorField:  DefaultVectorFieldName,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of create collection is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -333,7 +336,7 @@ func (h *Handlers) dropCollection(c *gin.Context) {
 	httpReq := DropCollectionReq{
 		DbName: DefaultDbName,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of drop collection is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -389,7 +392,7 @@ func (h *Handlers) query(c *gin.Context) {
 		Limit:        100,
 		OutputFields: []string{DefaultOutputFields},
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of query is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -448,12 +451,91 @@ func (h *Handlers) query(c *gin.Context) {
 	}
 }
 
+// queryStream writes the entities matching the filter as NDJSON, one entity per line, in the order of primary keys.
+// The entities are retrieved batch by batch and each batch is flushed once it's written,
+// the cursor is the primary key of the last entity received, the entities after it are returned.
+// The error after the first entity is written is returned as the last line.
+func (h *Handlers) queryStream(c *gin.Context) {
+	httpReq := QueryStreamReq{
+		DbName:       DefaultDbName,
+		OutputFields: []string{DefaultOutputFields},
+		BatchSize:    DefaultBatchSize,
+	}
+	if err := bindBody(c, &httpReq); err != nil {
+		log.Warn("high level restful api, the parameter of query stream is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	if httpReq.CollectionName == "" {
+		log.Warn("high level restful api, query stream require parameter: [collectionName], but miss")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+			HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [collectionName]",
+		})
+		return
+	}
+	req := milvuspb.QueryRequest{
+		DbName:             httpReq.DbName,
+		CollectionName:     httpReq.CollectionName,
+		Expr:               httpReq.Filter,
+		OutputFields:       httpReq.OutputFields,
+		GuaranteeTimestamp: BoundedTimestamp,
+	}
+	username, _ := c.Get(ContextUsername)
+	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	if err := checkAuthorization(ctx, c, &req); err != nil {
+		return
+	}
+	if !h.checkDatabase(ctx, c, req.DbName) {
+		return
+	}
+
+	allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+	started := false
+	encoder := json.NewEncoder(c.Writer)
+	err := h.proxy.QueryPages(ctx, &req, httpReq.Cursor, int64(httpReq.BatchSize), func(result *milvuspb.QueryResults) error {
+		rows, err := buildQueryResp(int64(0), result.OutputFields, result.FieldsData, nil, nil, allowJS)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("%s, error: %s", merr.ErrInvalidSearchResult.Error(), err.Error())
+		}
+		if !started {
+			c.Header("Content-Type", HTTPContentTypeNDJSON)
+			c.Status(http.StatusOK)
+			started = true
+		}
+		for _, row := range rows {
+			if err := encoder.Encode(row); err != nil {
+				return err
+			}
+		}
+		c.Writer.Flush()
+		return c.Request.Context().Err()
+	})
+	if err != nil {
+		log.Warn("high level restful api, fail to stream query result", zap.String("collection", req.CollectionName), zap.Bool("started", started), zap.Error(err))
+		if !started {
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+			return
+		}
+		_ = encoder.Encode(gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		c.Writer.Flush()
+		return
+	}
+	if !started {
+		c.Header("Content-Type", HTTPContentTypeNDJSON)
+		c.Status(http.StatusOK)
+	}
+}
+
 func (h *Handlers) get(c *gin.Context) {
 	httpReq := GetReq{
 		DbName:       DefaultDbName,
 		OutputFields: []string{DefaultOutputFields},
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of get is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -522,7 +604,7 @@ func (h *Handlers) delete(c *gin.Context) {
 	httpReq := DeleteReq{
 		DbName: DefaultDbName,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of delete is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -582,11 +664,11 @@ func (h *Handlers) insert(c *gin.Context) {
 	httpReq := InsertReq{
 		DbName: DefaultDbName,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		singleInsertReq := SingleInsertReq{
 			DbName: DefaultDbName,
 		}
-		if err = c.ShouldBindBodyWith(&singleInsertReq, binding.JSON); err != nil {
+		if err = bindBody(c, &singleInsertReq); err != nil {
 			log.Warn("high level restful api, the parameter of insert is incorrect", zap.Any("request", httpReq), zap.Error(err))
 			c.AbortWithStatusJSON(http.StatusOK, gin.H{
 				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -673,11 +755,11 @@ func (h *Handlers) upsert(c *gin.Context) {
 	httpReq := UpsertReq{
 		DbName: DefaultDbName,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		singleUpsertReq := SingleUpsertReq{
 			DbName: DefaultDbName,
 		}
-		if err = c.ShouldBindBodyWith(&singleUpsertReq, binding.JSON); err != nil {
+		if err = bindBody(c, &singleUpsertReq); err != nil {
 			log.Warn("high level restful api, the parameter of upsert is incorrect", zap.Any("request", httpReq), zap.Error(err))
 			c.AbortWithStatusJSON(http.StatusOK, gin.H{
 				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
@@ -770,7 +852,7 @@ func (h *Handlers) search(c *gin.Context) {
 		DbName: DefaultDbName,
 		Limit:  100,
 	}
-	if err := c.ShouldBindBodyWith(&httpReq, binding.JSON); err != nil {
+	if err := bindBody(c, &httpReq); err != nil {
 		log.Warn("high level restful api, the parameter of search is incorrect", zap.Any("request", httpReq), zap.Error(err))
 		c.AbortWithStatusJSON(http.StatusOK, gin.H{
 			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
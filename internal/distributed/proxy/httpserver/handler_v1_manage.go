@@ -0,0 +1,1122 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type requiredParam struct {
+	name     string
+	provided bool
+}
+
+func required(name string, provided bool) requiredParam {
+	return requiredParam{name: name, provided: provided}
+}
+
+// bindRequest binds the json body to httpReq, it aborts the request and returns false if failed.
+func bindRequest(c *gin.Context, httpReq interface{}, action string) bool {
+	if err := bindBody(c, httpReq); err != nil {
+		log.Warn("high level restful api, the parameter of "+action+" is incorrect", zap.Any("request", httpReq), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// checkRequiredParams aborts the request and returns false if any of the required parameters is missing.
+func checkRequiredParams(c *gin.Context, action string, params ...requiredParam) bool {
+	for _, param := range params {
+		if !param.provided {
+			names := strings.Join(lo.Map(params, func(p requiredParam, _ int) string { return p.name }), ", ")
+			log.Warn("high level restful api, " + action + " require parameters: [" + names + "], but miss")
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+				HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [" + names + "]",
+			})
+			return false
+		}
+	}
+	return true
+}
+
+// newContext returns the context carrying the user and the database, it checks the authorization of req and
+// the existence of the database, the database is not checked for the cluster level requests whose dbName is empty.
+func (h *Handlers) newContext(c *gin.Context, dbName string, req interface{}) (context.Context, bool) {
+	username, _ := c.Get(ContextUsername)
+	ctx := proxy.NewContextWithMetadata(c, username.(string), dbName)
+	if err := checkAuthorization(ctx, c, req); err != nil {
+		return nil, false
+	}
+	if dbName != "" && !h.checkDatabase(ctx, c, dbName) {
+		return nil, false
+	}
+	return ctx, true
+}
+
+// checkResponse writes the error and returns false if the proxy call failed.
+func checkResponse(c *gin.Context, resp any, err error) bool {
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return false
+	}
+	return true
+}
+
+func replyOK(c *gin.Context, data interface{}) {
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: data})
+}
+
+func (h *Handlers) registerManageRoutesToV1(router gin.IRouter) {
+	router.POST(VectorCollectionsLoadPath, h.loadCollection)
+	router.POST(VectorCollectionsReleasePath, h.releaseCollection)
+	router.GET(VectorCollectionsLoadStatePath, h.getLoadState)
+
+	router.GET(VectorPartitionsPath, h.listPartitions)
+	router.GET(VectorPartitionsHasPath, h.hasPartition)
+	router.POST(VectorPartitionsCreatePath, h.createPartition)
+	router.POST(VectorPartitionsDropPath, h.dropPartition)
+	router.POST(VectorPartitionsLoadPath, h.loadPartitions)
+	router.POST(VectorPartitionsReleasePath, h.releasePartitions)
+
+	router.POST(VectorIndexesCreatePath, h.createIndex)
+	router.GET(VectorIndexesDescribePath, h.describeIndex)
+	router.POST(VectorIndexesDropPath, h.dropIndex)
+
+	router.GET(VectorAliasesPath, h.listAliases)
+	router.GET(VectorAliasesDescribePath, h.describeAlias)
+	router.POST(VectorAliasesCreatePath, h.createAlias)
+	router.POST(VectorAliasesDropPath, h.dropAlias)
+	router.POST(VectorAliasesAlterPath, h.alterAlias)
+
+	router.GET(VectorUsersPath, h.listUsers)
+	router.GET(VectorUsersDescribePath, h.describeUser)
+	router.POST(VectorUsersCreatePath, h.createUser)
+	router.POST(VectorUsersUpdatePasswordPath, h.updatePassword)
+	router.POST(VectorUsersDropPath, h.dropUser)
+	router.POST(VectorUsersGrantRolePath, h.operateUserRole(milvuspb.OperateUserRoleType_AddUserToRole))
+	router.POST(VectorUsersRevokeRolePath, h.operateUserRole(milvuspb.OperateUserRoleType_RemoveUserFromRole))
+
+	router.GET(VectorAPIKeysPath, h.listAPIKeys)
+	router.POST(VectorAPIKeysCreatePath, h.createAPIKey)
+	router.POST(VectorAPIKeysRevokePath, h.revokeAPIKey)
+
+	router.GET(VectorRolesPath, h.listRoles)
+	router.GET(VectorRolesDescribePath, h.describeRole)
+	router.POST(VectorRolesCreatePath, h.createRole)
+	router.POST(VectorRolesDropPath, h.dropRole)
+	router.POST(VectorRolesGrantPrivilegePath, h.operatePrivilege(milvuspb.OperatePrivilegeType_Grant))
+	router.POST(VectorRolesRevokePrivilegePath, h.operatePrivilege(milvuspb.OperatePrivilegeType_Revoke))
+	router.GET(VectorRowPoliciesPath, h.listRowPolicies)
+	router.POST(VectorRowPoliciesGrantPath, h.grantRowPolicy)
+	router.POST(VectorRowPoliciesRevokePath, h.revokeRowPolicy)
+
+	router.GET(VectorResourceGroupsPath, h.listResourceGroups)
+	router.GET(VectorResourceGroupsDescribePath, h.describeResourceGroup)
+	router.POST(VectorResourceGroupsCreatePath, h.createResourceGroup)
+	router.POST(VectorResourceGroupsDropPath, h.dropResourceGroup)
+	router.POST(VectorResourceGroupsTransferNodePath, h.transferNode)
+	router.POST(VectorResourceGroupsTransferReplicaPath, h.transferReplica)
+
+	router.POST(VectorImportPath, h.importData)
+	router.GET(VectorImportStatePath, h.getImportState)
+	router.GET(VectorImportListPath, h.listImportTasks)
+}
+
+// --------------------- load & release --------------------- //
+
+func (h *Handlers) loadCollection(c *gin.Context) {
+	httpReq := LoadCollectionReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "load collection") ||
+		!checkRequiredParams(c, "load collection", required(HTTPCollectionName, httpReq.CollectionName != "")) {
+		return
+	}
+	req := milvuspb.LoadCollectionRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		ReplicaNumber:  httpReq.ReplicaNumber,
+		ResourceGroups: httpReq.ResourceGroups,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.LoadCollection(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) releaseCollection(c *gin.Context) {
+	httpReq := ReleaseCollectionReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "release collection") ||
+		!checkRequiredParams(c, "release collection", required(HTTPCollectionName, httpReq.CollectionName != "")) {
+		return
+	}
+	req := milvuspb.ReleaseCollectionRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ReleaseCollection(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// getLoadState returns the load state of the collection or the partitions, and the loading progress
+// if the loading is in progress, clients poll it to wait for the loading.
+func (h *Handlers) getLoadState(c *gin.Context) {
+	collectionName := c.Query(HTTPCollectionName)
+	if !checkRequiredParams(c, "get load state", required(HTTPCollectionName, collectionName != "")) {
+		return
+	}
+	req := milvuspb.GetLoadStateRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: collectionName,
+		PartitionNames: c.QueryArray(HTTPPartitionNames),
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	stateResp, err := h.proxy.GetLoadState(ctx, &req)
+	if !checkResponse(c, stateResp, err) {
+		return
+	}
+	progress := int64(0)
+	switch stateResp.GetState() {
+	case commonpb.LoadState_LoadStateLoaded:
+		progress = 100
+	case commonpb.LoadState_LoadStateLoading:
+		progressResp, err := h.proxy.GetLoadingProgress(ctx, &milvuspb.GetLoadingProgressRequest{
+			DbName:         req.DbName,
+			CollectionName: req.CollectionName,
+			PartitionNames: req.PartitionNames,
+		})
+		if !checkResponse(c, progressResp, err) {
+			return
+		}
+		progress = progressResp.GetProgress()
+	}
+	replyOK(c, gin.H{
+		"loadState":    stateResp.GetState().String(),
+		"loadProgress": progress,
+	})
+}
+
+// --------------------- partition --------------------- //
+
+func (h *Handlers) listPartitions(c *gin.Context) {
+	collectionName := c.Query(HTTPCollectionName)
+	if !checkRequiredParams(c, "list partitions", required(HTTPCollectionName, collectionName != "")) {
+		return
+	}
+	req := milvuspb.ShowPartitionsRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: collectionName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ShowPartitions(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Ternary(response.GetPartitionNames() != nil, response.GetPartitionNames(), []string{}))
+	}
+}
+
+func (h *Handlers) hasPartition(c *gin.Context) {
+	collectionName, partitionName := c.Query(HTTPCollectionName), c.Query(HTTPPartitionName)
+	if !checkRequiredParams(c, "has partition",
+		required(HTTPCollectionName, collectionName != ""), required(HTTPPartitionName, partitionName != "")) {
+		return
+	}
+	req := milvuspb.HasPartitionRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: collectionName,
+		PartitionName:  partitionName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.HasPartition(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{"has": response.GetValue()})
+	}
+}
+
+func (h *Handlers) createPartition(c *gin.Context) {
+	httpReq := PartitionReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "create partition") ||
+		!checkRequiredParams(c, "create partition",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPPartitionName, httpReq.PartitionName != "")) {
+		return
+	}
+	req := milvuspb.CreatePartitionRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionName:  httpReq.PartitionName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreatePartition(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) dropPartition(c *gin.Context) {
+	httpReq := PartitionReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "drop partition") ||
+		!checkRequiredParams(c, "drop partition",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPPartitionName, httpReq.PartitionName != "")) {
+		return
+	}
+	req := milvuspb.DropPartitionRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionName:  httpReq.PartitionName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DropPartition(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) loadPartitions(c *gin.Context) {
+	httpReq := PartitionsReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "load partitions") ||
+		!checkRequiredParams(c, "load partitions",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPPartitionNames, len(httpReq.PartitionNames) > 0)) {
+		return
+	}
+	req := milvuspb.LoadPartitionsRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionNames: httpReq.PartitionNames,
+		ReplicaNumber:  httpReq.ReplicaNumber,
+		ResourceGroups: httpReq.ResourceGroups,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.LoadPartitions(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) releasePartitions(c *gin.Context) {
+	httpReq := PartitionsReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "release partitions") ||
+		!checkRequiredParams(c, "release partitions",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPPartitionNames, len(httpReq.PartitionNames) > 0)) {
+		return
+	}
+	req := milvuspb.ReleasePartitionsRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionNames: httpReq.PartitionNames,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ReleasePartitions(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- index --------------------- //
+
+func (h *Handlers) createIndex(c *gin.Context) {
+	httpReq := CreateIndexReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "create index") ||
+		!checkRequiredParams(c, "create index",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPFieldName, httpReq.FieldName != "")) {
+		return
+	}
+	extraParams := make([]*commonpb.KeyValuePair, 0)
+	if httpReq.MetricType != "" {
+		extraParams = append(extraParams, &commonpb.KeyValuePair{Key: common.MetricTypeKey, Value: httpReq.MetricType})
+	}
+	if httpReq.IndexType != "" {
+		extraParams = append(extraParams, &commonpb.KeyValuePair{Key: common.IndexTypeKey, Value: httpReq.IndexType})
+	}
+	if len(httpReq.Params) > 0 {
+		params, err := json.Marshal(httpReq.Params)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return
+		}
+		extraParams = append(extraParams, &commonpb.KeyValuePair{Key: common.IndexParamsKey, Value: string(params)})
+	}
+	req := milvuspb.CreateIndexRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		FieldName:      httpReq.FieldName,
+		IndexName:      httpReq.IndexName,
+		ExtraParams:    extraParams,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateIndex(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) describeIndex(c *gin.Context) {
+	collectionName := c.Query(HTTPCollectionName)
+	if !checkRequiredParams(c, "describe index", required(HTTPCollectionName, collectionName != "")) {
+		return
+	}
+	req := milvuspb.DescribeIndexRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: collectionName,
+		FieldName:      c.Query(HTTPFieldName),
+		IndexName:      c.Query(HTTPIndexName),
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DescribeIndex(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, printIndexDetails(response.GetIndexDescriptions()))
+	}
+}
+
+func (h *Handlers) dropIndex(c *gin.Context) {
+	httpReq := DropIndexReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "drop index") ||
+		!checkRequiredParams(c, "drop index",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPIndexName, httpReq.IndexName != "")) {
+		return
+	}
+	req := milvuspb.DropIndexRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		FieldName:      httpReq.FieldName,
+		IndexName:      httpReq.IndexName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DropIndex(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- alias --------------------- //
+
+func (h *Handlers) listAliases(c *gin.Context) {
+	req := milvuspb.ListAliasesRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: c.Query(HTTPCollectionName),
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListAliases(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Ternary(response.GetAliases() != nil, response.GetAliases(), []string{}))
+	}
+}
+
+func (h *Handlers) describeAlias(c *gin.Context) {
+	aliasName := c.Query(HTTPAliasName)
+	if !checkRequiredParams(c, "describe alias", required(HTTPAliasName, aliasName != "")) {
+		return
+	}
+	req := milvuspb.DescribeAliasRequest{
+		DbName: c.DefaultQuery(HTTPDbName, DefaultDbName),
+		Alias:  aliasName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DescribeAlias(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{
+			HTTPDbName:         response.GetDbName(),
+			HTTPAliasName:      response.GetAlias(),
+			HTTPCollectionName: response.GetCollection(),
+		})
+	}
+}
+
+func (h *Handlers) createAlias(c *gin.Context) {
+	httpReq := AliasReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "create alias") ||
+		!checkRequiredParams(c, "create alias",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPAliasName, httpReq.AliasName != "")) {
+		return
+	}
+	req := milvuspb.CreateAliasRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		Alias:          httpReq.AliasName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateAlias(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) dropAlias(c *gin.Context) {
+	httpReq := DropAliasReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "drop alias") ||
+		!checkRequiredParams(c, "drop alias", required(HTTPAliasName, httpReq.AliasName != "")) {
+		return
+	}
+	req := milvuspb.DropAliasRequest{
+		DbName: httpReq.DbName,
+		Alias:  httpReq.AliasName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DropAlias(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) alterAlias(c *gin.Context) {
+	httpReq := AliasReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "alter alias") ||
+		!checkRequiredParams(c, "alter alias",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required(HTTPAliasName, httpReq.AliasName != "")) {
+		return
+	}
+	req := milvuspb.AlterAliasRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		Alias:          httpReq.AliasName,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.AlterAlias(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- user --------------------- //
+
+func (h *Handlers) listUsers(c *gin.Context) {
+	req := milvuspb.ListCredUsersRequest{}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListCredUsers(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Ternary(response.GetUsernames() != nil, response.GetUsernames(), []string{}))
+	}
+}
+
+func (h *Handlers) describeUser(c *gin.Context) {
+	userName := c.Query(HTTPUserName)
+	if !checkRequiredParams(c, "describe user", required(HTTPUserName, userName != "")) {
+		return
+	}
+	req := milvuspb.SelectUserRequest{
+		User:            &milvuspb.UserEntity{Name: userName},
+		IncludeRoleInfo: true,
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.SelectUser(ctx, &req)
+	if !checkResponse(c, response, err) {
+		return
+	}
+	roles := make([]string, 0)
+	for _, result := range response.GetResults() {
+		for _, role := range result.GetRoles() {
+			roles = append(roles, role.GetName())
+		}
+	}
+	replyOK(c, gin.H{HTTPUserName: userName, "roles": roles})
+}
+
+func (h *Handlers) createUser(c *gin.Context) {
+	httpReq := UserReq{}
+	if !bindRequest(c, &httpReq, "create user") ||
+		!checkRequiredParams(c, "create user",
+			required(HTTPUserName, httpReq.UserName != ""), required("password", httpReq.Password != "")) {
+		return
+	}
+	req := milvuspb.CreateCredentialRequest{
+		Username: httpReq.UserName,
+		Password: crypto.Base64Encode(httpReq.Password),
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateCredential(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) updatePassword(c *gin.Context) {
+	httpReq := UpdatePasswordReq{}
+	if !bindRequest(c, &httpReq, "update password") ||
+		!checkRequiredParams(c, "update password", required(HTTPUserName, httpReq.UserName != ""),
+			required("password", httpReq.Password != ""), required("newPassword", httpReq.NewPassword != "")) {
+		return
+	}
+	req := milvuspb.UpdateCredentialRequest{
+		Username:    httpReq.UserName,
+		OldPassword: crypto.Base64Encode(httpReq.Password),
+		NewPassword: crypto.Base64Encode(httpReq.NewPassword),
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.UpdateCredential(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) dropUser(c *gin.Context) {
+	httpReq := DropUserReq{}
+	if !bindRequest(c, &httpReq, "drop user") ||
+		!checkRequiredParams(c, "drop user", required(HTTPUserName, httpReq.UserName != "")) {
+		return
+	}
+	req := milvuspb.DeleteCredentialRequest{Username: httpReq.UserName}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DeleteCredential(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) operateUserRole(opType milvuspb.OperateUserRoleType) gin.HandlerFunc {
+	action := "grant role"
+	if opType == milvuspb.OperateUserRoleType_RemoveUserFromRole {
+		action = "revoke role"
+	}
+	return func(c *gin.Context) {
+		httpReq := UserRoleReq{}
+		if !bindRequest(c, &httpReq, action) ||
+			!checkRequiredParams(c, action,
+				required(HTTPUserName, httpReq.UserName != ""), required(HTTPRoleName, httpReq.RoleName != "")) {
+			return
+		}
+		req := milvuspb.OperateUserRoleRequest{
+			Username: httpReq.UserName,
+			RoleName: httpReq.RoleName,
+			Type:     opType,
+		}
+		ctx, ok := h.newContext(c, "", &req)
+		if !ok {
+			return
+		}
+		response, err := h.proxy.OperateUserRole(ctx, &req)
+		if checkResponse(c, response, err) {
+			replyOK(c, gin.H{})
+		}
+	}
+}
+
+// --------------------- api key --------------------- //
+
+func apiKeyInfoToHTTP(info *internalpb.APIKeyInfo) gin.H {
+	return gin.H{
+		"id":               info.GetId(),
+		HTTPUserName:       info.GetUsername(),
+		HTTPDbName:         info.GetScope().GetDbName(),
+		HTTPCollectionName: info.GetScope().GetCollectionName(),
+		"readOnly":         info.GetScope().GetReadOnly(),
+		"expireAt":         info.GetScope().GetExpireAt(),
+		"description":      info.GetDescription(),
+		"createdAt":        info.GetCreatedAt(),
+	}
+}
+
+func (h *Handlers) listAPIKeys(c *gin.Context) {
+	req := internalpb.ListAPIKeysRequest{Username: c.Query(HTTPUserName)}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListAPIKeys(ctx, &req)
+	if !checkResponse(c, response, err) {
+		return
+	}
+	apiKeys := make([]gin.H, 0, len(response.GetApiKeys()))
+	for _, info := range response.GetApiKeys() {
+		apiKeys = append(apiKeys, apiKeyInfoToHTTP(info))
+	}
+	replyOK(c, apiKeys)
+}
+
+func (h *Handlers) createAPIKey(c *gin.Context) {
+	httpReq := APIKeyReq{}
+	if !bindRequest(c, &httpReq, "create api key") {
+		return
+	}
+	req := internalpb.CreateAPIKeyRequest{
+		Username: httpReq.UserName,
+		Scope: &internalpb.APIKeyScope{
+			DbName:         httpReq.DbName,
+			CollectionName: httpReq.CollectionName,
+			ReadOnly:       httpReq.ReadOnly,
+			ExpireAt:       httpReq.ExpireAt,
+		},
+		Description: httpReq.Description,
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateAPIKey(ctx, &req)
+	if !checkResponse(c, response, err) {
+		return
+	}
+	data := apiKeyInfoToHTTP(response.GetInfo())
+	data["apiKey"] = response.GetApiKey()
+	replyOK(c, data)
+}
+
+func (h *Handlers) revokeAPIKey(c *gin.Context) {
+	httpReq := RevokeAPIKeyReq{}
+	if !bindRequest(c, &httpReq, "revoke api key") ||
+		!checkRequiredParams(c, "revoke api key", required("id", httpReq.ID != "")) {
+		return
+	}
+	req := internalpb.RevokeAPIKeyRequest{Username: httpReq.UserName, Id: httpReq.ID}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.RevokeAPIKey(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- role --------------------- //
+
+func (h *Handlers) listRoles(c *gin.Context) {
+	req := milvuspb.SelectRoleRequest{}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.SelectRole(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Map(response.GetResults(), func(result *milvuspb.RoleResult, _ int) string {
+			return result.GetRole().GetName()
+		}))
+	}
+}
+
+func (h *Handlers) describeRole(c *gin.Context) {
+	roleName := c.Query(HTTPRoleName)
+	if !checkRequiredParams(c, "describe role", required(HTTPRoleName, roleName != "")) {
+		return
+	}
+	req := milvuspb.SelectGrantRequest{
+		Entity: &milvuspb.GrantEntity{
+			Role:   &milvuspb.RoleEntity{Name: roleName},
+			DbName: c.Query(HTTPDbName),
+		},
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.SelectGrant(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, printGrants(response.GetEntities()))
+	}
+}
+
+func (h *Handlers) createRole(c *gin.Context) {
+	httpReq := RoleReq{}
+	if !bindRequest(c, &httpReq, "create role") ||
+		!checkRequiredParams(c, "create role", required(HTTPRoleName, httpReq.RoleName != "")) {
+		return
+	}
+	req := milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: httpReq.RoleName}}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateRole(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) dropRole(c *gin.Context) {
+	httpReq := RoleReq{}
+	if !bindRequest(c, &httpReq, "drop role") ||
+		!checkRequiredParams(c, "drop role", required(HTTPRoleName, httpReq.RoleName != "")) {
+		return
+	}
+	req := milvuspb.DropRoleRequest{RoleName: httpReq.RoleName}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DropRole(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) operatePrivilege(opType milvuspb.OperatePrivilegeType) gin.HandlerFunc {
+	action := "grant privilege"
+	if opType == milvuspb.OperatePrivilegeType_Revoke {
+		action = "revoke privilege"
+	}
+	return func(c *gin.Context) {
+		httpReq := PrivilegeReq{DbName: DefaultDbName}
+		if !bindRequest(c, &httpReq, action) ||
+			!checkRequiredParams(c, action, required(HTTPRoleName, httpReq.RoleName != ""),
+				required("objectType", httpReq.ObjectType != ""), required("objectName", httpReq.ObjectName != ""),
+				required("privilege", httpReq.Privilege != "")) {
+			return
+		}
+		req := milvuspb.OperatePrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{
+				Role:       &milvuspb.RoleEntity{Name: httpReq.RoleName},
+				Object:     &milvuspb.ObjectEntity{Name: httpReq.ObjectType},
+				ObjectName: httpReq.ObjectName,
+				DbName:     httpReq.DbName,
+				Grantor: &milvuspb.GrantorEntity{
+					Privilege: &milvuspb.PrivilegeEntity{Name: httpReq.Privilege},
+				},
+			},
+			Type: opType,
+		}
+		ctx, ok := h.newContext(c, "", &req)
+		if !ok {
+			return
+		}
+		response, err := h.proxy.OperatePrivilege(ctx, &req)
+		if checkResponse(c, response, err) {
+			replyOK(c, gin.H{})
+		}
+	}
+}
+
+// --------------------- row policy --------------------- //
+
+func (h *Handlers) listRowPolicies(c *gin.Context) {
+	req := internalpb.ListRowPoliciesRequest{
+		RoleName:       c.Query(HTTPRoleName),
+		DbName:         c.Query(HTTPDbName),
+		CollectionName: c.Query(HTTPCollectionName),
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListRowPolicies(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Map(response.GetPolicies(), func(policy *internalpb.RowPolicy, _ int) gin.H {
+			return gin.H{
+				HTTPRoleName:       policy.GetRoleName(),
+				HTTPDbName:         policy.GetDbName(),
+				HTTPCollectionName: policy.GetCollectionName(),
+				"expr":             policy.GetExpr(),
+			}
+		}))
+	}
+}
+
+func (h *Handlers) grantRowPolicy(c *gin.Context) {
+	httpReq := RowPolicyReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "grant row policy") ||
+		!checkRequiredParams(c, "grant row policy", required(HTTPCollectionName, httpReq.CollectionName != ""),
+			required(HTTPRoleName, httpReq.RoleName != ""), required("expr", httpReq.Expr != "")) {
+		return
+	}
+	req := internalpb.GrantRowPolicyRequest{
+		Policy: &internalpb.RowPolicy{
+			RoleName:       httpReq.RoleName,
+			DbName:         httpReq.DbName,
+			CollectionName: httpReq.CollectionName,
+			Expr:           httpReq.Expr,
+		},
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.GrantRowPolicy(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) revokeRowPolicy(c *gin.Context) {
+	httpReq := RevokeRowPolicyReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "revoke row policy") ||
+		!checkRequiredParams(c, "revoke row policy", required(HTTPCollectionName, httpReq.CollectionName != ""),
+			required(HTTPRoleName, httpReq.RoleName != "")) {
+		return
+	}
+	req := internalpb.RevokeRowPolicyRequest{
+		RoleName:       httpReq.RoleName,
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.RevokeRowPolicy(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- resource group --------------------- //
+
+func (h *Handlers) listResourceGroups(c *gin.Context) {
+	req := milvuspb.ListResourceGroupsRequest{}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListResourceGroups(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Ternary(response.GetResourceGroups() != nil, response.GetResourceGroups(), []string{}))
+	}
+}
+
+func (h *Handlers) describeResourceGroup(c *gin.Context) {
+	name := c.Query(HTTPResourceGroup)
+	if !checkRequiredParams(c, "describe resource group", required(HTTPResourceGroup, name != "")) {
+		return
+	}
+	req := milvuspb.DescribeResourceGroupRequest{ResourceGroup: name}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DescribeResourceGroup(ctx, &req)
+	if !checkResponse(c, response, err) {
+		return
+	}
+	rg := response.GetResourceGroup()
+	replyOK(c, gin.H{
+		"name":             rg.GetName(),
+		"capacity":         rg.GetCapacity(),
+		"numAvailableNode": rg.GetNumAvailableNode(),
+		"numLoadedReplica": rg.GetNumLoadedReplica(),
+		"numOutgoingNode":  rg.GetNumOutgoingNode(),
+		"numIncomingNode":  rg.GetNumIncomingNode(),
+	})
+}
+
+func (h *Handlers) createResourceGroup(c *gin.Context) {
+	httpReq := ResourceGroupReq{}
+	if !bindRequest(c, &httpReq, "create resource group") ||
+		!checkRequiredParams(c, "create resource group", required("name", httpReq.Name != "")) {
+		return
+	}
+	req := milvuspb.CreateResourceGroupRequest{ResourceGroup: httpReq.Name}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.CreateResourceGroup(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) dropResourceGroup(c *gin.Context) {
+	httpReq := ResourceGroupReq{}
+	if !bindRequest(c, &httpReq, "drop resource group") ||
+		!checkRequiredParams(c, "drop resource group", required("name", httpReq.Name != "")) {
+		return
+	}
+	req := milvuspb.DropResourceGroupRequest{ResourceGroup: httpReq.Name}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.DropResourceGroup(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) transferNode(c *gin.Context) {
+	httpReq := TransferNodeReq{}
+	if !bindRequest(c, &httpReq, "transfer node") ||
+		!checkRequiredParams(c, "transfer node", required("sourceResourceGroup", httpReq.SourceResourceGroup != ""),
+			required("targetResourceGroup", httpReq.TargetResourceGroup != ""), required("numNode", httpReq.NumNode > 0)) {
+		return
+	}
+	req := milvuspb.TransferNodeRequest{
+		SourceResourceGroup: httpReq.SourceResourceGroup,
+		TargetResourceGroup: httpReq.TargetResourceGroup,
+		NumNode:             httpReq.NumNode,
+	}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.TransferNode(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+func (h *Handlers) transferReplica(c *gin.Context) {
+	httpReq := TransferReplicaReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "transfer replica") ||
+		!checkRequiredParams(c, "transfer replica", required("sourceResourceGroup", httpReq.SourceResourceGroup != ""),
+			required("targetResourceGroup", httpReq.TargetResourceGroup != ""), required(HTTPCollectionName, httpReq.CollectionName != ""),
+			required("numReplica", httpReq.NumReplica > 0)) {
+		return
+	}
+	req := milvuspb.TransferReplicaRequest{
+		DbName:              httpReq.DbName,
+		SourceResourceGroup: httpReq.SourceResourceGroup,
+		TargetResourceGroup: httpReq.TargetResourceGroup,
+		CollectionName:      httpReq.CollectionName,
+		NumReplica:          httpReq.NumReplica,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.TransferReplica(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{})
+	}
+}
+
+// --------------------- import --------------------- //
+
+func (h *Handlers) importData(c *gin.Context) {
+	httpReq := ImportReq{DbName: DefaultDbName}
+	if !bindRequest(c, &httpReq, "import") ||
+		!checkRequiredParams(c, "import",
+			required(HTTPCollectionName, httpReq.CollectionName != ""), required("files", len(httpReq.Files) > 0)) {
+		return
+	}
+	options := make([]*commonpb.KeyValuePair, 0, len(httpReq.Options))
+	for key, value := range httpReq.Options {
+		options = append(options, &commonpb.KeyValuePair{Key: key, Value: fmt.Sprint(value)})
+	}
+	req := milvuspb.ImportRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+		PartitionName:  httpReq.PartitionName,
+		Files:          httpReq.Files,
+		Options:        options,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.Import(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, gin.H{"taskIds": formatInt64(response.GetTasks())})
+	}
+}
+
+func (h *Handlers) getImportState(c *gin.Context) {
+	taskID, err := strconv.ParseInt(c.Query(HTTPTaskID), 10, 64)
+	if !checkRequiredParams(c, "get import state", required(HTTPTaskID, err == nil)) {
+		return
+	}
+	req := milvuspb.GetImportStateRequest{Task: taskID}
+	ctx, ok := h.newContext(c, "", &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.GetImportState(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, printImportState(response))
+	}
+}
+
+func (h *Handlers) listImportTasks(c *gin.Context) {
+	limit, _ := strconv.ParseInt(c.DefaultQuery(ParamLimit, "0"), 10, 64)
+	req := milvuspb.ListImportTasksRequest{
+		DbName:         c.DefaultQuery(HTTPDbName, DefaultDbName),
+		CollectionName: c.Query(HTTPCollectionName),
+		Limit:          limit,
+	}
+	ctx, ok := h.newContext(c, req.DbName, &req)
+	if !ok {
+		return
+	}
+	response, err := h.proxy.ListImportTasks(ctx, &req)
+	if checkResponse(c, response, err) {
+		replyOK(c, lo.Map(response.GetTasks(), func(task *milvuspb.GetImportStateResponse, _ int) gin.H {
+			return printImportState(task)
+		}))
+	}
+}
@@ -0,0 +1,719 @@
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type manageTestCase struct {
+	name         string
+	method       string
+	path         string
+	body         string
+	setup        func(mp *mocks.MockProxy)
+	expectedBody string
+	expectedErr  error
+}
+
+func runManageTestCases(t *testing.T, testCases []manageTestCase) {
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := mocks.NewMockProxy(t)
+			if tt.setup != nil {
+				tt.setup(mp)
+			}
+			testEngine := initHTTPServer(mp, true)
+			var req *http.Request
+			if tt.method == http.MethodGet {
+				req = httptest.NewRequest(http.MethodGet, versional(tt.path), nil)
+			} else {
+				req = httptest.NewRequest(http.MethodPost, versional(tt.path), bytes.NewReader([]byte(tt.body)))
+			}
+			req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			if tt.expectedErr != nil {
+				assert.True(t, CheckErrCode(w.Body.String(), tt.expectedErr), w.Body.String())
+			} else {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestLoadAndRelease(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "load collection",
+			method: http.MethodPost,
+			path:   VectorCollectionsLoadPath,
+			body:   `{"collectionName": "book", "replicaNumber": 2}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().LoadCollection(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.LoadCollectionRequest) (*commonpb.Status, error) {
+						assert.Equal(t, DefaultDbName, req.GetDbName())
+						assert.Equal(t, int32(2), req.GetReplicaNumber())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "load collection without collection name",
+			method:      http.MethodPost,
+			path:        VectorCollectionsLoadPath,
+			body:        `{}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:        "load collection with incorrect body",
+			method:      http.MethodPost,
+			path:        VectorCollectionsLoadPath,
+			body:        `{"collectionName": 1}`,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+		},
+		{
+			name:   "release collection fail",
+			method: http.MethodPost,
+			path:   VectorCollectionsReleasePath,
+			body:   `{"collectionName": "book"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ReleaseCollection(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+			},
+			expectedErr: ErrDefault,
+		},
+		{
+			name:   "load state loaded",
+			method: http.MethodGet,
+			path:   VectorCollectionsLoadStatePath + "?collectionName=book",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GetLoadState(mock.Anything, mock.Anything).Return(&DefaultLoadStateResp, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"loadProgress":100,"loadState":"LoadStateLoaded"}}`,
+		},
+		{
+			name:   "load state loading",
+			method: http.MethodGet,
+			path:   VectorCollectionsLoadStatePath + "?collectionName=book&partitionNames=p1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GetLoadState(mock.Anything, mock.Anything).Return(&milvuspb.GetLoadStateResponse{
+					Status: &StatusSuccess,
+					State:  commonpb.LoadState_LoadStateLoading,
+				}, nil).Once()
+				mp.EXPECT().GetLoadingProgress(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.GetLoadingProgressRequest) (*milvuspb.GetLoadingProgressResponse, error) {
+						assert.Equal(t, []string{"p1"}, req.GetPartitionNames())
+						return &milvuspb.GetLoadingProgressResponse{Status: &StatusSuccess, Progress: 30}, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{"loadProgress":30,"loadState":"LoadStateLoading"}}`,
+		},
+		{
+			name:   "load state collection not found",
+			method: http.MethodGet,
+			path:   VectorCollectionsLoadStatePath + "?collectionName=book",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GetLoadState(mock.Anything, mock.Anything).Return(&milvuspb.GetLoadStateResponse{
+					Status: merr.Status(merr.WrapErrCollectionNotFound(DefaultCollectionName)),
+				}, nil).Once()
+			},
+			expectedErr: merr.ErrCollectionNotFound,
+		},
+	})
+}
+
+func TestPartitionsAndIndexes(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list partitions",
+			method: http.MethodGet,
+			path:   VectorPartitionsPath + "?collectionName=book",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+					Status:         &StatusSuccess,
+					PartitionNames: []string{"_default", "p1"},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":["_default","p1"]}`,
+		},
+		{
+			name:   "has partition",
+			method: http.MethodGet,
+			path:   VectorPartitionsHasPath + "?collectionName=book&partitionName=p1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().HasPartition(mock.Anything, mock.Anything).Return(&DefaultTrueResp, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"has":true}}`,
+		},
+		{
+			name:        "has partition without partition name",
+			method:      http.MethodGet,
+			path:        VectorPartitionsHasPath + "?collectionName=book",
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "create partition",
+			method: http.MethodPost,
+			path:   VectorPartitionsCreatePath,
+			body:   `{"collectionName": "book", "partitionName": "p1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreatePartition(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "load partitions",
+			method: http.MethodPost,
+			path:   VectorPartitionsLoadPath,
+			body:   `{"collectionName": "book", "partitionNames": ["p1"]}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().LoadPartitions(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "release partitions without partition names",
+			method:      http.MethodPost,
+			path:        VectorPartitionsReleasePath,
+			body:        `{"collectionName": "book"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "create index",
+			method: http.MethodPost,
+			path:   VectorIndexesCreatePath,
+			body:   `{"collectionName": "book", "fieldName": "book_intro", "indexName": "idx", "metricType": "L2", "indexType": "IVF_FLAT", "params": {"nlist": 128}}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateIndex(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+						assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+							{Key: "metric_type", Value: "L2"},
+							{Key: "index_type", Value: "IVF_FLAT"},
+							{Key: "params", Value: `{"nlist":128}`},
+						}, req.GetExtraParams())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "describe index",
+			method: http.MethodGet,
+			path:   VectorIndexesDescribePath + "?collectionName=book",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&milvuspb.DescribeIndexResponse{
+					Status: &StatusSuccess,
+					IndexDescriptions: []*milvuspb.IndexDescription{{
+						IndexName: "idx",
+						FieldName: "book_intro",
+						Params: []*commonpb.KeyValuePair{
+							{Key: "metric_type", Value: "L2"},
+							{Key: "index_type", Value: "IVF_FLAT"},
+						},
+						State:       commonpb.IndexState_Finished,
+						IndexedRows: 10,
+						TotalRows:   10,
+					}},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":[{"failReason":"","fieldName":"book_intro","indexName":"idx","indexState":"Finished","indexType":"IVF_FLAT","indexedRows":10,"metricType":"L2","pendingRows":0,"totalRows":10}]}`,
+		},
+		{
+			name:   "drop index fail",
+			method: http.MethodPost,
+			path:   VectorIndexesDropPath,
+			body:   `{"collectionName": "book", "indexName": "idx"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().DropIndex(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrIndexNotFound("idx")), nil).Once()
+			},
+			expectedErr: merr.ErrIndexNotFound,
+		},
+	})
+}
+
+func TestAliases(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list aliases",
+			method: http.MethodGet,
+			path:   VectorAliasesPath + "?collectionName=book",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListAliases(mock.Anything, mock.Anything).Return(&milvuspb.ListAliasesResponse{
+					Status:  &StatusSuccess,
+					Aliases: []string{"a1"},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":["a1"]}`,
+		},
+		{
+			name:   "describe alias",
+			method: http.MethodGet,
+			path:   VectorAliasesDescribePath + "?aliasName=a1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().DescribeAlias(mock.Anything, mock.Anything).Return(&milvuspb.DescribeAliasResponse{
+					Status:     &StatusSuccess,
+					DbName:     DefaultDbName,
+					Alias:      "a1",
+					Collection: "book",
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"aliasName":"a1","collectionName":"book","dbName":"default"}}`,
+		},
+		{
+			name:   "create alias",
+			method: http.MethodPost,
+			path:   VectorAliasesCreatePath,
+			body:   `{"collectionName": "book", "aliasName": "a1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateAlias(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "alter alias",
+			method: http.MethodPost,
+			path:   VectorAliasesAlterPath,
+			body:   `{"collectionName": "book", "aliasName": "a1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().AlterAlias(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "drop alias without alias name",
+			method:      http.MethodPost,
+			path:        VectorAliasesDropPath,
+			body:        `{}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+	})
+}
+
+func TestUsersAndRoles(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list users",
+			method: http.MethodGet,
+			path:   VectorUsersPath,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListCredUsers(mock.Anything, mock.Anything).Return(&milvuspb.ListCredUsersResponse{
+					Status:    &StatusSuccess,
+					Usernames: []string{"root"},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":["root"]}`,
+		},
+		{
+			name:   "describe user",
+			method: http.MethodGet,
+			path:   VectorUsersDescribePath + "?userName=root",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().SelectUser(mock.Anything, mock.Anything).Return(&milvuspb.SelectUserResponse{
+					Status: &StatusSuccess,
+					Results: []*milvuspb.UserResult{{
+						User:  &milvuspb.UserEntity{Name: "root"},
+						Roles: []*milvuspb.RoleEntity{{Name: "admin"}},
+					}},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"roles":["admin"],"userName":"root"}}`,
+		},
+		{
+			name:   "create user",
+			method: http.MethodPost,
+			path:   VectorUsersCreatePath,
+			body:   `{"userName": "u1", "password": "Milvus"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateCredential(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+						assert.Equal(t, crypto.Base64Encode("Milvus"), req.GetPassword())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "update password without new password",
+			method:      http.MethodPost,
+			path:        VectorUsersUpdatePasswordPath,
+			body:        `{"userName": "u1", "password": "Milvus"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "drop user",
+			method: http.MethodPost,
+			path:   VectorUsersDropPath,
+			body:   `{"userName": "u1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().DeleteCredential(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "revoke role",
+			method: http.MethodPost,
+			path:   VectorUsersRevokeRolePath,
+			body:   `{"userName": "u1", "roleName": "admin"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().OperateUserRole(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+						assert.Equal(t, milvuspb.OperateUserRoleType_RemoveUserFromRole, req.GetType())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "list roles",
+			method: http.MethodGet,
+			path:   VectorRolesPath,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().SelectRole(mock.Anything, mock.Anything).Return(&milvuspb.SelectRoleResponse{
+					Status:  &StatusSuccess,
+					Results: []*milvuspb.RoleResult{{Role: &milvuspb.RoleEntity{Name: "admin"}}},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":["admin"]}`,
+		},
+		{
+			name:   "describe role",
+			method: http.MethodGet,
+			path:   VectorRolesDescribePath + "?roleName=r1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().SelectGrant(mock.Anything, mock.Anything).Return(&milvuspb.SelectGrantResponse{
+					Status: &StatusSuccess,
+					Entities: []*milvuspb.GrantEntity{{
+						Role:       &milvuspb.RoleEntity{Name: "r1"},
+						Object:     &milvuspb.ObjectEntity{Name: "Collection"},
+						ObjectName: "book",
+						DbName:     DefaultDbName,
+						Grantor: &milvuspb.GrantorEntity{
+							User:      &milvuspb.UserEntity{Name: "root"},
+							Privilege: &milvuspb.PrivilegeEntity{Name: "Search"},
+						},
+					}},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":[{"dbName":"default","grantor":"root","objectName":"book","objectType":"Collection","privilege":"Search"}]}`,
+		},
+		{
+			name:   "create role",
+			method: http.MethodPost,
+			path:   VectorRolesCreatePath,
+			body:   `{"roleName": "r1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateRole(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "grant privilege",
+			method: http.MethodPost,
+			path:   VectorRolesGrantPrivilegePath,
+			body:   `{"roleName": "r1", "objectType": "Collection", "objectName": "book", "privilege": "Search"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.OperatePrivilegeRequest) (*commonpb.Status, error) {
+						assert.Equal(t, milvuspb.OperatePrivilegeType_Grant, req.GetType())
+						assert.Equal(t, "Collection", req.GetEntity().GetObject().GetName())
+						assert.Equal(t, "Search", req.GetEntity().GetGrantor().GetPrivilege().GetName())
+						assert.Equal(t, DefaultDbName, req.GetEntity().GetDbName())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "revoke privilege without privilege",
+			method:      http.MethodPost,
+			path:        VectorRolesRevokePrivilegePath,
+			body:        `{"roleName": "r1", "objectType": "Collection", "objectName": "book"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+	})
+}
+
+func TestAPIKeys(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list api keys",
+			method: http.MethodGet,
+			path:   VectorAPIKeysPath + "?userName=u1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+						assert.Equal(t, "u1", req.GetUsername())
+						return &internalpb.ListAPIKeysResponse{
+							Status: &StatusSuccess,
+							ApiKeys: []*internalpb.APIKeyInfo{{
+								Id:        "key1",
+								Username:  "u1",
+								Scope:     &internalpb.APIKeyScope{DbName: "db1", ReadOnly: true},
+								CreatedAt: 100,
+							}},
+						}, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":[{"collectionName":"","createdAt":100,"dbName":"db1","description":"","expireAt":0,"id":"key1","readOnly":true,"userName":"u1"}]}`,
+		},
+		{
+			name:   "create api key",
+			method: http.MethodPost,
+			path:   VectorAPIKeysCreatePath,
+			body:   `{"dbName": "db1", "collectionName": "book", "readOnly": true}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+						assert.Equal(t, "book", req.GetScope().GetCollectionName())
+						assert.True(t, req.GetScope().GetReadOnly())
+						return &internalpb.CreateAPIKeyResponse{
+							Status: &StatusSuccess,
+							ApiKey: "key1.secret",
+							Info: &internalpb.APIKeyInfo{
+								Id:        "key1",
+								Username:  "root",
+								Scope:     req.GetScope(),
+								CreatedAt: 100,
+							},
+						}, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{"apiKey":"key1.secret","collectionName":"book","createdAt":100,"dbName":"db1","description":"","expireAt":0,"id":"key1","readOnly":true,"userName":"root"}}`,
+		},
+		{
+			name:   "create api key fail",
+			method: http.MethodPost,
+			path:   VectorAPIKeysCreatePath,
+			body:   `{}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).Return(&internalpb.CreateAPIKeyResponse{
+					Status: merr.Status(merr.WrapErrParameterInvalidMsg("too many api keys")),
+				}, nil).Once()
+			},
+			expectedErr: merr.ErrParameterInvalid,
+		},
+		{
+			name:   "revoke api key",
+			method: http.MethodPost,
+			path:   VectorAPIKeysRevokePath,
+			body:   `{"id": "key1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "revoke api key without id",
+			method:      http.MethodPost,
+			path:        VectorAPIKeysRevokePath,
+			body:        `{}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+	})
+}
+
+func TestRowPolicies(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list row policies",
+			method: http.MethodGet,
+			path:   VectorRowPoliciesPath + "?roleName=r1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListRowPolicies(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+						assert.Equal(t, "r1", req.GetRoleName())
+						assert.Empty(t, req.GetCollectionName())
+						return &internalpb.ListRowPoliciesResponse{
+							Status: &StatusSuccess,
+							Policies: []*internalpb.RowPolicy{{
+								RoleName:       "r1",
+								DbName:         "default",
+								CollectionName: "book",
+								Expr:           "tenant == 1",
+							}},
+						}, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":[{"collectionName":"book","dbName":"default","expr":"tenant == 1","roleName":"r1"}]}`,
+		},
+		{
+			name:   "grant row policy",
+			method: http.MethodPost,
+			path:   VectorRowPoliciesGrantPath,
+			body:   `{"collectionName": "book", "roleName": "r1", "expr": "tenant == 1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GrantRowPolicy(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+						assert.Equal(t, DefaultDbName, req.GetPolicy().GetDbName())
+						assert.Equal(t, "tenant == 1", req.GetPolicy().GetExpr())
+						return &StatusSuccess, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "grant row policy without expr",
+			method:      http.MethodPost,
+			path:        VectorRowPoliciesGrantPath,
+			body:        `{"collectionName": "book", "roleName": "r1"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "grant row policy fail",
+			method: http.MethodPost,
+			path:   VectorRowPoliciesGrantPath,
+			body:   `{"collectionName": "book", "roleName": "r1", "expr": "unknown == 1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GrantRowPolicy(mock.Anything, mock.Anything).Return(
+					merr.Status(merr.WrapErrParameterInvalidMsg("invalid row policy")), nil).Once()
+			},
+			expectedErr: merr.ErrParameterInvalid,
+		},
+		{
+			name:   "revoke row policy",
+			method: http.MethodPost,
+			path:   VectorRowPoliciesRevokePath,
+			body:   `{"collectionName": "book", "roleName": "r1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().RevokeRowPolicy(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+	})
+}
+
+func TestResourceGroupsAndImport(t *testing.T) {
+	paramtable.Init()
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "list resource groups",
+			method: http.MethodGet,
+			path:   VectorResourceGroupsPath,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListResourceGroups(mock.Anything, mock.Anything).Return(&milvuspb.ListResourceGroupsResponse{
+					Status:         &StatusSuccess,
+					ResourceGroups: []string{"__default_resource_group"},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":["__default_resource_group"]}`,
+		},
+		{
+			name:   "describe resource group",
+			method: http.MethodGet,
+			path:   VectorResourceGroupsDescribePath + "?resourceGroup=rg1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().DescribeResourceGroup(mock.Anything, mock.Anything).Return(&milvuspb.DescribeResourceGroupResponse{
+					Status: &StatusSuccess,
+					ResourceGroup: &milvuspb.ResourceGroup{
+						Name:             "rg1",
+						Capacity:         2,
+						NumAvailableNode: 1,
+					},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"capacity":2,"name":"rg1","numAvailableNode":1,"numIncomingNode":null,"numLoadedReplica":null,"numOutgoingNode":null}}`,
+		},
+		{
+			name:   "create resource group",
+			method: http.MethodPost,
+			path:   VectorResourceGroupsCreatePath,
+			body:   `{"name": "rg1"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().CreateResourceGroup(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:        "transfer node without num node",
+			method:      http.MethodPost,
+			path:        VectorResourceGroupsTransferNodePath,
+			body:        `{"sourceResourceGroup": "rg1", "targetResourceGroup": "rg2"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "transfer replica",
+			method: http.MethodPost,
+			path:   VectorResourceGroupsTransferReplicaPath,
+			body:   `{"sourceResourceGroup": "rg1", "targetResourceGroup": "rg2", "collectionName": "book", "numReplica": 1}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().TransferReplica(mock.Anything, mock.Anything).Return(&StatusSuccess, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{}}`,
+		},
+		{
+			name:   "import",
+			method: http.MethodPost,
+			path:   VectorImportPath,
+			body:   `{"collectionName": "book", "files": ["a.json"], "options": {"backup": true}}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().Import(mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+						assert.Equal(t, []*commonpb.KeyValuePair{{Key: "backup", Value: "true"}}, req.GetOptions())
+						return &milvuspb.ImportResponse{Status: &StatusSuccess, Tasks: []int64{1}}, nil
+					}).Once()
+			},
+			expectedBody: `{"code":200,"data":{"taskIds":["1"]}}`,
+		},
+		{
+			name:        "import without files",
+			method:      http.MethodPost,
+			path:        VectorImportPath,
+			body:        `{"collectionName": "book"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+		{
+			name:   "import state",
+			method: http.MethodGet,
+			path:   VectorImportStatePath + "?taskId=1",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().GetImportState(mock.Anything, mock.Anything).Return(&milvuspb.GetImportStateResponse{
+					Status:       &StatusSuccess,
+					Id:           1,
+					State:        commonpb.ImportState_ImportCompleted,
+					RowCount:     10,
+					CollectionId: 100,
+					SegmentIds:   []int64{1000},
+					Infos:        []*commonpb.KeyValuePair{{Key: "failed_reason", Value: ""}},
+				}, nil).Once()
+			},
+			expectedBody: `{"code":200,"data":{"collectionId":"100","createTs":0,"infos":{"failed_reason":""},"rowCount":10,"segmentIds":["1000"],"state":"ImportCompleted","taskId":"1"}}`,
+		},
+		{
+			name:        "import state with invalid task id",
+			method:      http.MethodGet,
+			path:        VectorImportStatePath + "?taskId=abc",
+			expectedErr: merr.ErrIncorrectParameterFormat,
+		},
+		{
+			name:   "list import tasks fail",
+			method: http.MethodGet,
+			path:   VectorImportListPath + "?collectionName=book&limit=10",
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().ListImportTasks(mock.Anything, mock.Anything).Return(nil, ErrDefault).Once()
+			},
+			expectedErr: ErrDefault,
+		},
+	})
+}
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -35,8 +36,6 @@ const (
 	ReturnWrongStatus = 2
 	ReturnTrue        = 3
 	ReturnFalse       = 4
-
-	URIPrefixV1 = "/v1"
 )
 
 var StatusSuccess = commonpb.Status{
@@ -98,7 +97,7 @@ func initHTTPServer(proxy types.ProxyComponent, needAuth bool) *gin.Engine {
 		c.Next()
 	})
 	app := ginHandler.Group(URIPrefixV1, genAuthMiddleWare(needAuth))
-	NewHandlers(h.proxy).RegisterRoutesToV1(app)
+	h.RegisterRoutesToV1(app)
 	return ginHandler
 }
 
@@ -552,6 +551,86 @@ func genGetRequest() *http.Request {
 	return req
 }
 
+func genStreamPage(names ...string) *milvuspb.QueryResults {
+	return &milvuspb.QueryResults{
+		Status:       &StatusSuccess,
+		OutputFields: []string{"book_name"},
+		FieldsData: []*schemapb.FieldData{{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: "book_name",
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: names}},
+			}},
+		}},
+	}
+}
+
+func TestQueryStream(t *testing.T) {
+	paramtable.Init()
+	body := `{"collectionName": "book", "outputFields": ["book_name"], "cursor": "a", "batchSize": 2}`
+	streamErr := merr.WrapErrServiceInternal("stream broken")
+	runManageTestCases(t, []manageTestCase{
+		{
+			name:   "stream pages",
+			method: http.MethodPost,
+			path:   VectorQueryStreamPath,
+			body:   body,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().QueryPages(mock.Anything, mock.Anything, "a", int64(2), mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+						assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+						assert.Equal(t, []string{"book_name"}, req.GetOutputFields())
+						assert.NoError(t, send(genStreamPage("b", "c")))
+						assert.NoError(t, send(genStreamPage("d")))
+						return nil
+					})
+			},
+			expectedBody: "{\"book_name\":\"b\"}\n{\"book_name\":\"c\"}\n{\"book_name\":\"d\"}\n",
+		},
+		{
+			name:   "no entity matched",
+			method: http.MethodPost,
+			path:   VectorQueryStreamPath,
+			body:   `{"collectionName": "book", "filter": "book_id < 0"}`,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().QueryPages(mock.Anything, mock.Anything, "", int64(DefaultBatchSize), mock.Anything).Return(nil)
+			},
+			expectedBody: "",
+		},
+		{
+			name:   "fail before streaming",
+			method: http.MethodPost,
+			path:   VectorQueryStreamPath,
+			body:   body,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().QueryPages(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(merr.ErrCollectionNotFound)
+			},
+			expectedErr: merr.ErrCollectionNotFound,
+		},
+		{
+			name:   "fail while streaming",
+			method: http.MethodPost,
+			path:   VectorQueryStreamPath,
+			body:   body,
+			setup: func(mp *mocks.MockProxy) {
+				mp.EXPECT().QueryPages(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+					func(ctx context.Context, req *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+						assert.NoError(t, send(genStreamPage("b")))
+						return streamErr
+					})
+			},
+			expectedBody: "{\"book_name\":\"b\"}\n" + PrintErr(streamErr) + "\n",
+		},
+		{
+			name:        "missing collection name",
+			method:      http.MethodPost,
+			path:        VectorQueryStreamPath,
+			body:        `{"filter": "book_id > 0"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+		},
+	})
+}
+
 func TestDelete(t *testing.T) {
 	paramtable.Init()
 	testCases := []testCase{}
@@ -0,0 +1,412 @@
+package httpserver
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+
+	schemaTypeString  = "string"
+	schemaTypeInteger = "integer"
+	schemaTypeNumber  = "number"
+	schemaTypeBoolean = "boolean"
+	schemaTypeArray   = "array"
+	schemaTypeObject  = "object"
+
+	componentsSchemaPrefix = "#/components/schemas/"
+)
+
+// apiParam is a query parameter of a GET operation.
+type apiParam struct {
+	name     string
+	typ      string
+	required bool
+}
+
+func queryParam(name string, typ string, required bool) apiParam {
+	return apiParam{name: name, typ: typ, required: required}
+}
+
+// apiOperation describes a restful api, the request body is one of the bodies.
+type apiOperation struct {
+	method  string
+	path    string
+	summary string
+	params  []apiParam
+	bodies  []interface{}
+}
+
+// v1Operations describes all the apis registered by RegisterRoutesToV1,
+// the openapi document and the request validation are both generated from it.
+var v1Operations = []apiOperation{
+	{method: http.MethodGet, path: VectorCollectionsPath, summary: "List collections",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false)}},
+	{method: http.MethodPost, path: VectorCollectionsCreatePath, summary: "Create a collection",
+		bodies: []interface{}{CreateCollectionReq{}}},
+	{method: http.MethodGet, path: VectorCollectionsDescribePath, summary: "Describe a collection",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false), queryParam(HTTPCollectionName, schemaTypeString, true)}},
+	{method: http.MethodPost, path: VectorCollectionsDropPath, summary: "Drop a collection",
+		bodies: []interface{}{DropCollectionReq{}}},
+	{method: http.MethodPost, path: VectorQueryPath, summary: "Query entities by filter",
+		bodies: []interface{}{QueryReq{}}},
+	{method: http.MethodPost, path: VectorQueryStreamPath, summary: "Stream the entities matching the filter as NDJSON in the order of primary keys",
+		bodies: []interface{}{QueryStreamReq{}}},
+	{method: http.MethodPost, path: VectorGetPath, summary: "Get entities by primary keys",
+		bodies: []interface{}{GetReq{}}},
+	{method: http.MethodPost, path: VectorDeletePath, summary: "Delete entities by primary keys or filter",
+		bodies: []interface{}{DeleteReq{}}},
+	{method: http.MethodPost, path: VectorInsertPath, summary: "Insert entities",
+		bodies: []interface{}{InsertReq{}, SingleInsertReq{}}},
+	{method: http.MethodPost, path: VectorUpsertPath, summary: "Upsert entities",
+		bodies: []interface{}{UpsertReq{}, SingleUpsertReq{}}},
+	{method: http.MethodPost, path: VectorSearchPath, summary: "Search the most similar vectors",
+		bodies: []interface{}{SearchReq{}}},
+
+	{method: http.MethodPost, path: VectorCollectionsLoadPath, summary: "Load a collection",
+		bodies: []interface{}{LoadCollectionReq{}}},
+	{method: http.MethodPost, path: VectorCollectionsReleasePath, summary: "Release a collection",
+		bodies: []interface{}{ReleaseCollectionReq{}}},
+	{method: http.MethodGet, path: VectorCollectionsLoadStatePath, summary: "Get the load state and the loading progress of a collection or partitions",
+		params: []apiParam{
+			queryParam(HTTPDbName, schemaTypeString, false),
+			queryParam(HTTPCollectionName, schemaTypeString, true),
+			queryParam(HTTPPartitionNames, schemaTypeArray, false),
+		}},
+
+	{method: http.MethodGet, path: VectorPartitionsPath, summary: "List partitions",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false), queryParam(HTTPCollectionName, schemaTypeString, true)}},
+	{method: http.MethodGet, path: VectorPartitionsHasPath, summary: "Check whether a partition exists",
+		params: []apiParam{
+			queryParam(HTTPDbName, schemaTypeString, false),
+			queryParam(HTTPCollectionName, schemaTypeString, true),
+			queryParam(HTTPPartitionName, schemaTypeString, true),
+		}},
+	{method: http.MethodPost, path: VectorPartitionsCreatePath, summary: "Create a partition",
+		bodies: []interface{}{PartitionReq{}}},
+	{method: http.MethodPost, path: VectorPartitionsDropPath, summary: "Drop a partition",
+		bodies: []interface{}{PartitionReq{}}},
+	{method: http.MethodPost, path: VectorPartitionsLoadPath, summary: "Load partitions",
+		bodies: []interface{}{PartitionsReq{}}},
+	{method: http.MethodPost, path: VectorPartitionsReleasePath, summary: "Release partitions",
+		bodies: []interface{}{PartitionsReq{}}},
+
+	{method: http.MethodPost, path: VectorIndexesCreatePath, summary: "Create an index",
+		bodies: []interface{}{CreateIndexReq{}}},
+	{method: http.MethodGet, path: VectorIndexesDescribePath, summary: "Describe the indexes and their building progress",
+		params: []apiParam{
+			queryParam(HTTPDbName, schemaTypeString, false),
+			queryParam(HTTPCollectionName, schemaTypeString, true),
+			queryParam(HTTPFieldName, schemaTypeString, false),
+			queryParam(HTTPIndexName, schemaTypeString, false),
+		}},
+	{method: http.MethodPost, path: VectorIndexesDropPath, summary: "Drop an index",
+		bodies: []interface{}{DropIndexReq{}}},
+
+	{method: http.MethodGet, path: VectorAliasesPath, summary: "List aliases",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false), queryParam(HTTPCollectionName, schemaTypeString, false)}},
+	{method: http.MethodGet, path: VectorAliasesDescribePath, summary: "Describe an alias",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false), queryParam(HTTPAliasName, schemaTypeString, true)}},
+	{method: http.MethodPost, path: VectorAliasesCreatePath, summary: "Create an alias",
+		bodies: []interface{}{AliasReq{}}},
+	{method: http.MethodPost, path: VectorAliasesDropPath, summary: "Drop an alias",
+		bodies: []interface{}{DropAliasReq{}}},
+	{method: http.MethodPost, path: VectorAliasesAlterPath, summary: "Alter an alias to another collection",
+		bodies: []interface{}{AliasReq{}}},
+
+	{method: http.MethodGet, path: VectorUsersPath, summary: "List users"},
+	{method: http.MethodGet, path: VectorUsersDescribePath, summary: "Describe the roles of a user",
+		params: []apiParam{queryParam(HTTPUserName, schemaTypeString, true)}},
+	{method: http.MethodPost, path: VectorUsersCreatePath, summary: "Create a user",
+		bodies: []interface{}{UserReq{}}},
+	{method: http.MethodPost, path: VectorUsersUpdatePasswordPath, summary: "Update the password of a user",
+		bodies: []interface{}{UpdatePasswordReq{}}},
+	{method: http.MethodPost, path: VectorUsersDropPath, summary: "Drop a user",
+		bodies: []interface{}{DropUserReq{}}},
+	{method: http.MethodPost, path: VectorUsersGrantRolePath, summary: "Grant a role to a user",
+		bodies: []interface{}{UserRoleReq{}}},
+	{method: http.MethodPost, path: VectorUsersRevokeRolePath, summary: "Revoke a role from a user",
+		bodies: []interface{}{UserRoleReq{}}},
+
+	{method: http.MethodGet, path: VectorAPIKeysPath, summary: "List the api keys of a user",
+		params: []apiParam{queryParam(HTTPUserName, schemaTypeString, false)}},
+	{method: http.MethodPost, path: VectorAPIKeysCreatePath, summary: "Create an api key, the key is returned only once",
+		bodies: []interface{}{APIKeyReq{}}},
+	{method: http.MethodPost, path: VectorAPIKeysRevokePath, summary: "Revoke an api key",
+		bodies: []interface{}{RevokeAPIKeyReq{}}},
+
+	{method: http.MethodGet, path: VectorRolesPath, summary: "List roles"},
+	{method: http.MethodGet, path: VectorRolesDescribePath, summary: "Describe the privileges granted to a role",
+		params: []apiParam{queryParam(HTTPDbName, schemaTypeString, false), queryParam(HTTPRoleName, schemaTypeString, true)}},
+	{method: http.MethodPost, path: VectorRolesCreatePath, summary: "Create a role",
+		bodies: []interface{}{RoleReq{}}},
+	{method: http.MethodPost, path: VectorRolesDropPath, summary: "Drop a role",
+		bodies: []interface{}{RoleReq{}}},
+	{method: http.MethodPost, path: VectorRolesGrantPrivilegePath, summary: "Grant a privilege to a role",
+		bodies: []interface{}{PrivilegeReq{}}},
+	{method: http.MethodPost, path: VectorRolesRevokePrivilegePath, summary: "Revoke a privilege from a role",
+		bodies: []interface{}{PrivilegeReq{}}},
+
+	{method: http.MethodGet, path: VectorRowPoliciesPath, summary: "List the row policies of a role or a collection",
+		params: []apiParam{
+			queryParam(HTTPRoleName, schemaTypeString, false),
+			queryParam(HTTPDbName, schemaTypeString, false),
+			queryParam(HTTPCollectionName, schemaTypeString, false),
+		}},
+	{method: http.MethodPost, path: VectorRowPoliciesGrantPath, summary: "Grant a row policy on a collection to a role",
+		bodies: []interface{}{RowPolicyReq{}}},
+	{method: http.MethodPost, path: VectorRowPoliciesRevokePath, summary: "Revoke the row policy of a role on a collection",
+		bodies: []interface{}{RevokeRowPolicyReq{}}},
+
+	{method: http.MethodGet, path: VectorResourceGroupsPath, summary: "List resource groups"},
+	{method: http.MethodGet, path: VectorResourceGroupsDescribePath, summary: "Describe a resource group",
+		params: []apiParam{queryParam(HTTPResourceGroup, schemaTypeString, true)}},
+	{method: http.MethodPost, path: VectorResourceGroupsCreatePath, summary: "Create a resource group",
+		bodies: []interface{}{ResourceGroupReq{}}},
+	{method: http.MethodPost, path: VectorResourceGroupsDropPath, summary: "Drop a resource group",
+		bodies: []interface{}{ResourceGroupReq{}}},
+	{method: http.MethodPost, path: VectorResourceGroupsTransferNodePath, summary: "Transfer query nodes between resource groups",
+		bodies: []interface{}{TransferNodeReq{}}},
+	{method: http.MethodPost, path: VectorResourceGroupsTransferReplicaPath, summary: "Transfer replicas between resource groups",
+		bodies: []interface{}{TransferReplicaReq{}}},
+
+	{method: http.MethodPost, path: VectorImportPath, summary: "Import files into a collection",
+		bodies: []interface{}{ImportReq{}}},
+	{method: http.MethodGet, path: VectorImportStatePath, summary: "Get the state of an import task",
+		params: []apiParam{queryParam(HTTPTaskID, schemaTypeInteger, true)}},
+	{method: http.MethodGet, path: VectorImportListPath, summary: "List import tasks",
+		params: []apiParam{
+			queryParam(HTTPDbName, schemaTypeString, false),
+			queryParam(HTTPCollectionName, schemaTypeString, false),
+			queryParam(ParamLimit, schemaTypeInteger, false),
+		}},
+}
+
+// jsonSchema is the subset of the openapi schema object used by the restful apis.
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	OneOf                []*jsonSchema          `json:"oneOf,omitempty"`
+}
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Servers    []openAPIServer                         `json:"servers,omitempty"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type openAPIServer struct {
+	URL string `json:"url"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*jsonSchema `json:"schemas"`
+}
+
+type openAPIOperation struct {
+	Summary     string                      `json:"summary"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name     string      `json:"name"`
+	In       string      `json:"in"`
+	Required bool        `json:"required,omitempty"`
+	Schema   *jsonSchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                       `json:"description"`
+	Content     map[string]*openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *jsonSchema `json:"schema"`
+}
+
+// schemaGenerator generates the schemas of the request structs, the structs are put into the components.
+type schemaGenerator struct {
+	components map[string]*jsonSchema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{components: make(map[string]*jsonSchema)}
+}
+
+func (g *schemaGenerator) schemaOf(t reflect.Type) *jsonSchema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.schemaOf(t.Elem())
+	case reflect.String:
+		return &jsonSchema{Type: schemaTypeString}
+	case reflect.Bool:
+		return &jsonSchema{Type: schemaTypeBoolean}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &jsonSchema{Type: schemaTypeInteger, Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &jsonSchema{Type: schemaTypeInteger, Format: "int64"}
+	case reflect.Float32:
+		return &jsonSchema{Type: schemaTypeNumber, Format: "float"}
+	case reflect.Float64:
+		return &jsonSchema{Type: schemaTypeNumber, Format: "double"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &jsonSchema{Type: schemaTypeString, Format: "byte"}
+		}
+		return &jsonSchema{Type: schemaTypeArray, Items: g.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &jsonSchema{Type: schemaTypeObject, AdditionalProperties: g.schemaOf(t.Elem())}
+	case reflect.Struct:
+		name := t.Name()
+		if _, ok := g.components[name]; !ok {
+			schema := &jsonSchema{Type: schemaTypeObject, Properties: make(map[string]*jsonSchema)}
+			g.components[name] = schema
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				fieldName, ok := jsonFieldName(field)
+				if !ok {
+					continue
+				}
+				schema.Properties[fieldName] = g.schemaOf(field.Type)
+				if isRequiredField(field) {
+					schema.Required = append(schema.Required, fieldName)
+				}
+			}
+		}
+		return &jsonSchema{Ref: componentsSchemaPrefix + name}
+	default:
+		// interface{} accepts any value
+		return &jsonSchema{}
+	}
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+func isRequiredField(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// bodySchema returns the schema of the request body, it is one of the schemas if there are several bodies.
+func (g *schemaGenerator) bodySchema(bodies []interface{}) *jsonSchema {
+	if len(bodies) == 1 {
+		return g.schemaOf(reflect.TypeOf(bodies[0]))
+	}
+	schema := &jsonSchema{}
+	for _, body := range bodies {
+		schema.OneOf = append(schema.OneOf, g.schemaOf(reflect.TypeOf(body)))
+	}
+	return schema
+}
+
+// responseSchema is the envelope of all the responses, the code is 200 if succeeded,
+// otherwise it is the error code and the message is the reason.
+var responseSchema = &jsonSchema{
+	Type: schemaTypeObject,
+	Properties: map[string]*jsonSchema{
+		HTTPReturnCode:    {Type: schemaTypeInteger, Format: "int32"},
+		HTTPReturnMessage: {Type: schemaTypeString},
+		HTTPReturnData:    {},
+	},
+	Required: []string{HTTPReturnCode},
+}
+
+// genOpenAPIDocument generates the openapi document of the operations served under basePath.
+func genOpenAPIDocument(basePath string, operations []apiOperation) *openAPIDocument {
+	g := newSchemaGenerator()
+	doc := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info:    openAPIInfo{Title: "Milvus RESTful API", Version: "v1"},
+		Paths:   make(map[string]map[string]*openAPIOperation),
+	}
+	if basePath != "" && basePath != "/" {
+		doc.Servers = []openAPIServer{{URL: basePath}}
+	}
+	for _, op := range operations {
+		operation := &openAPIOperation{
+			Summary: op.summary,
+			Responses: map[string]*openAPIResponse{
+				"200": {
+					Description: "the code is 200 if succeeded, otherwise it is the error code and the message is the reason",
+					Content:     map[string]*openAPIMediaType{binding.MIMEJSON: {Schema: responseSchema}},
+				},
+			},
+		}
+		for _, param := range op.params {
+			schema := &jsonSchema{Type: param.typ}
+			if param.typ == schemaTypeArray {
+				schema.Items = &jsonSchema{Type: schemaTypeString}
+			}
+			operation.Parameters = append(operation.Parameters, openAPIParameter{
+				Name:     param.name,
+				In:       "query",
+				Required: param.required,
+				Schema:   schema,
+			})
+		}
+		if len(op.bodies) > 0 {
+			operation.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  map[string]*openAPIMediaType{binding.MIMEJSON: {Schema: g.bodySchema(op.bodies)}},
+			}
+		}
+		if doc.Paths[op.path] == nil {
+			doc.Paths[op.path] = make(map[string]*openAPIOperation)
+		}
+		doc.Paths[op.path][strings.ToLower(op.method)] = operation
+	}
+	doc.Components.Schemas = g.components
+	return doc
+}
+
+func (h *Handlers) registerOpenAPI(router gin.IRouter, operations []apiOperation) {
+	basePath := ""
+	if group, ok := router.(interface{ BasePath() string }); ok {
+		basePath = group.BasePath()
+	}
+	doc := genOpenAPIDocument(basePath, operations)
+	router.Use(newRequestValidator(basePath, operations, doc.Components.Schemas).validate)
+	router.GET(OpenAPIPath, func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	})
+}
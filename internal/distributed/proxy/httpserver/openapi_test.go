@@ -0,0 +1,228 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func getOpenAPIDocument(t *testing.T, testEngine http.Handler) *openAPIDocument {
+	req := httptest.NewRequest(http.MethodGet, versional(OpenAPIPath), nil)
+	req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	doc := &openAPIDocument{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), doc))
+	return doc
+}
+
+// TestOpenAPIDocument fails if the registered routes and the openapi document drift apart.
+func TestOpenAPIDocument(t *testing.T) {
+	paramtable.Init()
+	testEngine := initHTTPServer(mocks.NewMockProxy(t), true)
+	doc := getOpenAPIDocument(t, testEngine)
+	assert.Equal(t, openAPIVersion, doc.OpenAPI)
+	require.Len(t, doc.Servers, 1)
+	assert.Equal(t, URIPrefixV1, doc.Servers[0].URL)
+
+	documented := make(map[string]struct{})
+	for path, operations := range doc.Paths {
+		for method := range operations {
+			documented[operationKey(strings.ToUpper(method), URIPrefixV1+path)] = struct{}{}
+		}
+	}
+	registered := make(map[string]struct{})
+	for _, route := range testEngine.Routes() {
+		if route.Path == versional(OpenAPIPath) {
+			continue
+		}
+		registered[operationKey(route.Method, route.Path)] = struct{}{}
+	}
+	for key := range registered {
+		assert.Contains(t, documented, key, "the route is not documented")
+	}
+	for key := range documented {
+		assert.Contains(t, registered, key, "the documented route is not registered")
+	}
+
+	for _, op := range v1Operations {
+		operation := doc.Paths[op.path][strings.ToLower(op.method)]
+		require.NotNil(t, operation)
+		if op.method == http.MethodPost {
+			assert.NotNil(t, operation.RequestBody, op.path)
+		} else {
+			assert.Nil(t, operation.RequestBody, op.path)
+		}
+	}
+
+	createCollection := doc.Components.Schemas["CreateCollectionReq"]
+	require.NotNil(t, createCollection)
+	assert.Equal(t, []string{HTTPCollectionName, "dimension"}, createCollection.Required)
+	assert.Equal(t, schemaTypeInteger, createCollection.Properties["dimension"].Type)
+	insert := doc.Paths[VectorInsertPath]["post"].RequestBody.Content["application/json"].Schema
+	assert.Len(t, insert.OneOf, 2)
+	search := doc.Components.Schemas["SearchReq"]
+	assert.Equal(t, schemaTypeArray, search.Properties["vector"].Type)
+	assert.Equal(t, schemaTypeNumber, search.Properties["vector"].Items.Type)
+}
+
+func TestRequestValidation(t *testing.T) {
+	paramtable.Init()
+	testCases := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		expectedErr error
+		expectedMsg string
+	}{
+		{
+			name:        "missing required field",
+			method:      http.MethodPost,
+			path:        VectorCollectionsCreatePath,
+			body:        `{"collectionName": "book"}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+			expectedMsg: "required parameters: [dimension]",
+		},
+		{
+			name:        "empty required field",
+			method:      http.MethodPost,
+			path:        VectorCollectionsCreatePath,
+			body:        `{"collectionName": "", "dimension": 2}`,
+			expectedErr: merr.ErrMissingRequiredParameters,
+			expectedMsg: "required parameters: [collectionName]",
+		},
+		{
+			name:        "type mismatch",
+			method:      http.MethodPost,
+			path:        VectorCollectionsCreatePath,
+			body:        `{"collectionName": "book", "dimension": "2"}`,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+			expectedMsg: "field dimension: expected integer but got string",
+		},
+		{
+			name:        "not an integer",
+			method:      http.MethodPost,
+			path:        VectorCollectionsCreatePath,
+			body:        `{"collectionName": "book", "dimension": 2.5}`,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+			expectedMsg: "field dimension: expected integer but got 2.5",
+		},
+		{
+			name:        "array item mismatch",
+			method:      http.MethodPost,
+			path:        VectorSearchPath,
+			body:        `{"collectionName": "book", "vector": [0.1, "a"]}`,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+			expectedMsg: "field vector[1]: expected number but got string",
+		},
+		{
+			name:        "none of the bodies matched",
+			method:      http.MethodPost,
+			path:        VectorInsertPath,
+			body:        `{"collectionName": "book", "data": 1}`,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+			expectedMsg: "field data: expected array but got number",
+		},
+		{
+			name:        "invalid json",
+			method:      http.MethodPost,
+			path:        VectorCollectionsDropPath,
+			body:        `{"collectionName": `,
+			expectedErr: merr.ErrIncorrectParameterFormat,
+		},
+		{
+			name:        "missing query parameter",
+			method:      http.MethodGet,
+			path:        VectorPartitionsHasPath + "?collectionName=book",
+			expectedErr: merr.ErrMissingRequiredParameters,
+			expectedMsg: "required parameters: [partitionName]",
+		},
+		{
+			name:        "query parameter mismatch",
+			method:      http.MethodGet,
+			path:        VectorImportListPath + "?limit=abc",
+			expectedErr: merr.ErrIncorrectParameterFormat,
+			expectedMsg: "field limit: expected integer but got abc",
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// the requests are rejected before reaching the proxy
+			testEngine := initHTTPServer(mocks.NewMockProxy(t), true)
+			var req *http.Request
+			if tt.method == http.MethodGet {
+				req = httptest.NewRequest(http.MethodGet, versional(tt.path), nil)
+			} else {
+				req = httptest.NewRequest(http.MethodPost, versional(tt.path), bytes.NewReader([]byte(tt.body)))
+			}
+			req.SetBasicAuth(util.UserRoot, util.DefaultRootPassword)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.True(t, CheckErrCode(w.Body.String(), tt.expectedErr), w.Body.String())
+			assert.Contains(t, w.Body.String(), tt.expectedMsg)
+		})
+	}
+}
+
+func TestBindBody(t *testing.T) {
+	newContext := func(body string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, "/", bytes.NewReader([]byte(body)))
+		return c
+	}
+	body := `{"collectionName": "book", "data": [{"id": 1, "vector": [0.1, 0.2]}], "unknown": 1}`
+
+	t.Run("reuse the validated body", func(t *testing.T) {
+		validator := newRequestValidator("", v1Operations, genOpenAPIDocument("", v1Operations).Components.Schemas)
+		c := newContext(body)
+		schema := validator.bodies[operationKey(http.MethodPost, VectorInsertPath)]
+		require.NoError(t, validator.validateBody(c, schema))
+		_, ok := c.Get(ContextRequestBody)
+		require.True(t, ok)
+
+		httpReq := InsertReq{DbName: DefaultDbName}
+		assert.NoError(t, bindBody(c, &httpReq))
+		assert.Equal(t, InsertReq{
+			DbName:         DefaultDbName,
+			CollectionName: "book",
+			Data:           []map[string]interface{}{{"id": float64(1), "vector": []interface{}{0.1, 0.2}}},
+		}, httpReq)
+
+		// the body of the other shape fails to bind as json.Unmarshal does
+		singleReq := SingleInsertReq{}
+		assert.Error(t, bindBody(c, &singleReq))
+	})
+
+	t.Run("decode the body without validator", func(t *testing.T) {
+		c := newContext(body)
+		httpReq := InsertReq{DbName: DefaultDbName}
+		assert.NoError(t, bindBody(c, &httpReq))
+		assert.Equal(t, "book", httpReq.CollectionName)
+		assert.Equal(t, []map[string]interface{}{{"id": float64(1), "vector": []interface{}{0.1, 0.2}}}, httpReq.Data)
+	})
+
+	t.Run("number out of range", func(t *testing.T) {
+		var value interface{}
+		decoder := json.NewDecoder(strings.NewReader(`{"limit": 3000000000}`))
+		decoder.UseNumber()
+		require.NoError(t, decoder.Decode(&value))
+		httpReq := QueryReq{}
+		assert.Error(t, bindValue(reflect.ValueOf(&httpReq).Elem(), value))
+	})
+}
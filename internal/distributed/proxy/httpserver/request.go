@@ -24,6 +24,15 @@ type QueryReq struct {
 	Offset         int32    `json:"offset"`
 }
 
+type QueryStreamReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	OutputFields   []string `json:"outputFields"`
+	Filter         string   `json:"filter"`
+	Cursor         string   `json:"cursor"`
+	BatchSize      int32    `json:"batchSize"`
+}
+
 type GetReq struct {
 	DbName         string      `json:"dbName"`
 	CollectionName string      `json:"collectionName" validate:"required"`
@@ -71,3 +80,142 @@ type SearchReq struct {
 	OutputFields   []string  `json:"outputFields"`
 	Vector         []float32 `json:"vector"`
 }
+
+type LoadCollectionReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	ReplicaNumber  int32    `json:"replicaNumber"`
+	ResourceGroups []string `json:"resourceGroups"`
+}
+
+type ReleaseCollectionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+}
+
+type PartitionReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	PartitionName  string `json:"partitionName" validate:"required"`
+}
+
+type PartitionsReq struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" validate:"required"`
+	PartitionNames []string `json:"partitionNames" validate:"required"`
+	ReplicaNumber  int32    `json:"replicaNumber"`
+	ResourceGroups []string `json:"resourceGroups"`
+}
+
+type CreateIndexReq struct {
+	DbName         string                 `json:"dbName"`
+	CollectionName string                 `json:"collectionName" validate:"required"`
+	FieldName      string                 `json:"fieldName" validate:"required"`
+	IndexName      string                 `json:"indexName"`
+	MetricType     string                 `json:"metricType"`
+	IndexType      string                 `json:"indexType"`
+	Params         map[string]interface{} `json:"params"`
+}
+
+type DropIndexReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	FieldName      string `json:"fieldName"`
+	IndexName      string `json:"indexName" validate:"required"`
+}
+
+type AliasReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	AliasName      string `json:"aliasName" validate:"required"`
+}
+
+type DropAliasReq struct {
+	DbName    string `json:"dbName"`
+	AliasName string `json:"aliasName" validate:"required"`
+}
+
+type UserReq struct {
+	UserName string `json:"userName" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type UpdatePasswordReq struct {
+	UserName    string `json:"userName" validate:"required"`
+	Password    string `json:"password" validate:"required"`
+	NewPassword string `json:"newPassword" validate:"required"`
+}
+
+type DropUserReq struct {
+	UserName string `json:"userName" validate:"required"`
+}
+
+type UserRoleReq struct {
+	UserName string `json:"userName" validate:"required"`
+	RoleName string `json:"roleName" validate:"required"`
+}
+
+type APIKeyReq struct {
+	UserName       string `json:"userName"`
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName"`
+	ReadOnly       bool   `json:"readOnly"`
+	ExpireAt       int64  `json:"expireAt"`
+	Description    string `json:"description"`
+}
+
+type RevokeAPIKeyReq struct {
+	UserName string `json:"userName"`
+	ID       string `json:"id" validate:"required"`
+}
+
+type RoleReq struct {
+	RoleName string `json:"roleName" validate:"required"`
+}
+
+type RowPolicyReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	RoleName       string `json:"roleName" validate:"required"`
+	Expr           string `json:"expr" validate:"required"`
+}
+
+type RevokeRowPolicyReq struct {
+	DbName         string `json:"dbName"`
+	CollectionName string `json:"collectionName" validate:"required"`
+	RoleName       string `json:"roleName" validate:"required"`
+}
+
+type PrivilegeReq struct {
+	DbName     string `json:"dbName"`
+	RoleName   string `json:"roleName" validate:"required"`
+	ObjectType string `json:"objectType" validate:"required"`
+	ObjectName string `json:"objectName" validate:"required"`
+	Privilege  string `json:"privilege" validate:"required"`
+}
+
+type ResourceGroupReq struct {
+	Name string `json:"name" validate:"required"`
+}
+
+type TransferNodeReq struct {
+	SourceResourceGroup string `json:"sourceResourceGroup" validate:"required"`
+	TargetResourceGroup string `json:"targetResourceGroup" validate:"required"`
+	NumNode             int32  `json:"numNode" validate:"required"`
+}
+
+type TransferReplicaReq struct {
+	DbName              string `json:"dbName"`
+	SourceResourceGroup string `json:"sourceResourceGroup" validate:"required"`
+	TargetResourceGroup string `json:"targetResourceGroup" validate:"required"`
+	CollectionName      string `json:"collectionName" validate:"required"`
+	NumReplica          int64  `json:"numReplica" validate:"required"`
+}
+
+type ImportReq struct {
+	DbName         string                 `json:"dbName"`
+	CollectionName string                 `json:"collectionName" validate:"required"`
+	PartitionName  string                 `json:"partitionName"`
+	Files          []string               `json:"files" validate:"required"`
+	Options        map[string]interface{} `json:"options"`
+}
@@ -0,0 +1,432 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// fieldError is the validation error of a field in the request.
+type fieldError struct {
+	field   string
+	missing bool
+	reason  string
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("field %s: %s", e.field, e.reason)
+}
+
+// requestValidator validates the requests against the schemas of the operations before they reach the handlers.
+type requestValidator struct {
+	operations map[string]apiOperation
+	bodies     map[string]*jsonSchema
+	components map[string]*jsonSchema
+}
+
+func operationKey(method string, path string) string {
+	return method + " " + path
+}
+
+func newRequestValidator(basePath string, operations []apiOperation, components map[string]*jsonSchema) *requestValidator {
+	g := &schemaGenerator{components: components}
+	v := &requestValidator{
+		operations: make(map[string]apiOperation),
+		bodies:     make(map[string]*jsonSchema),
+		components: components,
+	}
+	basePath = strings.TrimSuffix(basePath, "/")
+	for _, op := range operations {
+		key := operationKey(op.method, basePath+op.path)
+		v.operations[key] = op
+		if len(op.bodies) > 0 {
+			v.bodies[key] = g.bodySchema(op.bodies)
+		}
+	}
+	return v
+}
+
+func (v *requestValidator) validate(c *gin.Context) {
+	key := operationKey(c.Request.Method, c.FullPath())
+	op, ok := v.operations[key]
+	if !ok {
+		c.Next()
+		return
+	}
+	var err error
+	if schema, ok := v.bodies[key]; ok {
+		err = v.validateBody(c, schema)
+	} else {
+		err = validateQuery(c, op.params)
+	}
+	if err != nil {
+		log.Warn("high level restful api, the request is invalid", zap.String("path", c.FullPath()), zap.Error(err))
+		if fieldErr, ok := err.(*fieldError); ok && fieldErr.missing {
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrMissingRequiredParameters),
+				HTTPReturnMessage: merr.ErrMissingRequiredParameters.Error() + ", required parameters: [" + fieldErr.field + "]",
+			})
+			return
+		}
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	c.Next()
+}
+
+// validateBody validates the json body, the decoded body is cached in the context so that the handlers
+// bind it by bindBody without decoding it again.
+func (v *requestValidator) validateBody(c *gin.Context, schema *jsonSchema) error {
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	c.Set(gin.BodyBytesKey, body)
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	if err := v.validateValue(schema, "", value); err != nil {
+		return err
+	}
+	c.Set(ContextRequestBody, value)
+	return nil
+}
+
+// bindBody binds the json body to obj, the body decoded by the request validator is reused if there is.
+func bindBody(c *gin.Context, obj interface{}) error {
+	if value, ok := c.Get(ContextRequestBody); ok {
+		return bindValue(reflect.ValueOf(obj).Elem(), value)
+	}
+	return c.ShouldBindBodyWith(obj, binding.JSON)
+}
+
+// bindValue sets the decoded json value to dst as json.Unmarshal does.
+func bindValue(dst reflect.Value, value interface{}) error {
+	if value == nil {
+		switch dst.Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Map, reflect.Slice:
+			dst.Set(reflect.Zero(dst.Type()))
+		}
+		return nil
+	}
+	mismatch := func() error {
+		return fmt.Errorf("json: cannot unmarshal %s into Go value of type %s", jsonTypeOf(value), dst.Type())
+	}
+
+	switch dst.Kind() {
+	case reflect.Interface:
+		if dst.NumMethod() != 0 {
+			return mismatch()
+		}
+		plain, err := plainValue(value)
+		if err != nil {
+			return err
+		}
+		dst.Set(reflect.ValueOf(plain))
+	case reflect.Ptr:
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return bindValue(dst.Elem(), value)
+	case reflect.String:
+		str, ok := value.(string)
+		if !ok {
+			return mismatch()
+		}
+		dst.SetString(str)
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return mismatch()
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return mismatch()
+		}
+		i, err := strconv.ParseInt(number.String(), 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("json: cannot unmarshal number %s into Go value of type %s", number, dst.Type())
+		}
+		dst.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return mismatch()
+		}
+		u, err := strconv.ParseUint(number.String(), 10, dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("json: cannot unmarshal number %s into Go value of type %s", number, dst.Type())
+		}
+		dst.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		number, ok := value.(json.Number)
+		if !ok {
+			return mismatch()
+		}
+		f, err := strconv.ParseFloat(number.String(), dst.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("json: cannot unmarshal number %s into Go value of type %s", number, dst.Type())
+		}
+		dst.SetFloat(f)
+	case reflect.Slice:
+		array, ok := value.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		slice := reflect.MakeSlice(dst.Type(), len(array), len(array))
+		for i, item := range array {
+			if err := bindValue(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		dst.Set(slice)
+	case reflect.Map:
+		object, ok := value.(map[string]interface{})
+		if !ok || dst.Type().Key().Kind() != reflect.String {
+			return mismatch()
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), len(object))
+		for key, item := range object {
+			elem := reflect.New(dst.Type().Elem()).Elem()
+			if err := bindValue(elem, item); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(dst.Type().Key()), elem)
+		}
+		dst.Set(m)
+	case reflect.Struct:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		for i := 0; i < dst.NumField(); i++ {
+			field := dst.Type().Field(i)
+			name, ok := jsonFieldName(field)
+			if !ok {
+				continue
+			}
+			item, ok := object[name]
+			if !ok {
+				// the field names are matched case-insensitively as json.Unmarshal does
+				for key, v := range object {
+					if strings.EqualFold(key, name) {
+						item, ok = v, true
+						break
+					}
+				}
+			}
+			if !ok {
+				continue
+			}
+			if err := bindValue(dst.Field(i), item); err != nil {
+				return err
+			}
+		}
+	default:
+		return mismatch()
+	}
+	return nil
+}
+
+// plainValue converts the numbers in the decoded json value to float64, as json.Unmarshal does for interface{}.
+func plainValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	case []interface{}:
+		ret := make([]interface{}, len(v))
+		for i, item := range v {
+			plain, err := plainValue(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[i] = plain
+		}
+		return ret, nil
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			plain, err := plainValue(item)
+			if err != nil {
+				return nil, err
+			}
+			ret[key] = plain
+		}
+		return ret, nil
+	}
+	return value, nil
+}
+
+func (v *requestValidator) resolve(schema *jsonSchema) *jsonSchema {
+	if schema.Ref == "" {
+		return schema
+	}
+	return v.components[strings.TrimPrefix(schema.Ref, componentsSchemaPrefix)]
+}
+
+func (v *requestValidator) validateValue(schema *jsonSchema, field string, value interface{}) error {
+	schema = v.resolve(schema)
+	if len(schema.OneOf) > 0 {
+		var firstErr error
+		for _, candidate := range schema.OneOf {
+			err := v.validateValue(candidate, field, value)
+			if err == nil {
+				return nil
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		return firstErr
+	}
+	if value == nil {
+		return nil
+	}
+
+	mismatch := func() error {
+		return &fieldError{field: fieldOrBody(field), reason: fmt.Sprintf("expected %s but got %s", schema.Type, jsonTypeOf(value))}
+	}
+	switch schema.Type {
+	case schemaTypeString:
+		if _, ok := value.(string); !ok {
+			return mismatch()
+		}
+	case schemaTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return mismatch()
+		}
+	case schemaTypeInteger:
+		number, ok := value.(json.Number)
+		if !ok {
+			return mismatch()
+		}
+		if _, err := number.Int64(); err != nil {
+			return &fieldError{field: fieldOrBody(field), reason: "expected integer but got " + number.String()}
+		}
+	case schemaTypeNumber:
+		if _, ok := value.(json.Number); !ok {
+			return mismatch()
+		}
+	case schemaTypeArray:
+		array, ok := value.([]interface{})
+		if !ok {
+			return mismatch()
+		}
+		for i, item := range array {
+			if err := v.validateValue(schema.Items, fmt.Sprintf("%s[%d]", field, i), item); err != nil {
+				return err
+			}
+		}
+	case schemaTypeObject:
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return mismatch()
+		}
+		for _, name := range schema.Required {
+			if isEmptyValue(object[name]) {
+				return &fieldError{field: joinField(field, name), missing: true, reason: "required"}
+			}
+		}
+		names := lo.Keys(object)
+		sort.Strings(names)
+		for _, name := range names {
+			item := object[name]
+			propSchema, ok := schema.Properties[name]
+			if !ok {
+				// the unknown fields are ignored as the handlers do
+				if schema.AdditionalProperties == nil {
+					continue
+				}
+				propSchema = schema.AdditionalProperties
+			}
+			if err := v.validateValue(propSchema, joinField(field, name), item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateQuery(c *gin.Context, params []apiParam) error {
+	for _, param := range params {
+		value, ok := c.GetQuery(param.name)
+		if param.required && (!ok || value == "") {
+			return &fieldError{field: param.name, missing: true, reason: "required"}
+		}
+		if ok && value != "" && param.typ == schemaTypeInteger {
+			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+				return &fieldError{field: param.name, reason: "expected integer but got " + value}
+			}
+		}
+	}
+	return nil
+}
+
+// isEmptyValue returns whether the value doesn't satisfy the required rule.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func jsonTypeOf(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return schemaTypeString
+	case bool:
+		return schemaTypeBoolean
+	case json.Number:
+		return schemaTypeNumber
+	case []interface{}:
+		return schemaTypeArray
+	case map[string]interface{}:
+		return schemaTypeObject
+	}
+	return "null"
+}
+
+func joinField(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func fieldOrBody(field string) string {
+	if field == "" {
+		return "body"
+	}
+	return field
+}
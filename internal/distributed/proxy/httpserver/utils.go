@@ -170,6 +170,60 @@ func printIndexes(indexes []*milvuspb.IndexDescription) []gin.H {
 	return res
 }
 
+func printIndexDetails(indexes []*milvuspb.IndexDescription) []gin.H {
+	res := make([]gin.H, 0, len(indexes))
+	for _, index := range indexes {
+		indexType := ""
+		for _, pair := range index.GetParams() {
+			if pair.GetKey() == common.IndexTypeKey {
+				indexType = pair.GetValue()
+			}
+		}
+		res = append(res, gin.H{
+			HTTPReturnIndexName:        index.GetIndexName(),
+			HTTPReturnIndexField:       index.GetFieldName(),
+			HTTPReturnIndexMetricsType: getMetricType(index.GetParams()),
+			"indexType":                indexType,
+			"indexState":               index.GetState().String(),
+			"indexedRows":              index.GetIndexedRows(),
+			"totalRows":                index.GetTotalRows(),
+			"pendingRows":              index.GetPendingIndexRows(),
+			"failReason":               index.GetIndexStateFailReason(),
+		})
+	}
+	return res
+}
+
+func printGrants(grants []*milvuspb.GrantEntity) []gin.H {
+	res := make([]gin.H, 0, len(grants))
+	for _, grant := range grants {
+		res = append(res, gin.H{
+			"objectType": grant.GetObject().GetName(),
+			"objectName": grant.GetObjectName(),
+			"dbName":     grant.GetDbName(),
+			"privilege":  grant.GetGrantor().GetPrivilege().GetName(),
+			"grantor":    grant.GetGrantor().GetUser().GetName(),
+		})
+	}
+	return res
+}
+
+func printImportState(state *milvuspb.GetImportStateResponse) gin.H {
+	infos := make(map[string]string, len(state.GetInfos()))
+	for _, info := range state.GetInfos() {
+		infos[info.GetKey()] = info.GetValue()
+	}
+	return gin.H{
+		"taskId":       strconv.FormatInt(state.GetId(), 10),
+		"state":        state.GetState().String(),
+		"rowCount":     state.GetRowCount(),
+		"collectionId": strconv.FormatInt(state.GetCollectionId(), 10),
+		"segmentIds":   formatInt64(state.GetSegmentIds()),
+		"createTs":     state.GetCreateTs(),
+		"infos":        infos,
+	}
+}
+
 // --------------------- insert param --------------------- //
 
 func checkAndSetData(body string, collDescResp *milvuspb.DescribeCollectionResponse) (error, []map[string]interface{}) {
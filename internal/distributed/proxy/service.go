@@ -120,29 +120,68 @@ func NewServer(ctx context.Context, factory dependency.Factory) (*Server, error)
 
 func authenticate(c *gin.Context) {
 	c.Set(httpserver.ContextUsername, "")
+	// the restful requests are audited with the client address and the path as the method
+	c.Set(accesslog.ContextClientAddr, c.ClientIP())
+	c.Set(accesslog.ContextMethod, c.Request.URL.Path)
 	if !proxy.Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
 		return
 	}
-	username, password, ok := httpserver.ParseUsernamePassword(c)
-	if ok {
+	// the openapi document is public
+	if c.Request.Method == http.MethodGet && c.Request.URL.Path == httpserver.URIPrefixV1+httpserver.OpenAPIPath {
+		return
+	}
+	username, password, hasPassword := httpserver.ParseUsernamePassword(c)
+	if hasPassword {
 		if proxy.PasswordVerify(c, username, password) {
+			if !isUpdatePasswordRequest(c) {
+				if err := proxy.CheckPasswordRotation(c, username); err != nil {
+					c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{httpserver.HTTPReturnCode: merr.Code(err), httpserver.HTTPReturnMessage: err.Error()})
+					return
+				}
+			}
 			log.Debug("auth successful", zap.String("username", username))
 			c.Set(httpserver.ContextUsername, username)
 			return
 		}
 	}
 	rawToken := httpserver.GetAuthorization(c)
-	if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
-		user, err := proxy.VerifyAPIKey(rawToken)
+	if jwtToken, ok := proxy.ParseJWTToken(rawToken); ok {
+		user, roles, err := proxy.VerifyJWT(c, jwtToken)
+		if err == nil {
+			c.Set(httpserver.ContextUsername, user)
+			c.Set(proxy.ContextTokenRoles, roles)
+			return
+		}
+		log.Warn("fail to verify jwt", zap.Error(err))
+		accesslog.PrintAuditLoginFailure(c, "", "invalid jwt: "+err.Error())
+	} else if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
+		user, apiKey, err := proxy.VerifyAPIKey(c, rawToken)
 		if err == nil {
 			c.Set(httpserver.ContextUsername, user)
+			if apiKey != nil {
+				c.Set(proxy.ContextAPIKey, apiKey)
+			}
 			return
 		}
 		log.Warn("fail to verify apikey", zap.Error(err))
+		// the failed password is audited by the verification
+		if !hasPassword {
+			accesslog.PrintAuditLoginFailure(c, "", "invalid api key")
+		}
+	} else if rawToken == "" {
+		accesslog.PrintAuditLoginFailure(c, "", "missing authorization")
 	}
 	c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{httpserver.HTTPReturnCode: merr.Code(merr.ErrNeedAuthenticate), httpserver.HTTPReturnMessage: merr.ErrNeedAuthenticate.Error()})
 }
 
+// isUpdatePasswordRequest checks whether the request updates the password,
+// which is permitted to the user who must change the password.
+func isUpdatePasswordRequest(c *gin.Context) bool {
+	path := c.Request.URL.Path
+	return (c.Request.Method == http.MethodPost && strings.HasSuffix(path, httpserver.VectorUsersUpdatePasswordPath)) ||
+		(c.Request.Method == http.MethodPatch && strings.HasSuffix(path, "/credential"))
+}
+
 // registerHTTPServer register the http server, panic when failed
 func (s *Server) registerHTTPServer() {
 	// (Embedded Milvus Only) Discard gin logs if logging is disabled.
@@ -188,7 +227,7 @@ func (s *Server) startHTTPServer(errChan chan error) {
 		}
 		c.Next()
 	}, authenticate, proxy.HTTPTraceLog)
-	app := ginHandler.Group("/v1")
+	app := ginHandler.Group(httpserver.URIPrefixV1)
 	httpserver.NewHandlers(s.proxy).RegisterRoutesToV1(app)
 	s.httpServer = &http.Server{Handler: ginHandler, ReadHeaderTimeout: time.Second}
 	errChan <- nil
@@ -232,6 +271,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	log.Debug("Get proxy rate limiter done", zap.Int("port", grpcPort))
 
 	opts := tracer.GetInterceptorOpts()
+	hookInterceptor := proxy.UnaryServerHookInterceptor()
 	grpcOpts := []grpc.ServerOption{
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
@@ -241,7 +281,8 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			otelgrpc.UnaryServerInterceptor(opts...),
 			grpc_auth.UnaryServerInterceptor(proxy.AuthenticationInterceptor),
 			proxy.DatabaseInterceptor(),
-			proxy.UnaryServerHookInterceptor(),
+			proxy.AuditLogInterceptor,
+			hookInterceptor,
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
 			proxy.RateLimitInterceptor(limiter),
@@ -249,6 +290,15 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			proxy.TraceLogInterceptor,
 			proxy.KeepActiveInterceptor,
 		)),
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			otelgrpc.StreamServerInterceptor(opts...),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
+			proxy.UnaryServerInterceptorToStream(proxy.DatabaseInterceptor()),
+			proxy.UnaryServerInterceptorToStream(proxy.AuditLogInterceptor),
+			proxy.UnaryServerInterceptorToStream(hookInterceptor),
+			proxy.StreamRequestInterceptor(limiter),
+			proxy.UnaryServerInterceptorToStream(accesslog.UnaryAccessLoggerInterceptor),
+		)),
 	}
 
 	if Params.TLSMode.GetAsInt() == 1 {
@@ -291,6 +341,16 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyStreamServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyAPIKeyServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyRowPolicyServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxySchemaServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyCloneServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyRecycleBinServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxySnapshotServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyDDLJobServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyDatabaseServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyAliasServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -841,6 +901,11 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+// QueryStream sends the results of the query to the client batch by batch.
+func (s *Server) QueryStream(request *milvuspb.QueryRequest, stream proxypb.ProxyStream_QueryStreamServer) error {
+	return s.proxy.QueryStream(request, stream)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -1009,6 +1074,86 @@ func (s *Server) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUsersR
 	return s.proxy.ListCredUsers(ctx, req)
 }
 
+func (s *Server) CreateAPIKey(ctx context.Context, req *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+	return s.proxy.CreateAPIKey(ctx, req)
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	return s.proxy.ListAPIKeys(ctx, req)
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokeAPIKey(ctx, req)
+}
+
+func (s *Server) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.GrantRowPolicy(ctx, req)
+}
+
+func (s *Server) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokeRowPolicy(ctx, req)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	return s.proxy.ListRowPolicies(ctx, req)
+}
+
+func (s *Server) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, req)
+}
+
+func (s *Server) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.CloneCollection(ctx, req)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, req *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	return s.proxy.ListDroppedCollections(ctx, req)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, req *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.UndropCollection(ctx, req)
+}
+
+func (s *Server) UndropPartition(ctx context.Context, req *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	return s.proxy.UndropPartition(ctx, req)
+}
+
+func (s *Server) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateSnapshot(ctx, req)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.DropSnapshot(ctx, req)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	return s.proxy.ListSnapshots(ctx, req)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return s.proxy.RestoreSnapshot(ctx, req)
+}
+
+func (s *Server) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	return s.proxy.GetDDLJob(ctx, req)
+}
+
+func (s *Server) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return s.proxy.ListDDLJobs(ctx, req)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, req *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterDatabase(ctx, req)
+}
+
+func (s *Server) DescribeDatabase(ctx context.Context, req *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	return s.proxy.DescribeDatabase(ctx, req)
+}
+
+func (s *Server) BatchAlterAliases(ctx context.Context, req *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	return s.proxy.BatchAlterAliases(ctx, req)
+}
+
 func (s *Server) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateRole(ctx, req)
 }
@@ -18,18 +18,24 @@ package grpcproxy
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -363,6 +369,14 @@ func (m *MockProxy) GetRateLimiter() (types.Limiter, error) {
 	return nil, nil
 }
 
+func (m *MockProxy) QueryStream(request *milvuspb.QueryRequest, stream proxypb.ProxyStream_QueryStreamServer) error {
+	return nil
+}
+
+func (m *MockProxy) QueryPages(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+	return nil
+}
+
 func (m *MockProxy) UpdateStateCode(stateCode commonpb.StateCode) {
 }
 
@@ -436,6 +450,86 @@ func (m *MockProxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUse
 	return nil, nil
 }
 
+func (m *MockProxy) CreateAPIKey(ctx context.Context, req *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDroppedCollections(ctx context.Context, req *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UndropCollection(ctx context.Context, req *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UndropPartition(ctx context.Context, req *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterDatabase(ctx context.Context, req *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DescribeDatabase(ctx context.Context, req *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) BatchAlterAliases(ctx context.Context, req *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -893,6 +987,106 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("CreateAPIKey", func(t *testing.T) {
+		_, err := server.CreateAPIKey(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListAPIKeys", func(t *testing.T) {
+		_, err := server.ListAPIKeys(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RevokeAPIKey", func(t *testing.T) {
+		_, err := server.RevokeAPIKey(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("GrantRowPolicy", func(t *testing.T) {
+		_, err := server.GrantRowPolicy(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RevokeRowPolicy", func(t *testing.T) {
+		_, err := server.RevokeRowPolicy(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListRowPolicies", func(t *testing.T) {
+		_, err := server.ListRowPolicies(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AddCollectionField", func(t *testing.T) {
+		_, err := server.AddCollectionField(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("CloneCollection", func(t *testing.T) {
+		_, err := server.CloneCollection(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListDroppedCollections", func(t *testing.T) {
+		_, err := server.ListDroppedCollections(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UndropCollection", func(t *testing.T) {
+		_, err := server.UndropCollection(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("UndropPartition", func(t *testing.T) {
+		_, err := server.UndropPartition(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("CreateSnapshot", func(t *testing.T) {
+		_, err := server.CreateSnapshot(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DropSnapshot", func(t *testing.T) {
+		_, err := server.DropSnapshot(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListSnapshots", func(t *testing.T) {
+		_, err := server.ListSnapshots(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("RestoreSnapshot", func(t *testing.T) {
+		_, err := server.RestoreSnapshot(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetDDLJob", func(t *testing.T) {
+		_, err := server.GetDDLJob(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ListDDLJobs", func(t *testing.T) {
+		_, err := server.ListDDLJobs(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlterDatabase", func(t *testing.T) {
+		_, err := server.AlterDatabase(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("DescribeDatabase", func(t *testing.T) {
+		_, err := server.DescribeDatabase(ctx, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BatchAlterAliases", func(t *testing.T) {
+		_, err := server.BatchAlterAliases(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("InvalidateCredentialCache", func(t *testing.T) {
 		_, err := server.InvalidateCredentialCache(ctx, nil)
 		assert.NoError(t, err)
@@ -1380,3 +1574,54 @@ func TestHttpAuthenticate(t *testing.T) {
 		assert.Equal(t, "foo", ctxName)
 	}
 }
+
+func TestHttpAuthenticateWithJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	jwks, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{{
+		"kty": "EC",
+		"kid": "ec",
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		"y":   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}}})
+	assert.NoError(t, err)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(jwksFile, jwks, 0o600))
+
+	params := paramtable.Get()
+	params.Save(proxy.Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	params.Save(proxy.Params.CommonCfg.JWTEnabled.Key, "true")
+	params.Save(proxy.Params.CommonCfg.JWKSURI.Key, jwksFile)
+	params.Save(proxy.Params.CommonCfg.JWTRolesClaim.Key, "groups")
+	defer params.Reset(proxy.Params.CommonCfg.AuthorizationEnabled.Key)
+	defer params.Reset(proxy.Params.CommonCfg.JWTEnabled.Key)
+	defer params.Reset(proxy.Params.CommonCfg.JWKSURI.Key)
+	defer params.Reset(proxy.Params.CommonCfg.JWTRolesClaim.Key)
+
+	sign := func(exp time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "alice", "groups": "role1,role2", "exp": exp.Unix()})
+		token.Header["kid"] = "ec"
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+		return signed
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", "/test", nil)
+		ctx.Request.Header.Set("Authorization", "Bearer "+sign(time.Now().Add(time.Hour)))
+		authenticate(ctx)
+		assert.False(t, ctx.IsAborted())
+		assert.Equal(t, "alice", ctx.GetString(httpserver.ContextUsername))
+		assert.Equal(t, []string{"role1", "role2"}, proxy.GetTokenRolesFromContext(ctx))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", "/test", nil)
+		ctx.Request.Header.Set("Authorization", "Bearer "+sign(time.Now().Add(-time.Hour)))
+		authenticate(ctx)
+		assert.True(t, ctx.IsAborted())
+	})
+}
@@ -213,6 +213,18 @@ func (c *Client) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncN
 	})
 }
 
+// UpdateSchema notifies QueryCoord to push the schema of the collection to the QueryNodes if it is loaded.
+func (c *Client) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.sess.ServerID)),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryCoordClient) (*commonpb.Status, error) {
+		return client.UpdateSchema(ctx, req)
+	})
+}
+
 // GetPartitionStates gets the states of the specified partition.
 func (c *Client) GetPartitionStates(ctx context.Context, req *querypb.GetPartitionStatesRequest, opts ...grpc.CallOption) (*querypb.GetPartitionStatesResponse, error) {
 	req = typeutil.Clone(req)
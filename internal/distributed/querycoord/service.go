@@ -360,6 +360,11 @@ func (s *Server) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncN
 	return s.queryCoord.SyncNewCreatedPartition(ctx, req)
 }
 
+// UpdateSchema notifies QueryCoord to push the schema of the collection to the QueryNodes if it is loaded.
+func (s *Server) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UpdateSchema(ctx, req)
+}
+
 // GetSegmentInfo gets the information of the specified segment from QueryCoord.
 func (s *Server) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 	return s.queryCoord.GetSegmentInfo(ctx, req)
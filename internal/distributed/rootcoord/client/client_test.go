@@ -184,6 +184,78 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListCredUsers(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.CreateAPIKey(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListAPIKeys(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RevokeAPIKey(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ReportLoginFailure(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GrantRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RevokeRowPolicy(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.AddCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.CloneCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDroppedCollections(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.UndropCollection(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.UndropPartition(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.CreateSnapshot(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DropSnapshot(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListSnapshots(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.RestoreSnapshot(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.GetDDLJob(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListDDLJobs(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.ListRowPolicies(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.InvalidateCollectionMetaCache(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -240,6 +312,18 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListDatabases(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AlterDatabase(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DescribeDatabase(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.BatchAlterAliases(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[rootcoordpb.RootCoordClient]{
@@ -398,6 +482,34 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListCredUsers(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.CreateAPIKey(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListAPIKeys(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RevokeAPIKey(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ReportLoginFailure(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GrantRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RevokeRowPolicy(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListRowPolicies(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.ListImportTasks(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -454,6 +566,62 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListDatabases(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AlterDatabase(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DescribeDatabase(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.BatchAlterAliases(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.AddCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.CloneCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDroppedCollections(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.UndropCollection(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.UndropPartition(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.CreateSnapshot(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DropSnapshot(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListSnapshots(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.RestoreSnapshot(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.GetDDLJob(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.ListDDLJobs(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	// clean up
 	err = client.Close()
 	assert.NoError(t, err)
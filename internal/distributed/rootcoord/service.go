@@ -89,6 +89,18 @@ func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDataba
 	return s.rootCoord.ListDatabases(ctx, request)
 }
 
+func (s *Server) AlterDatabase(ctx context.Context, request *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterDatabase(ctx, request)
+}
+
+func (s *Server) DescribeDatabase(ctx context.Context, request *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	return s.rootCoord.DescribeDatabase(ctx, request)
+}
+
+func (s *Server) BatchAlterAliases(ctx context.Context, request *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	return s.rootCoord.BatchAlterAliases(ctx, request)
+}
+
 func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.rootCoord.CheckHealth(ctx, request)
 }
@@ -481,6 +493,34 @@ func (s *Server) ListCredUsers(ctx context.Context, request *milvuspb.ListCredUs
 	return s.rootCoord.ListCredUsers(ctx, request)
 }
 
+func (s *Server) CreateAPIKey(ctx context.Context, request *internalpb.APIKeyInfo) (*commonpb.Status, error) {
+	return s.rootCoord.CreateAPIKey(ctx, request)
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, request *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	return s.rootCoord.ListAPIKeys(ctx, request)
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, request *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeAPIKey(ctx, request)
+}
+
+func (s *Server) ReportLoginFailure(ctx context.Context, request *internalpb.ReportLoginFailureRequest) (*internalpb.ReportLoginFailureResponse, error) {
+	return s.rootCoord.ReportLoginFailure(ctx, request)
+}
+
+func (s *Server) GrantRowPolicy(ctx context.Context, request *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.GrantRowPolicy(ctx, request)
+}
+
+func (s *Server) RevokeRowPolicy(ctx context.Context, request *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeRowPolicy(ctx, request)
+}
+
+func (s *Server) ListRowPolicies(ctx context.Context, request *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	return s.rootCoord.ListRowPolicies(ctx, request)
+}
+
 func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	return s.rootCoord.CreateRole(ctx, request)
 }
@@ -520,3 +560,47 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) AddCollectionField(ctx context.Context, request *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+func (s *Server) CloneCollection(ctx context.Context, request *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CloneCollection(ctx, request)
+}
+
+func (s *Server) ListDroppedCollections(ctx context.Context, request *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	return s.rootCoord.ListDroppedCollections(ctx, request)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, request *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UndropCollection(ctx, request)
+}
+
+func (s *Server) UndropPartition(ctx context.Context, request *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UndropPartition(ctx, request)
+}
+
+func (s *Server) CreateSnapshot(ctx context.Context, request *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateSnapshot(ctx, request)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, request *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropSnapshot(ctx, request)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, request *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	return s.rootCoord.ListSnapshots(ctx, request)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, request *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RestoreSnapshot(ctx, request)
+}
+
+func (s *Server) GetDDLJob(ctx context.Context, request *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	return s.rootCoord.GetDDLJob(ctx, request)
+}
+
+func (s *Server) ListDDLJobs(ctx context.Context, request *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	return s.rootCoord.ListDDLJobs(ctx, request)
+}
@@ -92,4 +92,5 @@ type SnapShotKV interface {
 	MultiSave(kvs map[string]string, ts typeutil.Timestamp) error
 	LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error)
 	MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp) error
+	MultiSaveAndRemoveWithPlainKvs(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error
 }
@@ -6,11 +6,12 @@ import (
 
 type mockSnapshotKV struct {
 	SnapShotKV
-	SaveFunc                         func(key string, value string, ts typeutil.Timestamp) error
-	LoadFunc                         func(key string, ts typeutil.Timestamp) (string, error)
-	MultiSaveFunc                    func(kvs map[string]string, ts typeutil.Timestamp) error
-	LoadWithPrefixFunc               func(key string, ts typeutil.Timestamp) ([]string, []string, error)
-	MultiSaveAndRemoveWithPrefixFunc func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
+	SaveFunc                           func(key string, value string, ts typeutil.Timestamp) error
+	LoadFunc                           func(key string, ts typeutil.Timestamp) (string, error)
+	MultiSaveFunc                      func(kvs map[string]string, ts typeutil.Timestamp) error
+	LoadWithPrefixFunc                 func(key string, ts typeutil.Timestamp) ([]string, []string, error)
+	MultiSaveAndRemoveWithPrefixFunc   func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
+	MultiSaveAndRemoveWithPlainKvsFunc func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error
 }
 
 func NewMockSnapshotKV() *mockSnapshotKV {
@@ -51,3 +52,10 @@ func (m mockSnapshotKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 	}
 	return nil
 }
+
+func (m mockSnapshotKV) MultiSaveAndRemoveWithPlainKvs(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error {
+	if m.MultiSaveAndRemoveWithPlainKvsFunc != nil {
+		return m.MultiSaveAndRemoveWithPlainKvsFunc(saves, removals, plainSaves, plainRemovals, ts)
+	}
+	return nil
+}
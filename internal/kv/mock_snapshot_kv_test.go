@@ -87,3 +87,19 @@ func Test_mockSnapshotKV_MultiSaveAndRemoveWithPrefix(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_mockSnapshotKV_MultiSaveAndRemoveWithPlainKvs(t *testing.T) {
+	t.Run("func not set", func(t *testing.T) {
+		snapshot := NewMockSnapshotKV()
+		err := snapshot.MultiSaveAndRemoveWithPlainKvs(nil, nil, nil, nil, 0)
+		assert.NoError(t, err)
+	})
+	t.Run("func set", func(t *testing.T) {
+		snapshot := NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithPlainKvsFunc = func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error {
+			return nil
+		}
+		err := snapshot.MultiSaveAndRemoveWithPlainKvs(nil, nil, nil, nil, 0)
+		assert.NoError(t, err)
+	})
+}
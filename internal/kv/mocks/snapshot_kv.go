@@ -177,6 +177,52 @@ func (_c *SnapShotKV_MultiSave_Call) RunAndReturn(run func(map[string]string, ui
 	return _c
 }
 
+// MultiSaveAndRemoveWithPlainKvs provides a mock function with given fields: saves, removals, plainSaves, plainRemovals, ts
+func (_m *SnapShotKV) MultiSaveAndRemoveWithPlainKvs(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts uint64) error {
+	ret := _m.Called(saves, removals, plainSaves, plainRemovals, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string, []string, map[string]string, []string, uint64) error); ok {
+		r0 = rf(saves, removals, plainSaves, plainRemovals, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiSaveAndRemoveWithPlainKvs'
+type SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call struct {
+	*mock.Call
+}
+
+// MultiSaveAndRemoveWithPlainKvs is a helper method to define mock.On call
+//   - saves map[string]string
+//   - removals []string
+//   - plainSaves map[string]string
+//   - plainRemovals []string
+//   - ts uint64
+func (_e *SnapShotKV_Expecter) MultiSaveAndRemoveWithPlainKvs(saves interface{}, removals interface{}, plainSaves interface{}, plainRemovals interface{}, ts interface{}) *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call {
+	return &SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call{Call: _e.mock.On("MultiSaveAndRemoveWithPlainKvs", saves, removals, plainSaves, plainRemovals, ts)}
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call) Run(run func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts uint64)) *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string), args[1].([]string), args[2].(map[string]string), args[3].([]string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call) Return(_a0 error) *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call) RunAndReturn(run func(map[string]string, []string, map[string]string, []string, uint64) error) *SnapShotKV_MultiSaveAndRemoveWithPlainKvs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MultiSaveAndRemoveWithPrefix provides a mock function with given fields: saves, removals, ts
 func (_m *SnapShotKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts uint64) error {
 	ret := _m.Called(saves, removals, ts)
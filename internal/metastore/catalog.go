@@ -16,6 +16,9 @@ type RootCoordCatalog interface {
 	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
 	DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error
 	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
+	// AlterDatabase alters the database, if the database is renamed, the grants on the objects of the database
+	// are remapped in the same transaction.
+	AlterDatabase(ctx context.Context, tenant string, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error
 
 	CreateCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	GetCollectionByID(ctx context.Context, dbID int64, ts typeutil.Timestamp, collectionID typeutil.UniqueID) (*model.Collection, error)
@@ -24,6 +27,9 @@ type RootCoordCatalog interface {
 	CollectionExists(ctx context.Context, dbID int64, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool
 	DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType AlterType, ts typeutil.Timestamp) error
+	// MoveCollection renames the collection or moves it to another database together with its aliases, the grants
+	// on the collection are remapped in the same transaction.
+	MoveCollection(ctx context.Context, tenant string, oldColl *model.Collection, newColl *model.Collection, oldDBName string, newDBName string, ts typeutil.Timestamp) error
 
 	CreatePartition(ctx context.Context, dbID int64, partition *model.Partition, ts typeutil.Timestamp) error
 	DropPartition(ctx context.Context, dbID int64, collectionID typeutil.UniqueID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) error
@@ -32,6 +38,8 @@ type RootCoordCatalog interface {
 	CreateAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
 	DropAlias(ctx context.Context, dbID int64, alias string, ts typeutil.Timestamp) error
 	AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error
+	// AlterAliases saves and drops the aliases of the database in a single transaction.
+	AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts typeutil.Timestamp) error
 	ListAliases(ctx context.Context, dbID int64, ts typeutil.Timestamp) ([]*model.Alias, error)
 
 	// GetCredential gets the credential info for the username, returns error if no credential exists for this username.
@@ -44,6 +52,30 @@ type RootCoordCatalog interface {
 	DropCredential(ctx context.Context, username string) error
 	// ListCredentials gets all usernames.
 	ListCredentials(ctx context.Context) ([]string, error)
+	// SaveAPIKey saves the api key, the key already exists will be overwritten.
+	SaveAPIKey(ctx context.Context, key *model.APIKey) error
+	// DropAPIKey removes the api key by id.
+	DropAPIKey(ctx context.Context, id string) error
+	// ListAPIKeys gets all api keys.
+	ListAPIKeys(ctx context.Context) ([]*model.APIKey, error)
+	// SaveRowPolicy saves the row policy of the role on the collection, the policy already exists will be overwritten.
+	SaveRowPolicy(ctx context.Context, policy *model.RowPolicy) error
+	// DropRowPolicy removes the row policy of the role on the collection.
+	DropRowPolicy(ctx context.Context, collectionID int64, roleName string) error
+	// ListRowPolicies gets all row policies.
+	ListRowPolicies(ctx context.Context) ([]*model.RowPolicy, error)
+	// SaveCollectionSnapshot saves the snapshot of the collection, the snapshot already exists will be overwritten.
+	SaveCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error
+	// DropCollectionSnapshot removes the snapshot by id.
+	DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID int64) error
+	// ListCollectionSnapshots gets the snapshots of all collections.
+	ListCollectionSnapshots(ctx context.Context) ([]*model.CollectionSnapshot, error)
+	// SaveDDLJob saves the progress of the DDL job, the job already exists will be overwritten.
+	SaveDDLJob(ctx context.Context, job *model.DDLJob) error
+	// DropDDLJob removes the DDL job by id.
+	DropDDLJob(ctx context.Context, jobID int64) error
+	// ListDDLJobs gets all DDL jobs.
+	ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error)
 
 	// CreateRole creates role by the entity for the tenant. Please make sure the tenent and entity.Name aren't empty. Empty entity.Name may end up with deleting all roles
 	// Returns common.IgnorableError if the role already existes
@@ -135,6 +167,10 @@ type DataCoordCatalog interface {
 	AlterSegmentIndexes(ctx context.Context, newSegIdxes []*model.SegmentIndex) error
 	DropSegmentIndex(ctx context.Context, collID, partID, segID, buildID typeutil.UniqueID) error
 
+	SaveSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error
+	ListSnapshots(ctx context.Context) ([]*datapb.CollectionSnapshot, error)
+	DropSnapshot(ctx context.Context, snapshotID typeutil.UniqueID) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 }
 
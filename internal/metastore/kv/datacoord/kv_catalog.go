@@ -518,6 +518,82 @@ func (kc *Catalog) DropChannelCheckpoint(ctx context.Context, vChannel string) e
 	return kc.MetaKv.Remove(k)
 }
 
+// SaveSnapshot saves the snapshot, every segment of it is saved to a separate key together with its segment indexes
+// to avoid over-sized values, the snapshot meta is saved at last so that a partially saved snapshot is never listed.
+func (kc *Catalog) SaveSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error {
+	segmentIndexes := make(map[typeutil.UniqueID][]*indexpb.SegmentIndex)
+	for _, segIdx := range snapshot.GetSegmentIndexes() {
+		segmentIndexes[segIdx.GetSegmentID()] = append(segmentIndexes[segIdx.GetSegmentID()], segIdx)
+	}
+	kvs := make(map[string]string)
+	for _, segment := range snapshot.GetSegments() {
+		v, err := proto.Marshal(&datapb.CollectionSnapshot{
+			SnapshotID:     snapshot.GetSnapshotID(),
+			Segments:       []*datapb.SegmentInfo{segment},
+			SegmentIndexes: segmentIndexes[segment.GetID()],
+		})
+		if err != nil {
+			return err
+		}
+		kvs[buildSnapshotSegmentKey(snapshot.GetSnapshotID(), segment.GetID())] = string(v)
+	}
+	if err := kc.SaveByBatch(kvs); err != nil {
+		return err
+	}
+
+	v, err := proto.Marshal(&datapb.CollectionSnapshot{
+		SnapshotID:   snapshot.GetSnapshotID(),
+		CollectionID: snapshot.GetCollectionID(),
+		Timestamp:    snapshot.GetTimestamp(),
+		Indexes:      snapshot.GetIndexes(),
+	})
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(buildSnapshotMetaKey(snapshot.GetSnapshotID()), string(v))
+}
+
+func (kc *Catalog) ListSnapshots(ctx context.Context) ([]*datapb.CollectionSnapshot, error) {
+	keys, values, err := kc.MetaKv.LoadWithPrefix(SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	metas := make(map[typeutil.UniqueID]*datapb.CollectionSnapshot)
+	parts := make(map[typeutil.UniqueID][]*datapb.CollectionSnapshot)
+	for i, key := range keys {
+		snapshot := &datapb.CollectionSnapshot{}
+		if err := proto.Unmarshal([]byte(values[i]), snapshot); err != nil {
+			log.Error("unmarshal snapshot failed when ListSnapshots", zap.String("key", key), zap.Error(err))
+			return nil, err
+		}
+		if strings.HasSuffix(key, "/meta") {
+			metas[snapshot.GetSnapshotID()] = snapshot
+		} else {
+			parts[snapshot.GetSnapshotID()] = append(parts[snapshot.GetSnapshotID()], snapshot)
+		}
+	}
+
+	snapshots := make([]*datapb.CollectionSnapshot, 0, len(metas))
+	for snapshotID, snapshot := range metas {
+		for _, part := range parts[snapshotID] {
+			snapshot.Segments = append(snapshot.Segments, part.GetSegments()...)
+			snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, part.GetSegmentIndexes()...)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// DropSnapshot removes the snapshot meta before the segments of it, a snapshot is considered removed once
+// the meta is gone.
+func (kc *Catalog) DropSnapshot(ctx context.Context, snapshotID typeutil.UniqueID) error {
+	if err := kc.MetaKv.Remove(buildSnapshotMetaKey(snapshotID)); err != nil {
+		return err
+	}
+	return kc.MetaKv.RemoveWithPrefix(buildSnapshotPrefix(snapshotID))
+}
+
 func (kc *Catalog) getBinlogsWithPrefix(binlogType storage.BinlogType, collectionID, partitionID,
 	segmentID typeutil.UniqueID,
 ) ([]string, []string, error) {
@@ -721,6 +797,10 @@ func fillLogPathByLogID(chunkManagerRootPath string, binlogType storage.BinlogTy
 	segmentID typeutil.UniqueID, fieldBinlog *datapb.FieldBinlog,
 ) {
 	for _, binlog := range fieldBinlog.Binlogs {
+		// the log path of the binlog shared from other segment is kept
+		if binlog.GetLogPath() != "" {
+			continue
+		}
 		path := buildLogPath(chunkManagerRootPath, binlogType, collectionID, partitionID,
 			segmentID, fieldBinlog.GetFieldID(), binlog.GetLogID())
 		binlog.LogPath = path
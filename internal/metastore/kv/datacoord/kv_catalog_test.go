@@ -30,6 +30,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/maps"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -40,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -319,6 +321,35 @@ func Test_AddSegments(t *testing.T) {
 		assert.Equal(t, 4, len(savedKvs))
 		verifySavedKvsForSegment(t, savedKvs)
 	})
+
+	t.Run("save cloned segment", func(t *testing.T) {
+		savedKvs := make(map[string]string)
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(m map[string]string) error {
+			savedKvs = m
+			return nil
+		})
+
+		catalog := NewCatalog(metakv, rootPath, "")
+		cloned := proto.Clone(segment1).(*datapb.SegmentInfo)
+		cloned.ID = segmentID2
+		cloned.ClonedFrom = segmentID
+		err := catalog.AddSegment(context.TODO(), cloned)
+		assert.NoError(t, err)
+
+		// the binlogs shared from the source segment keep the log path
+		for k, path := range map[string]string{k7: binlogPath, k8: deltalogPath, k9: statslogPath} {
+			ret, ok := savedKvs[k]
+			assert.True(t, ok)
+			fieldBinlog := &datapb.FieldBinlog{}
+			assert.NoError(t, proto.Unmarshal([]byte(ret), fieldBinlog))
+			assert.Equal(t, path, fieldBinlog.GetBinlogs()[0].GetLogPath())
+			assert.Equal(t, logID, fieldBinlog.GetBinlogs()[0].GetLogID())
+
+			fillLogPathByLogID(rootPath, storage.InsertBinlog, collectionID, partitionID, segmentID2, fieldBinlog)
+			assert.Equal(t, path, fieldBinlog.GetBinlogs()[0].GetLogPath())
+		}
+	})
 }
 
 func Test_AlterSegments(t *testing.T) {
@@ -609,6 +640,95 @@ func TestChannelCP(t *testing.T) {
 	})
 }
 
+func TestCatalog_Snapshot(t *testing.T) {
+	snapshot := &datapb.CollectionSnapshot{
+		SnapshotID:   1000,
+		CollectionID: collectionID,
+		Timestamp:    2000,
+		Segments: []*datapb.SegmentInfo{
+			{ID: 1, CollectionID: collectionID, PartitionID: partitionID, State: commonpb.SegmentState_Flushed},
+			{ID: 2, CollectionID: collectionID, PartitionID: partitionID, State: commonpb.SegmentState_Flushed},
+		},
+		Indexes: []*indexpb.FieldIndex{{IndexInfo: &indexpb.IndexInfo{CollectionID: collectionID, IndexID: 10}}},
+		SegmentIndexes: []*indexpb.SegmentIndex{
+			{SegmentID: 1, IndexID: 10, BuildID: 100, SourceBuildID: 100},
+			{SegmentID: 2, IndexID: 10, BuildID: 101, SourceBuildID: 101},
+		},
+	}
+
+	t.Run("save and list", func(t *testing.T) {
+		saved := make(map[string]string)
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything).RunAndReturn(func(kvs map[string]string) error {
+			for k, v := range kvs {
+				saved[k] = v
+			}
+			return nil
+		})
+		txn.EXPECT().Save(mock.Anything, mock.Anything).RunAndReturn(func(k string, v string) error {
+			saved[k] = v
+			return nil
+		})
+		txn.EXPECT().LoadWithPrefix(SnapshotPrefix).RunAndReturn(func(string) ([]string, []string, error) {
+			keys := make([]string, 0, len(saved))
+			values := make([]string, 0, len(saved))
+			for k, v := range saved {
+				keys = append(keys, k)
+				values = append(values, v)
+			}
+			return keys, values, nil
+		})
+		catalog := NewCatalog(txn, rootPath, "")
+		assert.NoError(t, catalog.SaveSnapshot(context.TODO(), snapshot))
+		assert.Len(t, saved, 3)
+
+		snapshots, err := catalog.ListSnapshots(context.TODO())
+		assert.NoError(t, err)
+		require.Len(t, snapshots, 1)
+		assert.Equal(t, snapshot.GetSnapshotID(), snapshots[0].GetSnapshotID())
+		assert.Equal(t, snapshot.GetTimestamp(), snapshots[0].GetTimestamp())
+		assert.Len(t, snapshots[0].GetSegments(), 2)
+		assert.Len(t, snapshots[0].GetIndexes(), 1)
+		assert.Len(t, snapshots[0].GetSegmentIndexes(), 2)
+
+		// the snapshot whose meta is missing isn't listed.
+		delete(saved, buildSnapshotMetaKey(snapshot.GetSnapshotID()))
+		snapshots, err = catalog.ListSnapshots(context.TODO())
+		assert.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("save failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().MultiSave(mock.Anything).Return(errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		assert.Error(t, catalog.SaveSnapshot(context.TODO(), snapshot))
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().LoadWithPrefix(mock.Anything).Return(nil, nil, errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		_, err := catalog.ListSnapshots(context.TODO())
+		assert.Error(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(buildSnapshotMetaKey(snapshot.GetSnapshotID())).Return(nil)
+		txn.EXPECT().RemoveWithPrefix(buildSnapshotPrefix(snapshot.GetSnapshotID())).Return(nil)
+		catalog := NewCatalog(txn, rootPath, "")
+		assert.NoError(t, catalog.DropSnapshot(context.TODO(), snapshot.GetSnapshotID()))
+	})
+
+	t.Run("drop failed", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Remove(mock.Anything).Return(errors.New("mock error"))
+		catalog := NewCatalog(txn, rootPath, "")
+		assert.Error(t, catalog.DropSnapshot(context.TODO(), snapshot.GetSnapshotID()))
+	})
+}
+
 func Test_MarkChannelDeleted_SaveError(t *testing.T) {
 	txn := mocks.NewMetaKv(t)
 	txn.EXPECT().
@@ -83,17 +83,20 @@ func ValidateSegment(segment *datapb.SegmentInfo) error {
 		zap.Int64("collection", segment.GetCollectionID()),
 		zap.Int64("partition", segment.GetPartitionID()),
 		zap.Int64("segment", segment.GetID()))
-	err := checkBinlogs(storage.InsertBinlog, segment.GetID(), segment.GetBinlogs())
-	if err != nil {
-		return err
-	}
-	checkBinlogs(storage.DeleteBinlog, segment.GetID(), segment.GetDeltalogs())
-	if err != nil {
-		return err
-	}
-	checkBinlogs(storage.StatsBinlog, segment.GetID(), segment.GetStatslogs())
-	if err != nil {
-		return err
+	// the binlogs of the cloned segment are shared from the source segment
+	if segment.GetClonedFrom() == 0 {
+		err := checkBinlogs(storage.InsertBinlog, segment.GetID(), segment.GetBinlogs())
+		if err != nil {
+			return err
+		}
+		checkBinlogs(storage.DeleteBinlog, segment.GetID(), segment.GetDeltalogs())
+		if err != nil {
+			return err
+		}
+		checkBinlogs(storage.StatsBinlog, segment.GetID(), segment.GetStatslogs())
+		if err != nil {
+			return err
+		}
 	}
 	// check stats log and bin log size match
 
@@ -193,7 +196,7 @@ func hasSpecialStatslog(segment *datapb.SegmentInfo) bool {
 func buildBinlogKvsWithLogID(collectionID, partitionID, segmentID typeutil.UniqueID,
 	binlogs, deltalogs, statslogs []*datapb.FieldBinlog,
 ) (map[string]string, error) {
-	fillLogIDByLogPath(binlogs, deltalogs, statslogs)
+	fillLogIDByLogPath(segmentID, binlogs, deltalogs, statslogs)
 	kvs, err := buildBinlogKvs(collectionID, partitionID, segmentID, binlogs, deltalogs, statslogs)
 	if err != nil {
 		return nil, err
@@ -259,8 +262,8 @@ func cloneLogs(binlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	return res
 }
 
-func fillLogIDByLogPath(multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
-	for _, fieldBinlogs := range multiFieldBinlogs {
+func fillLogIDByLogPath(segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs []*datapb.FieldBinlog) error {
+	fill := func(fieldBinlogs []*datapb.FieldBinlog, getSegmentID func(logPath string) typeutil.UniqueID) error {
 		for _, fieldBinlog := range fieldBinlogs {
 			for _, binlog := range fieldBinlog.Binlogs {
 				logPath := binlog.LogPath
@@ -274,13 +277,24 @@ func fillLogIDByLogPath(multiFieldBinlogs ...[]*datapb.FieldBinlog) error {
 					return err
 				}
 
+				binlog.LogID = logID
+				// the binlogs shared from other segment keep the log path, since it can't be rebuilt by the log id
+				if getSegmentID(logPath) != segmentID {
+					continue
+				}
 				// set log path to empty and only store log id
 				binlog.LogPath = ""
-				binlog.LogID = logID
 			}
 		}
+		return nil
 	}
-	return nil
+	if err := fill(binlogs, metautil.GetSegmentIDFromInsertLogPath); err != nil {
+		return err
+	}
+	if err := fill(deltalogs, metautil.GetSegmentIDFromDeltaLogPath); err != nil {
+		return err
+	}
+	return fill(statslogs, metautil.GetSegmentIDFromStatsLogPath)
 }
 
 func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs []*datapb.FieldBinlog) (map[string]string, error) {
@@ -395,6 +409,18 @@ func buildChannelCPKey(vChannel string) string {
 	return fmt.Sprintf("%s/%s", ChannelCheckpointPrefix, vChannel)
 }
 
+func buildSnapshotPrefix(snapshotID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/", SnapshotPrefix, snapshotID)
+}
+
+func buildSnapshotMetaKey(snapshotID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/meta", SnapshotPrefix, snapshotID)
+}
+
+func buildSnapshotSegmentKey(snapshotID, segmentID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d/segment/%d", SnapshotPrefix, snapshotID, segmentID)
+}
+
 func BuildIndexKey(collectionID, indexID int64) string {
 	return fmt.Sprintf("%s/%d/%d", util.FieldIndexPrefix, collectionID, indexID)
 }
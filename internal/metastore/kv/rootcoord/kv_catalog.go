@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -133,6 +135,36 @@ func (kc *Catalog) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]
 	return dbs, nil
 }
 
+func (kc *Catalog) AlterDatabase(ctx context.Context, tenant string, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error {
+	if oldDB.ID != newDB.ID {
+		return fmt.Errorf("altering database id is forbidden")
+	}
+	key := BuildDatabaseKey(newDB.ID)
+	v, err := proto.Marshal(model.MarshalDatabaseModel(newDB))
+	if err != nil {
+		return err
+	}
+	if oldDB.Name == newDB.Name {
+		return kc.Snapshot.Save(key, string(v), ts)
+	}
+
+	grantSaves, grantRemovals, err := kc.renameGrants(tenant, func(object string, dbName string, objectName string) (string, string, bool) {
+		return newDB.Name, objectName, dbName == oldDB.Name
+	})
+	if err != nil {
+		return err
+	}
+	// the api keys are scoped to the database by name as well.
+	apiKeySaves, err := kc.renameAPIKeys(ctx, oldDB.Name, newDB.Name)
+	if err != nil {
+		return err
+	}
+	for k, v := range apiKeySaves {
+		grantSaves[k] = v
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithPlainKvs(map[string]string{key: string(v)}, nil, grantSaves, grantRemovals, ts)
+}
+
 func (kc *Catalog) CreateCollection(ctx context.Context, coll *model.Collection, ts typeutil.Timestamp) error {
 	if coll.State != pb.CollectionState_CollectionCreating {
 		return fmt.Errorf("cannot create collection with state: %s, collection: %s", coll.State.String(), coll.Name)
@@ -283,7 +315,12 @@ func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 
 func (kc *Catalog) CreateCredential(ctx context.Context, credential *model.Credential) error {
 	k := fmt.Sprintf("%s/%s", CredentialPrefix, credential.Username)
-	v, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: credential.EncryptedPassword})
+	v, err := json.Marshal(&internalpb.CredentialInfo{
+		EncryptedPassword:  credential.EncryptedPassword,
+		PasswordHistory:    credential.PasswordHistory,
+		PasswordUpdateTime: credential.PasswordUpdateTime,
+		NeedPasswordChange: credential.NeedPasswordChange,
+	})
 	if err != nil {
 		log.Error("create credential marshal fail", zap.String("key", k), zap.Error(err))
 		return err
@@ -398,13 +435,44 @@ func (kc *Catalog) GetCredential(ctx context.Context, username string) (*model.C
 		return nil, fmt.Errorf("unmarshal credential info err:%w", err)
 	}
 
-	return &model.Credential{Username: username, EncryptedPassword: credentialInfo.EncryptedPassword}, nil
+	return &model.Credential{
+		Username:           username,
+		EncryptedPassword:  credentialInfo.EncryptedPassword,
+		PasswordHistory:    credentialInfo.PasswordHistory,
+		PasswordUpdateTime: credentialInfo.PasswordUpdateTime,
+		NeedPasswordChange: credentialInfo.NeedPasswordChange,
+	}, nil
 }
 
 func (kc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
 	return kc.CreateAlias(ctx, alias, ts)
 }
 
+// AlterAliases removes the aliases by saving tombstones to their exact keys, the removal prefixes would
+// remove the other aliases sharing the same prefix, e.g. dropping alias "prod" removes "prod_x" as well.
+func (kc *Catalog) AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts typeutil.Timestamp) error {
+	kvs := make(map[string]string, 3*len(aliases)+3*len(droppedAliases))
+	tombstone := string(ConstructTombstone())
+	// the keys of the old versions go first, they're the same as the new keys without database
+	for _, alias := range aliases {
+		kvs[BuildAliasKey210(alias.Name)] = tombstone
+		kvs[BuildAliasKey(alias.Name)] = tombstone
+	}
+	for _, alias := range droppedAliases {
+		kvs[BuildAliasKey210(alias)] = tombstone
+		kvs[BuildAliasKey(alias)] = tombstone
+		kvs[BuildAliasKeyWithDB(dbID, alias)] = tombstone
+	}
+	for _, alias := range aliases {
+		v, err := proto.Marshal(model.MarshalAliasModel(alias))
+		if err != nil {
+			return err
+		}
+		kvs[BuildAliasKeyWithDB(dbID, alias.Name)] = string(v)
+	}
+	return kc.Snapshot.MultiSave(kvs, ts)
+}
+
 func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
 	collectionKeys := []string{BuildCollectionKey(collectionInfo.DBID, collectionInfo.CollectionID)}
 
@@ -455,6 +523,8 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.SchemaVersion = newColl.SchemaVersion
+	oldCollClone.DropTime = newColl.DropTime
 
 	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
 	newKey := BuildCollectionKey(newColl.DBID, oldColl.CollectionID)
@@ -463,7 +533,25 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 		return err
 	}
 	saves := map[string]string{newKey: string(value)}
+	// fields are saved separately, only the appended ones need to be saved.
+	oldFieldIDs := typeutil.NewSet[int64]()
+	for _, field := range oldColl.Fields {
+		oldFieldIDs.Insert(field.FieldID)
+	}
+	for _, field := range newColl.Fields {
+		if oldFieldIDs.Contain(field.FieldID) {
+			continue
+		}
+		fieldValue, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		saves[BuildFieldKey(newColl.CollectionID, field.FieldID)] = string(fieldValue)
+	}
 	if oldKey == newKey {
+		if len(saves) > 1 {
+			return kc.Snapshot.MultiSave(saves, ts)
+		}
 		return kc.Snapshot.Save(newKey, string(value), ts)
 	}
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(saves, []string{oldKey}, ts)
@@ -476,6 +564,67 @@ func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collectio
 	return fmt.Errorf("altering collection doesn't support %s", alterType.String())
 }
 
+func (kc *Catalog) MoveCollection(ctx context.Context, tenant string, oldColl *model.Collection, newColl *model.Collection,
+	oldDBName string, newDBName string, ts typeutil.Timestamp,
+) error {
+	if oldColl.TenantID != newColl.TenantID || oldColl.CollectionID != newColl.CollectionID {
+		return fmt.Errorf("altering tenant id or collection id is forbidden")
+	}
+	value, err := proto.Marshal(model.MarshalCollectionModel(newColl))
+	if err != nil {
+		return err
+	}
+	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
+	newKey := BuildCollectionKey(newColl.DBID, newColl.CollectionID)
+	saves := map[string]string{newKey: string(value)}
+	var removals []string
+	if oldKey != newKey {
+		removals = append(removals, oldKey)
+	}
+
+	// the aliases are moved to the new database with the collection.
+	if oldColl.DBID != newColl.DBID {
+		for _, aliasName := range newColl.Aliases {
+			alias := &model.Alias{
+				Name:         aliasName,
+				CollectionID: newColl.CollectionID,
+				CreatedTime:  ts,
+				State:        pb.AliasState_AliasCreated,
+				DbID:         newColl.DBID,
+			}
+			aliasValue, err := proto.Marshal(model.MarshalAliasModel(alias))
+			if err != nil {
+				return err
+			}
+			saves[BuildAliasKeyWithDB(newColl.DBID, aliasName)] = string(aliasValue)
+			removals = append(removals,
+				BuildAliasKey210(aliasName),
+				BuildAliasKey(aliasName),
+				BuildAliasKeyWithDB(oldColl.DBID, aliasName),
+			)
+		}
+	}
+
+	// the grants on the collection and its fields follow the new name.
+	grantSaves, grantRemovals, err := kc.renameGrants(tenant, func(object string, dbName string, objectName string) (string, string, bool) {
+		if dbName != oldDBName {
+			return "", "", false
+		}
+		switch {
+		case object == commonpb.ObjectType_Collection.String() && objectName == oldColl.Name:
+			return newDBName, newColl.Name, true
+		case object == util.FieldObjectType && strings.HasPrefix(objectName, oldColl.Name+"."):
+			return newDBName, newColl.Name + strings.TrimPrefix(objectName, oldColl.Name), true
+		default:
+			return "", "", false
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithPlainKvs(saves, removals, grantSaves, grantRemovals, ts)
+}
+
 func (kc *Catalog) alterModifyPartition(oldPart *model.Partition, newPart *model.Partition, ts typeutil.Timestamp) error {
 	if oldPart.CollectionID != newPart.CollectionID || oldPart.PartitionID != newPart.PartitionID {
 		return fmt.Errorf("altering collection id or partition id is forbidden")
@@ -485,6 +634,7 @@ func (kc *Catalog) alterModifyPartition(oldPart *model.Partition, newPart *model
 	oldPartClone.PartitionName = newPartClone.PartitionName
 	oldPartClone.PartitionCreatedTimestamp = newPartClone.PartitionCreatedTimestamp
 	oldPartClone.State = newPartClone.State
+	oldPartClone.DropTime = newPartClone.DropTime
 	key := BuildPartitionKey(oldPart.CollectionID, oldPart.PartitionID)
 	value, err := proto.Marshal(model.MarshalPartitionModel(oldPartClone))
 	if err != nil {
@@ -553,6 +703,11 @@ func (kc *Catalog) DropCredential(ctx context.Context, username string) error {
 		log.Warn("fail to list user", zap.String("key", k), zap.Error(err))
 		return err
 	}
+	apiKeys, err := kc.ListAPIKeys(ctx)
+	if err != nil {
+		log.Warn("fail to list api keys", zap.String("key", k), zap.Error(err))
+		return err
+	}
 	deleteKeys := make([]string, 0, len(userResults)+1)
 	deleteKeys = append(deleteKeys, k)
 	for _, userResult := range userResults {
@@ -563,6 +718,12 @@ func (kc *Catalog) DropCredential(ctx context.Context, username string) error {
 			}
 		}
 	}
+	// the api keys of the user are revoked along with the credential
+	for _, apiKey := range apiKeys {
+		if apiKey.Username == username {
+			deleteKeys = append(deleteKeys, fmt.Sprintf("%s/%s", APIKeyPrefix, apiKey.ID))
+		}
+	}
 	err = kc.Txn.MultiRemove(deleteKeys)
 	if err != nil {
 		log.Warn("fail to drop credential", zap.String("key", k), zap.Error(err))
@@ -724,6 +885,215 @@ func (kc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	return usernames, nil
 }
 
+func (kc *Catalog) SaveAPIKey(ctx context.Context, key *model.APIKey) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, key.ID)
+	v, err := json.Marshal(model.MarshalAPIKeyModel(key))
+	if err != nil {
+		log.Error("save api key marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Error("save api key persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) DropAPIKey(ctx context.Context, id string) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, id)
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Warn("fail to drop api key", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(APIKeyPrefix)
+	if err != nil {
+		log.Error("list all api keys fail", zap.String("prefix", APIKeyPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	keys := make([]*model.APIKey, 0, len(values))
+	for _, v := range values {
+		info := &internalpb.APIKeyInfo{}
+		if err := json.Unmarshal([]byte(v), info); err != nil {
+			return nil, fmt.Errorf("unmarshal api key info err:%w", err)
+		}
+		keys = append(keys, model.UnmarshalAPIKeyModel(info))
+	}
+
+	return keys, nil
+}
+
+// renameAPIKeys returns the api keys scoped to the old database, which are remapped to the new name.
+func (kc *Catalog) renameAPIKeys(ctx context.Context, oldName string, newName string) (map[string]string, error) {
+	keys, err := kc.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	saves := make(map[string]string)
+	for _, key := range keys {
+		if key.DBName != oldName {
+			continue
+		}
+		key.DBName = newName
+		v, err := json.Marshal(model.MarshalAPIKeyModel(key))
+		if err != nil {
+			return nil, err
+		}
+		saves[fmt.Sprintf("%s/%s", APIKeyPrefix, key.ID)] = string(v)
+	}
+	return saves, nil
+}
+
+func (kc *Catalog) SaveRowPolicy(ctx context.Context, policy *model.RowPolicy) error {
+	k := fmt.Sprintf("%s/%d/%s", RowPolicyPrefix, policy.CollectionID, policy.RoleName)
+	v, err := json.Marshal(model.MarshalRowPolicyModel(policy))
+	if err != nil {
+		log.Error("save row policy marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Error("save row policy persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) DropRowPolicy(ctx context.Context, collectionID int64, roleName string) error {
+	k := fmt.Sprintf("%s/%d/%s", RowPolicyPrefix, collectionID, roleName)
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Warn("fail to drop row policy", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) ListRowPolicies(ctx context.Context) ([]*model.RowPolicy, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(RowPolicyPrefix)
+	if err != nil {
+		log.Error("list all row policies fail", zap.String("prefix", RowPolicyPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	policies := make([]*model.RowPolicy, 0, len(values))
+	for _, v := range values {
+		info := &internalpb.RowPolicy{}
+		if err := json.Unmarshal([]byte(v), info); err != nil {
+			return nil, fmt.Errorf("unmarshal row policy err:%w", err)
+		}
+		policies = append(policies, model.UnmarshalRowPolicyModel(info))
+	}
+
+	return policies, nil
+}
+
+func (kc *Catalog) SaveCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error {
+	k := BuildCollectionSnapshotKey(snapshot.DBID, snapshot.ID)
+	v, err := proto.Marshal(model.MarshalCollectionSnapshotModel(snapshot))
+	if err != nil {
+		log.Error("save collection snapshot marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Error("save collection snapshot persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID int64) error {
+	k := BuildCollectionSnapshotKey(dbID, snapshotID)
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Warn("fail to drop collection snapshot", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) ListCollectionSnapshots(ctx context.Context) ([]*model.CollectionSnapshot, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(CollectionSnapshotPrefix)
+	if err != nil {
+		log.Error("list all collection snapshots fail", zap.String("prefix", CollectionSnapshotPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	snapshots := make([]*model.CollectionSnapshot, 0, len(values))
+	for _, v := range values {
+		info := &pb.CollectionSnapshotInfo{}
+		if err := proto.Unmarshal([]byte(v), info); err != nil {
+			return nil, fmt.Errorf("unmarshal collection snapshot err:%w", err)
+		}
+		snapshots = append(snapshots, model.UnmarshalCollectionSnapshotModel(info))
+	}
+
+	return snapshots, nil
+}
+
+func (kc *Catalog) SaveDDLJob(ctx context.Context, job *model.DDLJob) error {
+	k := BuildDDLJobKey(job.ID)
+	v, err := proto.Marshal(model.MarshalDDLJobModel(job))
+	if err != nil {
+		log.Error("save ddl job marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Error("save ddl job persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) DropDDLJob(ctx context.Context, jobID int64) error {
+	k := BuildDDLJobKey(jobID)
+	err := kc.Txn.Remove(k)
+	if err != nil {
+		log.Warn("fail to drop ddl job", zap.String("key", k), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (kc *Catalog) ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(DDLJobPrefix)
+	if err != nil {
+		log.Error("list all ddl jobs fail", zap.String("prefix", DDLJobPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	jobs := make([]*model.DDLJob, 0, len(values))
+	for _, v := range values {
+		info := &internalpb.DDLJobInfo{}
+		if err := proto.Unmarshal([]byte(v), info); err != nil {
+			return nil, fmt.Errorf("unmarshal ddl job err:%w", err)
+		}
+		jobs = append(jobs, model.UnmarshalDDLJobModel(info))
+	}
+
+	return jobs, nil
+}
+
 func (kc *Catalog) save(k string) error {
 	var err error
 	if _, err = kc.Txn.Load(k); err != nil && !errors.Is(err, merr.ErrIoKeyNotFound) {
@@ -1100,6 +1470,75 @@ func (kc *Catalog) ListGrant(ctx context.Context, tenant string, entity *milvusp
 	return entities, nil
 }
 
+// renameGrants returns the kvs to save and the keys to remove to remap the grants, whose objects are renamed by
+// the rename function. The grant keeps its id, unless a grant on the new object exists already, then the privileges
+// are merged into it.
+func (kc *Catalog) renameGrants(tenant string, rename func(object string, dbName string, objectName string) (string, string, bool)) (map[string]string, []string, error) {
+	granteeKey := funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "")
+	keys, values, err := kc.Txn.LoadWithPrefix(granteeKey)
+	if err != nil {
+		log.Error("fail to load all grant privilege entities", zap.String("key", granteeKey), zap.Error(err))
+		return nil, nil, err
+	}
+
+	type grant struct {
+		role       string
+		object     string
+		objectName string
+		id         string
+	}
+	grants := make([]grant, 0, len(keys))
+	grantIDs := make(map[string]string, len(keys))
+	for i, key := range keys {
+		grantInfos := typeutil.AfterN(key, granteeKey+"/", "/")
+		if len(grantInfos) != 3 {
+			log.Warn("invalid grantee key", zap.String("string", key), zap.String("sub_string", granteeKey))
+			continue
+		}
+		grants = append(grants, grant{role: grantInfos[0], object: grantInfos[1], objectName: grantInfos[2], id: values[i]})
+		grantIDs[strings.Join(grantInfos, "/")] = values[i]
+	}
+
+	saves := make(map[string]string)
+	var removals []string
+	for _, g := range grants {
+		dbName, objectName := funcutil.SplitObjectName(g.objectName)
+		newDBName, newObjectName, ok := rename(g.object, dbName, objectName)
+		if !ok {
+			continue
+		}
+		oldGrant := fmt.Sprintf("%s/%s/%s", g.role, g.object, g.objectName)
+		newGrant := fmt.Sprintf("%s/%s/%s", g.role, g.object, funcutil.CombineObjectName(newDBName, newObjectName))
+		if oldGrant == newGrant {
+			continue
+		}
+		removals = append(removals, funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, oldGrant))
+		id, ok := grantIDs[newGrant]
+		if !ok {
+			grantIDs[newGrant] = g.id
+			saves[funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, newGrant)] = g.id
+			continue
+		}
+
+		granteeIDKey := funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, g.id)
+		idKeys, grantors, err := kc.Txn.LoadWithPrefix(granteeIDKey)
+		if err != nil {
+			log.Error("fail to load the grantee ids", zap.String("key", granteeIDKey), zap.Error(err))
+			return nil, nil, err
+		}
+		for i, idKey := range idKeys {
+			granteeIDInfos := typeutil.AfterN(idKey, granteeIDKey+"/", "/")
+			if len(granteeIDInfos) != 1 {
+				log.Warn("invalid grantee id", zap.String("string", idKey), zap.String("sub_string", granteeIDKey))
+				continue
+			}
+			saves[funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, fmt.Sprintf("%s/%s", id, granteeIDInfos[0]))] = grantors[i]
+			removals = append(removals, funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, fmt.Sprintf("%s/%s", g.id, granteeIDInfos[0])))
+		}
+	}
+	return saves, removals, nil
+}
+
 func (kc *Catalog) DeleteGrant(ctx context.Context, tenant string, role *milvuspb.RoleEntity) error {
 	var (
 		k   = funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, role.Name+"/")
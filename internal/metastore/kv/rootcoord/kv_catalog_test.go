@@ -633,6 +633,41 @@ func TestCatalog_AlterAliasV2(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCatalog_AlterAliases(t *testing.T) {
+	ctx := context.Background()
+
+	snapshot := kv.NewMockSnapshotKV()
+	snapshot.MultiSaveFunc = func(kvs map[string]string, ts typeutil.Timestamp) error {
+		return errors.New("mock")
+	}
+
+	kc := Catalog{Snapshot: snapshot}
+
+	err := kc.AlterAliases(ctx, testDb, []*model.Alias{{Name: "alias1", CollectionID: 1, DbID: testDb}}, []string{"alias2"}, 0)
+	assert.Error(t, err)
+
+	// the dropped aliases are removed by the exact keys, the ones sharing the prefix are kept
+	snapshot.MultiSaveFunc = func(kvs map[string]string, ts typeutil.Timestamp) error {
+		assert.True(t, IsTombstone(kvs[BuildAliasKeyWithDB(testDb, "alias2")]))
+		assert.True(t, IsTombstone(kvs[BuildAliasKey("alias2")]))
+		assert.True(t, IsTombstone(kvs[BuildAliasKey210("alias2")]))
+		assert.False(t, IsTombstone(kvs[BuildAliasKeyWithDB(testDb, "alias1")]))
+		assert.True(t, IsTombstone(kvs[BuildAliasKey("alias1")]))
+		assert.Len(t, kvs, 6)
+		return nil
+	}
+	err = kc.AlterAliases(ctx, testDb, []*model.Alias{{Name: "alias1", CollectionID: 1, DbID: testDb}}, []string{"alias2"}, 0)
+	assert.NoError(t, err)
+
+	// the alias key without database is the same as the old one
+	snapshot.MultiSaveFunc = func(kvs map[string]string, ts typeutil.Timestamp) error {
+		assert.False(t, IsTombstone(kvs[BuildAliasKey("alias1")]))
+		return nil
+	}
+	err = kc.AlterAliases(ctx, util.NonDBID, []*model.Alias{{Name: "alias1", CollectionID: 1, DbID: util.NonDBID}}, nil, 0)
+	assert.NoError(t, err)
+}
+
 func Test_dropPartition(t *testing.T) {
 	t.Run("nil, won't panic", func(t *testing.T) {
 		dropPartition(nil, 1)
@@ -1023,6 +1058,34 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("modify, add field", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			for key, value := range saves {
+				kvs[key] = value
+			}
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100}}}
+		newC := &model.Collection{CollectionID: collectionID, SchemaVersion: 1, Fields: []*model.Field{{FieldID: 100}, {FieldID: 101, Name: "f"}}}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		assert.Len(t, kvs, 2)
+
+		var collPb pb.CollectionInfo
+		err = proto.Unmarshal([]byte(kvs[BuildCollectionKey(0, collectionID)]), &collPb)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), collPb.GetSchemaVersion())
+		var fieldPb schemapb.FieldSchema
+		err = proto.Unmarshal([]byte(kvs[BuildFieldKey(collectionID, 101)]), &fieldPb)
+		assert.NoError(t, err)
+		assert.Equal(t, "f", fieldPb.GetName())
+	})
+
 	t.Run("modify db name", func(t *testing.T) {
 		var collectionID int64 = 1
 		snapshot := kv.NewMockSnapshotKV()
@@ -1042,6 +1105,174 @@ func TestCatalog_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestCatalog_MoveCollection(t *testing.T) {
+	var (
+		tenant       = "default"
+		collectionID = int64(1)
+		granteeKey   = funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "")
+		grantKey     = func(role string, object string, objectName string) string {
+			return funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, fmt.Sprintf("%s/%s/%s", role, object, objectName))
+		}
+	)
+
+	t.Run("tenant id changed", func(t *testing.T) {
+		kc := &Catalog{}
+		oldC := &model.Collection{TenantID: "1", CollectionID: collectionID}
+		newC := &model.Collection{TenantID: "2", CollectionID: collectionID}
+		err := kc.MoveCollection(context.Background(), tenant, oldC, newC, "db1", "db2", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to load grants", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return(nil, nil, errors.New("mock"))
+		kc := &Catalog{Txn: txn}
+		oldC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "coll"}
+		newC := &model.Collection{DBID: 2, CollectionID: collectionID, Name: "coll"}
+		err := kc.MoveCollection(context.Background(), tenant, oldC, newC, "db1", "db2", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return([]string{
+			grantKey("role1", "Collection", "db1.coll"),
+			grantKey("role1", util.FieldObjectType, "db1.coll.field"),
+			grantKey("role1", util.FieldObjectType, "db1.coll2.field"),
+			grantKey("role1", "Collection", "db2.coll"),
+			grantKey("role2", "Collection", "db1.coll"),
+			grantKey("role2", "Collection", "db1.coll2"),
+		}, []string{"id1", "id2", "id3", "id4", "id5", "id6"}, nil)
+		granteeIDKey := funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, "id1")
+		txn.EXPECT().LoadWithPrefix(granteeIDKey).Return([]string{granteeIDKey + "/PrivilegeLoad"}, []string{"user1"}, nil)
+
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithPlainKvsFunc = func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error {
+			assert.Contains(t, maps.Keys(saves), BuildCollectionKey(2, collectionID))
+			assert.Contains(t, maps.Keys(saves), BuildAliasKeyWithDB(2, "alias"))
+			assert.Contains(t, removals, BuildCollectionKey(1, collectionID))
+			assert.Contains(t, removals, BuildAliasKeyWithDB(1, "alias"))
+
+			// the grant of role1 is merged into the existing one, the others keep their ids.
+			assert.Equal(t, map[string]string{
+				funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, "id4/PrivilegeLoad"): "user1",
+				grantKey("role1", util.FieldObjectType, "db2.coll.field"):                     "id2",
+				grantKey("role2", "Collection", "db2.coll"):                                   "id5",
+			}, plainSaves)
+			assert.ElementsMatch(t, []string{
+				grantKey("role1", "Collection", "db1.coll"),
+				funcutil.HandleTenantForEtcdKey(GranteeIDPrefix, tenant, "id1/PrivilegeLoad"),
+				grantKey("role1", util.FieldObjectType, "db1.coll.field"),
+				grantKey("role2", "Collection", "db1.coll"),
+			}, plainRemovals)
+			return nil
+		}
+		kc := &Catalog{Txn: txn, Snapshot: snapshot}
+		oldC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "coll"}
+		newC := &model.Collection{DBID: 2, CollectionID: collectionID, Name: "coll", Aliases: []string{"alias"}}
+		err := kc.MoveCollection(context.Background(), tenant, oldC, newC, "db1", "db2", 100)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rename in default db", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return([]string{
+			grantKey("role1", "Collection", "coll"),
+		}, []string{"id1"}, nil)
+
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithPlainKvsFunc = func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error {
+			assert.Len(t, saves, 1)
+			assert.Empty(t, removals)
+			assert.Equal(t, map[string]string{grantKey("role1", "Collection", "default.new"): "id1"}, plainSaves)
+			assert.Equal(t, []string{grantKey("role1", "Collection", "coll")}, plainRemovals)
+			return nil
+		}
+		kc := &Catalog{Txn: txn, Snapshot: snapshot}
+		oldC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "coll", Aliases: []string{"alias"}}
+		newC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "new", Aliases: []string{"alias"}}
+		err := kc.MoveCollection(context.Background(), tenant, oldC, newC, util.DefaultDBName, util.DefaultDBName, 100)
+		assert.NoError(t, err)
+	})
+}
+
+func TestCatalog_AlterDatabase(t *testing.T) {
+	tenant := "default"
+	granteeKey := funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "")
+
+	t.Run("database id changed", func(t *testing.T) {
+		kc := &Catalog{}
+		err := kc.AlterDatabase(context.Background(), tenant, &model.Database{ID: 1}, &model.Database{ID: 2}, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("not renamed", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+			assert.Equal(t, BuildDatabaseKey(1), key)
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		err := kc.AlterDatabase(context.Background(), tenant, &model.Database{ID: 1, Name: "db"}, &model.Database{ID: 1, Name: "db"}, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("failed to load grants", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return(nil, nil, errors.New("mock"))
+		kc := &Catalog{Txn: txn}
+		err := kc.AlterDatabase(context.Background(), tenant, &model.Database{ID: 1, Name: "db1"}, &model.Database{ID: 1, Name: "db2"}, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to load api keys", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return(nil, nil, nil)
+		txn.EXPECT().LoadWithPrefix(APIKeyPrefix).Return(nil, nil, errors.New("mock"))
+		kc := &Catalog{Txn: txn}
+		err := kc.AlterDatabase(context.Background(), tenant, &model.Database{ID: 1, Name: "db1"}, &model.Database{ID: 1, Name: "db2"}, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("renamed", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return([]string{
+			funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Collection/db1.coll"),
+			funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Global/db1.*"),
+			funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Collection/db3.coll"),
+		}, []string{"id1", "id2", "id3"}, nil)
+		apiKey := func(id string, dbName string) string {
+			v, err := json.Marshal(model.MarshalAPIKeyModel(&model.APIKey{ID: id, Username: "user", DBName: dbName}))
+			assert.NoError(t, err)
+			return string(v)
+		}
+		txn.EXPECT().LoadWithPrefix(APIKeyPrefix).Return(
+			[]string{APIKeyPrefix + "/key1", APIKeyPrefix + "/key2", APIKeyPrefix + "/key3"},
+			[]string{apiKey("key1", "db1"), apiKey("key2", "db3"), apiKey("key3", "")}, nil)
+
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithPlainKvsFunc = func(saves map[string]string, removals []string, plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp) error {
+			var dbPb pb.DatabaseInfo
+			assert.NoError(t, proto.Unmarshal([]byte(saves[BuildDatabaseKey(1)]), &dbPb))
+			assert.Equal(t, "db2", dbPb.GetName())
+			assert.Empty(t, removals)
+			assert.Equal(t, map[string]string{
+				funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Collection/db2.coll"): "id1",
+				funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Global/db2.*"):        "id2",
+				APIKeyPrefix + "/key1": apiKey("key1", "db2"),
+			}, plainSaves)
+			assert.ElementsMatch(t, []string{
+				funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Collection/db1.coll"),
+				funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "role1/Global/db1.*"),
+			}, plainRemovals)
+			return nil
+		}
+		kc := &Catalog{Txn: txn, Snapshot: snapshot}
+		err := kc.AlterDatabase(context.Background(), tenant, &model.Database{ID: 1, Name: "db1"}, &model.Database{ID: 1, Name: "db2"}, 100)
+		assert.NoError(t, err)
+	})
+}
+
 func TestCatalog_AlterPartition(t *testing.T) {
 	t.Run("add", func(t *testing.T) {
 		kc := &Catalog{}
@@ -1082,6 +1313,25 @@ func TestCatalog_AlterPartition(t *testing.T) {
 		assert.Equal(t, pb.PartitionState_PartitionCreated, got.State)
 	})
 
+	t.Run("modify, recycled", func(t *testing.T) {
+		snapshot := kv.NewMockSnapshotKV()
+		kvs := map[string]string{}
+		snapshot.SaveFunc = func(key string, value string, ts typeutil.Timestamp) error {
+			kvs[key] = value
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		oldP := &model.Partition{PartitionID: 2, CollectionID: 1, State: pb.PartitionState_PartitionCreated}
+		newP := &model.Partition{PartitionID: 2, CollectionID: 1, State: pb.PartitionState_PartitionRecycled, DropTime: 100}
+		err := kc.AlterPartition(context.Background(), testDb, oldP, newP, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+		var partPb pb.PartitionInfo
+		err = proto.Unmarshal([]byte(kvs[BuildPartitionKey(1, 2)]), &partPb)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.PartitionState_PartitionRecycled, partPb.GetState())
+		assert.Equal(t, uint64(100), partPb.GetDropTime())
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
@@ -1358,6 +1608,35 @@ func TestRBAC_Credential(t *testing.T) {
 		}
 	})
 
+	t.Run("test credential password policy", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+			key    = fmt.Sprintf("%s/%s", CredentialPrefix, "user1")
+			saved  string
+		)
+
+		kvmock.EXPECT().Save(key, mock.Anything).RunAndReturn(func(_ string, value string) error {
+			saved = value
+			return nil
+		})
+		kvmock.EXPECT().Load(key).RunAndReturn(func(string) (string, error) {
+			return saved, nil
+		})
+
+		credential := &model.Credential{
+			Username:           "user1",
+			EncryptedPassword:  "password2",
+			PasswordHistory:    []string{"password1", "password0"},
+			PasswordUpdateTime: 100,
+			NeedPasswordChange: true,
+		}
+		assert.NoError(t, c.AlterCredential(ctx, credential))
+		cre, err := c.GetCredential(ctx, "user1")
+		assert.NoError(t, err)
+		assert.Equal(t, credential, cre)
+	})
+
 	t.Run("test DropCredential", func(t *testing.T) {
 		var (
 			kvmock = mocks.NewTxnKV(t)
@@ -1377,6 +1656,7 @@ func TestRBAC_Credential(t *testing.T) {
 				fmt.Sprintf("%s/%s", CredentialPrefix, validName),
 				validUserRoleKeyPrefix + "/role1",
 				validUserRoleKeyPrefix + "/role2",
+				fmt.Sprintf("%s/%s", APIKeyPrefix, "key1"),
 			},
 		).Return(nil)
 		kvmock.EXPECT().MultiRemove(mock.Anything).Return(errors.New("Mock invalid multi remove"))
@@ -1391,6 +1671,11 @@ func TestRBAC_Credential(t *testing.T) {
 			nil,
 		)
 		kvmock.EXPECT().LoadWithPrefix(dropUserRoleKeyPrefix).Return([]string{}, []string{}, nil)
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix).Return(
+			[]string{fmt.Sprintf("%s/%s", APIKeyPrefix, "key1"), fmt.Sprintf("%s/%s", APIKeyPrefix, "key2")},
+			[]string{`{"id":"key1","username":"user1"}`, `{"id":"key2","username":"user2"}`},
+			nil,
+		)
 
 		tests := []struct {
 			description string
@@ -1478,6 +1763,67 @@ func TestRBAC_Credential(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("test APIKey", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+			key    = &model.APIKey{ID: "key1", Username: "user1", Sha256Key: "xxxx", DBName: "db", ReadOnly: true}
+		)
+
+		kvmock.EXPECT().Save(fmt.Sprintf("%s/%s", APIKeyPrefix, "key1"), mock.Anything).Return(nil).Once()
+		kvmock.EXPECT().Save(fmt.Sprintf("%s/%s", APIKeyPrefix, "key1"), mock.Anything).Return(errors.New("Mock save fail")).Once()
+		assert.NoError(t, c.SaveAPIKey(ctx, key))
+		assert.Error(t, c.SaveAPIKey(ctx, key))
+
+		saved := kvmock.Calls[0].Arguments.String(1)
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix).Return([]string{fmt.Sprintf("%s/%s", APIKeyPrefix, "key1")}, []string{saved}, nil).Once()
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix).Return([]string{"invalid"}, []string{"invalid"}, nil).Once()
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix).Return(nil, nil, errors.New("Mock load fail")).Once()
+		keys, err := c.ListAPIKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.APIKey{key}, keys)
+		_, err = c.ListAPIKeys(ctx)
+		assert.Error(t, err)
+		_, err = c.ListAPIKeys(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().Remove(fmt.Sprintf("%s/%s", APIKeyPrefix, "key1")).Return(nil).Once()
+		kvmock.EXPECT().Remove(fmt.Sprintf("%s/%s", APIKeyPrefix, "key1")).Return(errors.New("Mock remove fail")).Once()
+		assert.NoError(t, c.DropAPIKey(ctx, "key1"))
+		assert.Error(t, c.DropAPIKey(ctx, "key1"))
+	})
+
+	t.Run("test RowPolicy", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+			policy = &model.RowPolicy{RoleName: "role1", DBName: "db", CollectionName: "coll", CollectionID: 100, Expr: "tenant == 'a'"}
+			key    = fmt.Sprintf("%s/%d/%s", RowPolicyPrefix, 100, "role1")
+		)
+
+		kvmock.EXPECT().Save(key, mock.Anything).Return(nil).Once()
+		kvmock.EXPECT().Save(key, mock.Anything).Return(errors.New("Mock save fail")).Once()
+		assert.NoError(t, c.SaveRowPolicy(ctx, policy))
+		assert.Error(t, c.SaveRowPolicy(ctx, policy))
+
+		saved := kvmock.Calls[0].Arguments.String(1)
+		kvmock.EXPECT().LoadWithPrefix(RowPolicyPrefix).Return([]string{key}, []string{saved}, nil).Once()
+		kvmock.EXPECT().LoadWithPrefix(RowPolicyPrefix).Return([]string{"invalid"}, []string{"invalid"}, nil).Once()
+		kvmock.EXPECT().LoadWithPrefix(RowPolicyPrefix).Return(nil, nil, errors.New("Mock load fail")).Once()
+		policies, err := c.ListRowPolicies(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.RowPolicy{policy}, policies)
+		_, err = c.ListRowPolicies(ctx)
+		assert.Error(t, err)
+		_, err = c.ListRowPolicies(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().Remove(key).Return(nil).Once()
+		kvmock.EXPECT().Remove(key).Return(errors.New("Mock remove fail")).Once()
+		assert.NoError(t, c.DropRowPolicy(ctx, 100, "role1"))
+		assert.Error(t, c.DropRowPolicy(ctx, 100, "role1"))
+	})
 }
 
 func TestRBAC_Role(t *testing.T) {
@@ -2487,3 +2833,84 @@ func TestRBAC_Grant(t *testing.T) {
 		}
 	})
 }
+
+func TestCatalog_CollectionSnapshot(t *testing.T) {
+	var (
+		ctx      = context.TODO()
+		kvmock   = mocks.NewTxnKV(t)
+		c        = &Catalog{Txn: kvmock}
+		snapshot = &model.CollectionSnapshot{
+			ID:         1,
+			Name:       "snapshot",
+			DBID:       2,
+			CreateTime: 100,
+			Collection: &model.Collection{CollectionID: 3, DBID: 2, Name: "coll", Partitions: []*model.Partition{}},
+		}
+		key = BuildCollectionSnapshotKey(2, 1)
+	)
+
+	kvmock.EXPECT().Save(key, mock.Anything).Return(nil).Once()
+	kvmock.EXPECT().Save(key, mock.Anything).Return(errors.New("Mock save fail")).Once()
+	assert.NoError(t, c.SaveCollectionSnapshot(ctx, snapshot))
+	assert.Error(t, c.SaveCollectionSnapshot(ctx, snapshot))
+
+	saved := kvmock.Calls[0].Arguments.String(1)
+	kvmock.EXPECT().LoadWithPrefix(CollectionSnapshotPrefix).Return([]string{key}, []string{saved}, nil).Once()
+	kvmock.EXPECT().LoadWithPrefix(CollectionSnapshotPrefix).Return([]string{"invalid"}, []string{"invalid"}, nil).Once()
+	kvmock.EXPECT().LoadWithPrefix(CollectionSnapshotPrefix).Return(nil, nil, errors.New("Mock load fail")).Once()
+	snapshots, err := c.ListCollectionSnapshots(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, "snapshot", snapshots[0].Name)
+	assert.Equal(t, int64(3), snapshots[0].Collection.CollectionID)
+	_, err = c.ListCollectionSnapshots(ctx)
+	assert.Error(t, err)
+	_, err = c.ListCollectionSnapshots(ctx)
+	assert.Error(t, err)
+
+	kvmock.EXPECT().Remove(key).Return(nil).Once()
+	kvmock.EXPECT().Remove(key).Return(errors.New("Mock remove fail")).Once()
+	assert.NoError(t, c.DropCollectionSnapshot(ctx, 2, 1))
+	assert.Error(t, c.DropCollectionSnapshot(ctx, 2, 1))
+}
+
+func TestCatalog_DDLJob(t *testing.T) {
+	var (
+		ctx    = context.TODO()
+		kvmock = mocks.NewTxnKV(t)
+		c      = &Catalog{Txn: kvmock}
+		job    = &model.DDLJob{
+			ID:             1,
+			Type:           "DropCollection",
+			DBName:         "default",
+			CollectionName: "coll",
+			State:          internalpb.DDLJobState_DDLJobRunning,
+			CurrentStep:    "delete collection meta",
+			CreateTime:     100,
+			StartTime:      101,
+		}
+		key = BuildDDLJobKey(1)
+	)
+
+	kvmock.EXPECT().Save(key, mock.Anything).Return(nil).Once()
+	kvmock.EXPECT().Save(key, mock.Anything).Return(errors.New("Mock save fail")).Once()
+	assert.NoError(t, c.SaveDDLJob(ctx, job))
+	assert.Error(t, c.SaveDDLJob(ctx, job))
+
+	saved := kvmock.Calls[0].Arguments.String(1)
+	kvmock.EXPECT().LoadWithPrefix(DDLJobPrefix).Return([]string{key}, []string{saved}, nil).Once()
+	kvmock.EXPECT().LoadWithPrefix(DDLJobPrefix).Return([]string{"invalid"}, []string{"invalid"}, nil).Once()
+	kvmock.EXPECT().LoadWithPrefix(DDLJobPrefix).Return(nil, nil, errors.New("Mock load fail")).Once()
+	jobs, err := c.ListDDLJobs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.DDLJob{job}, jobs)
+	_, err = c.ListDDLJobs(ctx)
+	assert.Error(t, err)
+	_, err = c.ListDDLJobs(ctx)
+	assert.Error(t, err)
+
+	kvmock.EXPECT().Remove(key).Return(nil).Once()
+	kvmock.EXPECT().Remove(key).Return(errors.New("Mock remove fail")).Once()
+	assert.NoError(t, c.DropDDLJob(ctx, 1))
+	assert.Error(t, c.DropDDLJob(ctx, 1))
+}
@@ -21,6 +21,12 @@ const (
 	AliasMetaPrefix     = ComponentPrefix + "/aliases"
 	FieldMetaPrefix     = ComponentPrefix + "/fields"
 
+	// CollectionSnapshotPrefix prefix for the named snapshots of the collections
+	CollectionSnapshotPrefix = ComponentPrefix + "/collection-snapshots"
+
+	// DDLJobPrefix prefix for the progress of the DDL jobs
+	DDLJobPrefix = ComponentPrefix + "/ddl-jobs"
+
 	// CollectionAliasMetaPrefix210 prefix for collection alias meta
 	CollectionAliasMetaPrefix210 = ComponentPrefix + "/collection-alias"
 
@@ -49,6 +55,12 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// APIKeyPrefix prefix for api key
+	APIKeyPrefix = ComponentPrefix + CommonCredentialPrefix + "/api-keys"
+
+	// RowPolicyPrefix prefix for the row policies of the roles on the collections
+	RowPolicyPrefix = ComponentPrefix + CommonCredentialPrefix + "/row-policies"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -59,6 +71,14 @@ func BuildCollectionKeyWithDBID(dbID int64, collectionID int64) string {
 	return fmt.Sprintf("%s/%d/%d", CollectionInfoMetaPrefix, dbID, collectionID)
 }
 
+func BuildCollectionSnapshotKey(dbID int64, snapshotID int64) string {
+	return fmt.Sprintf("%s/%d/%d", CollectionSnapshotPrefix, dbID, snapshotID)
+}
+
+func BuildDDLJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", DDLJobPrefix, jobID)
+}
+
 func BuildDatabaseKey(dbID int64) string {
 	return fmt.Sprintf("%s/%d", DBInfoMetaPrefix, dbID)
 }
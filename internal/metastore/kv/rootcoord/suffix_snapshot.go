@@ -520,11 +520,81 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 	}
 
 	// load each removal, change execution to adding tombstones
+	updateList, err = ss.generateRemoveExecute(execute, updateList, removals, ts)
+	if err != nil {
+		return err
+	}
+
+	// multi save execute map; if succeeds, update ts in the update list
+	err = ss.MetaKv.MultiSave(execute)
+	if err == nil {
+		for _, key := range updateList {
+			ss.lastestTS[key] = ts
+		}
+	}
+	return err
+}
+
+// MultiSaveAndRemoveWithPlainKvs acts like MultiSaveAndRemoveWithPrefix, and saves and removes the plain kvs
+// without ts in the same transaction, the plain removals are the exact keys.
+// if ts == 0, act like MetaKv
+func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPlainKvs(saves map[string]string, removals []string,
+	plainSaves map[string]string, plainRemovals []string, ts typeutil.Timestamp,
+) error {
+	// if ts == 0, act like MetaKv
+	if ts == 0 {
+		execute := make(map[string]string, len(saves)+len(plainSaves))
+		for key, value := range saves {
+			execute[key] = value
+		}
+		for key, value := range plainSaves {
+			execute[key] = value
+		}
+		removeKeys := append([]string{}, plainRemovals...)
+		for _, removal := range removals {
+			keys, _, err := ss.MetaKv.LoadWithPrefix(removal)
+			if err != nil {
+				log.Warn("SuffixSnapshot MetaKv LoadwithPrefix failed", zap.String("key", removal), zap.Error(err))
+				return err
+			}
+			for _, key := range keys {
+				removeKeys = append(removeKeys, ss.hideRootPrefix(key))
+			}
+		}
+		return ss.MetaKv.MultiSaveAndRemove(execute, removeKeys)
+	}
+	ss.Lock()
+	defer ss.Unlock()
+
+	execute, updateList, err := ss.generateSaveExecute(saves, ts)
+	if err != nil {
+		return err
+	}
+	updateList, err = ss.generateRemoveExecute(execute, updateList, removals, ts)
+	if err != nil {
+		return err
+	}
+	for key, value := range plainSaves {
+		execute[key] = value
+	}
+
+	err = ss.MetaKv.MultiSaveAndRemove(execute, plainRemovals)
+	if err == nil {
+		for _, key := range updateList {
+			ss.lastestTS[key] = ts
+		}
+	}
+	return err
+}
+
+// generateRemoveExecute adds the tombstones of the keys with the removal prefixes to the execute map
+// returns the update ts list appended with the removed keys
+func (ss *SuffixSnapshot) generateRemoveExecute(execute map[string]string, updateList []string, removals []string, ts typeutil.Timestamp) ([]string, error) {
 	for _, removal := range removals {
 		keys, _, err := ss.MetaKv.LoadWithPrefix(removal)
 		if err != nil {
 			log.Warn("SuffixSnapshot MetaKv LoadwithPrefix failed", zap.String("key", removal), zap.Error(err))
-			return err
+			return nil, err
 		}
 
 		// add tombstone to original key and add ts entry
@@ -535,15 +605,7 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 			updateList = append(updateList, key)
 		}
 	}
-
-	// multi save execute map; if succeeds, update ts in the update list
-	err = ss.MetaKv.MultiSave(execute)
-	if err == nil {
-		for _, key := range updateList {
-			ss.lastestTS[key] = ts
-		}
-	}
-	return err
+	return updateList, nil
 }
 
 func (ss *SuffixSnapshot) Close() {
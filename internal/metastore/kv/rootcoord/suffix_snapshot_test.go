@@ -673,6 +673,70 @@ func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
 }
 
+func Test_SuffixSnapshotMultiSaveAndRemoveWithPlainKvs(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+
+	rootPath := fmt.Sprintf("/test/meta/plain-%d", randVal)
+	sep := "_ts"
+
+	etcdCli, err := etcd.GetEtcdClient(
+		Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		Params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		Params.EtcdCfg.Endpoints.GetAsStrings(),
+		Params.EtcdCfg.EtcdTLSCert.GetValue(),
+		Params.EtcdCfg.EtcdTLSKey.GetValue(),
+		Params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		Params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	require.Nil(t, err)
+	defer etcdCli.Close()
+	etcdkv := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	defer etcdkv.Close()
+
+	ss, err := NewSuffixSnapshot(etcdkv, sep, rootPath, snapshotPrefix)
+	assert.NoError(t, err)
+	defer ss.Close()
+
+	assert.NoError(t, ss.Save("kd-old", "value-old", 100))
+	assert.NoError(t, etcdkv.Save("plain-old", "plain-value"))
+
+	err = ss.MultiSaveAndRemoveWithPlainKvs(map[string]string{"kd-new": "value-new"}, []string{"kd-old"},
+		map[string]string{"plain-new": "plain-value"}, []string{"plain-old"}, 200)
+	assert.NoError(t, err)
+
+	// the versioned kvs keep the history.
+	val, err := ss.Load("kd-old", 150)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-old", val)
+	_, err = ss.Load("kd-old", 250)
+	assert.Error(t, err)
+	val, err = ss.Load("kd-new", 250)
+	assert.NoError(t, err)
+	assert.Equal(t, "value-new", val)
+
+	// the plain kvs are saved and removed as is.
+	val, err = etcdkv.Load("plain-new")
+	assert.NoError(t, err)
+	assert.Equal(t, "plain-value", val)
+	_, err = etcdkv.Load("plain-old")
+	assert.Error(t, err)
+
+	// act like MetaKv if ts is zero.
+	err = ss.MultiSaveAndRemoveWithPlainKvs(map[string]string{"kd-zero": "value-zero"}, []string{"kd-new"},
+		map[string]string{"plain-old": "plain-value"}, []string{"plain-new"}, 0)
+	assert.NoError(t, err)
+	val, err = etcdkv.Load("kd-zero")
+	assert.NoError(t, err)
+	assert.Equal(t, "value-zero", val)
+	_, err = etcdkv.Load("kd-new")
+	assert.Error(t, err)
+	_, err = etcdkv.Load("plain-new")
+	assert.Error(t, err)
+
+	// cleanup
+	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
+}
+
 func TestSuffixSnapshot_LoadWithPrefix(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	randVal := rand.Int()
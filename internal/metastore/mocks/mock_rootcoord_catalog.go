@@ -70,6 +70,52 @@ func (_c *RootCoordCatalog_AlterAlias_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: ctx, dbID, aliases, droppedAliases, ts
+func (_m *RootCoordCatalog) AlterAliases(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64) error {
+	ret := _m.Called(ctx, dbID, aliases, droppedAliases, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []*model.Alias, []string, uint64) error); ok {
+		r0 = rf(ctx, dbID, aliases, droppedAliases, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type RootCoordCatalog_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - aliases []*model.Alias
+//   - droppedAliases []string
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) AlterAliases(ctx interface{}, dbID interface{}, aliases interface{}, droppedAliases interface{}, ts interface{}) *RootCoordCatalog_AlterAliases_Call {
+	return &RootCoordCatalog_AlterAliases_Call{Call: _e.mock.On("AlterAliases", ctx, dbID, aliases, droppedAliases, ts)}
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) Run(run func(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64)) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]*model.Alias), args[3].([]string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) Return(_a0 error) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterAliases_Call) RunAndReturn(run func(context.Context, int64, []*model.Alias, []string, uint64) error) *RootCoordCatalog_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, alterType, ts
 func (_m *RootCoordCatalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, alterType, ts)
@@ -159,6 +205,52 @@ func (_c *RootCoordCatalog_AlterCredential_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, tenant, oldDB, newDB, ts
+func (_m *RootCoordCatalog) AlterDatabase(ctx context.Context, tenant string, oldDB *model.Database, newDB *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, tenant, oldDB, newDB, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Database, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, tenant, oldDB, newDB, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type RootCoordCatalog_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - oldDB *model.Database
+//   - newDB *model.Database
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) AlterDatabase(ctx interface{}, tenant interface{}, oldDB interface{}, newDB interface{}, ts interface{}) *RootCoordCatalog_AlterDatabase_Call {
+	return &RootCoordCatalog_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", ctx, tenant, oldDB, newDB, ts)}
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) Run(run func(ctx context.Context, tenant string, oldDB *model.Database, newDB *model.Database, ts uint64)) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.Database), args[3].(*model.Database), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) Return(_a0 error) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) RunAndReturn(run func(context.Context, string, *model.Database, *model.Database, uint64) error) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterGrant provides a mock function with given fields: ctx, tenant, entity, operateType
 func (_m *RootCoordCatalog) AlterGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
 	ret := _m.Called(ctx, tenant, entity, operateType)
@@ -682,6 +774,49 @@ func (_c *RootCoordCatalog_DeleteGrant_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DropAPIKey provides a mock function with given fields: ctx, id
+func (_m *RootCoordCatalog) DropAPIKey(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAPIKey'
+type RootCoordCatalog_DropAPIKey_Call struct {
+	*mock.Call
+}
+
+// DropAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RootCoordCatalog_Expecter) DropAPIKey(ctx interface{}, id interface{}) *RootCoordCatalog_DropAPIKey_Call {
+	return &RootCoordCatalog_DropAPIKey_Call{Call: _e.mock.On("DropAPIKey", ctx, id)}
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Run(run func(ctx context.Context, id string)) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Return(_a0 error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) RunAndReturn(run func(context.Context, string) error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbID, alias, ts
 func (_m *RootCoordCatalog) DropAlias(ctx context.Context, dbID int64, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbID, alias, ts)
@@ -771,6 +906,50 @@ func (_c *RootCoordCatalog_DropCollection_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DropCollectionSnapshot provides a mock function with given fields: ctx, dbID, snapshotID
+func (_m *RootCoordCatalog) DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID int64) error {
+	ret := _m.Called(ctx, dbID, snapshotID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, dbID, snapshotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropCollectionSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionSnapshot'
+type RootCoordCatalog_DropCollectionSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropCollectionSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - snapshotID int64
+func (_e *RootCoordCatalog_Expecter) DropCollectionSnapshot(ctx interface{}, dbID interface{}, snapshotID interface{}) *RootCoordCatalog_DropCollectionSnapshot_Call {
+	return &RootCoordCatalog_DropCollectionSnapshot_Call{Call: _e.mock.On("DropCollectionSnapshot", ctx, dbID, snapshotID)}
+}
+
+func (_c *RootCoordCatalog_DropCollectionSnapshot_Call) Run(run func(ctx context.Context, dbID int64, snapshotID int64)) *RootCoordCatalog_DropCollectionSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropCollectionSnapshot_Call) Return(_a0 error) *RootCoordCatalog_DropCollectionSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropCollectionSnapshot_Call) RunAndReturn(run func(context.Context, int64, int64) error) *RootCoordCatalog_DropCollectionSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropCredential provides a mock function with given fields: ctx, username
 func (_m *RootCoordCatalog) DropCredential(ctx context.Context, username string) error {
 	ret := _m.Called(ctx, username)
@@ -814,6 +993,49 @@ func (_c *RootCoordCatalog_DropCredential_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DropDDLJob provides a mock function with given fields: ctx, jobID
+func (_m *RootCoordCatalog) DropDDLJob(ctx context.Context, jobID int64) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDDLJob'
+type RootCoordCatalog_DropDDLJob_Call struct {
+	*mock.Call
+}
+
+// DropDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *RootCoordCatalog_Expecter) DropDDLJob(ctx interface{}, jobID interface{}) *RootCoordCatalog_DropDDLJob_Call {
+	return &RootCoordCatalog_DropDDLJob_Call{Call: _e.mock.On("DropDDLJob", ctx, jobID)}
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) Run(run func(ctx context.Context, jobID int64)) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) Return(_a0 error) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropDDLJob_Call) RunAndReturn(run func(context.Context, int64) error) *RootCoordCatalog_DropDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) DropDatabase(ctx context.Context, dbID int64, ts uint64) error {
 	ret := _m.Called(ctx, dbID, ts)
@@ -948,6 +1170,50 @@ func (_c *RootCoordCatalog_DropRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropRowPolicy provides a mock function with given fields: ctx, collectionID, roleName
+func (_m *RootCoordCatalog) DropRowPolicy(ctx context.Context, collectionID int64, roleName string) error {
+	ret := _m.Called(ctx, collectionID, roleName)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, collectionID, roleName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRowPolicy'
+type RootCoordCatalog_DropRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DropRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - roleName string
+func (_e *RootCoordCatalog_Expecter) DropRowPolicy(ctx interface{}, collectionID interface{}, roleName interface{}) *RootCoordCatalog_DropRowPolicy_Call {
+	return &RootCoordCatalog_DropRowPolicy_Call{Call: _e.mock.On("DropRowPolicy", ctx, collectionID, roleName)}
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) Run(run func(ctx context.Context, collectionID int64, roleName string)) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) Return(_a0 error) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropRowPolicy_Call) RunAndReturn(run func(context.Context, int64, string) error) *RootCoordCatalog_DropRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbID, ts, collectionID
 func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, dbID int64, ts uint64, collectionID int64) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts, collectionID)
@@ -1117,6 +1383,60 @@ func (_c *RootCoordCatalog_GetCredential_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoordCatalog_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListAPIKeys(ctx interface{}) *RootCoordCatalog_ListAPIKeys_Call {
+	return &RootCoordCatalog_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Return(_a0 []*model.APIKey, _a1 error) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) RunAndReturn(run func(context.Context) ([]*model.APIKey, error)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) ListAliases(ctx context.Context, dbID int64, ts uint64) ([]*model.Alias, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1173,6 +1493,60 @@ func (_c *RootCoordCatalog_ListAliases_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListCollectionSnapshots provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListCollectionSnapshots(ctx context.Context) ([]*model.CollectionSnapshot, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.CollectionSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CollectionSnapshot, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CollectionSnapshot); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListCollectionSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionSnapshots'
+type RootCoordCatalog_ListCollectionSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListCollectionSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListCollectionSnapshots(ctx interface{}) *RootCoordCatalog_ListCollectionSnapshots_Call {
+	return &RootCoordCatalog_ListCollectionSnapshots_Call{Call: _e.mock.On("ListCollectionSnapshots", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListCollectionSnapshots_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListCollectionSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListCollectionSnapshots_Call) Return(_a0 []*model.CollectionSnapshot, _a1 error) *RootCoordCatalog_ListCollectionSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListCollectionSnapshots_Call) RunAndReturn(run func(context.Context) ([]*model.CollectionSnapshot, error)) *RootCoordCatalog_ListCollectionSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCollections provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) ListCollections(ctx context.Context, dbID int64, ts uint64) ([]*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1283,6 +1657,60 @@ func (_c *RootCoordCatalog_ListCredentials_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.DDLJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.DDLJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.DDLJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DDLJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type RootCoordCatalog_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListDDLJobs(ctx interface{}) *RootCoordCatalog_ListDDLJobs_Call {
+	return &RootCoordCatalog_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) Return(_a0 []*model.DDLJob, _a1 error) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListDDLJobs_Call) RunAndReturn(run func(context.Context) ([]*model.DDLJob, error)) *RootCoordCatalog_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: ctx, ts
 func (_m *RootCoordCatalog) ListDatabases(ctx context.Context, ts uint64) ([]*model.Database, error) {
 	ret := _m.Called(ctx, ts)
@@ -1506,6 +1934,60 @@ func (_c *RootCoordCatalog_ListRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListRowPolicies(ctx context.Context) ([]*model.RowPolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.RowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.RowPolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RowPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type RootCoordCatalog_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListRowPolicies(ctx interface{}) *RootCoordCatalog_ListRowPolicies_Call {
+	return &RootCoordCatalog_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListRowPolicies_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRowPolicies_Call) Return(_a0 []*model.RowPolicy, _a1 error) *RootCoordCatalog_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRowPolicies_Call) RunAndReturn(run func(context.Context) ([]*model.RowPolicy, error)) *RootCoordCatalog_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUser provides a mock function with given fields: ctx, tenant, entity, includeRoleInfo
 func (_m *RootCoordCatalog) ListUser(ctx context.Context, tenant string, entity *milvuspb.UserEntity, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
 	ret := _m.Called(ctx, tenant, entity, includeRoleInfo)
@@ -1618,6 +2100,226 @@ func (_c *RootCoordCatalog_ListUserRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// MoveCollection provides a mock function with given fields: ctx, tenant, oldColl, newColl, oldDBName, newDBName, ts
+func (_m *RootCoordCatalog) MoveCollection(ctx context.Context, tenant string, oldColl *model.Collection, newColl *model.Collection, oldDBName string, newDBName string, ts uint64) error {
+	ret := _m.Called(ctx, tenant, oldColl, newColl, oldDBName, newDBName, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.Collection, *model.Collection, string, string, uint64) error); ok {
+		r0 = rf(ctx, tenant, oldColl, newColl, oldDBName, newDBName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_MoveCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveCollection'
+type RootCoordCatalog_MoveCollection_Call struct {
+	*mock.Call
+}
+
+// MoveCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - oldColl *model.Collection
+//   - newColl *model.Collection
+//   - oldDBName string
+//   - newDBName string
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) MoveCollection(ctx interface{}, tenant interface{}, oldColl interface{}, newColl interface{}, oldDBName interface{}, newDBName interface{}, ts interface{}) *RootCoordCatalog_MoveCollection_Call {
+	return &RootCoordCatalog_MoveCollection_Call{Call: _e.mock.On("MoveCollection", ctx, tenant, oldColl, newColl, oldDBName, newDBName, ts)}
+}
+
+func (_c *RootCoordCatalog_MoveCollection_Call) Run(run func(ctx context.Context, tenant string, oldColl *model.Collection, newColl *model.Collection, oldDBName string, newDBName string, ts uint64)) *RootCoordCatalog_MoveCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*model.Collection), args[3].(*model.Collection), args[4].(string), args[5].(string), args[6].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_MoveCollection_Call) Return(_a0 error) *RootCoordCatalog_MoveCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_MoveCollection_Call) RunAndReturn(run func(context.Context, string, *model.Collection, *model.Collection, string, string, uint64) error) *RootCoordCatalog_MoveCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAPIKey provides a mock function with given fields: ctx, key
+func (_m *RootCoordCatalog) SaveAPIKey(ctx context.Context, key *model.APIKey) error {
+	ret := _m.Called(ctx, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.APIKey) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAPIKey'
+type RootCoordCatalog_SaveAPIKey_Call struct {
+	*mock.Call
+}
+
+// SaveAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *model.APIKey
+func (_e *RootCoordCatalog_Expecter) SaveAPIKey(ctx interface{}, key interface{}) *RootCoordCatalog_SaveAPIKey_Call {
+	return &RootCoordCatalog_SaveAPIKey_Call{Call: _e.mock.On("SaveAPIKey", ctx, key)}
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Run(run func(ctx context.Context, key *model.APIKey)) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.APIKey))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Return(_a0 error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) RunAndReturn(run func(context.Context, *model.APIKey) error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveCollectionSnapshot provides a mock function with given fields: ctx, snapshot
+func (_m *RootCoordCatalog) SaveCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error {
+	ret := _m.Called(ctx, snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionSnapshot) error); ok {
+		r0 = rf(ctx, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveCollectionSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveCollectionSnapshot'
+type RootCoordCatalog_SaveCollectionSnapshot_Call struct {
+	*mock.Call
+}
+
+// SaveCollectionSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot *model.CollectionSnapshot
+func (_e *RootCoordCatalog_Expecter) SaveCollectionSnapshot(ctx interface{}, snapshot interface{}) *RootCoordCatalog_SaveCollectionSnapshot_Call {
+	return &RootCoordCatalog_SaveCollectionSnapshot_Call{Call: _e.mock.On("SaveCollectionSnapshot", ctx, snapshot)}
+}
+
+func (_c *RootCoordCatalog_SaveCollectionSnapshot_Call) Run(run func(ctx context.Context, snapshot *model.CollectionSnapshot)) *RootCoordCatalog_SaveCollectionSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CollectionSnapshot))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveCollectionSnapshot_Call) Return(_a0 error) *RootCoordCatalog_SaveCollectionSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveCollectionSnapshot_Call) RunAndReturn(run func(context.Context, *model.CollectionSnapshot) error) *RootCoordCatalog_SaveCollectionSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDDLJob provides a mock function with given fields: ctx, job
+func (_m *RootCoordCatalog) SaveDDLJob(ctx context.Context, job *model.DDLJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DDLJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDDLJob'
+type RootCoordCatalog_SaveDDLJob_Call struct {
+	*mock.Call
+}
+
+// SaveDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *model.DDLJob
+func (_e *RootCoordCatalog_Expecter) SaveDDLJob(ctx interface{}, job interface{}) *RootCoordCatalog_SaveDDLJob_Call {
+	return &RootCoordCatalog_SaveDDLJob_Call{Call: _e.mock.On("SaveDDLJob", ctx, job)}
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) Run(run func(ctx context.Context, job *model.DDLJob)) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.DDLJob))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) Return(_a0 error) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveDDLJob_Call) RunAndReturn(run func(context.Context, *model.DDLJob) error) *RootCoordCatalog_SaveDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRowPolicy provides a mock function with given fields: ctx, policy
+func (_m *RootCoordCatalog) SaveRowPolicy(ctx context.Context, policy *model.RowPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RowPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRowPolicy'
+type RootCoordCatalog_SaveRowPolicy_Call struct {
+	*mock.Call
+}
+
+// SaveRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - policy *model.RowPolicy
+func (_e *RootCoordCatalog_Expecter) SaveRowPolicy(ctx interface{}, policy interface{}) *RootCoordCatalog_SaveRowPolicy_Call {
+	return &RootCoordCatalog_SaveRowPolicy_Call{Call: _e.mock.On("SaveRowPolicy", ctx, policy)}
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) Run(run func(ctx context.Context, policy *model.RowPolicy)) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) Return(_a0 error) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveRowPolicy_Call) RunAndReturn(run func(context.Context, *model.RowPolicy) error) *RootCoordCatalog_SaveRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
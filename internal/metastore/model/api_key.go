@@ -0,0 +1,51 @@
+package model
+
+import "github.com/milvus-io/milvus/internal/proto/internalpb"
+
+type APIKey struct {
+	ID             string
+	Username       string
+	Sha256Key      string
+	DBName         string
+	CollectionName string
+	ReadOnly       bool
+	ExpireAt       int64
+	Description    string
+	CreatedAt      int64
+}
+
+func MarshalAPIKeyModel(key *APIKey) *internalpb.APIKeyInfo {
+	if key == nil {
+		return nil
+	}
+	return &internalpb.APIKeyInfo{
+		Id:        key.ID,
+		Username:  key.Username,
+		Sha256Key: key.Sha256Key,
+		Scope: &internalpb.APIKeyScope{
+			DbName:         key.DBName,
+			CollectionName: key.CollectionName,
+			ReadOnly:       key.ReadOnly,
+			ExpireAt:       key.ExpireAt,
+		},
+		Description: key.Description,
+		CreatedAt:   key.CreatedAt,
+	}
+}
+
+func UnmarshalAPIKeyModel(info *internalpb.APIKeyInfo) *APIKey {
+	if info == nil {
+		return nil
+	}
+	return &APIKey{
+		ID:             info.GetId(),
+		Username:       info.GetUsername(),
+		Sha256Key:      info.GetSha256Key(),
+		DBName:         info.GetScope().GetDbName(),
+		CollectionName: info.GetScope().GetCollectionName(),
+		ReadOnly:       info.GetScope().GetReadOnly(),
+		ExpireAt:       info.GetScope().GetExpireAt(),
+		Description:    info.GetDescription(),
+		CreatedAt:      info.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+var (
+	apiKeyModel = &APIKey{
+		ID:             "0123456789abcdef",
+		Username:       "user",
+		Sha256Key:      "xxxx",
+		DBName:         "db",
+		CollectionName: "collection",
+		ReadOnly:       true,
+		ExpireAt:       1700000000,
+		Description:    "reader",
+		CreatedAt:      1600000000,
+	}
+
+	apiKeyPb = &internalpb.APIKeyInfo{
+		Id:        "0123456789abcdef",
+		Username:  "user",
+		Sha256Key: "xxxx",
+		Scope: &internalpb.APIKeyScope{
+			DbName:         "db",
+			CollectionName: "collection",
+			ReadOnly:       true,
+			ExpireAt:       1700000000,
+		},
+		Description: "reader",
+		CreatedAt:   1600000000,
+	}
+)
+
+func TestMarshalAPIKeyModel(t *testing.T) {
+	ret := MarshalAPIKeyModel(apiKeyModel)
+	assert.Equal(t, apiKeyPb, ret)
+
+	assert.Nil(t, MarshalAPIKeyModel(nil))
+}
+
+func TestUnmarshalAPIKeyModel(t *testing.T) {
+	ret := UnmarshalAPIKeyModel(apiKeyPb)
+	assert.Equal(t, apiKeyModel, ret)
+
+	assert.Nil(t, UnmarshalAPIKeyModel(nil))
+}
@@ -28,6 +28,8 @@ type Collection struct {
 	Properties           []*commonpb.KeyValuePair
 	State                pb.CollectionState
 	EnableDynamicField   bool
+	SchemaVersion        int32
+	DropTime             uint64
 }
 
 func (c *Collection) Available() bool {
@@ -54,6 +56,8 @@ func (c *Collection) Clone() *Collection {
 		Properties:           common.CloneKeyValuePairs(c.Properties),
 		State:                c.State,
 		EnableDynamicField:   c.EnableDynamicField,
+		SchemaVersion:        c.SchemaVersion,
+		DropTime:             c.DropTime,
 	}
 }
 
@@ -110,6 +114,8 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		State:                coll.State,
 		Properties:           coll.Properties,
 		EnableDynamicField:   coll.Schema.EnableDynamicField,
+		SchemaVersion:        coll.SchemaVersion,
+		DropTime:             coll.DropTime,
 	}
 }
 
@@ -171,6 +177,8 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
+		DropTime:             coll.DropTime,
 	}
 
 	if c.withPartitions {
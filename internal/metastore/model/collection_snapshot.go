@@ -0,0 +1,96 @@
+package model
+
+import (
+	"github.com/samber/lo"
+
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+// CollectionSnapshot is a named snapshot of the collection, the flushed segments of it are recorded by datacoord.
+type CollectionSnapshot struct {
+	ID          int64
+	Name        string
+	Description string
+	DBID        int64
+	Collection  *Collection
+	CreateTime  uint64
+	// the row policies and the field grants on the collection when the snapshot is created
+	RowPolicies []*SnapshotRowPolicy
+	FieldGrants []*SnapshotFieldGrant
+}
+
+type SnapshotRowPolicy struct {
+	RoleName string
+	Expr     string
+}
+
+type SnapshotFieldGrant struct {
+	RoleName  string
+	FieldName string
+	Privilege string
+}
+
+func (s *CollectionSnapshot) Clone() *CollectionSnapshot {
+	return &CollectionSnapshot{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		DBID:        s.DBID,
+		Collection:  s.Collection.Clone(),
+		CreateTime:  s.CreateTime,
+		RowPolicies: lo.Map(s.RowPolicies, func(policy *SnapshotRowPolicy, _ int) *SnapshotRowPolicy {
+			cloned := *policy
+			return &cloned
+		}),
+		FieldGrants: lo.Map(s.FieldGrants, func(grant *SnapshotFieldGrant, _ int) *SnapshotFieldGrant {
+			cloned := *grant
+			return &cloned
+		}),
+	}
+}
+
+func MarshalCollectionSnapshotModel(snapshot *CollectionSnapshot) *pb.CollectionSnapshotInfo {
+	if snapshot == nil {
+		return nil
+	}
+	return &pb.CollectionSnapshotInfo{
+		Id:          snapshot.ID,
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		DbId:        snapshot.DBID,
+		Collection:  MarshalCollectionModelWithOption(snapshot.Collection, WithFields(), WithPartitions()),
+		CreateTime:  snapshot.CreateTime,
+		RowPolicies: lo.Map(snapshot.RowPolicies, func(policy *SnapshotRowPolicy, _ int) *pb.SnapshotRowPolicy {
+			return &pb.SnapshotRowPolicy{RoleName: policy.RoleName, Expr: policy.Expr}
+		}),
+		FieldGrants: lo.Map(snapshot.FieldGrants, func(grant *SnapshotFieldGrant, _ int) *pb.SnapshotFieldGrant {
+			return &pb.SnapshotFieldGrant{RoleName: grant.RoleName, FieldName: grant.FieldName, Privilege: grant.Privilege}
+		}),
+	}
+}
+
+func UnmarshalCollectionSnapshotModel(info *pb.CollectionSnapshotInfo) *CollectionSnapshot {
+	if info == nil {
+		return nil
+	}
+	coll := UnmarshalCollectionModel(info.GetCollection())
+	if coll != nil {
+		for _, partition := range coll.Partitions {
+			partition.CollectionID = coll.CollectionID
+		}
+	}
+	return &CollectionSnapshot{
+		ID:          info.GetId(),
+		Name:        info.GetName(),
+		Description: info.GetDescription(),
+		DBID:        info.GetDbId(),
+		Collection:  coll,
+		CreateTime:  info.GetCreateTime(),
+		RowPolicies: lo.Map(info.GetRowPolicies(), func(policy *pb.SnapshotRowPolicy, _ int) *SnapshotRowPolicy {
+			return &SnapshotRowPolicy{RoleName: policy.GetRoleName(), Expr: policy.GetExpr()}
+		}),
+		FieldGrants: lo.Map(info.GetFieldGrants(), func(grant *pb.SnapshotFieldGrant, _ int) *SnapshotFieldGrant {
+			return &SnapshotFieldGrant{RoleName: grant.GetRoleName(), FieldName: grant.GetFieldName(), Privilege: grant.GetPrivilege()}
+		}),
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func TestCollectionSnapshotModel(t *testing.T) {
+	snapshot := &CollectionSnapshot{
+		ID:          1,
+		Name:        "snapshot",
+		Description: "desc",
+		DBID:        2,
+		CreateTime:  100,
+		Collection: &Collection{
+			CollectionID: 3,
+			DBID:         2,
+			Name:         "coll",
+			Fields: []*Field{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+			Partitions: []*Partition{
+				{PartitionID: 4, PartitionName: "_default", PartitionCreatedTimestamp: 10, CollectionID: 3},
+			},
+			VirtualChannelNames: []string{"vch"},
+			ShardsNum:           1,
+			State:               pb.CollectionState_CollectionCreated,
+		},
+		RowPolicies: []*SnapshotRowPolicy{{RoleName: "role1", Expr: "pk > 10"}},
+		FieldGrants: []*SnapshotFieldGrant{{RoleName: "role1", FieldName: "vec", Privilege: "PrivilegeReadField"}},
+	}
+
+	info := MarshalCollectionSnapshotModel(snapshot)
+	assert.Equal(t, "snapshot", info.GetName())
+	assert.Len(t, info.GetCollection().GetSchema().GetFields(), 1)
+	assert.Equal(t, []int64{4}, info.GetCollection().GetPartitionIDs())
+
+	ret := UnmarshalCollectionSnapshotModel(info)
+	assert.Equal(t, snapshot.ID, ret.ID)
+	assert.Equal(t, snapshot.Name, ret.Name)
+	assert.Equal(t, snapshot.Description, ret.Description)
+	assert.Equal(t, snapshot.DBID, ret.DBID)
+	assert.Equal(t, snapshot.CreateTime, ret.CreateTime)
+	assert.True(t, snapshot.Collection.Equal(*ret.Collection))
+	assert.Equal(t, snapshot.Collection.Partitions, ret.Collection.Partitions)
+	assert.Equal(t, snapshot.Collection.VirtualChannelNames, ret.Collection.VirtualChannelNames)
+	assert.Equal(t, snapshot.RowPolicies, ret.RowPolicies)
+	assert.Equal(t, snapshot.FieldGrants, ret.FieldGrants)
+
+	cloned := snapshot.Clone()
+	assert.Equal(t, snapshot.Name, cloned.Name)
+	assert.Equal(t, snapshot.FieldGrants, cloned.FieldGrants)
+	assert.Nil(t, MarshalCollectionSnapshotModel(nil))
+	assert.Nil(t, UnmarshalCollectionSnapshotModel(nil))
+}
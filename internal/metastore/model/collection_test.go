@@ -102,6 +102,16 @@ func TestUnmarshalCollectionModel(t *testing.T) {
 
 func TestMarshalCollectionModel(t *testing.T) {
 	assert.Nil(t, MarshalCollectionModel(nil))
+
+	coll := colModel.Clone()
+	coll.SchemaVersion = 2
+	collPb := MarshalCollectionModel(coll)
+	assert.Equal(t, int32(2), collPb.GetSchemaVersion())
+	assert.Equal(t, int32(2), UnmarshalCollectionModel(collPb).SchemaVersion)
+
+	coll.DropTime = 100
+	assert.Equal(t, uint64(100), UnmarshalCollectionModel(MarshalCollectionModel(coll)).DropTime)
+	assert.Equal(t, uint64(100), coll.Clone().DropTime)
 }
 
 func TestCollection_GetPartitionNum(t *testing.T) {
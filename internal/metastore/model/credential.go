@@ -8,6 +8,10 @@ type Credential struct {
 	Tenant            string
 	IsSuper           bool
 	Sha256Password    string
+	// PasswordHistory are the previous encrypted passwords, the latest first
+	PasswordHistory    []string
+	PasswordUpdateTime int64
+	NeedPasswordChange bool
 }
 
 func MarshalCredentialModel(cred *Credential) *internalpb.CredentialInfo {
@@ -15,10 +19,13 @@ func MarshalCredentialModel(cred *Credential) *internalpb.CredentialInfo {
 		return nil
 	}
 	return &internalpb.CredentialInfo{
-		Tenant:            cred.Tenant,
-		Username:          cred.Username,
-		EncryptedPassword: cred.EncryptedPassword,
-		IsSuper:           cred.IsSuper,
-		Sha256Password:    cred.Sha256Password,
+		Tenant:             cred.Tenant,
+		Username:           cred.Username,
+		EncryptedPassword:  cred.EncryptedPassword,
+		IsSuper:            cred.IsSuper,
+		Sha256Password:     cred.Sha256Password,
+		PasswordHistory:    cred.PasswordHistory,
+		PasswordUpdateTime: cred.PasswordUpdateTime,
+		NeedPasswordChange: cred.NeedPasswordChange,
 	}
 }
@@ -10,19 +10,25 @@ import (
 
 var (
 	credentialModel = &Credential{
-		Username:          "user",
-		EncryptedPassword: "password",
-		Tenant:            "tenant-1",
-		IsSuper:           true,
-		Sha256Password:    "xxxx",
+		Username:           "user",
+		EncryptedPassword:  "password",
+		Tenant:             "tenant-1",
+		IsSuper:            true,
+		Sha256Password:     "xxxx",
+		PasswordHistory:    []string{"password0"},
+		PasswordUpdateTime: 100,
+		NeedPasswordChange: true,
 	}
 
 	credentialPb = &internalpb.CredentialInfo{
-		Username:          "user",
-		EncryptedPassword: "password",
-		Tenant:            "tenant-1",
-		IsSuper:           true,
-		Sha256Password:    "xxxx",
+		Username:           "user",
+		EncryptedPassword:  "password",
+		Tenant:             "tenant-1",
+		IsSuper:            true,
+		Sha256Password:     "xxxx",
+		PasswordHistory:    []string{"password0"},
+		PasswordUpdateTime: 100,
+		NeedPasswordChange: true,
 	}
 )
 
@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/common"
 )
 
 var (
@@ -15,6 +17,7 @@ var (
 		Id:          1,
 		CreatedTime: 1,
 		State:       etcdpb.DatabaseState_DatabaseCreated,
+		Properties:  []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}},
 	}
 
 	dbModel = &Database{
@@ -23,6 +26,7 @@ var (
 		ID:          1,
 		CreatedTime: 1,
 		State:       etcdpb.DatabaseState_DatabaseCreated,
+		Properties:  []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}},
 	}
 )
 
@@ -41,6 +45,11 @@ func TestUnmarshalDatabaseModel(t *testing.T) {
 func TestDatabaseCloneAndEqual(t *testing.T) {
 	clone := dbModel.Clone()
 	assert.Equal(t, dbModel, clone)
+	assert.True(t, dbModel.Equal(*clone))
+
+	clone.Properties[0].Value = "3"
+	assert.Equal(t, "2", dbModel.Properties[0].Value)
+	assert.False(t, dbModel.Equal(*clone))
 }
 
 func TestDatabaseAvailable(t *testing.T) {
@@ -0,0 +1,71 @@
+package model
+
+import "github.com/milvus-io/milvus/internal/proto/internalpb"
+
+// DDLJob is the progress of a DDL request executed by rootcoord.
+type DDLJob struct {
+	ID             int64
+	Type           string
+	DBName         string
+	CollectionName string
+	PartitionName  string
+	State          internalpb.DDLJobState
+	CurrentStep    string
+	FailReason     string
+	CreateTime     int64
+	StartTime      int64
+	EndTime        int64
+}
+
+func (j *DDLJob) Clone() *DDLJob {
+	clone := *j
+	return &clone
+}
+
+// Finished returns whether the job won't change any more.
+func (j *DDLJob) Finished() bool {
+	switch j.State {
+	case internalpb.DDLJobState_DDLJobCompleted, internalpb.DDLJobState_DDLJobFailed, internalpb.DDLJobState_DDLJobRolledBack:
+		return true
+	default:
+		return false
+	}
+}
+
+func MarshalDDLJobModel(job *DDLJob) *internalpb.DDLJobInfo {
+	if job == nil {
+		return nil
+	}
+	return &internalpb.DDLJobInfo{
+		JobID:          job.ID,
+		Type:           job.Type,
+		DbName:         job.DBName,
+		CollectionName: job.CollectionName,
+		PartitionName:  job.PartitionName,
+		State:          job.State,
+		CurrentStep:    job.CurrentStep,
+		FailReason:     job.FailReason,
+		CreateTime:     job.CreateTime,
+		StartTime:      job.StartTime,
+		EndTime:        job.EndTime,
+	}
+}
+
+func UnmarshalDDLJobModel(info *internalpb.DDLJobInfo) *DDLJob {
+	if info == nil {
+		return nil
+	}
+	return &DDLJob{
+		ID:             info.GetJobID(),
+		Type:           info.GetType(),
+		DBName:         info.GetDbName(),
+		CollectionName: info.GetCollectionName(),
+		PartitionName:  info.GetPartitionName(),
+		State:          info.GetState(),
+		CurrentStep:    info.GetCurrentStep(),
+		FailReason:     info.GetFailReason(),
+		CreateTime:     info.GetCreateTime(),
+		StartTime:      info.GetStartTime(),
+		EndTime:        info.GetEndTime(),
+	}
+}
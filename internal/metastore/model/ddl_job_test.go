@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+var (
+	ddlJobModel = &DDLJob{
+		ID:             100,
+		Type:           "DropPartition",
+		DBName:         "db",
+		CollectionName: "collection",
+		PartitionName:  "partition",
+		State:          internalpb.DDLJobState_DDLJobFailed,
+		CurrentStep:    "delete partition meta",
+		FailReason:     "mock",
+		CreateTime:     1600000000,
+		StartTime:      1600000001,
+		EndTime:        1600000002,
+	}
+
+	ddlJobPb = &internalpb.DDLJobInfo{
+		JobID:          100,
+		Type:           "DropPartition",
+		DbName:         "db",
+		CollectionName: "collection",
+		PartitionName:  "partition",
+		State:          internalpb.DDLJobState_DDLJobFailed,
+		CurrentStep:    "delete partition meta",
+		FailReason:     "mock",
+		CreateTime:     1600000000,
+		StartTime:      1600000001,
+		EndTime:        1600000002,
+	}
+)
+
+func TestMarshalDDLJobModel(t *testing.T) {
+	ret := MarshalDDLJobModel(ddlJobModel)
+	assert.Equal(t, ddlJobPb, ret)
+
+	assert.Nil(t, MarshalDDLJobModel(nil))
+}
+
+func TestUnmarshalDDLJobModel(t *testing.T) {
+	ret := UnmarshalDDLJobModel(ddlJobPb)
+	assert.Equal(t, ddlJobModel, ret)
+
+	assert.Nil(t, UnmarshalDDLJobModel(nil))
+}
+
+func TestDDLJob_Finished(t *testing.T) {
+	job := ddlJobModel.Clone()
+	assert.True(t, job.Finished())
+
+	job.State = internalpb.DDLJobState_DDLJobUndoing
+	assert.False(t, job.Finished())
+	assert.Equal(t, internalpb.DDLJobState_DDLJobFailed, ddlJobModel.State)
+}
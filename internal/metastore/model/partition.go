@@ -12,6 +12,7 @@ type Partition struct {
 	Extra                     map[string]string // deprecated.
 	CollectionID              int64
 	State                     pb.PartitionState
+	DropTime                  uint64
 }
 
 func (p *Partition) Available() bool {
@@ -26,6 +27,7 @@ func (p *Partition) Clone() *Partition {
 		Extra:                     common.CloneStr2Str(p.Extra),
 		CollectionID:              p.CollectionID,
 		State:                     p.State,
+		DropTime:                  p.DropTime,
 	}
 }
 
@@ -61,6 +63,7 @@ func MarshalPartitionModel(partition *Partition) *pb.PartitionInfo {
 		PartitionCreatedTimestamp: partition.PartitionCreatedTimestamp,
 		CollectionId:              partition.CollectionID,
 		State:                     partition.State,
+		DropTime:                  partition.DropTime,
 	}
 }
 
@@ -71,5 +74,6 @@ func UnmarshalPartitionModel(info *pb.PartitionInfo) *Partition {
 		PartitionCreatedTimestamp: info.GetPartitionCreatedTimestamp(),
 		CollectionID:              info.GetCollectionId(),
 		State:                     info.GetState(),
+		DropTime:                  info.GetDropTime(),
 	}
 }
@@ -0,0 +1,37 @@
+package model
+
+import "github.com/milvus-io/milvus/internal/proto/internalpb"
+
+type RowPolicy struct {
+	RoleName       string
+	DBName         string
+	CollectionName string
+	CollectionID   int64
+	Expr           string
+}
+
+func MarshalRowPolicyModel(policy *RowPolicy) *internalpb.RowPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &internalpb.RowPolicy{
+		RoleName:       policy.RoleName,
+		DbName:         policy.DBName,
+		CollectionName: policy.CollectionName,
+		CollectionID:   policy.CollectionID,
+		Expr:           policy.Expr,
+	}
+}
+
+func UnmarshalRowPolicyModel(info *internalpb.RowPolicy) *RowPolicy {
+	if info == nil {
+		return nil
+	}
+	return &RowPolicy{
+		RoleName:       info.GetRoleName(),
+		DBName:         info.GetDbName(),
+		CollectionName: info.GetCollectionName(),
+		CollectionID:   info.GetCollectionID(),
+		Expr:           info.GetExpr(),
+	}
+}
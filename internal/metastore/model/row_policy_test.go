@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+var (
+	rowPolicyModel = &RowPolicy{
+		RoleName:       "role",
+		DBName:         "db",
+		CollectionName: "collection",
+		CollectionID:   100,
+		Expr:           "tenant == 'a'",
+	}
+
+	rowPolicyPb = &internalpb.RowPolicy{
+		RoleName:       "role",
+		DbName:         "db",
+		CollectionName: "collection",
+		CollectionID:   100,
+		Expr:           "tenant == 'a'",
+	}
+)
+
+func TestMarshalRowPolicyModel(t *testing.T) {
+	ret := MarshalRowPolicyModel(rowPolicyModel)
+	assert.Equal(t, rowPolicyPb, ret)
+
+	assert.Nil(t, MarshalRowPolicyModel(nil))
+}
+
+func TestUnmarshalRowPolicyModel(t *testing.T) {
+	ret := UnmarshalRowPolicyModel(rowPolicyPb)
+	assert.Equal(t, rowPolicyModel, ret)
+
+	assert.Nil(t, UnmarshalRowPolicyModel(nil))
+}
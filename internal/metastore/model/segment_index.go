@@ -24,6 +24,10 @@ type SegmentIndex struct {
 	// deprecated
 	WriteHandoff        bool
 	CurrentIndexVersion int32
+	// the index files of the cloned segment are shared from the source segment index
+	SourceBuildID     int64
+	SourcePartitionID int64
+	SourceSegmentID   int64
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -48,6 +52,9 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.SerializeSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.GetCurrentIndexVersion(),
+		SourceBuildID:       segIndex.GetSourceBuildID(),
+		SourcePartitionID:   segIndex.GetSourcePartitionID(),
+		SourceSegmentID:     segIndex.GetSourceSegmentID(),
 	}
 }
 
@@ -73,6 +80,9 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		SerializeSize:       segIdx.IndexSize,
 		WriteHandoff:        segIdx.WriteHandoff,
 		CurrentIndexVersion: segIdx.CurrentIndexVersion,
+		SourceBuildID:       segIdx.SourceBuildID,
+		SourcePartitionID:   segIdx.SourcePartitionID,
+		SourceSegmentID:     segIdx.SourceSegmentID,
 	}
 }
 
@@ -94,5 +104,17 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.IndexSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.CurrentIndexVersion,
+		SourceBuildID:       segIndex.SourceBuildID,
+		SourcePartitionID:   segIndex.SourcePartitionID,
+		SourceSegmentID:     segIndex.SourceSegmentID,
 	}
 }
+
+// FilesBuildID returns the build id, partition id and segment id which the paths of the index files are built with,
+// they belong to the source segment index if the segment is cloned.
+func (s *SegmentIndex) FilesBuildID() (buildID, partitionID, segmentID int64) {
+	if s.SourceBuildID != 0 {
+		return s.SourceBuildID, s.SourcePartitionID, s.SourceSegmentID
+	}
+	return s.BuildID, s.PartitionID, s.SegmentID
+}
@@ -53,3 +53,18 @@ func TestUnmarshalSegmentIndexModel(t *testing.T) {
 	assert.Equal(t, indexModel2.SegmentID, ret.SegmentID)
 	assert.Nil(t, UnmarshalSegmentIndexModel(nil))
 }
+
+func TestSegmentIndex_FilesBuildID(t *testing.T) {
+	buildID, partitionID, segmentID := indexModel2.FilesBuildID()
+	assert.Equal(t, indexModel2.BuildID, buildID)
+	assert.Equal(t, indexModel2.PartitionID, partitionID)
+	assert.Equal(t, indexModel2.SegmentID, segmentID)
+
+	cloned := CloneSegmentIndex(indexModel2)
+	cloned.BuildID, cloned.PartitionID, cloned.SegmentID = 100, 101, 102
+	cloned.SourceBuildID, cloned.SourcePartitionID, cloned.SourceSegmentID = buildID, partitionID, segmentID
+	buildID, partitionID, segmentID = cloned.FilesBuildID()
+	assert.Equal(t, indexModel2.BuildID, buildID)
+	assert.Equal(t, indexModel2.PartitionID, partitionID)
+	assert.Equal(t, indexModel2.SegmentID, segmentID)
+}
@@ -34,6 +34,61 @@ func (_m *MockProxy) EXPECT() *MockProxy_Expecter {
 	return &MockProxy_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionField(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxy_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AddCollectionFieldRequest
+func (_e *MockProxy_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionField_Call {
+	return &MockProxy_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -199,6 +254,116 @@ func (_c *MockProxy_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterDatabase(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxy_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterDatabaseRequest
+func (_e *MockProxy_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_AlterDatabase_Call {
+	return &MockProxy_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchAlterAliases provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) BatchAlterAliases(_a0 context.Context, _a1 *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.BatchAlterAliasesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_BatchAlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchAlterAliases'
+type MockProxy_BatchAlterAliases_Call struct {
+	*mock.Call
+}
+
+// BatchAlterAliases is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.BatchAlterAliasesRequest
+func (_e *MockProxy_Expecter) BatchAlterAliases(_a0 interface{}, _a1 interface{}) *MockProxy_BatchAlterAliases_Call {
+	return &MockProxy_BatchAlterAliases_Call{Call: _e.mock.On("BatchAlterAliases", _a0, _a1)}
+}
+
+func (_c *MockProxy_BatchAlterAliases_Call) Run(run func(_a0 context.Context, _a1 *internalpb.BatchAlterAliasesRequest)) *MockProxy_BatchAlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.BatchAlterAliasesRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_BatchAlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_BatchAlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_BatchAlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error)) *MockProxy_BatchAlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -309,6 +474,61 @@ func (_c *MockProxy_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CloneCollection(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockProxy_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CloneCollectionRequest
+func (_e *MockProxy_Expecter) CloneCollection(_a0 interface{}, _a1 interface{}) *MockProxy_CloneCollection_Call {
+	return &MockProxy_CloneCollection_Call{Call: _e.mock.On("CloneCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_CloneCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest)) *MockProxy_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)) *MockProxy_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Connect provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Connect(_a0 context.Context, _a1 *milvuspb.ConnectRequest) (*milvuspb.ConnectResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -364,6 +584,61 @@ func (_c *MockProxy_Connect_Call) RunAndReturn(run func(context.Context, *milvus
 	return _c
 }
 
+// CreateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateAPIKey(_a0 context.Context, _a1 *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.CreateAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateAPIKeyRequest) *internalpb.CreateAPIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.CreateAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockProxy_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateAPIKeyRequest
+func (_e *MockProxy_Expecter) CreateAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_CreateAPIKey_Call {
+	return &MockProxy_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateAPIKeyRequest)) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) Return(_a0 *internalpb.CreateAPIKeyResponse, _a1 error) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error)) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateAlias(_a0 context.Context, _a1 *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -804,6 +1079,61 @@ func (_c *MockProxy_CreateRole_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateSnapshot(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockProxy_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateSnapshotRequest
+func (_e *MockProxy_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_CreateSnapshot_Call {
+	return &MockProxy_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest)) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)) *MockProxy_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Delete(_a0 context.Context, _a1 *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1024,6 +1354,61 @@ func (_c *MockProxy_DescribeCollection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DescribeDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeDatabase(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) *internalpb.DescribeDatabaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type MockProxy_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DescribeDatabaseRequest
+func (_e *MockProxy_Expecter) DescribeDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeDatabase_Call {
+	return &MockProxy_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeIndex(_a0 context.Context, _a1 *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1574,6 +1959,61 @@ func (_c *MockProxy_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropSnapshot(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockProxy_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropSnapshotRequest
+func (_e *MockProxy_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_DropSnapshot_Call {
+	return &MockProxy_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest)) *MockProxy_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)) *MockProxy_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Dummy provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Dummy(_a0 context.Context, _a1 *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1928,41 +2368,96 @@ func (_e *MockProxy_Expecter) GetCompactionStateWithPlans(_a0 interface{}, _a1 i
 	return &MockProxy_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", _a0, _a1)}
 }
 
-func (_c *MockProxy_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockProxy_GetCompactionStateWithPlans_Call {
+func (_c *MockProxy_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockProxy_GetCompactionStateWithPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockProxy_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockProxy_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockProxy_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetComponentStatesRequest
+func (_e *MockProxy_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockProxy_GetComponentStates_Call {
+	return &MockProxy_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockProxy_GetComponentStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockProxy_GetCompactionStateWithPlans_Call {
+func (_c *MockProxy_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockProxy_GetComponentStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockProxy_GetCompactionStateWithPlans_Call {
+func (_c *MockProxy_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockProxy_GetComponentStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+// GetDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetDDLJob(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *internalpb.GetDDLJobResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) *internalpb.GetDDLJobResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1971,31 +2466,31 @@ func (_m *MockProxy) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetCo
 	return r0, r1
 }
 
-// MockProxy_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockProxy_GetComponentStates_Call struct {
+// MockProxy_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type MockProxy_GetDDLJob_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// GetDDLJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetComponentStatesRequest
-func (_e *MockProxy_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockProxy_GetComponentStates_Call {
-	return &MockProxy_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+//   - _a1 *internalpb.GetDDLJobRequest
+func (_e *MockProxy_Expecter) GetDDLJob(_a0 interface{}, _a1 interface{}) *MockProxy_GetDDLJob_Call {
+	return &MockProxy_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob", _a0, _a1)}
 }
 
-func (_c *MockProxy_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockProxy_GetComponentStates_Call {
+func (_c *MockProxy_GetDDLJob_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest)) *MockProxy_GetDDLJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockProxy_GetComponentStates_Call {
+func (_c *MockProxy_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *MockProxy_GetDDLJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockProxy_GetComponentStates_Call {
+func (_c *MockProxy_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)) *MockProxy_GetDDLJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2988,6 +3483,61 @@ func (_c *MockProxy_GetVersion_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// GrantRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GrantRowPolicy(_a0 context.Context, _a1 *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GrantRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GrantRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantRowPolicy'
+type MockProxy_GrantRowPolicy_Call struct {
+	*mock.Call
+}
+
+// GrantRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GrantRowPolicyRequest
+func (_e *MockProxy_Expecter) GrantRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_GrantRowPolicy_Call {
+	return &MockProxy_GrantRowPolicy_Call{Call: _e.mock.On("GrantRowPolicy", _a0, _a1)}
+}
+
+func (_c *MockProxy_GrantRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GrantRowPolicyRequest)) *MockProxy_GrantRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GrantRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GrantRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_GrantRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GrantRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_GrantRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasCollection provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) HasCollection(_a0 context.Context, _a1 *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3359,6 +3909,61 @@ func (_c *MockProxy_InvalidateCredentialCache_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListAPIKeys(_a0 context.Context, _a1 *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest) *internalpb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockProxy_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListAPIKeysRequest
+func (_e *MockProxy_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *MockProxy_ListAPIKeys_Call {
+	return &MockProxy_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListAPIKeysRequest)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Return(_a0 *internalpb.ListAPIKeysResponse, _a1 error) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListAliases(_a0 context.Context, _a1 *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3524,6 +4129,61 @@ func (_c *MockProxy_ListCredUsers_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListDDLJobs(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type MockProxy_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDDLJobsRequest
+func (_e *MockProxy_Expecter) ListDDLJobs(_a0 interface{}, _a1 interface{}) *MockProxy_ListDDLJobs_Call {
+	return &MockProxy_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest)) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)) *MockProxy_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListDatabases(_a0 context.Context, _a1 *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3579,6 +4239,61 @@ func (_c *MockProxy_ListDatabases_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDroppedCollections provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListDroppedCollections(_a0 context.Context, _a1 *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDroppedCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) *internalpb.ListDroppedCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDroppedCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListDroppedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDroppedCollections'
+type MockProxy_ListDroppedCollections_Call struct {
+	*mock.Call
+}
+
+// ListDroppedCollections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDroppedCollectionsRequest
+func (_e *MockProxy_Expecter) ListDroppedCollections(_a0 interface{}, _a1 interface{}) *MockProxy_ListDroppedCollections_Call {
+	return &MockProxy_ListDroppedCollections_Call{Call: _e.mock.On("ListDroppedCollections", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListDroppedCollections_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDroppedCollectionsRequest)) *MockProxy_ListDroppedCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDroppedCollectionsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListDroppedCollections_Call) Return(_a0 *internalpb.ListDroppedCollectionsResponse, _a1 error) *MockProxy_ListDroppedCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListDroppedCollections_Call) RunAndReturn(run func(context.Context, *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error)) *MockProxy_ListDroppedCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListImportTasks(_a0 context.Context, _a1 *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3744,6 +4459,116 @@ func (_c *MockProxy_ListResourceGroups_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListRowPolicies(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockProxy_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListRowPoliciesRequest
+func (_e *MockProxy_Expecter) ListRowPolicies(_a0 interface{}, _a1 interface{}) *MockProxy_ListRowPolicies_Call {
+	return &MockProxy_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)) *MockProxy_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListSnapshots(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListSnapshotsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) *internalpb.ListSnapshotsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListSnapshotsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListSnapshotsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockProxy_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListSnapshotsRequest
+func (_e *MockProxy_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MockProxy_ListSnapshots_Call {
+	return &MockProxy_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest)) *MockProxy_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListSnapshotsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListSnapshots_Call) Return(_a0 *internalpb.ListSnapshotsResponse, _a1 error) *MockProxy_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListSnapshots_Call) RunAndReturn(run func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)) *MockProxy_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBalance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) LoadBalance(_a0 context.Context, _a1 *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4129,6 +4954,95 @@ func (_c *MockProxy_Query_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// QueryPages provides a mock function with given fields: ctx, request, cursor, pageSize, send
+func (_m *MockProxy) QueryPages(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+	ret := _m.Called(ctx, request, cursor, pageSize, send)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest, string, int64, func(*milvuspb.QueryResults) error) error); ok {
+		r0 = rf(ctx, request, cursor, pageSize, send)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_QueryPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryPages'
+type MockProxy_QueryPages_Call struct {
+	*mock.Call
+}
+
+// QueryPages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *milvuspb.QueryRequest
+//   - cursor string
+//   - pageSize int64
+//   - send func(*milvuspb.QueryResults) error
+func (_e *MockProxy_Expecter) QueryPages(ctx interface{}, request interface{}, cursor interface{}, pageSize interface{}, send interface{}) *MockProxy_QueryPages_Call {
+	return &MockProxy_QueryPages_Call{Call: _e.mock.On("QueryPages", ctx, request, cursor, pageSize, send)}
+}
+
+func (_c *MockProxy_QueryPages_Call) Run(run func(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error)) *MockProxy_QueryPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.QueryRequest), args[2].(string), args[3].(int64), args[4].(func(*milvuspb.QueryResults) error))
+	})
+	return _c
+}
+
+func (_c *MockProxy_QueryPages_Call) Return(_a0 error) *MockProxy_QueryPages_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_QueryPages_Call) RunAndReturn(run func(context.Context, *milvuspb.QueryRequest, string, int64, func(*milvuspb.QueryResults) error) error) *MockProxy_QueryPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryStream provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) QueryStream(_a0 *milvuspb.QueryRequest, _a1 proxypb.ProxyStream_QueryStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*milvuspb.QueryRequest, proxypb.ProxyStream_QueryStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_QueryStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryStream'
+type MockProxy_QueryStream_Call struct {
+	*mock.Call
+}
+
+// QueryStream is a helper method to define mock.On call
+//   - _a0 *milvuspb.QueryRequest
+//   - _a1 proxypb.ProxyStream_QueryStreamServer
+func (_e *MockProxy_Expecter) QueryStream(_a0 interface{}, _a1 interface{}) *MockProxy_QueryStream_Call {
+	return &MockProxy_QueryStream_Call{Call: _e.mock.On("QueryStream", _a0, _a1)}
+}
+
+func (_c *MockProxy_QueryStream_Call) Run(run func(_a0 *milvuspb.QueryRequest, _a1 proxypb.ProxyStream_QueryStreamServer)) *MockProxy_QueryStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*milvuspb.QueryRequest), args[1].(proxypb.ProxyStream_QueryStreamServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) Return(_a0 error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) RunAndReturn(run func(*milvuspb.QueryRequest, proxypb.ProxyStream_QueryStreamServer) error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) RefreshPolicyInfoCache(_a0 context.Context, _a1 *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4500,6 +5414,171 @@ func (_c *MockProxy_ReplicateMessage_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RestoreSnapshot(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockProxy_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RestoreSnapshotRequest
+func (_e *MockProxy_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_RestoreSnapshot_Call {
+	return &MockProxy_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest)) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)) *MockProxy_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RevokeAPIKey(_a0 context.Context, _a1 *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockProxy_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RevokeAPIKeyRequest
+func (_e *MockProxy_Expecter) RevokeAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_RevokeAPIKey_Call {
+	return &MockProxy_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RevokeAPIKeyRequest)) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error)) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RevokeRowPolicy(_a0 context.Context, _a1 *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RevokeRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRowPolicy'
+type MockProxy_RevokeRowPolicy_Call struct {
+	*mock.Call
+}
+
+// RevokeRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RevokeRowPolicyRequest
+func (_e *MockProxy_Expecter) RevokeRowPolicy(_a0 interface{}, _a1 interface{}) *MockProxy_RevokeRowPolicy_Call {
+	return &MockProxy_RevokeRowPolicy_Call{Call: _e.mock.On("RevokeRowPolicy", _a0, _a1)}
+}
+
+func (_c *MockProxy_RevokeRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RevokeRowPolicyRequest)) *MockProxy_RevokeRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RevokeRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RevokeRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RevokeRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error)) *MockProxy_RevokeRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Search(_a0 context.Context, _a1 *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5275,6 +6354,116 @@ func (_c *MockProxy_TransferReplica_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) UndropCollection(_a0 context.Context, _a1 *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type MockProxy_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.UndropCollectionRequest
+func (_e *MockProxy_Expecter) UndropCollection(_a0 interface{}, _a1 interface{}) *MockProxy_UndropCollection_Call {
+	return &MockProxy_UndropCollection_Call{Call: _e.mock.On("UndropCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_UndropCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.UndropCollectionRequest)) *MockProxy_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.UndropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_UndropCollection_Call) RunAndReturn(run func(context.Context, *internalpb.UndropCollectionRequest) (*commonpb.Status, error)) *MockProxy_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndropPartition provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) UndropPartition(_a0 context.Context, _a1 *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropPartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_UndropPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropPartition'
+type MockProxy_UndropPartition_Call struct {
+	*mock.Call
+}
+
+// UndropPartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.UndropPartitionRequest
+func (_e *MockProxy_Expecter) UndropPartition(_a0 interface{}, _a1 interface{}) *MockProxy_UndropPartition_Call {
+	return &MockProxy_UndropPartition_Call{Call: _e.mock.On("UndropPartition", _a0, _a1)}
+}
+
+func (_c *MockProxy_UndropPartition_Call) Run(run func(_a0 context.Context, _a1 *internalpb.UndropPartitionRequest)) *MockProxy_UndropPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.UndropPartitionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_UndropPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_UndropPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_UndropPartition_Call) RunAndReturn(run func(context.Context, *internalpb.UndropPartitionRequest) (*commonpb.Status, error)) *MockProxy_UndropPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) UpdateCredential(_a0 context.Context, _a1 *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -36,6 +36,61 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AddCollectionField(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -256,6 +311,116 @@ func (_c *RootCoord_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterDatabase(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type RootCoord_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterDatabaseRequest
+func (_e *RootCoord_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *RootCoord_AlterDatabase_Call {
+	return &RootCoord_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchAlterAliases provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) BatchAlterAliases(_a0 context.Context, _a1 *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.BatchAlterAliasesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_BatchAlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchAlterAliases'
+type RootCoord_BatchAlterAliases_Call struct {
+	*mock.Call
+}
+
+// BatchAlterAliases is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.BatchAlterAliasesRequest
+func (_e *RootCoord_Expecter) BatchAlterAliases(_a0 interface{}, _a1 interface{}) *RootCoord_BatchAlterAliases_Call {
+	return &RootCoord_BatchAlterAliases_Call{Call: _e.mock.On("BatchAlterAliases", _a0, _a1)}
+}
+
+func (_c *RootCoord_BatchAlterAliases_Call) Run(run func(_a0 context.Context, _a1 *internalpb.BatchAlterAliasesRequest)) *RootCoord_BatchAlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.BatchAlterAliasesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_BatchAlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_BatchAlterAliases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_BatchAlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error)) *RootCoord_BatchAlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -311,6 +476,116 @@ func (_c *RootCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CloneCollection provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CloneCollection(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type RootCoord_CloneCollection_Call struct {
+	*mock.Call
+}
+
+// CloneCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CloneCollectionRequest
+func (_e *RootCoord_Expecter) CloneCollection(_a0 interface{}, _a1 interface{}) *RootCoord_CloneCollection_Call {
+	return &RootCoord_CloneCollection_Call{Call: _e.mock.On("CloneCollection", _a0, _a1)}
+}
+
+func (_c *RootCoord_CloneCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CloneCollectionRequest)) *RootCoord_CloneCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest) (*commonpb.Status, error)) *RootCoord_CloneCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CreateAPIKey(_a0 context.Context, _a1 *internalpb.APIKeyInfo) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.APIKeyInfo) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.APIKeyInfo) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.APIKeyInfo) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type RootCoord_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.APIKeyInfo
+func (_e *RootCoord_Expecter) CreateAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_CreateAPIKey_Call {
+	return &RootCoord_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *internalpb.APIKeyInfo)) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.APIKeyInfo))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.APIKeyInfo) (*commonpb.Status, error)) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CreateAlias(_a0 context.Context, _a1 *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -641,6 +916,61 @@ func (_c *RootCoord_CreateRole_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CreateSnapshot(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type RootCoord_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.CreateSnapshotRequest
+func (_e *RootCoord_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *RootCoord_CreateSnapshot_Call {
+	return &RootCoord_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
+}
+
+func (_c *RootCoord_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.CreateSnapshotRequest)) *RootCoord_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.CreateSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_CreateSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.CreateSnapshotRequest) (*commonpb.Status, error)) *RootCoord_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DeleteCredential(_a0 context.Context, _a1 *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -806,24 +1136,24 @@ func (_c *RootCoord_DescribeCollectionInternal_Call) RunAndReturn(run func(conte
 	return _c
 }
 
-// DropAlias provides a mock function with given fields: _a0, _a1
-func (_m *RootCoord) DropAlias(_a0 context.Context, _a1 *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+// DescribeDatabase provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DescribeDatabase(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *internalpb.DescribeDatabaseResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) *internalpb.DescribeDatabaseResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropAliasRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -832,26 +1162,81 @@ func (_m *RootCoord) DropAlias(_a0 context.Context, _a1 *milvuspb.DropAliasReque
 	return r0, r1
 }
 
-// RootCoord_DropAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAlias'
-type RootCoord_DropAlias_Call struct {
+// RootCoord_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type RootCoord_DescribeDatabase_Call struct {
 	*mock.Call
 }
 
-// DropAlias is a helper method to define mock.On call
+// DescribeDatabase is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.DropAliasRequest
-func (_e *RootCoord_Expecter) DropAlias(_a0 interface{}, _a1 interface{}) *RootCoord_DropAlias_Call {
-	return &RootCoord_DropAlias_Call{Call: _e.mock.On("DropAlias", _a0, _a1)}
+//   - _a1 *internalpb.DescribeDatabaseRequest
+func (_e *RootCoord_Expecter) DescribeDatabase(_a0 interface{}, _a1 interface{}) *RootCoord_DescribeDatabase_Call {
+	return &RootCoord_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase", _a0, _a1)}
 }
 
-func (_c *RootCoord_DropAlias_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropAliasRequest)) *RootCoord_DropAlias_Call {
+func (_c *RootCoord_DescribeDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest)) *RootCoord_DescribeDatabase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.DropAliasRequest))
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_DropAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropAlias_Call {
+func (_c *RootCoord_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *RootCoord_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)) *RootCoord_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropAlias provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropAlias(_a0 context.Context, _a1 *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropAliasRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAlias'
+type RootCoord_DropAlias_Call struct {
+	*mock.Call
+}
+
+// DropAlias is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropAliasRequest
+func (_e *RootCoord_Expecter) DropAlias(_a0 interface{}, _a1 interface{}) *RootCoord_DropAlias_Call {
+	return &RootCoord_DropAlias_Call{Call: _e.mock.On("DropAlias", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropAlias_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropAliasRequest)) *RootCoord_DropAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropAliasRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropAlias_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
@@ -1081,6 +1466,61 @@ func (_c *RootCoord_DropRole_Call) RunAndReturn(run func(context.Context, *milvu
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropSnapshot(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type RootCoord_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DropSnapshotRequest
+func (_e *RootCoord_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *RootCoord_DropSnapshot_Call {
+	return &RootCoord_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DropSnapshotRequest)) *RootCoord_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DropSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.DropSnapshotRequest) (*commonpb.Status, error)) *RootCoord_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1191,6 +1631,61 @@ func (_c *RootCoord_GetCredential_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// GetDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GetDDLJob(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type RootCoord_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetDDLJobRequest
+func (_e *RootCoord_Expecter) GetDDLJob(_a0 interface{}, _a1 interface{}) *RootCoord_GetDDLJob_Call {
+	return &RootCoord_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob", _a0, _a1)}
+}
+
+func (_c *RootCoord_GetDDLJob_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetDDLJobRequest)) *RootCoord_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *RootCoord_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error)) *RootCoord_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetImportState provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) GetImportState(_a0 context.Context, _a1 *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1411,6 +1906,61 @@ func (_c *RootCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GrantRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) GrantRowPolicy(_a0 context.Context, _a1 *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GrantRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_GrantRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantRowPolicy'
+type RootCoord_GrantRowPolicy_Call struct {
+	*mock.Call
+}
+
+// GrantRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GrantRowPolicyRequest
+func (_e *RootCoord_Expecter) GrantRowPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_GrantRowPolicy_Call {
+	return &RootCoord_GrantRowPolicy_Call{Call: _e.mock.On("GrantRowPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_GrantRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GrantRowPolicyRequest)) *RootCoord_GrantRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GrantRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_GrantRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_GrantRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_GrantRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error)) *RootCoord_GrantRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HasCollection provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) HasCollection(_a0 context.Context, _a1 *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1672,6 +2222,61 @@ func (_c *RootCoord_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListAPIKeys(_a0 context.Context, _a1 *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest) *internalpb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoord_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListAPIKeysRequest
+func (_e *RootCoord_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *RootCoord_ListAPIKeys_Call {
+	return &RootCoord_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListAPIKeysRequest)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Return(_a0 *internalpb.ListAPIKeysResponse, _a1 error) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCredUsers provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListCredUsers(_a0 context.Context, _a1 *milvuspb.ListCredUsersRequest) (*milvuspb.ListCredUsersResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1727,6 +2332,61 @@ func (_c *RootCoord_ListCredUsers_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListDDLJobs(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDDLJobsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest) *internalpb.ListDDLJobsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type RootCoord_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDDLJobsRequest
+func (_e *RootCoord_Expecter) ListDDLJobs(_a0 interface{}, _a1 interface{}) *RootCoord_ListDDLJobs_Call {
+	return &RootCoord_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDDLJobsRequest)) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error)) *RootCoord_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListDatabases(_a0 context.Context, _a1 *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1782,6 +2442,61 @@ func (_c *RootCoord_ListDatabases_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// ListDroppedCollections provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListDroppedCollections(_a0 context.Context, _a1 *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListDroppedCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) *internalpb.ListDroppedCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListDroppedCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDroppedCollectionsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListDroppedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDroppedCollections'
+type RootCoord_ListDroppedCollections_Call struct {
+	*mock.Call
+}
+
+// ListDroppedCollections is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListDroppedCollectionsRequest
+func (_e *RootCoord_Expecter) ListDroppedCollections(_a0 interface{}, _a1 interface{}) *RootCoord_ListDroppedCollections_Call {
+	return &RootCoord_ListDroppedCollections_Call{Call: _e.mock.On("ListDroppedCollections", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListDroppedCollections_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListDroppedCollectionsRequest)) *RootCoord_ListDroppedCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListDroppedCollectionsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListDroppedCollections_Call) Return(_a0 *internalpb.ListDroppedCollectionsResponse, _a1 error) *RootCoord_ListDroppedCollections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListDroppedCollections_Call) RunAndReturn(run func(context.Context, *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error)) *RootCoord_ListDroppedCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportTasks provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListImportTasks(_a0 context.Context, _a1 *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1832,29 +2547,139 @@ func (_c *RootCoord_ListImportTasks_Call) Return(_a0 *milvuspb.ListImportTasksRe
 	return _c
 }
 
-func (_c *RootCoord_ListImportTasks_Call) RunAndReturn(run func(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)) *RootCoord_ListImportTasks_Call {
+func (_c *RootCoord_ListImportTasks_Call) RunAndReturn(run func(context.Context, *milvuspb.ListImportTasksRequest) (*milvuspb.ListImportTasksResponse, error)) *RootCoord_ListImportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListPolicy(_a0 context.Context, _a1 *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListPolicyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest) *internalpb.ListPolicyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListPolicyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicy'
+type RootCoord_ListPolicy_Call struct {
+	*mock.Call
+}
+
+// ListPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListPolicyRequest
+func (_e *RootCoord_Expecter) ListPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_ListPolicy_Call {
+	return &RootCoord_ListPolicy_Call{Call: _e.mock.On("ListPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListPolicyRequest)) *RootCoord_ListPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListPolicy_Call) Return(_a0 *internalpb.ListPolicyResponse, _a1 error) *RootCoord_ListPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)) *RootCoord_ListPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRowPolicies provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListRowPolicies(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ListRowPoliciesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest) *internalpb.ListRowPoliciesResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type RootCoord_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ListRowPoliciesRequest
+func (_e *RootCoord_Expecter) ListRowPolicies(_a0 interface{}, _a1 interface{}) *RootCoord_ListRowPolicies_Call {
+	return &RootCoord_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListRowPoliciesRequest)) *RootCoord_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *RootCoord_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error)) *RootCoord_ListRowPolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPolicy provides a mock function with given fields: _a0, _a1
-func (_m *RootCoord) ListPolicy(_a0 context.Context, _a1 *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListSnapshots(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *internalpb.ListPolicyResponse
+	var r0 *internalpb.ListSnapshotsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest) *internalpb.ListPolicyResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest) *internalpb.ListSnapshotsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ListPolicyResponse)
+			r0 = ret.Get(0).(*internalpb.ListSnapshotsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPolicyRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListSnapshotsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1863,31 +2688,31 @@ func (_m *RootCoord) ListPolicy(_a0 context.Context, _a1 *internalpb.ListPolicyR
 	return r0, r1
 }
 
-// RootCoord_ListPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicy'
-type RootCoord_ListPolicy_Call struct {
+// RootCoord_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type RootCoord_ListSnapshots_Call struct {
 	*mock.Call
 }
 
-// ListPolicy is a helper method to define mock.On call
+// ListSnapshots is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.ListPolicyRequest
-func (_e *RootCoord_Expecter) ListPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_ListPolicy_Call {
-	return &RootCoord_ListPolicy_Call{Call: _e.mock.On("ListPolicy", _a0, _a1)}
+//   - _a1 *internalpb.ListSnapshotsRequest
+func (_e *RootCoord_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *RootCoord_ListSnapshots_Call {
+	return &RootCoord_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
 }
 
-func (_c *RootCoord_ListPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListPolicyRequest)) *RootCoord_ListPolicy_Call {
+func (_c *RootCoord_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListSnapshotsRequest)) *RootCoord_ListSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.ListPolicyRequest))
+		run(args[0].(context.Context), args[1].(*internalpb.ListSnapshotsRequest))
 	})
 	return _c
 }
 
-func (_c *RootCoord_ListPolicy_Call) Return(_a0 *internalpb.ListPolicyResponse, _a1 error) *RootCoord_ListPolicy_Call {
+func (_c *RootCoord_ListSnapshots_Call) Return(_a0 *internalpb.ListSnapshotsResponse, _a1 error) *RootCoord_ListSnapshots_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *RootCoord_ListPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)) *RootCoord_ListPolicy_Call {
+func (_c *RootCoord_ListSnapshots_Call) RunAndReturn(run func(context.Context, *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error)) *RootCoord_ListSnapshots_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2153,6 +2978,226 @@ func (_c *RootCoord_ReportImport_Call) RunAndReturn(run func(context.Context, *r
 	return _c
 }
 
+// ReportLoginFailure provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ReportLoginFailure(_a0 context.Context, _a1 *internalpb.ReportLoginFailureRequest) (*internalpb.ReportLoginFailureResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ReportLoginFailureResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ReportLoginFailureRequest) (*internalpb.ReportLoginFailureResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ReportLoginFailureRequest) *internalpb.ReportLoginFailureResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ReportLoginFailureResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ReportLoginFailureRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ReportLoginFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportLoginFailure'
+type RootCoord_ReportLoginFailure_Call struct {
+	*mock.Call
+}
+
+// ReportLoginFailure is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ReportLoginFailureRequest
+func (_e *RootCoord_Expecter) ReportLoginFailure(_a0 interface{}, _a1 interface{}) *RootCoord_ReportLoginFailure_Call {
+	return &RootCoord_ReportLoginFailure_Call{Call: _e.mock.On("ReportLoginFailure", _a0, _a1)}
+}
+
+func (_c *RootCoord_ReportLoginFailure_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ReportLoginFailureRequest)) *RootCoord_ReportLoginFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ReportLoginFailureRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ReportLoginFailure_Call) Return(_a0 *internalpb.ReportLoginFailureResponse, _a1 error) *RootCoord_ReportLoginFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ReportLoginFailure_Call) RunAndReturn(run func(context.Context, *internalpb.ReportLoginFailureRequest) (*internalpb.ReportLoginFailureResponse, error)) *RootCoord_ReportLoginFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RestoreSnapshot(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type RootCoord_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RestoreSnapshotRequest
+func (_e *RootCoord_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *RootCoord_RestoreSnapshot_Call {
+	return &RootCoord_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
+}
+
+func (_c *RootCoord_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RestoreSnapshotRequest)) *RootCoord_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RestoreSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error)) *RootCoord_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RevokeAPIKey(_a0 context.Context, _a1 *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type RootCoord_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RevokeAPIKeyRequest
+func (_e *RootCoord_Expecter) RevokeAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_RevokeAPIKey_Call {
+	return &RootCoord_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RevokeAPIKeyRequest)) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error)) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeRowPolicy provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RevokeRowPolicy(_a0 context.Context, _a1 *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeRowPolicyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RevokeRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRowPolicy'
+type RootCoord_RevokeRowPolicy_Call struct {
+	*mock.Call
+}
+
+// RevokeRowPolicy is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.RevokeRowPolicyRequest
+func (_e *RootCoord_Expecter) RevokeRowPolicy(_a0 interface{}, _a1 interface{}) *RootCoord_RevokeRowPolicy_Call {
+	return &RootCoord_RevokeRowPolicy_Call{Call: _e.mock.On("RevokeRowPolicy", _a0, _a1)}
+}
+
+func (_c *RootCoord_RevokeRowPolicy_Call) Run(run func(_a0 context.Context, _a1 *internalpb.RevokeRowPolicyRequest)) *RootCoord_RevokeRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeRowPolicyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RevokeRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RevokeRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RevokeRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error)) *RootCoord_RevokeRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2891,6 +3936,116 @@ func (_c *RootCoord_Stop_Call) RunAndReturn(run func() error) *RootCoord_Stop_Ca
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) UndropCollection(_a0 context.Context, _a1 *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type RootCoord_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.UndropCollectionRequest
+func (_e *RootCoord_Expecter) UndropCollection(_a0 interface{}, _a1 interface{}) *RootCoord_UndropCollection_Call {
+	return &RootCoord_UndropCollection_Call{Call: _e.mock.On("UndropCollection", _a0, _a1)}
+}
+
+func (_c *RootCoord_UndropCollection_Call) Run(run func(_a0 context.Context, _a1 *internalpb.UndropCollectionRequest)) *RootCoord_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.UndropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_UndropCollection_Call) RunAndReturn(run func(context.Context, *internalpb.UndropCollectionRequest) (*commonpb.Status, error)) *RootCoord_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndropPartition provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) UndropPartition(_a0 context.Context, _a1 *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropPartitionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_UndropPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropPartition'
+type RootCoord_UndropPartition_Call struct {
+	*mock.Call
+}
+
+// UndropPartition is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.UndropPartitionRequest
+func (_e *RootCoord_Expecter) UndropPartition(_a0 interface{}, _a1 interface{}) *RootCoord_UndropPartition_Call {
+	return &RootCoord_UndropPartition_Call{Call: _e.mock.On("UndropPartition", _a0, _a1)}
+}
+
+func (_c *RootCoord_UndropPartition_Call) Run(run func(_a0 context.Context, _a1 *internalpb.UndropPartitionRequest)) *RootCoord_UndropPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.UndropPartitionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_UndropPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_UndropPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_UndropPartition_Call) RunAndReturn(run func(context.Context, *internalpb.UndropPartitionRequest) (*commonpb.Status, error)) *RootCoord_UndropPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) UpdateChannelTimeTick(_a0 context.Context, _a1 *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -33,6 +33,76 @@ func (_m *MockRootCoordClient) EXPECT() *MockRootCoordClient_Expecter {
 	return &MockRootCoordClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockRootCoordClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AddCollectionField_Call {
+	return &MockRootCoordClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *internalpb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocIDResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -313,8 +383,8 @@ func (_c *MockRootCoordClient_AlterCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
-// CheckHealth provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
+// AlterDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -324,20 +394,20 @@ func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Che
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.CheckHealthResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) *milvuspb.CheckHealthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -346,21 +416,21 @@ func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.Che
 	return r0, r1
 }
 
-// MockRootCoordClient_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
-type MockRootCoordClient_CheckHealth_Call struct {
+// MockRootCoordClient_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockRootCoordClient_AlterDatabase_Call struct {
 	*mock.Call
 }
 
-// CheckHealth is a helper method to define mock.On call
+// AlterDatabase is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CheckHealthRequest
+//   - in *internalpb.AlterDatabaseRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CheckHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CheckHealth_Call {
-	return &MockRootCoordClient_CheckHealth_Call{Call: _e.mock.On("CheckHealth",
+func (_e *MockRootCoordClient_Expecter) AlterDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AlterDatabase_Call {
+	return &MockRootCoordClient_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CheckHealth_Call) Run(run func(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CheckHealth_Call {
+func (_c *MockRootCoordClient_AlterDatabase_Call) Run(run func(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AlterDatabase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -368,64 +438,23 @@ func (_c *MockRootCoordClient_CheckHealth_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockRootCoordClient_CheckHealth_Call {
+func (_c *MockRootCoordClient_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AlterDatabase_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)) *MockRootCoordClient_CheckHealth_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// Close provides a mock function with given fields:
-func (_m *MockRootCoordClient) Close() error {
-	ret := _m.Called()
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockRootCoordClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
-type MockRootCoordClient_Close_Call struct {
-	*mock.Call
-}
-
-// Close is a helper method to define mock.On call
-func (_e *MockRootCoordClient_Expecter) Close() *MockRootCoordClient_Close_Call {
-	return &MockRootCoordClient_Close_Call{Call: _e.mock.On("Close")}
-}
-
-func (_c *MockRootCoordClient_Close_Call) Run(run func()) *MockRootCoordClient_Close_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockRootCoordClient_Close_Call) Return(_a0 error) *MockRootCoordClient_Close_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockRootCoordClient_Close_Call) RunAndReturn(run func() error) *MockRootCoordClient_Close_Call {
+func (_c *MockRootCoordClient_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AlterDatabase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateAlias provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// BatchAlterAliases provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) BatchAlterAliases(ctx context.Context, in *internalpb.BatchAlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -437,10 +466,10 @@ func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.Cre
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.BatchAlterAliasesRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -448,7 +477,7 @@ func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.Cre
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.BatchAlterAliasesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -457,21 +486,21 @@ func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.Cre
 	return r0, r1
 }
 
-// MockRootCoordClient_CreateAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlias'
-type MockRootCoordClient_CreateAlias_Call struct {
+// MockRootCoordClient_BatchAlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchAlterAliases'
+type MockRootCoordClient_BatchAlterAliases_Call struct {
 	*mock.Call
 }
 
-// CreateAlias is a helper method to define mock.On call
+// BatchAlterAliases is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreateAliasRequest
+//   - in *internalpb.BatchAlterAliasesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreateAlias(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateAlias_Call {
-	return &MockRootCoordClient_CreateAlias_Call{Call: _e.mock.On("CreateAlias",
+func (_e *MockRootCoordClient_Expecter) BatchAlterAliases(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_BatchAlterAliases_Call {
+	return &MockRootCoordClient_BatchAlterAliases_Call{Call: _e.mock.On("BatchAlterAliases",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreateAlias_Call) Run(run func(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateAlias_Call {
+func (_c *MockRootCoordClient_BatchAlterAliases_Call) Run(run func(ctx context.Context, in *internalpb.BatchAlterAliasesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_BatchAlterAliases_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -479,23 +508,23 @@ func (_c *MockRootCoordClient_CreateAlias_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateAliasRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.BatchAlterAliasesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateAlias_Call {
+func (_c *MockRootCoordClient_BatchAlterAliases_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_BatchAlterAliases_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateAlias_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateAlias_Call {
+func (_c *MockRootCoordClient_BatchAlterAliases_Call) RunAndReturn(run func(context.Context, *internalpb.BatchAlterAliasesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_BatchAlterAliases_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CheckHealth provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -505,20 +534,20 @@ func (_m *MockRootCoordClient) CreateCollection(ctx context.Context, in *milvusp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *milvuspb.CheckHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) *milvuspb.CheckHealthResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -527,21 +556,21 @@ func (_m *MockRootCoordClient) CreateCollection(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockRootCoordClient_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
-type MockRootCoordClient_CreateCollection_Call struct {
+// MockRootCoordClient_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
+type MockRootCoordClient_CheckHealth_Call struct {
 	*mock.Call
 }
 
-// CreateCollection is a helper method to define mock.On call
+// CheckHealth is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreateCollectionRequest
+//   - in *milvuspb.CheckHealthRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreateCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCollection_Call {
-	return &MockRootCoordClient_CreateCollection_Call{Call: _e.mock.On("CreateCollection",
+func (_e *MockRootCoordClient_Expecter) CheckHealth(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CheckHealth_Call {
+	return &MockRootCoordClient_CheckHealth_Call{Call: _e.mock.On("CheckHealth",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreateCollection_Call) Run(run func(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCollection_Call {
+func (_c *MockRootCoordClient_CheckHealth_Call) Run(run func(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CheckHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -549,23 +578,23 @@ func (_c *MockRootCoordClient_CreateCollection_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCollection_Call {
+func (_c *MockRootCoordClient_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockRootCoordClient_CheckHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCollection_Call {
+func (_c *MockRootCoordClient_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest, ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)) *MockRootCoordClient_CheckHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateCredential provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CloneCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -577,10 +606,10 @@ func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *interna
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -588,7 +617,7 @@ func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *interna
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -597,21 +626,21 @@ func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *interna
 	return r0, r1
 }
 
-// MockRootCoordClient_CreateCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCredential'
-type MockRootCoordClient_CreateCredential_Call struct {
+// MockRootCoordClient_CloneCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneCollection'
+type MockRootCoordClient_CloneCollection_Call struct {
 	*mock.Call
 }
 
-// CreateCredential is a helper method to define mock.On call
+// CloneCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.CredentialInfo
+//   - in *internalpb.CloneCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreateCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCredential_Call {
-	return &MockRootCoordClient_CreateCredential_Call{Call: _e.mock.On("CreateCredential",
+func (_e *MockRootCoordClient_Expecter) CloneCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CloneCollection_Call {
+	return &MockRootCoordClient_CloneCollection_Call{Call: _e.mock.On("CloneCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreateCredential_Call) Run(run func(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCredential_Call {
+func (_c *MockRootCoordClient_CloneCollection_Call) Run(run func(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CloneCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -619,23 +648,64 @@ func (_c *MockRootCoordClient_CreateCredential_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.CredentialInfo), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.CloneCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateCredential_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCredential_Call {
+func (_c *MockRootCoordClient_CloneCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CloneCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateCredential_Call) RunAndReturn(run func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCredential_Call {
+func (_c *MockRootCoordClient_CloneCollection_Call) RunAndReturn(run func(context.Context, *internalpb.CloneCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CloneCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateDatabase provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// Close provides a mock function with given fields:
+func (_m *MockRootCoordClient) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRootCoordClient_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockRootCoordClient_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockRootCoordClient_Expecter) Close() *MockRootCoordClient_Close_Call {
+	return &MockRootCoordClient_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockRootCoordClient_Close_Call) Run(run func()) *MockRootCoordClient_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_Close_Call) Return(_a0 error) *MockRootCoordClient_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRootCoordClient_Close_Call) RunAndReturn(run func() error) *MockRootCoordClient_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateAPIKey(ctx context.Context, in *internalpb.APIKeyInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -647,10 +717,10 @@ func (_m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.APIKeyInfo, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.APIKeyInfo, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -658,7 +728,7 @@ func (_m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.APIKeyInfo, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -667,21 +737,21 @@ func (_m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.
 	return r0, r1
 }
 
-// MockRootCoordClient_CreateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatabase'
-type MockRootCoordClient_CreateDatabase_Call struct {
+// MockRootCoordClient_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockRootCoordClient_CreateAPIKey_Call struct {
 	*mock.Call
 }
 
-// CreateDatabase is a helper method to define mock.On call
+// CreateAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreateDatabaseRequest
+//   - in *internalpb.APIKeyInfo
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreateDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateDatabase_Call {
-	return &MockRootCoordClient_CreateDatabase_Call{Call: _e.mock.On("CreateDatabase",
+func (_e *MockRootCoordClient_Expecter) CreateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateAPIKey_Call {
+	return &MockRootCoordClient_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreateDatabase_Call) Run(run func(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateDatabase_Call {
+func (_c *MockRootCoordClient_CreateAPIKey_Call) Run(run func(ctx context.Context, in *internalpb.APIKeyInfo, opts ...grpc.CallOption)) *MockRootCoordClient_CreateAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -689,23 +759,23 @@ func (_c *MockRootCoordClient_CreateDatabase_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateDatabaseRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.APIKeyInfo), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateDatabase_Call {
+func (_c *MockRootCoordClient_CreateAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateDatabase_Call {
+func (_c *MockRootCoordClient_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.APIKeyInfo, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreatePartition provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CreateAlias provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -717,10 +787,10 @@ func (_m *MockRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -728,7 +798,7 @@ func (_m *MockRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -737,21 +807,21 @@ func (_m *MockRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb
 	return r0, r1
 }
 
-// MockRootCoordClient_CreatePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePartition'
-type MockRootCoordClient_CreatePartition_Call struct {
+// MockRootCoordClient_CreateAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlias'
+type MockRootCoordClient_CreateAlias_Call struct {
 	*mock.Call
 }
 
-// CreatePartition is a helper method to define mock.On call
+// CreateAlias is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreatePartitionRequest
+//   - in *milvuspb.CreateAliasRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreatePartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreatePartition_Call {
-	return &MockRootCoordClient_CreatePartition_Call{Call: _e.mock.On("CreatePartition",
-		append([]interface{}{ctx, in}, opts...)...)}
+func (_e *MockRootCoordClient_Expecter) CreateAlias(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateAlias_Call {
+	return &MockRootCoordClient_CreateAlias_Call{Call: _e.mock.On("CreateAlias",
+		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreatePartition_Call) Run(run func(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreatePartition_Call {
+func (_c *MockRootCoordClient_CreateAlias_Call) Run(run func(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateAlias_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -759,23 +829,23 @@ func (_c *MockRootCoordClient_CreatePartition_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreatePartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateAliasRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreatePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreatePartition_Call {
+func (_c *MockRootCoordClient_CreateAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateAlias_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreatePartition_Call) RunAndReturn(run func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreatePartition_Call {
+func (_c *MockRootCoordClient_CreateAlias_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateAlias_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateRole provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CreateCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -787,10 +857,10 @@ func (_m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.Crea
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -798,7 +868,7 @@ func (_m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.Crea
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -807,21 +877,21 @@ func (_m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.Crea
 	return r0, r1
 }
 
-// MockRootCoordClient_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
-type MockRootCoordClient_CreateRole_Call struct {
+// MockRootCoordClient_CreateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollection'
+type MockRootCoordClient_CreateCollection_Call struct {
 	*mock.Call
 }
 
-// CreateRole is a helper method to define mock.On call
+// CreateCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.CreateRoleRequest
+//   - in *milvuspb.CreateCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) CreateRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateRole_Call {
-	return &MockRootCoordClient_CreateRole_Call{Call: _e.mock.On("CreateRole",
+func (_e *MockRootCoordClient_Expecter) CreateCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCollection_Call {
+	return &MockRootCoordClient_CreateCollection_Call{Call: _e.mock.On("CreateCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_CreateRole_Call) Run(run func(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateRole_Call {
+func (_c *MockRootCoordClient_CreateCollection_Call) Run(run func(ctx context.Context, in *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -829,23 +899,23 @@ func (_c *MockRootCoordClient_CreateRole_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.CreateRoleRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateRole_Call {
+func (_c *MockRootCoordClient_CreateCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_CreateRole_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateRole_Call {
+func (_c *MockRootCoordClient_CreateCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteCredential provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CreateCredential provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -857,10 +927,10 @@ func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvusp
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -868,7 +938,7 @@ func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvusp
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -877,21 +947,21 @@ func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockRootCoordClient_DeleteCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredential'
-type MockRootCoordClient_DeleteCredential_Call struct {
+// MockRootCoordClient_CreateCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCredential'
+type MockRootCoordClient_CreateCredential_Call struct {
 	*mock.Call
 }
 
-// DeleteCredential is a helper method to define mock.On call
+// CreateCredential is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DeleteCredentialRequest
+//   - in *internalpb.CredentialInfo
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DeleteCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DeleteCredential_Call {
-	return &MockRootCoordClient_DeleteCredential_Call{Call: _e.mock.On("DeleteCredential",
+func (_e *MockRootCoordClient_Expecter) CreateCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateCredential_Call {
+	return &MockRootCoordClient_CreateCredential_Call{Call: _e.mock.On("CreateCredential",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DeleteCredential_Call) Run(run func(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DeleteCredential_Call {
+func (_c *MockRootCoordClient_CreateCredential_Call) Run(run func(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption)) *MockRootCoordClient_CreateCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -899,23 +969,23 @@ func (_c *MockRootCoordClient_DeleteCredential_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DeleteCredentialRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.CredentialInfo), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DeleteCredential_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DeleteCredential_Call {
+func (_c *MockRootCoordClient_CreateCredential_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateCredential_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DeleteCredential_Call) RunAndReturn(run func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DeleteCredential_Call {
+func (_c *MockRootCoordClient_CreateCredential_Call) RunAndReturn(run func(context.Context, *internalpb.CredentialInfo, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateCredential_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+// CreateDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -925,20 +995,20 @@ func (_m *MockRootCoordClient) DescribeCollection(ctx context.Context, in *milvu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.DescribeCollectionResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) *milvuspb.DescribeCollectionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.DescribeCollectionResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -947,21 +1017,21 @@ func (_m *MockRootCoordClient) DescribeCollection(ctx context.Context, in *milvu
 	return r0, r1
 }
 
-// MockRootCoordClient_DescribeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollection'
-type MockRootCoordClient_DescribeCollection_Call struct {
+// MockRootCoordClient_CreateDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDatabase'
+type MockRootCoordClient_CreateDatabase_Call struct {
 	*mock.Call
 }
 
-// DescribeCollection is a helper method to define mock.On call
+// CreateDatabase is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DescribeCollectionRequest
+//   - in *milvuspb.CreateDatabaseRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DescribeCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeCollection_Call {
-	return &MockRootCoordClient_DescribeCollection_Call{Call: _e.mock.On("DescribeCollection",
+func (_e *MockRootCoordClient_Expecter) CreateDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateDatabase_Call {
+	return &MockRootCoordClient_CreateDatabase_Call{Call: _e.mock.On("CreateDatabase",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DescribeCollection_Call) Run(run func(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeCollection_Call {
+func (_c *MockRootCoordClient_CreateDatabase_Call) Run(run func(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateDatabase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -969,23 +1039,23 @@ func (_c *MockRootCoordClient_DescribeCollection_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateDatabaseRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DescribeCollection_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockRootCoordClient_DescribeCollection_Call {
+func (_c *MockRootCoordClient_CreateDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateDatabase_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DescribeCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)) *MockRootCoordClient_DescribeCollection_Call {
+func (_c *MockRootCoordClient_CreateDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateDatabase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeCollectionInternal provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DescribeCollectionInternal(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+// CreatePartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -995,20 +1065,20 @@ func (_m *MockRootCoordClient) DescribeCollectionInternal(ctx context.Context, i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.DescribeCollectionResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) *milvuspb.DescribeCollectionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.DescribeCollectionResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1017,21 +1087,21 @@ func (_m *MockRootCoordClient) DescribeCollectionInternal(ctx context.Context, i
 	return r0, r1
 }
 
-// MockRootCoordClient_DescribeCollectionInternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollectionInternal'
-type MockRootCoordClient_DescribeCollectionInternal_Call struct {
+// MockRootCoordClient_CreatePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePartition'
+type MockRootCoordClient_CreatePartition_Call struct {
 	*mock.Call
 }
 
-// DescribeCollectionInternal is a helper method to define mock.On call
+// CreatePartition is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DescribeCollectionRequest
+//   - in *milvuspb.CreatePartitionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DescribeCollectionInternal(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeCollectionInternal_Call {
-	return &MockRootCoordClient_DescribeCollectionInternal_Call{Call: _e.mock.On("DescribeCollectionInternal",
+func (_e *MockRootCoordClient_Expecter) CreatePartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreatePartition_Call {
+	return &MockRootCoordClient_CreatePartition_Call{Call: _e.mock.On("CreatePartition",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) Run(run func(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeCollectionInternal_Call {
+func (_c *MockRootCoordClient_CreatePartition_Call) Run(run func(ctx context.Context, in *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreatePartition_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1039,23 +1109,23 @@ func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) Run(run func(ctx
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CreatePartitionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockRootCoordClient_DescribeCollectionInternal_Call {
+func (_c *MockRootCoordClient_CreatePartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreatePartition_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)) *MockRootCoordClient_DescribeCollectionInternal_Call {
+func (_c *MockRootCoordClient_CreatePartition_Call) RunAndReturn(run func(context.Context, *milvuspb.CreatePartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreatePartition_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropAlias provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CreateRole provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1067,10 +1137,10 @@ func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropA
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1078,7 +1148,7 @@ func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropA
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1087,21 +1157,21 @@ func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropA
 	return r0, r1
 }
 
-// MockRootCoordClient_DropAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAlias'
-type MockRootCoordClient_DropAlias_Call struct {
+// MockRootCoordClient_CreateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRole'
+type MockRootCoordClient_CreateRole_Call struct {
 	*mock.Call
 }
 
-// DropAlias is a helper method to define mock.On call
+// CreateRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropAliasRequest
+//   - in *milvuspb.CreateRoleRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DropAlias(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropAlias_Call {
-	return &MockRootCoordClient_DropAlias_Call{Call: _e.mock.On("DropAlias",
+func (_e *MockRootCoordClient_Expecter) CreateRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateRole_Call {
+	return &MockRootCoordClient_CreateRole_Call{Call: _e.mock.On("CreateRole",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DropAlias_Call) Run(run func(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropAlias_Call {
+func (_c *MockRootCoordClient_CreateRole_Call) Run(run func(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1109,23 +1179,23 @@ func (_c *MockRootCoordClient_DropAlias_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropAliasRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.CreateRoleRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropAlias_Call {
+func (_c *MockRootCoordClient_CreateRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropAlias_Call) RunAndReturn(run func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropAlias_Call {
+func (_c *MockRootCoordClient_CreateRole_Call) RunAndReturn(run func(context.Context, *milvuspb.CreateRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// CreateSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1137,10 +1207,10 @@ func (_m *MockRootCoordClient) DropCollection(ctx context.Context, in *milvuspb.
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1148,7 +1218,7 @@ func (_m *MockRootCoordClient) DropCollection(ctx context.Context, in *milvuspb.
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1157,21 +1227,21 @@ func (_m *MockRootCoordClient) DropCollection(ctx context.Context, in *milvuspb.
 	return r0, r1
 }
 
-// MockRootCoordClient_DropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollection'
-type MockRootCoordClient_DropCollection_Call struct {
+// MockRootCoordClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockRootCoordClient_CreateSnapshot_Call struct {
 	*mock.Call
 }
 
-// DropCollection is a helper method to define mock.On call
+// CreateSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropCollectionRequest
+//   - in *internalpb.CreateSnapshotRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DropCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollection_Call {
-	return &MockRootCoordClient_DropCollection_Call{Call: _e.mock.On("DropCollection",
+func (_e *MockRootCoordClient_Expecter) CreateSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateSnapshot_Call {
+	return &MockRootCoordClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DropCollection_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollection_Call {
+func (_c *MockRootCoordClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1179,23 +1249,23 @@ func (_c *MockRootCoordClient_DropCollection_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.CreateSnapshotRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropCollection_Call {
+func (_c *MockRootCoordClient_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_CreateSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropCollection_Call {
+func (_c *MockRootCoordClient_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.CreateSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_CreateSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropDatabase provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// DeleteCredential provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1207,10 +1277,10 @@ func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.Dr
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1218,7 +1288,7 @@ func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.Dr
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1227,21 +1297,21 @@ func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.Dr
 	return r0, r1
 }
 
-// MockRootCoordClient_DropDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabase'
-type MockRootCoordClient_DropDatabase_Call struct {
+// MockRootCoordClient_DeleteCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCredential'
+type MockRootCoordClient_DeleteCredential_Call struct {
 	*mock.Call
 }
 
-// DropDatabase is a helper method to define mock.On call
+// DeleteCredential is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropDatabaseRequest
+//   - in *milvuspb.DeleteCredentialRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DropDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropDatabase_Call {
-	return &MockRootCoordClient_DropDatabase_Call{Call: _e.mock.On("DropDatabase",
+func (_e *MockRootCoordClient_Expecter) DeleteCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DeleteCredential_Call {
+	return &MockRootCoordClient_DeleteCredential_Call{Call: _e.mock.On("DeleteCredential",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DropDatabase_Call) Run(run func(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropDatabase_Call {
+func (_c *MockRootCoordClient_DeleteCredential_Call) Run(run func(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DeleteCredential_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1249,23 +1319,23 @@ func (_c *MockRootCoordClient_DropDatabase_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.DeleteCredentialRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropDatabase_Call {
+func (_c *MockRootCoordClient_DeleteCredential_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DeleteCredential_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropDatabase_Call {
+func (_c *MockRootCoordClient_DeleteCredential_Call) RunAndReturn(run func(context.Context, *milvuspb.DeleteCredentialRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DeleteCredential_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropPartition provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// DescribeCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DescribeCollection(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1275,20 +1345,20 @@ func (_m *MockRootCoordClient) DropPartition(ctx context.Context, in *milvuspb.D
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *milvuspb.DescribeCollectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) *milvuspb.DescribeCollectionResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*milvuspb.DescribeCollectionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1297,21 +1367,21 @@ func (_m *MockRootCoordClient) DropPartition(ctx context.Context, in *milvuspb.D
 	return r0, r1
 }
 
-// MockRootCoordClient_DropPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropPartition'
-type MockRootCoordClient_DropPartition_Call struct {
+// MockRootCoordClient_DescribeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollection'
+type MockRootCoordClient_DescribeCollection_Call struct {
 	*mock.Call
 }
 
-// DropPartition is a helper method to define mock.On call
+// DescribeCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropPartitionRequest
+//   - in *milvuspb.DescribeCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DropPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropPartition_Call {
-	return &MockRootCoordClient_DropPartition_Call{Call: _e.mock.On("DropPartition",
+func (_e *MockRootCoordClient_Expecter) DescribeCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeCollection_Call {
+	return &MockRootCoordClient_DescribeCollection_Call{Call: _e.mock.On("DescribeCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DropPartition_Call) Run(run func(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropPartition_Call {
+func (_c *MockRootCoordClient_DescribeCollection_Call) Run(run func(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1319,23 +1389,23 @@ func (_c *MockRootCoordClient_DropPartition_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropPartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropPartition_Call {
+func (_c *MockRootCoordClient_DescribeCollection_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockRootCoordClient_DescribeCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropPartition_Call {
+func (_c *MockRootCoordClient_DescribeCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)) *MockRootCoordClient_DescribeCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropRole provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// DescribeCollectionInternal provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DescribeCollectionInternal(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1345,12 +1415,152 @@ func (_m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *milvuspb.DescribeCollectionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) *milvuspb.DescribeCollectionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.DescribeCollectionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DescribeCollectionInternal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeCollectionInternal'
+type MockRootCoordClient_DescribeCollectionInternal_Call struct {
+	*mock.Call
+}
+
+// DescribeCollectionInternal is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DescribeCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DescribeCollectionInternal(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeCollectionInternal_Call {
+	return &MockRootCoordClient_DescribeCollectionInternal_Call{Call: _e.mock.On("DescribeCollectionInternal",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) Run(run func(ctx context.Context, in *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeCollectionInternal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockRootCoordClient_DescribeCollectionInternal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest, ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)) *MockRootCoordClient_DescribeCollectionInternal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) *internalpb.DescribeDatabaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type MockRootCoordClient_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DescribeDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DescribeDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeDatabase_Call {
+	return &MockRootCoordClient_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DescribeDatabase_Call) Run(run func(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *MockRootCoordClient_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error)) *MockRootCoordClient_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropAlias provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -1358,7 +1568,7 @@ func (_m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRo
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1367,21 +1577,21 @@ func (_m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRo
 	return r0, r1
 }
 
-// MockRootCoordClient_DropRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRole'
-type MockRootCoordClient_DropRole_Call struct {
+// MockRootCoordClient_DropAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAlias'
+type MockRootCoordClient_DropAlias_Call struct {
 	*mock.Call
 }
 
-// DropRole is a helper method to define mock.On call
+// DropAlias is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.DropRoleRequest
+//   - in *milvuspb.DropAliasRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) DropRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropRole_Call {
-	return &MockRootCoordClient_DropRole_Call{Call: _e.mock.On("DropRole",
+func (_e *MockRootCoordClient_Expecter) DropAlias(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropAlias_Call {
+	return &MockRootCoordClient_DropAlias_Call{Call: _e.mock.On("DropAlias",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_DropRole_Call) Run(run func(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropRole_Call {
+func (_c *MockRootCoordClient_DropAlias_Call) Run(run func(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropAlias_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1389,23 +1599,23 @@ func (_c *MockRootCoordClient_DropRole_Call) Run(run func(ctx context.Context, i
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.DropRoleRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.DropAliasRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropRole_Call {
+func (_c *MockRootCoordClient_DropAlias_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropAlias_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_DropRole_Call) RunAndReturn(run func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropRole_Call {
+func (_c *MockRootCoordClient_DropAlias_Call) RunAndReturn(run func(context.Context, *milvuspb.DropAliasRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropAlias_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+// DropCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1415,20 +1625,20 @@ func (_m *MockRootCoordClient) GetComponentStates(ctx context.Context, in *milvu
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1437,21 +1647,21 @@ func (_m *MockRootCoordClient) GetComponentStates(ctx context.Context, in *milvu
 	return r0, r1
 }
 
-// MockRootCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockRootCoordClient_GetComponentStates_Call struct {
+// MockRootCoordClient_DropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollection'
+type MockRootCoordClient_DropCollection_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// DropCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetComponentStatesRequest
+//   - in *milvuspb.DropCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetComponentStates_Call {
-	return &MockRootCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+func (_e *MockRootCoordClient_Expecter) DropCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollection_Call {
+	return &MockRootCoordClient_DropCollection_Call{Call: _e.mock.On("DropCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetComponentStates_Call {
+func (_c *MockRootCoordClient_DropCollection_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1459,23 +1669,23 @@ func (_c *MockRootCoordClient_GetComponentStates_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockRootCoordClient_GetComponentStates_Call {
+func (_c *MockRootCoordClient_DropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockRootCoordClient_GetComponentStates_Call {
+func (_c *MockRootCoordClient_DropCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCredential provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+// DropDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1485,20 +1695,20 @@ func (_m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *rootcoordpb.GetCredentialResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) *rootcoordpb.GetCredentialResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rootcoordpb.GetCredentialResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1507,21 +1717,21 @@ func (_m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordp
 	return r0, r1
 }
 
-// MockRootCoordClient_GetCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredential'
-type MockRootCoordClient_GetCredential_Call struct {
+// MockRootCoordClient_DropDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDatabase'
+type MockRootCoordClient_DropDatabase_Call struct {
 	*mock.Call
 }
 
-// GetCredential is a helper method to define mock.On call
+// DropDatabase is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *rootcoordpb.GetCredentialRequest
+//   - in *milvuspb.DropDatabaseRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetCredential_Call {
-	return &MockRootCoordClient_GetCredential_Call{Call: _e.mock.On("GetCredential",
+func (_e *MockRootCoordClient_Expecter) DropDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropDatabase_Call {
+	return &MockRootCoordClient_DropDatabase_Call{Call: _e.mock.On("DropDatabase",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetCredential_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetCredential_Call {
+func (_c *MockRootCoordClient_DropDatabase_Call) Run(run func(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropDatabase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1529,23 +1739,1143 @@ func (_c *MockRootCoordClient_GetCredential_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*rootcoordpb.GetCredentialRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.DropDatabaseRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialResponse, _a1 error) *MockRootCoordClient_GetCredential_Call {
+func (_c *MockRootCoordClient_DropDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropDatabase_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetCredential_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error)) *MockRootCoordClient_GetCredential_Call {
+func (_c *MockRootCoordClient_DropDatabase_Call) RunAndReturn(run func(context.Context, *milvuspb.DropDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropPartition'
+type MockRootCoordClient_DropPartition_Call struct {
+	*mock.Call
+}
+
+// DropPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropPartition_Call {
+	return &MockRootCoordClient_DropPartition_Call{Call: _e.mock.On("DropPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropPartition_Call) Run(run func(ctx context.Context, in *milvuspb.DropPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.DropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropRole provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropRole'
+type MockRootCoordClient_DropRole_Call struct {
+	*mock.Call
+}
+
+// DropRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropRoleRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropRole_Call {
+	return &MockRootCoordClient_DropRole_Call{Call: _e.mock.On("DropRole",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropRole_Call) Run(run func(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropRoleRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropRole_Call) RunAndReturn(run func(context.Context, *milvuspb.DropRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockRootCoordClient_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DropSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropSnapshot_Call {
+	return &MockRootCoordClient_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DropSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_DropSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.DropSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockRootCoordClient_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetComponentStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetComponentStates_Call {
+	return &MockRootCoordClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockRootCoordClient_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockRootCoordClient_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCredential provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.GetCredentialResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) *rootcoordpb.GetCredentialResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.GetCredentialResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetCredential_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCredential'
+type MockRootCoordClient_GetCredential_Call struct {
+	*mock.Call
+}
+
+// GetCredential is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.GetCredentialRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetCredential(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetCredential_Call {
+	return &MockRootCoordClient_GetCredential_Call{Call: _e.mock.On("GetCredential",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetCredential_Call) Run(run func(ctx context.Context, in *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetCredential_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.GetCredentialRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetCredential_Call) Return(_a0 *rootcoordpb.GetCredentialResponse, _a1 error) *MockRootCoordClient_GetCredential_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetCredential_Call) RunAndReturn(run func(context.Context, *rootcoordpb.GetCredentialRequest, ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error)) *MockRootCoordClient_GetCredential_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDDLJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) *internalpb.GetDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDDLJob'
+type MockRootCoordClient_GetDDLJob_Call struct {
+	*mock.Call
+}
+
+// GetDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetDDLJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetDDLJob(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetDDLJob_Call {
+	return &MockRootCoordClient_GetDDLJob_Call{Call: _e.mock.On("GetDDLJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) Run(run func(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetDDLJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) Return(_a0 *internalpb.GetDDLJobResponse, _a1 error) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetDDLJob_Call) RunAndReturn(run func(context.Context, *internalpb.GetDDLJobRequest, ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error)) *MockRootCoordClient_GetDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportState provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetImportStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) *milvuspb.GetImportStateResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetImportStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetImportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportState'
+type MockRootCoordClient_GetImportState_Call struct {
+	*mock.Call
+}
+
+// GetImportState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetImportStateRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetImportState(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetImportState_Call {
+	return &MockRootCoordClient_GetImportState_Call{Call: _e.mock.On("GetImportState",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetImportState_Call) Run(run func(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetImportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetImportStateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetImportState_Call) Return(_a0 *milvuspb.GetImportStateResponse, _a1 error) *MockRootCoordClient_GetImportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetImportState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)) *MockRootCoordClient_GetImportState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockRootCoordClient_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetMetricsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetMetrics_Call {
+	return &MockRootCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockRootCoordClient_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockRootCoordClient_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockRootCoordClient_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetStatisticsChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetStatisticsChannel_Call {
+	return &MockRootCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockRootCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockRootCoordClient_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockRootCoordClient_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetTimeTickChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetTimeTickChannel_Call {
+	return &MockRootCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockRootCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockRootCoordClient_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GrantRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) GrantRowPolicy(ctx context.Context, in *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GrantRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GrantRowPolicyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_GrantRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrantRowPolicy'
+type MockRootCoordClient_GrantRowPolicy_Call struct {
+	*mock.Call
+}
+
+// GrantRowPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GrantRowPolicyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) GrantRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GrantRowPolicy_Call {
+	return &MockRootCoordClient_GrantRowPolicy_Call{Call: _e.mock.On("GrantRowPolicy",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_GrantRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GrantRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GrantRowPolicyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_GrantRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_GrantRowPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_GrantRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.GrantRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_GrantRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.BoolResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_HasCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasCollection'
+type MockRootCoordClient_HasCollection_Call struct {
+	*mock.Call
+}
+
+// HasCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.HasCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) HasCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasCollection_Call {
+	return &MockRootCoordClient_HasCollection_Call{Call: _e.mock.On("HasCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_HasCollection_Call) Run(run func(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.HasCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasCollection_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.BoolResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
+type MockRootCoordClient_HasPartition_Call struct {
+	*mock.Call
+}
+
+// HasPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.HasPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasPartition_Call {
+	return &MockRootCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) (*milvuspb.ImportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) *milvuspb.ImportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type MockRootCoordClient_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.ImportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) Import(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_Import_Call {
+	return &MockRootCoordClient_Import_Call{Call: _e.mock.On("Import",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_Import_Call) Run(run func(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption)) *MockRootCoordClient_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.ImportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_Import_Call) Return(_a0 *milvuspb.ImportResponse, _a1 error) *MockRootCoordClient_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_Import_Call) RunAndReturn(run func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) (*milvuspb.ImportResponse, error)) *MockRootCoordClient_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
+type MockRootCoordClient_InvalidateCollectionMetaCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateCollectionMetaCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.InvalidateCollMetaCacheRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+	return &MockRootCoordClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListAPIKeys(ctx context.Context, in *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest, ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListAPIKeysRequest, ...grpc.CallOption) *internalpb.ListAPIKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListAPIKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockRootCoordClient_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ListAPIKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) ListAPIKeys(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListAPIKeys_Call {
+	return &MockRootCoordClient_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Run(run func(ctx context.Context, in *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ListAPIKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Return(_a0 *internalpb.ListAPIKeysResponse, _a1 error) *MockRootCoordClient_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *internalpb.ListAPIKeysRequest, ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error)) *MockRootCoordClient_ListAPIKeys_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetImportState provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+// ListCredUsers provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1555,20 +2885,20 @@ func (_m *MockRootCoordClient) GetImportState(ctx context.Context, in *milvuspb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetImportStateResponse
+	var r0 *milvuspb.ListCredUsersResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) *milvuspb.GetImportStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) *milvuspb.ListCredUsersResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetImportStateResponse)
+			r0 = ret.Get(0).(*milvuspb.ListCredUsersResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1577,21 +2907,21 @@ func (_m *MockRootCoordClient) GetImportState(ctx context.Context, in *milvuspb.
 	return r0, r1
 }
 
-// MockRootCoordClient_GetImportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportState'
-type MockRootCoordClient_GetImportState_Call struct {
+// MockRootCoordClient_ListCredUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCredUsers'
+type MockRootCoordClient_ListCredUsers_Call struct {
 	*mock.Call
 }
 
-// GetImportState is a helper method to define mock.On call
+// ListCredUsers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetImportStateRequest
+//   - in *milvuspb.ListCredUsersRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetImportState(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetImportState_Call {
-	return &MockRootCoordClient_GetImportState_Call{Call: _e.mock.On("GetImportState",
+func (_e *MockRootCoordClient_Expecter) ListCredUsers(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListCredUsers_Call {
+	return &MockRootCoordClient_ListCredUsers_Call{Call: _e.mock.On("ListCredUsers",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetImportState_Call) Run(run func(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetImportState_Call {
+func (_c *MockRootCoordClient_ListCredUsers_Call) Run(run func(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListCredUsers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1599,23 +2929,23 @@ func (_c *MockRootCoordClient_GetImportState_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetImportStateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.ListCredUsersRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetImportState_Call) Return(_a0 *milvuspb.GetImportStateResponse, _a1 error) *MockRootCoordClient_GetImportState_Call {
+func (_c *MockRootCoordClient_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersResponse, _a1 error) *MockRootCoordClient_ListCredUsers_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetImportState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetImportStateRequest, ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)) *MockRootCoordClient_GetImportState_Call {
+func (_c *MockRootCoordClient_ListCredUsers_Call) RunAndReturn(run func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)) *MockRootCoordClient_ListCredUsers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+// ListDDLJobs provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1625,20 +2955,20 @@ func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetM
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *internalpb.ListDDLJobsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) *internalpb.ListDDLJobsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*internalpb.ListDDLJobsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1647,21 +2977,21 @@ func (_m *MockRootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetM
 	return r0, r1
 }
 
-// MockRootCoordClient_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockRootCoordClient_GetMetrics_Call struct {
+// MockRootCoordClient_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type MockRootCoordClient_ListDDLJobs_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
+// ListDDLJobs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetMetricsRequest
+//   - in *internalpb.ListDDLJobsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetMetrics_Call {
-	return &MockRootCoordClient_GetMetrics_Call{Call: _e.mock.On("GetMetrics",
+func (_e *MockRootCoordClient_Expecter) ListDDLJobs(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListDDLJobs_Call {
+	return &MockRootCoordClient_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetMetrics_Call {
+func (_c *MockRootCoordClient_ListDDLJobs_Call) Run(run func(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListDDLJobs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1669,23 +2999,23 @@ func (_c *MockRootCoordClient_GetMetrics_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListDDLJobsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockRootCoordClient_GetMetrics_Call {
+func (_c *MockRootCoordClient_ListDDLJobs_Call) Return(_a0 *internalpb.ListDDLJobsResponse, _a1 error) *MockRootCoordClient_ListDDLJobs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockRootCoordClient_GetMetrics_Call {
+func (_c *MockRootCoordClient_ListDDLJobs_Call) RunAndReturn(run func(context.Context, *internalpb.ListDDLJobsRequest, ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error)) *MockRootCoordClient_ListDDLJobs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ListDatabases provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1695,20 +3025,20 @@ func (_m *MockRootCoordClient) GetStatisticsChannel(ctx context.Context, in *int
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *milvuspb.ListDatabasesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) *milvuspb.ListDatabasesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*milvuspb.ListDatabasesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1717,21 +3047,21 @@ func (_m *MockRootCoordClient) GetStatisticsChannel(ctx context.Context, in *int
 	return r0, r1
 }
 
-// MockRootCoordClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockRootCoordClient_GetStatisticsChannel_Call struct {
+// MockRootCoordClient_ListDatabases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabases'
+type MockRootCoordClient_ListDatabases_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// ListDatabases is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetStatisticsChannelRequest
+//   - in *milvuspb.ListDatabasesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetStatisticsChannel_Call {
-	return &MockRootCoordClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+func (_e *MockRootCoordClient_Expecter) ListDatabases(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListDatabases_Call {
+	return &MockRootCoordClient_ListDatabases_Call{Call: _e.mock.On("ListDatabases",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetStatisticsChannel_Call {
+func (_c *MockRootCoordClient_ListDatabases_Call) Run(run func(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListDatabases_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1739,23 +3069,23 @@ func (_c *MockRootCoordClient_GetStatisticsChannel_Call) Run(run func(ctx contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.ListDatabasesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockRootCoordClient_GetStatisticsChannel_Call {
+func (_c *MockRootCoordClient_ListDatabases_Call) Return(_a0 *milvuspb.ListDatabasesResponse, _a1 error) *MockRootCoordClient_ListDatabases_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockRootCoordClient_GetStatisticsChannel_Call {
+func (_c *MockRootCoordClient_ListDatabases_Call) RunAndReturn(run func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)) *MockRootCoordClient_ListDatabases_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ListDroppedCollections provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListDroppedCollections(ctx context.Context, in *internalpb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1765,20 +3095,20 @@ func (_m *MockRootCoordClient) GetTimeTickChannel(ctx context.Context, in *inter
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *internalpb.ListDroppedCollectionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest, ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListDroppedCollectionsRequest, ...grpc.CallOption) *internalpb.ListDroppedCollectionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*internalpb.ListDroppedCollectionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListDroppedCollectionsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1787,21 +3117,21 @@ func (_m *MockRootCoordClient) GetTimeTickChannel(ctx context.Context, in *inter
 	return r0, r1
 }
 
-// MockRootCoordClient_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockRootCoordClient_GetTimeTickChannel_Call struct {
+// MockRootCoordClient_ListDroppedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDroppedCollections'
+type MockRootCoordClient_ListDroppedCollections_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// ListDroppedCollections is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetTimeTickChannelRequest
+//   - in *internalpb.ListDroppedCollectionsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) GetTimeTickChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_GetTimeTickChannel_Call {
-	return &MockRootCoordClient_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel",
+func (_e *MockRootCoordClient_Expecter) ListDroppedCollections(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListDroppedCollections_Call {
+	return &MockRootCoordClient_ListDroppedCollections_Call{Call: _e.mock.On("ListDroppedCollections",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption)) *MockRootCoordClient_GetTimeTickChannel_Call {
+func (_c *MockRootCoordClient_ListDroppedCollections_Call) Run(run func(ctx context.Context, in *internalpb.ListDroppedCollectionsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListDroppedCollections_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1809,23 +3139,23 @@ func (_c *MockRootCoordClient_GetTimeTickChannel_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListDroppedCollectionsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockRootCoordClient_GetTimeTickChannel_Call {
+func (_c *MockRootCoordClient_ListDroppedCollections_Call) Return(_a0 *internalpb.ListDroppedCollectionsResponse, _a1 error) *MockRootCoordClient_ListDroppedCollections_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockRootCoordClient_GetTimeTickChannel_Call {
+func (_c *MockRootCoordClient_ListDroppedCollections_Call) RunAndReturn(run func(context.Context, *internalpb.ListDroppedCollectionsRequest, ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error)) *MockRootCoordClient_ListDroppedCollections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) HasCollection(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+// ListImportTasks provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1835,20 +3165,20 @@ func (_m *MockRootCoordClient) HasCollection(ctx context.Context, in *milvuspb.H
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.BoolResponse
+	var r0 *milvuspb.ListImportTasksResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) *milvuspb.ListImportTasksResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+			r0 = ret.Get(0).(*milvuspb.ListImportTasksResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1857,21 +3187,21 @@ func (_m *MockRootCoordClient) HasCollection(ctx context.Context, in *milvuspb.H
 	return r0, r1
 }
 
-// MockRootCoordClient_HasCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasCollection'
-type MockRootCoordClient_HasCollection_Call struct {
+// MockRootCoordClient_ListImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImportTasks'
+type MockRootCoordClient_ListImportTasks_Call struct {
 	*mock.Call
 }
 
-// HasCollection is a helper method to define mock.On call
+// ListImportTasks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.HasCollectionRequest
+//   - in *milvuspb.ListImportTasksRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) HasCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasCollection_Call {
-	return &MockRootCoordClient_HasCollection_Call{Call: _e.mock.On("HasCollection",
+func (_e *MockRootCoordClient_Expecter) ListImportTasks(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListImportTasks_Call {
+	return &MockRootCoordClient_ListImportTasks_Call{Call: _e.mock.On("ListImportTasks",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_HasCollection_Call) Run(run func(ctx context.Context, in *milvuspb.HasCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasCollection_Call {
+func (_c *MockRootCoordClient_ListImportTasks_Call) Run(run func(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListImportTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1879,23 +3209,23 @@ func (_c *MockRootCoordClient_HasCollection_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.HasCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.ListImportTasksRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasCollection_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasCollection_Call {
+func (_c *MockRootCoordClient_ListImportTasks_Call) Return(_a0 *milvuspb.ListImportTasksResponse, _a1 error) *MockRootCoordClient_ListImportTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.HasCollectionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasCollection_Call {
+func (_c *MockRootCoordClient_ListImportTasks_Call) RunAndReturn(run func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)) *MockRootCoordClient_ListImportTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasPartition provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption) (*milvuspb.BoolResponse, error) {
+// ListPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1905,20 +3235,20 @@ func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.Ha
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.BoolResponse
+	var r0 *internalpb.ListPolicyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) *milvuspb.BoolResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) *internalpb.ListPolicyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.BoolResponse)
+			r0 = ret.Get(0).(*internalpb.ListPolicyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1927,21 +3257,21 @@ func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.Ha
 	return r0, r1
 }
 
-// MockRootCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
-type MockRootCoordClient_HasPartition_Call struct {
+// MockRootCoordClient_ListPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicy'
+type MockRootCoordClient_ListPolicy_Call struct {
 	*mock.Call
 }
 
-// HasPartition is a helper method to define mock.On call
+// ListPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.HasPartitionRequest
+//   - in *internalpb.ListPolicyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasPartition_Call {
-	return &MockRootCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+func (_e *MockRootCoordClient_Expecter) ListPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListPolicy_Call {
+	return &MockRootCoordClient_ListPolicy_Call{Call: _e.mock.On("ListPolicy",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_ListPolicy_Call) Run(run func(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1949,23 +3279,23 @@ func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListPolicyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_ListPolicy_Call) Return(_a0 *internalpb.ListPolicyResponse, _a1 error) *MockRootCoordClient_ListPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_ListPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)) *MockRootCoordClient_ListPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Import provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+// ListRowPolicies provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1975,20 +3305,20 @@ func (_m *MockRootCoordClient) Import(ctx context.Context, in *milvuspb.ImportRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ImportResponse
+	var r0 *internalpb.ListRowPoliciesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) (*milvuspb.ImportResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) *milvuspb.ImportResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) *internalpb.ListRowPoliciesResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ImportResponse)
+			r0 = ret.Get(0).(*internalpb.ListRowPoliciesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1997,21 +3327,21 @@ func (_m *MockRootCoordClient) Import(ctx context.Context, in *milvuspb.ImportRe
 	return r0, r1
 }
 
-// MockRootCoordClient_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
-type MockRootCoordClient_Import_Call struct {
+// MockRootCoordClient_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type MockRootCoordClient_ListRowPolicies_Call struct {
 	*mock.Call
 }
 
-// Import is a helper method to define mock.On call
+// ListRowPolicies is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ImportRequest
+//   - in *internalpb.ListRowPoliciesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) Import(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_Import_Call {
-	return &MockRootCoordClient_Import_Call{Call: _e.mock.On("Import",
+func (_e *MockRootCoordClient_Expecter) ListRowPolicies(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListRowPolicies_Call {
+	return &MockRootCoordClient_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_Import_Call) Run(run func(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption)) *MockRootCoordClient_Import_Call {
+func (_c *MockRootCoordClient_ListRowPolicies_Call) Run(run func(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListRowPolicies_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2019,23 +3349,23 @@ func (_c *MockRootCoordClient_Import_Call) Run(run func(ctx context.Context, in
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ImportRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListRowPoliciesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_Import_Call) Return(_a0 *milvuspb.ImportResponse, _a1 error) *MockRootCoordClient_Import_Call {
+func (_c *MockRootCoordClient_ListRowPolicies_Call) Return(_a0 *internalpb.ListRowPoliciesResponse, _a1 error) *MockRootCoordClient_ListRowPolicies_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_Import_Call) RunAndReturn(run func(context.Context, *milvuspb.ImportRequest, ...grpc.CallOption) (*milvuspb.ImportResponse, error)) *MockRootCoordClient_Import_Call {
+func (_c *MockRootCoordClient_ListRowPolicies_Call) RunAndReturn(run func(context.Context, *internalpb.ListRowPoliciesRequest, ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error)) *MockRootCoordClient_ListRowPolicies_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ListSnapshots provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2045,20 +3375,20 @@ func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *internalpb.ListSnapshotsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) *internalpb.ListSnapshotsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*internalpb.ListSnapshotsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2067,21 +3397,21 @@ func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context
 	return r0, r1
 }
 
-// MockRootCoordClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
-type MockRootCoordClient_InvalidateCollectionMetaCache_Call struct {
+// MockRootCoordClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockRootCoordClient_ListSnapshots_Call struct {
 	*mock.Call
 }
 
-// InvalidateCollectionMetaCache is a helper method to define mock.On call
+// ListSnapshots is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.InvalidateCollMetaCacheRequest
+//   - in *internalpb.ListSnapshotsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
-	return &MockRootCoordClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
+func (_e *MockRootCoordClient_Expecter) ListSnapshots(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListSnapshots_Call {
+	return &MockRootCoordClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2089,23 +3419,23 @@ func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(c
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListSnapshotsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListSnapshots_Call) Return(_a0 *internalpb.ListSnapshotsResponse, _a1 error) *MockRootCoordClient_ListSnapshots_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListSnapshots_Call) RunAndReturn(run func(context.Context, *internalpb.ListSnapshotsRequest, ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error)) *MockRootCoordClient_ListSnapshots_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListCredUsers provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error) {
+// OperatePrivilege provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2115,20 +3445,20 @@ func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.L
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ListCredUsersResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) *milvuspb.ListCredUsersResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListCredUsersResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2137,21 +3467,21 @@ func (_m *MockRootCoordClient) ListCredUsers(ctx context.Context, in *milvuspb.L
 	return r0, r1
 }
 
-// MockRootCoordClient_ListCredUsers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCredUsers'
-type MockRootCoordClient_ListCredUsers_Call struct {
+// MockRootCoordClient_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
+type MockRootCoordClient_OperatePrivilege_Call struct {
 	*mock.Call
 }
 
-// ListCredUsers is a helper method to define mock.On call
+// OperatePrivilege is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ListCredUsersRequest
+//   - in *milvuspb.OperatePrivilegeRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) ListCredUsers(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListCredUsers_Call {
-	return &MockRootCoordClient_ListCredUsers_Call{Call: _e.mock.On("ListCredUsers",
+func (_e *MockRootCoordClient_Expecter) OperatePrivilege(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperatePrivilege_Call {
+	return &MockRootCoordClient_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_ListCredUsers_Call) Run(run func(ctx context.Context, in *milvuspb.ListCredUsersRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListCredUsers_Call {
+func (_c *MockRootCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperatePrivilege_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2159,23 +3489,23 @@ func (_c *MockRootCoordClient_ListCredUsers_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ListCredUsersRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListCredUsers_Call) Return(_a0 *milvuspb.ListCredUsersResponse, _a1 error) *MockRootCoordClient_ListCredUsers_Call {
+func (_c *MockRootCoordClient_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperatePrivilege_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListCredUsers_Call) RunAndReturn(run func(context.Context, *milvuspb.ListCredUsersRequest, ...grpc.CallOption) (*milvuspb.ListCredUsersResponse, error)) *MockRootCoordClient_ListCredUsers_Call {
+func (_c *MockRootCoordClient_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperatePrivilege_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListDatabases provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+// OperateUserRole provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2185,20 +3515,20 @@ func (_m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.L
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ListDatabasesResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) *milvuspb.ListDatabasesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListDatabasesResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2207,21 +3537,21 @@ func (_m *MockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.L
 	return r0, r1
 }
 
-// MockRootCoordClient_ListDatabases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDatabases'
-type MockRootCoordClient_ListDatabases_Call struct {
+// MockRootCoordClient_OperateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateUserRole'
+type MockRootCoordClient_OperateUserRole_Call struct {
 	*mock.Call
 }
 
-// ListDatabases is a helper method to define mock.On call
+// OperateUserRole is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ListDatabasesRequest
+//   - in *milvuspb.OperateUserRoleRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) ListDatabases(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListDatabases_Call {
-	return &MockRootCoordClient_ListDatabases_Call{Call: _e.mock.On("ListDatabases",
+func (_e *MockRootCoordClient_Expecter) OperateUserRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateUserRole_Call {
+	return &MockRootCoordClient_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_ListDatabases_Call) Run(run func(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListDatabases_Call {
+func (_c *MockRootCoordClient_OperateUserRole_Call) Run(run func(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateUserRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2229,23 +3559,23 @@ func (_c *MockRootCoordClient_ListDatabases_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ListDatabasesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListDatabases_Call) Return(_a0 *milvuspb.ListDatabasesResponse, _a1 error) *MockRootCoordClient_ListDatabases_Call {
+func (_c *MockRootCoordClient_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperateUserRole_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListDatabases_Call) RunAndReturn(run func(context.Context, *milvuspb.ListDatabasesRequest, ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)) *MockRootCoordClient_ListDatabases_Call {
+func (_c *MockRootCoordClient_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperateUserRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListImportTasks provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) ListImportTasks(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error) {
+// RenameCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2255,20 +3585,20 @@ func (_m *MockRootCoordClient) ListImportTasks(ctx context.Context, in *milvuspb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ListImportTasksResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) *milvuspb.ListImportTasksResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ListImportTasksResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2277,21 +3607,21 @@ func (_m *MockRootCoordClient) ListImportTasks(ctx context.Context, in *milvuspb
 	return r0, r1
 }
 
-// MockRootCoordClient_ListImportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImportTasks'
-type MockRootCoordClient_ListImportTasks_Call struct {
+// MockRootCoordClient_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
+type MockRootCoordClient_RenameCollection_Call struct {
 	*mock.Call
 }
 
-// ListImportTasks is a helper method to define mock.On call
+// RenameCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.ListImportTasksRequest
+//   - in *milvuspb.RenameCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) ListImportTasks(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListImportTasks_Call {
-	return &MockRootCoordClient_ListImportTasks_Call{Call: _e.mock.On("ListImportTasks",
+func (_e *MockRootCoordClient_Expecter) RenameCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RenameCollection_Call {
+	return &MockRootCoordClient_RenameCollection_Call{Call: _e.mock.On("RenameCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_ListImportTasks_Call) Run(run func(ctx context.Context, in *milvuspb.ListImportTasksRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListImportTasks_Call {
+func (_c *MockRootCoordClient_RenameCollection_Call) Run(run func(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RenameCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2299,23 +3629,23 @@ func (_c *MockRootCoordClient_ListImportTasks_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.ListImportTasksRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*milvuspb.RenameCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListImportTasks_Call) Return(_a0 *milvuspb.ListImportTasksResponse, _a1 error) *MockRootCoordClient_ListImportTasks_Call {
+func (_c *MockRootCoordClient_RenameCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RenameCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListImportTasks_Call) RunAndReturn(run func(context.Context, *milvuspb.ListImportTasksRequest, ...grpc.CallOption) (*milvuspb.ListImportTasksResponse, error)) *MockRootCoordClient_ListImportTasks_Call {
+func (_c *MockRootCoordClient_RenameCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RenameCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListPolicy provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error) {
+// ReportImport provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2325,20 +3655,20 @@ func (_m *MockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Li
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ListPolicyResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) *internalpb.ListPolicyResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ListPolicyResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2347,21 +3677,21 @@ func (_m *MockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Li
 	return r0, r1
 }
 
-// MockRootCoordClient_ListPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicy'
-type MockRootCoordClient_ListPolicy_Call struct {
+// MockRootCoordClient_ReportImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportImport'
+type MockRootCoordClient_ReportImport_Call struct {
 	*mock.Call
 }
 
-// ListPolicy is a helper method to define mock.On call
+// ReportImport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ListPolicyRequest
+//   - in *rootcoordpb.ImportResult
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) ListPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListPolicy_Call {
-	return &MockRootCoordClient_ListPolicy_Call{Call: _e.mock.On("ListPolicy",
+func (_e *MockRootCoordClient_Expecter) ReportImport(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ReportImport_Call {
+	return &MockRootCoordClient_ReportImport_Call{Call: _e.mock.On("ReportImport",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_ListPolicy_Call) Run(run func(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListPolicy_Call {
+func (_c *MockRootCoordClient_ReportImport_Call) Run(run func(ctx context.Context, in *rootcoordpb.ImportResult, opts ...grpc.CallOption)) *MockRootCoordClient_ReportImport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2369,23 +3699,23 @@ func (_c *MockRootCoordClient_ListPolicy_Call) Run(run func(ctx context.Context,
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ListPolicyRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*rootcoordpb.ImportResult), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListPolicy_Call) Return(_a0 *internalpb.ListPolicyResponse, _a1 error) *MockRootCoordClient_ListPolicy_Call {
+func (_c *MockRootCoordClient_ReportImport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_ReportImport_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_ListPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.ListPolicyRequest, ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)) *MockRootCoordClient_ListPolicy_Call {
+func (_c *MockRootCoordClient_ReportImport_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_ReportImport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperatePrivilege provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ReportLoginFailure provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ReportLoginFailure(ctx context.Context, in *internalpb.ReportLoginFailureRequest, opts ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2395,20 +3725,20 @@ func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvusp
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *internalpb.ReportLoginFailureResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ReportLoginFailureRequest, ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ReportLoginFailureRequest, ...grpc.CallOption) *internalpb.ReportLoginFailureResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*internalpb.ReportLoginFailureResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ReportLoginFailureRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2417,21 +3747,21 @@ func (_m *MockRootCoordClient) OperatePrivilege(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockRootCoordClient_OperatePrivilege_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperatePrivilege'
-type MockRootCoordClient_OperatePrivilege_Call struct {
+// MockRootCoordClient_ReportLoginFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportLoginFailure'
+type MockRootCoordClient_ReportLoginFailure_Call struct {
 	*mock.Call
 }
 
-// OperatePrivilege is a helper method to define mock.On call
+// ReportLoginFailure is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.OperatePrivilegeRequest
+//   - in *internalpb.ReportLoginFailureRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) OperatePrivilege(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperatePrivilege_Call {
-	return &MockRootCoordClient_OperatePrivilege_Call{Call: _e.mock.On("OperatePrivilege",
+func (_e *MockRootCoordClient_Expecter) ReportLoginFailure(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ReportLoginFailure_Call {
+	return &MockRootCoordClient_ReportLoginFailure_Call{Call: _e.mock.On("ReportLoginFailure",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Context, in *milvuspb.OperatePrivilegeRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperatePrivilege_Call {
+func (_c *MockRootCoordClient_ReportLoginFailure_Call) Run(run func(ctx context.Context, in *internalpb.ReportLoginFailureRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ReportLoginFailure_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2439,23 +3769,23 @@ func (_c *MockRootCoordClient_OperatePrivilege_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.OperatePrivilegeRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ReportLoginFailureRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_OperatePrivilege_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperatePrivilege_Call {
+func (_c *MockRootCoordClient_ReportLoginFailure_Call) Return(_a0 *internalpb.ReportLoginFailureResponse, _a1 error) *MockRootCoordClient_ReportLoginFailure_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_OperatePrivilege_Call) RunAndReturn(run func(context.Context, *milvuspb.OperatePrivilegeRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperatePrivilege_Call {
+func (_c *MockRootCoordClient_ReportLoginFailure_Call) RunAndReturn(run func(context.Context, *internalpb.ReportLoginFailureRequest, ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error)) *MockRootCoordClient_ReportLoginFailure_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OperateUserRole provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RestoreSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2467,10 +3797,10 @@ func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2478,7 +3808,7 @@ func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2487,21 +3817,21 @@ func (_m *MockRootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb
 	return r0, r1
 }
 
-// MockRootCoordClient_OperateUserRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OperateUserRole'
-type MockRootCoordClient_OperateUserRole_Call struct {
+// MockRootCoordClient_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockRootCoordClient_RestoreSnapshot_Call struct {
 	*mock.Call
 }
 
-// OperateUserRole is a helper method to define mock.On call
+// RestoreSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.OperateUserRoleRequest
+//   - in *internalpb.RestoreSnapshotRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) OperateUserRole(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_OperateUserRole_Call {
-	return &MockRootCoordClient_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole",
+func (_e *MockRootCoordClient_Expecter) RestoreSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RestoreSnapshot_Call {
+	return &MockRootCoordClient_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_OperateUserRole_Call) Run(run func(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption)) *MockRootCoordClient_OperateUserRole_Call {
+func (_c *MockRootCoordClient_RestoreSnapshot_Call) Run(run func(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RestoreSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2509,23 +3839,23 @@ func (_c *MockRootCoordClient_OperateUserRole_Call) Run(run func(ctx context.Con
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.RestoreSnapshotRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_OperateUserRole_Call {
+func (_c *MockRootCoordClient_RestoreSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RestoreSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_OperateUserRole_Call {
+func (_c *MockRootCoordClient_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *internalpb.RestoreSnapshotRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RestoreSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RenameCollection provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RevokeAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RevokeAPIKey(ctx context.Context, in *internalpb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2537,10 +3867,10 @@ func (_m *MockRootCoordClient) RenameCollection(ctx context.Context, in *milvusp
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeAPIKeyRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2548,7 +3878,7 @@ func (_m *MockRootCoordClient) RenameCollection(ctx context.Context, in *milvusp
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeAPIKeyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2557,21 +3887,21 @@ func (_m *MockRootCoordClient) RenameCollection(ctx context.Context, in *milvusp
 	return r0, r1
 }
 
-// MockRootCoordClient_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
-type MockRootCoordClient_RenameCollection_Call struct {
+// MockRootCoordClient_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockRootCoordClient_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// RenameCollection is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.RenameCollectionRequest
+//   - in *internalpb.RevokeAPIKeyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) RenameCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RenameCollection_Call {
-	return &MockRootCoordClient_RenameCollection_Call{Call: _e.mock.On("RenameCollection",
+func (_e *MockRootCoordClient_Expecter) RevokeAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RevokeAPIKey_Call {
+	return &MockRootCoordClient_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_RenameCollection_Call) Run(run func(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RenameCollection_Call {
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) Run(run func(ctx context.Context, in *internalpb.RevokeAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2579,23 +3909,23 @@ func (_c *MockRootCoordClient_RenameCollection_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.RenameCollectionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeAPIKeyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_RenameCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RenameCollection_Call {
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RevokeAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_RenameCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.RenameCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RenameCollection_Call {
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReportImport provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) ReportImport(ctx context.Context, in *rootcoordpb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RevokeRowPolicy provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RevokeRowPolicy(ctx context.Context, in *internalpb.RevokeRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -2607,10 +3937,10 @@ func (_m *MockRootCoordClient) ReportImport(ctx context.Context, in *rootcoordpb
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.RevokeRowPolicyRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -2618,7 +3948,7 @@ func (_m *MockRootCoordClient) ReportImport(ctx context.Context, in *rootcoordpb
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.RevokeRowPolicyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -2627,21 +3957,21 @@ func (_m *MockRootCoordClient) ReportImport(ctx context.Context, in *rootcoordpb
 	return r0, r1
 }
 
-// MockRootCoordClient_ReportImport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportImport'
-type MockRootCoordClient_ReportImport_Call struct {
+// MockRootCoordClient_RevokeRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeRowPolicy'
+type MockRootCoordClient_RevokeRowPolicy_Call struct {
 	*mock.Call
 }
 
-// ReportImport is a helper method to define mock.On call
+// RevokeRowPolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *rootcoordpb.ImportResult
+//   - in *internalpb.RevokeRowPolicyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) ReportImport(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ReportImport_Call {
-	return &MockRootCoordClient_ReportImport_Call{Call: _e.mock.On("ReportImport",
+func (_e *MockRootCoordClient_Expecter) RevokeRowPolicy(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RevokeRowPolicy_Call {
+	return &MockRootCoordClient_RevokeRowPolicy_Call{Call: _e.mock.On("RevokeRowPolicy",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_ReportImport_Call) Run(run func(ctx context.Context, in *rootcoordpb.ImportResult, opts ...grpc.CallOption)) *MockRootCoordClient_ReportImport_Call {
+func (_c *MockRootCoordClient_RevokeRowPolicy_Call) Run(run func(ctx context.Context, in *internalpb.RevokeRowPolicyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RevokeRowPolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2649,17 +3979,17 @@ func (_c *MockRootCoordClient_ReportImport_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*rootcoordpb.ImportResult), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.RevokeRowPolicyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_ReportImport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_ReportImport_Call {
+func (_c *MockRootCoordClient_RevokeRowPolicy_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RevokeRowPolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_ReportImport_Call) RunAndReturn(run func(context.Context, *rootcoordpb.ImportResult, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_ReportImport_Call {
+func (_c *MockRootCoordClient_RevokeRowPolicy_Call) RunAndReturn(run func(context.Context, *internalpb.RevokeRowPolicyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RevokeRowPolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -3224,6 +4554,146 @@ func (_c *MockRootCoordClient_ShowSegments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) UndropCollection(ctx context.Context, in *internalpb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type MockRootCoordClient_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.UndropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) UndropCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_UndropCollection_Call {
+	return &MockRootCoordClient_UndropCollection_Call{Call: _e.mock.On("UndropCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) Run(run func(ctx context.Context, in *internalpb.UndropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.UndropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) RunAndReturn(run func(context.Context, *internalpb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndropPartition provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) UndropPartition(ctx context.Context, in *internalpb.UndropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.UndropPartitionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.UndropPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_UndropPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropPartition'
+type MockRootCoordClient_UndropPartition_Call struct {
+	*mock.Call
+}
+
+// UndropPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.UndropPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) UndropPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_UndropPartition_Call {
+	return &MockRootCoordClient_UndropPartition_Call{Call: _e.mock.On("UndropPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_UndropPartition_Call) Run(run func(ctx context.Context, in *internalpb.UndropPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_UndropPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.UndropPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropPartition_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_UndropPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropPartition_Call) RunAndReturn(run func(context.Context, *internalpb.UndropPartitionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_UndropPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -0,0 +1,437 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/requestutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// the event classes of the audit records
+const (
+	AuditClassDDL  = "ddl"
+	AuditClassRBAC = "rbac"
+	AuditClassDML  = "dml"
+	AuditClassDQL  = "dql"
+	AuditClassAuth = "auth"
+)
+
+// the class and the method of the record which starts a new hash chain, as the latest record is corrupt
+const (
+	auditChainBreakClass  = "audit"
+	auditChainBreakMethod = "ChainBreak"
+)
+
+// the max number of the sealed audit log files waiting to be uploaded
+const auditUploadQueueLen = 16
+
+var auditMethodClasses = map[string]string{
+	"CreateCollection":    AuditClassDDL,
+	"DropCollection":      AuditClassDDL,
+	"AlterCollection":     AuditClassDDL,
+	"AddCollectionField":  AuditClassDDL,
+	"CloneCollection":     AuditClassDDL,
+	"UndropCollection":    AuditClassDDL,
+	"RenameCollection":    AuditClassDDL,
+	"LoadCollection":      AuditClassDDL,
+	"ReleaseCollection":   AuditClassDDL,
+	"CreatePartition":     AuditClassDDL,
+	"DropPartition":       AuditClassDDL,
+	"UndropPartition":     AuditClassDDL,
+	"CreateSnapshot":      AuditClassDDL,
+	"DropSnapshot":        AuditClassDDL,
+	"RestoreSnapshot":     AuditClassDDL,
+	"LoadPartitions":      AuditClassDDL,
+	"ReleasePartitions":   AuditClassDDL,
+	"CreateIndex":         AuditClassDDL,
+	"DropIndex":           AuditClassDDL,
+	"CreateAlias":         AuditClassDDL,
+	"DropAlias":           AuditClassDDL,
+	"AlterAlias":          AuditClassDDL,
+	"BatchAlterAliases":   AuditClassDDL,
+	"CreateDatabase":      AuditClassDDL,
+	"DropDatabase":        AuditClassDDL,
+	"AlterDatabase":       AuditClassDDL,
+	"Flush":               AuditClassDDL,
+	"FlushAll":            AuditClassDDL,
+	"ManualCompaction":    AuditClassDDL,
+	"LoadBalance":         AuditClassDDL,
+	"CreateResourceGroup": AuditClassDDL,
+	"DropResourceGroup":   AuditClassDDL,
+	"TransferNode":        AuditClassDDL,
+	"TransferReplica":     AuditClassDDL,
+
+	"CreateCredential": AuditClassRBAC,
+	"UpdateCredential": AuditClassRBAC,
+	"DeleteCredential": AuditClassRBAC,
+	"ListCredUsers":    AuditClassRBAC,
+	"CreateAPIKey":     AuditClassRBAC,
+	"ListAPIKeys":      AuditClassRBAC,
+	"RevokeAPIKey":     AuditClassRBAC,
+	"CreateRole":       AuditClassRBAC,
+	"DropRole":         AuditClassRBAC,
+	"OperateUserRole":  AuditClassRBAC,
+	"SelectRole":       AuditClassRBAC,
+	"SelectUser":       AuditClassRBAC,
+	"OperatePrivilege": AuditClassRBAC,
+	"SelectGrant":      AuditClassRBAC,
+	"GrantRowPolicy":   AuditClassRBAC,
+	"RevokeRowPolicy":  AuditClassRBAC,
+	"ListRowPolicies":  AuditClassRBAC,
+
+	"Insert": AuditClassDML,
+	"Delete": AuditClassDML,
+	"Upsert": AuditClassDML,
+	"Import": AuditClassDML,
+
+	"Search":      AuditClassDQL,
+	"Query":       AuditClassDQL,
+	"QueryStream": AuditClassDQL,
+}
+
+// GetAuditClass returns the event class of the grpc method, or empty if the method isn't audited
+func GetAuditClass(fullMethod string) string {
+	_, methodName := path.Split(fullMethod)
+	return auditMethodClasses[methodName]
+}
+
+var (
+	_globalAudit atomic.Value
+	auditOnce    sync.Once
+)
+
+func SetupAuditLog(logCfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig) {
+	auditOnce.Do(func() {
+		_, err := InitAuditLogger(logCfg, minioCfg)
+		if err != nil {
+			log.Fatal("initialize audit logger error", zap.Error(err))
+		}
+	})
+}
+
+// InitAuditLogger initializes the audit logger for proxy, the hash chain continues from the latest local audit record
+func InitAuditLogger(logCfg *paramtable.AuditLogConfig, minioCfg *paramtable.MinioConfig) (*AuditLogger, error) {
+	if !logCfg.Enable.GetAsBool() {
+		return nil, nil
+	}
+
+	var writer io.Writer = os.Stdout
+	var prevHash string
+	var chainErr error
+	if len(logCfg.Filename.GetValue()) > 0 {
+		lg := &RotateLogger{
+			localPath:   logCfg.LocalPath.GetValue(),
+			fileName:    logCfg.Filename.GetValue(),
+			rotatedTime: logCfg.RotatedTime.GetAsInt64(),
+			maxSize:     logCfg.MaxSize.GetAsInt(),
+			maxBackups:  logCfg.MaxBackups.GetAsInt(),
+		}
+		log.Info("Audit log save to " + lg.dir())
+		prevHash, chainErr = lastAuditHash(lg)
+		if logCfg.MinioEnable.GetAsBool() {
+			if err := lg.enableMinio(minioCfg, logCfg.RemotePath.GetValue(), logCfg.RemoteMaxTime.GetAsInt(), auditUploadQueueLen); err != nil {
+				return nil, err
+			}
+		}
+		lg.start()
+		writer = lg
+	}
+
+	logger := NewAuditLogger(writer, prevHash, logCfg.EventClasses.GetAsStrings())
+	if chainErr != nil {
+		// the new chain starts from an explicit record, so that the break is never mistaken for a removal
+		log.Warn("the latest audit record is corrupt, start a new hash chain", zap.Error(chainErr))
+		if err := logger.Write(newChainBreakRecord(chainErr)); err != nil {
+			return nil, err
+		}
+	}
+	_globalAudit.Store(logger)
+	return logger, nil
+}
+
+// newChainBreakRecord returns the first record of the new hash chain started since the latest record is corrupt.
+func newChainBreakRecord(cause error) *AuditRecord {
+	return &AuditRecord{
+		Time:       time.Now().Format(time.RFC3339Nano),
+		Class:      auditChainBreakClass,
+		Method:     auditChainBreakMethod,
+		Result:     "ChainBroken",
+		Reason:     cause.Error(),
+		ClientAddr: "Unknown",
+	}
+}
+
+// AuditRecord is a record of the audit log written as a json line. It's chained to the previous record by the hash,
+// so that any modification, removal or reordering of the records breaks the chain.
+type AuditRecord struct {
+	Time       string `json:"time"`
+	Class      string `json:"class"`
+	User       string `json:"user"`
+	Method     string `json:"method"`
+	Database   string `json:"database,omitempty"`
+	Collection string `json:"collection,omitempty"`
+	Result     string `json:"result"`
+	ErrorCode  int32  `json:"errorCode"`
+	Reason     string `json:"reason,omitempty"`
+	ClientAddr string `json:"clientAddr"`
+	TraceID    string `json:"traceId"`
+	PrevHash   string `json:"prevHash"`
+	Hash       string `json:"hash,omitempty"`
+}
+
+// computeHash returns the sha256 of the record without the hash, the previous hash included
+func (r *AuditRecord) computeHash() (string, error) {
+	record := *r
+	record.Hash = ""
+	data, err := json.Marshal(&record)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+type AuditLogger struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	prevHash string
+	classes  typeutil.Set[string]
+}
+
+func NewAuditLogger(writer io.Writer, prevHash string, classes []string) *AuditLogger {
+	classSet := typeutil.NewSet[string]()
+	for _, class := range classes {
+		if class = strings.TrimSpace(class); class != "" {
+			classSet.Insert(strings.ToLower(class))
+		}
+	}
+	return &AuditLogger{
+		writer:   writer,
+		prevHash: prevHash,
+		classes:  classSet,
+	}
+}
+
+// Audit writes the audit record of the grpc request if its event class is enabled
+func (a *AuditLogger) Audit(ctx context.Context, user string, req interface{}, resp interface{}, err error, fullMethod string) bool {
+	class := GetAuditClass(fullMethod)
+	if class == "" || !a.classes.Contain(class) {
+		return false
+	}
+
+	_, methodName := path.Split(fullMethod)
+	traceID, _ := getTraceID(ctx)
+	record := &AuditRecord{
+		Time:       time.Now().Format(time.RFC3339Nano),
+		Class:      class,
+		User:       user,
+		Method:     methodName,
+		Result:     getGrpcStatus(err),
+		ErrorCode:  merr.Code(err),
+		ClientAddr: getAccessAddr(ctx),
+		TraceID:    traceID,
+	}
+	if dbName, ok := requestutil.GetDbNameFromRequest(req); ok {
+		record.Database, _ = dbName.(string)
+	}
+	if collectionName, ok := requestutil.GetCollectionNameFromRequest(req); ok {
+		record.Collection, _ = collectionName.(string)
+	}
+	if err != nil {
+		record.Reason = err.Error()
+	} else if status, ok := getRespStatus(resp); ok && !merr.Ok(status) {
+		statusErr := merr.Error(status)
+		record.Result = "TaskFailed"
+		record.ErrorCode = merr.Code(statusErr)
+		record.Reason = status.GetReason()
+	}
+
+	if err := a.Write(record); err != nil {
+		log.Warn("audit log print failed", zap.String("method", methodName), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// AuditLoginFailure writes the audit record of the failed login if the auth class is enabled, the method is the one
+// the client called with the rejected credential.
+func (a *AuditLogger) AuditLoginFailure(ctx context.Context, user string, reason string) bool {
+	if !a.classes.Contain(AuditClassAuth) {
+		return false
+	}
+
+	traceID, _ := getTraceID(ctx)
+	record := &AuditRecord{
+		Time:       time.Now().Format(time.RFC3339Nano),
+		Class:      AuditClassAuth,
+		User:       user,
+		Method:     getMethodName(ctx),
+		Result:     "Unauthenticated",
+		ErrorCode:  merr.Code(merr.ErrNeedAuthenticate),
+		Reason:     reason,
+		ClientAddr: getAccessAddr(ctx),
+		TraceID:    traceID,
+	}
+	if err := a.Write(record); err != nil {
+		log.Warn("audit log print failed", zap.String("method", record.Method), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// Write chains the record to the previous one and writes it as a json line
+func (a *AuditLogger) Write(record *AuditRecord) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	record.PrevHash = a.prevHash
+	hash, err := record.computeHash()
+	if err != nil {
+		return err
+	}
+	record.Hash = hash
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := a.writer.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	a.prevHash = hash
+	return nil
+}
+
+func PrintAuditInfo(ctx context.Context, user string, req interface{}, resp interface{}, err error, fullMethod string) bool {
+	logger, ok := _globalAudit.Load().(*AuditLogger)
+	if !ok {
+		return false
+	}
+	return logger.Audit(ctx, user, req, resp, err, fullMethod)
+}
+
+// PrintAuditLoginFailure writes the audit record of the failed login by the global audit logger
+func PrintAuditLoginFailure(ctx context.Context, user string, reason string) bool {
+	logger, ok := _globalAudit.Load().(*AuditLogger)
+	if !ok {
+		return false
+	}
+	return logger.AuditLoginFailure(ctx, user, reason)
+}
+
+// VerifyAuditLog checks the hash chain of the audit records read from r, which must follow the record of prevHash.
+// The first record isn't checked against the previous one if prevHash is empty. It returns the hash of the last
+// record, so that the rotated log files could be verified one by one in order.
+func VerifyAuditLog(r io.Reader, prevHash string) (string, error) {
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return prevHash, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			record := &AuditRecord{}
+			if err := json.Unmarshal(line, record); err != nil {
+				return prevHash, fmt.Errorf("invalid audit record at line %d: %w", lineNum, err)
+			}
+			if prevHash != "" && record.Class == auditChainBreakClass && record.PrevHash == "" {
+				return prevHash, fmt.Errorf("audit hash chain is broken before line %d: %s", lineNum, record.Reason)
+			}
+			if prevHash != "" && record.PrevHash != prevHash {
+				return prevHash, fmt.Errorf("audit record at line %d isn't chained to the previous record", lineNum)
+			}
+			hash, err := record.computeHash()
+			if err != nil {
+				return prevHash, err
+			}
+			if hash != record.Hash {
+				return prevHash, fmt.Errorf("audit record at line %d has been modified", lineNum)
+			}
+			prevHash = record.Hash
+		}
+		if err == io.EOF {
+			return prevHash, nil
+		}
+	}
+}
+
+// lastAuditHash returns the hash of the latest audit record in the local log files, or empty if there is none.
+// It fails if the latest record is corrupt, like being truncated or modified.
+func lastAuditHash(l *RotateLogger) (string, error) {
+	fileNames := []string{l.fileName}
+	oldFiles, err := l.oldLogFiles()
+	if err == nil {
+		sort.Slice(oldFiles, func(i, j int) bool {
+			return oldFiles[i].timestamp.After(oldFiles[j].timestamp)
+		})
+		for _, f := range oldFiles {
+			fileNames = append(fileNames, f.fileName)
+		}
+	}
+
+	for _, fileName := range fileNames {
+		data, err := os.ReadFile(path.Join(l.dir(), fileName))
+		if err != nil {
+			continue
+		}
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 {
+			continue
+		}
+		record := &AuditRecord{}
+		if err := json.Unmarshal(data[bytes.LastIndexByte(data, '\n')+1:], record); err != nil {
+			return "", fmt.Errorf("invalid audit record at the end of %s: %w", fileName, err)
+		}
+		if hash, err := record.computeHash(); err != nil || hash != record.Hash {
+			return "", fmt.Errorf("audit record at the end of %s has been modified", fileName)
+		}
+		return record.Hash, nil
+	}
+	return "", nil
+}
+
+func getRespStatus(resp interface{}) (*commonpb.Status, bool) {
+	if status, ok := resp.(*commonpb.Status); ok {
+		return status, true
+	}
+	baseResp, ok := resp.(BaseResponse)
+	if !ok {
+		return nil, false
+	}
+	return baseResp.GetStatus(), true
+}
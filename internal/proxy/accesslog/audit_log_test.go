@@ -0,0 +1,193 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditLogger_Audit(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.IPAddr{IP: net.IPv4(127, 0, 0, 1)},
+	})
+	buf := &bytes.Buffer{}
+	logger := NewAuditLogger(buf, "", []string{"ddl", " RBAC"})
+
+	req := &milvuspb.DropCollectionRequest{DbName: "db1", CollectionName: "coll1"}
+	assert.True(t, logger.Audit(ctx, "user1", req, merr.Success(), nil, "/milvus.proto.milvus.MilvusService/DropCollection"))
+	assert.True(t, logger.Audit(ctx, "user1", &milvuspb.CreateRoleRequest{}, merr.Status(merr.ErrPrivilegeNotPermitted), nil, "/milvus.proto.milvus.MilvusService/CreateRole"))
+	// the classes not enabled and the methods not audited
+	assert.False(t, logger.Audit(ctx, "user1", &milvuspb.SearchRequest{}, &milvuspb.SearchResults{}, nil, "/milvus.proto.milvus.MilvusService/Search"))
+	assert.False(t, logger.Audit(ctx, "user1", &milvuspb.GetVersionRequest{}, &milvuspb.GetVersionResponse{}, nil, "/milvus.proto.milvus.MilvusService/GetVersion"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	records := make([]*AuditRecord, 0, len(lines))
+	for _, line := range lines {
+		record := &AuditRecord{}
+		assert.NoError(t, json.Unmarshal([]byte(line), record))
+		records = append(records, record)
+	}
+	assert.Equal(t, AuditClassDDL, records[0].Class)
+	assert.Equal(t, "user1", records[0].User)
+	assert.Equal(t, "DropCollection", records[0].Method)
+	assert.Equal(t, "db1", records[0].Database)
+	assert.Equal(t, "coll1", records[0].Collection)
+	assert.Equal(t, "OK", records[0].Result)
+	assert.Equal(t, "", records[0].PrevHash)
+	assert.NotEmpty(t, records[0].ClientAddr)
+
+	assert.Equal(t, AuditClassRBAC, records[1].Class)
+	assert.Equal(t, "TaskFailed", records[1].Result)
+	assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), records[1].ErrorCode)
+	assert.Equal(t, records[0].Hash, records[1].PrevHash)
+
+	lastHash, err := VerifyAuditLog(strings.NewReader(buf.String()), "")
+	assert.NoError(t, err)
+	assert.Equal(t, records[1].Hash, lastHash)
+}
+
+func TestAuditLogger_AuditLoginFailure(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewAuditLogger(buf, "", []string{AuditClassAuth})
+	assert.True(t, logger.AuditLoginFailure(context.Background(), "user1", "wrong password"))
+	assert.False(t, logger.Audit(context.Background(), "user1", &milvuspb.QueryRequest{}, nil, nil, "QueryStream"))
+
+	// the restful requests carry the client address and the method in the gin context
+	ginCtx := &gin.Context{}
+	ginCtx.Set(ContextClientAddr, "10.0.0.1")
+	ginCtx.Set(ContextMethod, "/v1/vector/query")
+	assert.True(t, logger.AuditLoginFailure(ginCtx, "", "invalid api key"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	record := &AuditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), record))
+	assert.Equal(t, AuditClassAuth, record.Class)
+	assert.Equal(t, "user1", record.User)
+	assert.Equal(t, "Unauthenticated", record.Result)
+	assert.Equal(t, "wrong password", record.Reason)
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), record))
+	assert.Equal(t, "10.0.0.1", record.ClientAddr)
+	assert.Equal(t, "/v1/vector/query", record.Method)
+
+	// the auth class isn't enabled
+	logger = NewAuditLogger(buf, "", []string{AuditClassDQL})
+	assert.False(t, logger.AuditLoginFailure(context.Background(), "user1", "wrong password"))
+	assert.True(t, logger.Audit(context.Background(), "user1", &milvuspb.QueryRequest{}, nil, nil, "QueryStream"))
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := NewAuditLogger(buf, "prev", []string{AuditClassDML})
+	for _, user := range []string{"user1", "user2", "user3"} {
+		assert.NoError(t, logger.Write(&AuditRecord{Class: AuditClassDML, User: user, Method: "Insert"}))
+	}
+	content := buf.String()
+
+	_, err := VerifyAuditLog(strings.NewReader(content), "prev")
+	assert.NoError(t, err)
+	_, err = VerifyAuditLog(strings.NewReader(content), "other")
+	assert.Error(t, err)
+
+	// modified
+	_, err = VerifyAuditLog(strings.NewReader(strings.Replace(content, "user2", "user4", 1)), "prev")
+	assert.ErrorContains(t, err, "line 2")
+
+	// removed
+	lines := strings.SplitAfter(content, "\n")
+	_, err = VerifyAuditLog(strings.NewReader(lines[0]+lines[2]), "prev")
+	assert.ErrorContains(t, err, "line 2")
+
+	// reordered
+	_, err = VerifyAuditLog(strings.NewReader(lines[1]+lines[0]+lines[2]), "")
+	assert.ErrorContains(t, err, "line 2")
+
+	_, err = VerifyAuditLog(strings.NewReader("not json\n"), "")
+	assert.Error(t, err)
+}
+
+func TestAuditLogger_Init(t *testing.T) {
+	var Params paramtable.ComponentParam
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	logger, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	assert.Nil(t, logger)
+
+	testPath := t.TempDir()
+	Params.Save(Params.ProxyCfg.AuditLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AuditLog.LocalPath.Key, testPath)
+	logger, err = InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, logger.Write(&AuditRecord{Class: AuditClassDDL, User: "user1"}))
+	assert.NoError(t, logger.writer.(*RotateLogger).Rotate())
+	assert.True(t, PrintAuditInfo(context.Background(), "user1", &milvuspb.InsertRequest{}, &milvuspb.MutationResult{Status: &commonpb.Status{}}, nil, "Insert"))
+	assert.NoError(t, logger.writer.(*RotateLogger).Close())
+
+	// the hash chain continues after restart
+	restarted, err := InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, logger.prevHash, restarted.prevHash)
+	assert.NoError(t, restarted.writer.(*RotateLogger).Close())
+
+	files, err := os.ReadDir(testPath)
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+	prevHash := ""
+	for _, f := range files {
+		file, err := os.Open(path.Join(testPath, f.Name()))
+		assert.NoError(t, err)
+		prevHash, err = VerifyAuditLog(file, prevHash)
+		assert.NoError(t, err)
+		file.Close()
+	}
+
+	// the corrupt latest record breaks the chain explicitly
+	logFile := path.Join(testPath, Params.ProxyCfg.AuditLog.Filename.GetValue())
+	data, err := os.ReadFile(logFile)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(logFile, bytes.Replace(data, []byte("user1"), []byte("user2"), 1), 0o600))
+	restarted, err = InitAuditLogger(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	assert.NoError(t, restarted.writer.(*RotateLogger).Close())
+	data, err = os.ReadFile(logFile)
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	record := &AuditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), record))
+	assert.Equal(t, auditChainBreakMethod, record.Method)
+	assert.Empty(t, record.PrevHash)
+	assert.Contains(t, record.Reason, "modified")
+	_, err = VerifyAuditLog(strings.NewReader(lines[len(lines)-1]), "prev")
+	assert.ErrorContains(t, err, "broken")
+}
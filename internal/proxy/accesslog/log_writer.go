@@ -96,18 +96,11 @@ func NewRotateLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.Mi
 	}
 	log.Info("Access log save to " + logger.dir())
 	if logCfg.MinioEnable.GetAsBool() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-
 		log.Debug("remtepath", zap.Any("remote", logCfg.RemotePath.GetValue()))
 		log.Debug("maxBackups", zap.Any("maxBackups", logCfg.MaxBackups.GetValue()))
-		handler, err := NewMinioHandler(ctx, minioCfg, logCfg.RemotePath.GetValue(), logCfg.MaxBackups.GetAsInt())
-		if err != nil {
+		if err := logger.enableMinio(minioCfg, logCfg.RemotePath.GetValue(), logCfg.RemoteMaxTime.GetAsInt(), logCfg.MaxBackups.GetAsInt()); err != nil {
 			return nil, err
 		}
-		prefix, ext := logger.prefixAndExt()
-		handler.retentionPolicy = getTimeRetentionFunc(logCfg.RemoteMaxTime.GetAsInt(), prefix, ext)
-		logger.handler = handler
 	}
 
 	logger.start()
@@ -115,6 +108,21 @@ func NewRotateLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.Mi
 	return logger, nil
 }
 
+// enableMinio uploads the sealed log files to minIO, and removes the remote files older than remoteMaxTime hours
+func (l *RotateLogger) enableMinio(minioCfg *paramtable.MinioConfig, remotePath string, remoteMaxTime int, queueLen int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	handler, err := NewMinioHandler(ctx, minioCfg, remotePath, queueLen)
+	if err != nil {
+		return err
+	}
+	prefix, ext := l.prefixAndExt()
+	handler.retentionPolicy = getTimeRetentionFunc(remoteMaxTime, prefix, ext)
+	l.handler = handler
+	return nil
+}
+
 func (l *RotateLogger) Write(p []byte) (n int, err error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -19,6 +19,7 @@ package accesslog
 import (
 	"context"
 	"fmt"
+	"path"
 	"strings"
 	"time"
 
@@ -34,6 +35,13 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 )
 
+// the keys of the client address and the method of the restful requests, which aren't the grpc calls. They're
+// looked up from the gin context of the requests.
+const (
+	ContextClientAddr = "accessClientAddr"
+	ContextMethod     = "accessMethod"
+)
+
 type BaseResponse interface {
 	GetStatus() *commonpb.Status
 }
@@ -66,11 +74,26 @@ func timeFromName(filename, prefix, ext string) (time.Time, error) {
 func getAccessAddr(ctx context.Context) string {
 	ip, ok := peer.FromContext(ctx)
 	if !ok {
+		if addr, ok := ctx.Value(ContextClientAddr).(string); ok {
+			return addr
+		}
 		return "Unknown"
 	}
 	return fmt.Sprintf("%s-%s", ip.Addr.Network(), ip.Addr.String())
 }
 
+// getMethodName returns the name of the grpc method, or the method set by the restful apis.
+func getMethodName(ctx context.Context) string {
+	if fullMethod, ok := grpc.Method(ctx); ok {
+		_, methodName := path.Split(fullMethod)
+		return methodName
+	}
+	if method, ok := ctx.Value(ContextMethod).(string); ok {
+		return method
+	}
+	return "Unknown"
+}
+
 func getTraceID(ctx context.Context) (id string, ok bool) {
 	meta, ok := metadata.FromOutgoingContext(ctx)
 	if ok {
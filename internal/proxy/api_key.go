@@ -0,0 +1,176 @@
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ContextAPIKey is the key of the api key which authenticates the request in the gin context,
+// the http server sets it since the gin context only resolves the string keys.
+const ContextAPIKey = "apiKey"
+
+type apiKeyContextKey struct{}
+
+// NewContextWithAPIKey returns the context carrying the api key which authenticates the request.
+func NewContextWithAPIKey(ctx context.Context, apiKey *internalpb.APIKeyInfo) context.Context {
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+// GetAPIKeyFromContext returns the api key which authenticates the request,
+// it's nil if the request is authenticated by the password or the api key hook.
+func GetAPIKeyFromContext(ctx context.Context) *internalpb.APIKeyInfo {
+	if apiKey, ok := ctx.Value(apiKeyContextKey{}).(*internalpb.APIKeyInfo); ok {
+		return apiKey
+	}
+	if apiKey, ok := ctx.Value(ContextAPIKey).(*internalpb.APIKeyInfo); ok {
+		return apiKey
+	}
+	return nil
+}
+
+// verifyNativeAPIKey verifies the api key created by CreateAPIKey, ok is false if the key is not in the native format
+// or not found, so that the key could be verified by the hook.
+func verifyNativeAPIKey(ctx context.Context, rawToken string) (apiKey *internalpb.APIKeyInfo, ok bool, err error) {
+	id, secret, ok := crypto.ParseAPIKey(rawToken)
+	if !ok || globalMetaCache == nil {
+		return nil, false, nil
+	}
+	apiKey, err = globalMetaCache.GetAPIKey(ctx, id)
+	if err != nil {
+		return nil, false, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(crypto.SHA256(secret, id)), []byte(apiKey.GetSha256Key())) != 1 {
+		return nil, true, merr.WrapErrPrivilegeNotAuthenticated("invalid api key %s", id)
+	}
+	if expireAt := apiKey.GetScope().GetExpireAt(); expireAt > 0 && time.Now().Unix() >= expireAt {
+		return nil, true, merr.WrapErrPrivilegeNotAuthenticated("api key %s expired at %s", id, time.Unix(expireAt, 0).String())
+	}
+	return apiKey, true, nil
+}
+
+// apiKeyReadPrivileges are the privileges permitted to the read-only api keys.
+var apiKeyReadPrivileges = typeutil.NewSet(
+	commonpb.ObjectPrivilege_PrivilegeQuery.String(),
+	commonpb.ObjectPrivilege_PrivilegeSearch.String(),
+	commonpb.ObjectPrivilege_PrivilegeGetStatistics.String(),
+	commonpb.ObjectPrivilege_PrivilegeIndexDetail.String(),
+	commonpb.ObjectPrivilege_PrivilegeGetLoadState.String(),
+	commonpb.ObjectPrivilege_PrivilegeGetLoadingProgress.String(),
+	commonpb.ObjectPrivilege_PrivilegeDescribeCollection.String(),
+	commonpb.ObjectPrivilege_PrivilegeShowCollections.String(),
+	commonpb.ObjectPrivilege_PrivilegeListDatabases.String(),
+	commonpb.ObjectPrivilege_PrivilegeDescribeResourceGroup.String(),
+	commonpb.ObjectPrivilege_PrivilegeListResourceGroups.String(),
+	commonpb.ObjectPrivilege_PrivilegeSelectOwnership.String(),
+	commonpb.ObjectPrivilege_PrivilegeSelectUser.String(),
+)
+
+// apiKeyDatabasePrivileges are the global privileges acting inside a database, which are permitted to the api keys
+// limited to a database, the other global privileges act on the whole cluster.
+var apiKeyDatabasePrivileges = typeutil.NewSet(
+	commonpb.ObjectPrivilege_PrivilegeCreateCollection.String(),
+	commonpb.ObjectPrivilege_PrivilegeDropCollection.String(),
+	commonpb.ObjectPrivilege_PrivilegeDescribeCollection.String(),
+	commonpb.ObjectPrivilege_PrivilegeShowCollections.String(),
+)
+
+func isAPIKeyReadRequest(req interface{}, privilegeExt *commonpb.PrivilegeExt) bool {
+	switch req.(type) {
+	case *milvuspb.GetLoadStateRequest, *milvuspb.GetLoadingProgressRequest:
+		// they share the load privilege with LoadCollection
+		return true
+	}
+	return apiKeyReadPrivileges.Contain(privilegeExt.GetObjectPrivilege().String())
+}
+
+// checkAPIKeyScope checks the request against the scope of the api key which authenticates it, the scope only narrows
+// what the user is permitted by the RBAC, it's checked before the RBAC so that the api keys of root are limited too.
+func checkAPIKeyScope(ctx context.Context, req interface{}, privilegeExt *commonpb.PrivilegeExt) error {
+	apiKey := GetAPIKeyFromContext(ctx)
+	if apiKey == nil {
+		return nil
+	}
+	scope := apiKey.GetScope()
+	privilege := privilegeExt.GetObjectPrivilege().String()
+	if scope.GetReadOnly() && !isAPIKeyReadRequest(req, privilegeExt) {
+		return merr.WrapErrPrivilegeNotPermitted("api key %s is read-only, %s is not permitted", apiKey.GetId(), util.MetaStore2API(privilege))
+	}
+	if scope.GetDbName() == "" {
+		return nil
+	}
+
+	switch privilegeExt.GetObjectType() {
+	case commonpb.ObjectType_User:
+		// the user level requests act on the user itself
+		return nil
+	case commonpb.ObjectType_Global:
+		if !apiKeyDatabasePrivileges.Contain(privilege) {
+			return merr.WrapErrPrivilegeNotPermitted("api key %s is limited to database %s, %s is not permitted",
+				apiKey.GetId(), scope.GetDbName(), util.MetaStore2API(privilege))
+		}
+	}
+	if dbName := getRequestDBName(ctx, req); dbName != scope.GetDbName() {
+		return merr.WrapErrPrivilegeNotPermitted("api key %s is limited to database %s, database %s is not permitted",
+			apiKey.GetId(), scope.GetDbName(), dbName)
+	}
+	if scope.GetCollectionName() == "" {
+		return nil
+	}
+
+	var collectionNames []string
+	switch {
+	case privilegeExt.GetObjectType() == commonpb.ObjectType_Global:
+		r, ok := req.(interface{ GetCollectionName() string })
+		if !ok {
+			return merr.WrapErrPrivilegeNotPermitted("api key %s is limited to collection %s, %s is not permitted",
+				apiKey.GetId(), scope.GetCollectionName(), util.MetaStore2API(privilege))
+		}
+		collectionNames = []string{r.GetCollectionName()}
+	case privilegeExt.GetObjectNameIndexs() != 0:
+		collectionNames = funcutil.GetObjectNames(req, privilegeExt.GetObjectNameIndexs())
+	default:
+		collectionNames = []string{funcutil.GetObjectName(req, privilegeExt.GetObjectNameIndex())}
+	}
+	for _, name := range collectionNames {
+		if name != scope.GetCollectionName() {
+			return merr.WrapErrPrivilegeNotPermitted("api key %s is limited to collection %s, collection %s is not permitted",
+				apiKey.GetId(), scope.GetCollectionName(), name)
+		}
+	}
+	return nil
+}
+
+// apiKeyOwner returns the user whose api keys are managed, it's the current user if username is empty.
+// Only root and the super users manage the api keys of the other users, and no api key manages the api keys.
+func apiKeyOwner(ctx context.Context, username string) (string, error) {
+	if apiKey := GetAPIKeyFromContext(ctx); apiKey != nil {
+		return "", merr.WrapErrPrivilegeNotPermitted("the api keys can't be managed with api key %s", apiKey.GetId())
+	}
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		if username == "" {
+			return "", merr.WrapErrParameterInvalidMsg("username is required when the authorization is disabled")
+		}
+		return username, nil
+	}
+	currentUser, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return "", merr.WrapErrPrivilegeNotAuthenticated("%s", err.Error())
+	}
+	if username == "" || username == currentUser {
+		return currentUser, nil
+	}
+	if isReservedUser(currentUser) {
+		return username, nil
+	}
+	return "", merr.WrapErrPrivilegeNotPermitted("the api keys of user %s can't be managed by user %s", username, currentUser)
+}
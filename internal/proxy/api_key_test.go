@@ -0,0 +1,280 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAPIKeyContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, GetAPIKeyFromContext(ctx))
+
+	apiKey := &internalpb.APIKeyInfo{Id: "key1"}
+	assert.Equal(t, apiKey, GetAPIKeyFromContext(NewContextWithAPIKey(ctx, apiKey)))
+	// the gin context resolves the string keys only
+	assert.Equal(t, apiKey, GetAPIKeyFromContext(context.WithValue(ctx, ContextAPIKey, apiKey))) //nolint:staticcheck
+}
+
+func TestVerifyNativeAPIKey(t *testing.T) {
+	ctx := context.Background()
+	key, id, secret, err := crypto.NewAPIKey()
+	assert.NoError(t, err)
+
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+
+	t.Run("not native", func(t *testing.T) {
+		_, ok, err := verifyNativeAPIKey(ctx, "mockapikey")
+		assert.False(t, ok)
+		assert.NoError(t, err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		cache.EXPECT().GetAPIKey(mock.Anything, id).Return(nil, errors.New("mock")).Once()
+		_, ok, err := verifyNativeAPIKey(ctx, key)
+		assert.False(t, ok)
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong secret", func(t *testing.T) {
+		cache.EXPECT().GetAPIKey(mock.Anything, id).Return(&internalpb.APIKeyInfo{Id: id, Sha256Key: crypto.SHA256(secret, id)}, nil).Once()
+		_, ok, err := verifyNativeAPIKey(ctx, id+".wrong")
+		assert.True(t, ok)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		cache.EXPECT().GetAPIKey(mock.Anything, id).Return(&internalpb.APIKeyInfo{
+			Id:        id,
+			Sha256Key: crypto.SHA256(secret, id),
+			Scope:     &internalpb.APIKeyScope{ExpireAt: time.Now().Add(-time.Minute).Unix()},
+		}, nil).Once()
+		_, ok, err := verifyNativeAPIKey(ctx, key)
+		assert.True(t, ok)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		cache.EXPECT().GetAPIKey(mock.Anything, id).Return(&internalpb.APIKeyInfo{
+			Id:        id,
+			Username:  "alice",
+			Sha256Key: crypto.SHA256(secret, id),
+			Scope:     &internalpb.APIKeyScope{ExpireAt: time.Now().Add(time.Minute).Unix()},
+		}, nil).Once()
+		user, apiKey, err := VerifyAPIKey(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", user)
+		assert.Equal(t, id, apiKey.GetId())
+	})
+}
+
+func TestCheckAPIKeyScope(t *testing.T) {
+	getExt := func(req interface{}) *commonpb.PrivilegeExt {
+		ext, err := funcutil.GetPrivilegeExtObj(req)
+		assert.NoError(t, err)
+		return &ext
+	}
+	check := func(scope *internalpb.APIKeyScope, req interface{}) error {
+		ctx := NewContextWithAPIKey(context.Background(), &internalpb.APIKeyInfo{Id: "key1", Scope: scope})
+		return checkAPIKeyScope(ctx, req, getExt(req))
+	}
+
+	t.Run("no api key", func(t *testing.T) {
+		req := &milvuspb.DropCollectionRequest{CollectionName: "col1"}
+		assert.NoError(t, checkAPIKeyScope(context.Background(), req, getExt(req)))
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		scope := &internalpb.APIKeyScope{ReadOnly: true}
+		assert.NoError(t, check(scope, &milvuspb.SearchRequest{CollectionName: "col1"}))
+		assert.NoError(t, check(scope, &milvuspb.QueryRequest{CollectionName: "col1"}))
+		assert.NoError(t, check(scope, &milvuspb.ShowCollectionsRequest{}))
+		assert.NoError(t, check(scope, &milvuspb.GetLoadStateRequest{CollectionName: "col1"}))
+		assert.ErrorIs(t, check(scope, &milvuspb.InsertRequest{CollectionName: "col1"}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.LoadCollectionRequest{CollectionName: "col1"}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.CreateRoleRequest{}), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("database", func(t *testing.T) {
+		scope := &internalpb.APIKeyScope{DbName: "db1"}
+		assert.NoError(t, check(scope, &milvuspb.InsertRequest{DbName: "db1", CollectionName: "col1"}))
+		assert.NoError(t, check(scope, &milvuspb.CreateCollectionRequest{DbName: "db1", CollectionName: "col2"}))
+		assert.NoError(t, check(scope, &milvuspb.UpdateCredentialRequest{Username: "alice"}))
+		assert.ErrorIs(t, check(scope, &milvuspb.InsertRequest{DbName: "db2", CollectionName: "col1"}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.InsertRequest{CollectionName: "col1"}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.ListDatabasesRequest{}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.CreateResourceGroupRequest{}), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		scope := &internalpb.APIKeyScope{DbName: util.DefaultDBName, CollectionName: "col1"}
+		assert.NoError(t, check(scope, &milvuspb.SearchRequest{CollectionName: "col1"}))
+		assert.NoError(t, check(scope, &milvuspb.DescribeCollectionRequest{CollectionName: "col1"}))
+		assert.NoError(t, check(scope, &milvuspb.FlushRequest{CollectionNames: []string{"col1"}}))
+		assert.ErrorIs(t, check(scope, &milvuspb.SearchRequest{CollectionName: "col2"}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.FlushRequest{CollectionNames: []string{"col1", "col2"}}), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, check(scope, &milvuspb.ShowCollectionsRequest{}), merr.ErrPrivilegeNotPermitted)
+	})
+}
+
+func TestPrivilegeInterceptorWithAPIKey(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	// the scope limits root as well
+	ctx := NewContextWithAPIKey(GetContext(context.Background(), "root:123456"), &internalpb.APIKeyInfo{
+		Id:    "key1",
+		Scope: &internalpb.APIKeyScope{ReadOnly: true},
+	})
+	_, err := PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.DropCollectionRequest{CollectionName: "col1"})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+}
+
+func TestProxy_APIKey(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	aliceCtx := GetContext(context.Background(), "alice:123456")
+	rootCtx := GetContext(context.Background(), "root:123456")
+
+	t.Run("create", func(t *testing.T) {
+		var saved *internalpb.APIKeyInfo
+		var savedKey string
+		rootCoord.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, info *internalpb.APIKeyInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				saved, savedKey = info, info.GetSha256Key()
+				return merr.Success(), nil
+			}).Once()
+		resp, err := node.CreateAPIKey(aliceCtx, &internalpb.CreateAPIKeyRequest{
+			Scope: &internalpb.APIKeyScope{CollectionName: "col1", ReadOnly: true},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, "alice", saved.GetUsername())
+		assert.Equal(t, util.DefaultDBName, saved.GetScope().GetDbName())
+		assert.Empty(t, resp.GetInfo().GetSha256Key())
+
+		id, secret, ok := crypto.ParseAPIKey(resp.GetApiKey())
+		assert.True(t, ok)
+		assert.Equal(t, id, saved.GetId())
+		assert.Equal(t, crypto.SHA256(secret, id), savedKey)
+	})
+
+	t.Run("create invalid", func(t *testing.T) {
+		resp, err := node.CreateAPIKey(aliceCtx, &internalpb.CreateAPIKeyRequest{
+			Scope: &internalpb.APIKeyScope{ExpireAt: time.Now().Add(-time.Minute).Unix()},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		// the keys of the other users are managed by root only
+		resp, err = node.CreateAPIKey(aliceCtx, &internalpb.CreateAPIKeyRequest{Username: "bob"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+
+		// no api key manages the api keys
+		resp, err = node.CreateAPIKey(NewContextWithAPIKey(aliceCtx, &internalpb.APIKeyInfo{Id: "key1"}), &internalpb.CreateAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		rootCoord.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error) {
+				assert.Equal(t, "bob", req.GetUsername())
+				return &internalpb.ListAPIKeysResponse{
+					Status:  merr.Success(),
+					ApiKeys: []*internalpb.APIKeyInfo{{Id: "key1", Username: "bob", Sha256Key: "xxxx"}},
+				}, nil
+			}).Once()
+		resp, err := node.ListAPIKeys(rootCtx, &internalpb.ListAPIKeysRequest{Username: "bob"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetApiKeys(), 1)
+		assert.Empty(t, resp.GetApiKeys()[0].GetSha256Key())
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		status, err := node.RevokeAPIKey(aliceCtx, &internalpb.RevokeAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		rootCoord.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock")), nil).Once()
+		status, err = node.RevokeAPIKey(aliceCtx, &internalpb.RevokeAPIKeyRequest{Id: "key1"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+
+		rootCoord.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		status, err = node.RevokeAPIKey(aliceCtx, &internalpb.RevokeAPIKeyRequest{Id: "key1"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+	})
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.CreateAPIKey(aliceCtx, &internalpb.CreateAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+		listResp, err := node.ListAPIKeys(aliceCtx, &internalpb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(listResp.GetStatus()), merr.ErrServiceNotReady)
+		status, err := node.RevokeAPIKey(aliceCtx, &internalpb.RevokeAPIKeyRequest{Id: "key1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+}
+
+func TestMetaCache_GetAPIKey(t *testing.T) {
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	cache, err := NewMetaCache(rootCoord, nil, nil)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	rootCoord.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(&internalpb.ListAPIKeysResponse{
+		Status:  merr.Success(),
+		ApiKeys: []*internalpb.APIKeyInfo{{Id: "key1", Username: "alice"}},
+	}, nil).Once()
+	apiKey, err := cache.GetAPIKey(ctx, "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", apiKey.GetUsername())
+	// cached
+	_, err = cache.GetAPIKey(ctx, "key1")
+	assert.NoError(t, err)
+
+	// the api keys are removed along with the credential
+	cache.RemoveCredential("alice")
+	rootCoord.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(&internalpb.ListAPIKeysResponse{
+		Status: merr.Success(),
+	}, nil).Once()
+	_, err = cache.GetAPIKey(ctx, "key1")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	rootCoord.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	_, err = cache.GetAPIKey(ctx, "key2")
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+package proxy
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+)
+
+// AuditLogInterceptor writes the audit records of the ddl, rbac and data-access requests, it's placed before
+// the privilege interceptor so that the denied requests are audited too.
+func AuditLogInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	username, _ := GetCurUserFromContext(ctx)
+	accesslog.PrintAuditInfo(ctx, username, req, resp, err, info.FullMethod)
+	return resp, err
+}
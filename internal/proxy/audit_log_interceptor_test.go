@@ -0,0 +1,30 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestAuditLogInterceptor(t *testing.T) {
+	ctx := GetContext(context.Background(), "root:123456")
+	req := &milvuspb.DropCollectionRequest{CollectionName: "coll1"}
+	info := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/DropCollection"}
+
+	resp, err := AuditLogInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return merr.Success(), nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, merr.Ok(resp.(*commonpb.Status)))
+
+	_, err = AuditLogInterceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, merr.ErrPrivilegeNotPermitted
+	})
+	assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+}
@@ -62,28 +62,47 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 
 			if len(authStrArr) < 1 {
 				log.Warn("key not found in header")
+				recordAuthFailure(ctx, "", "missing authorization")
 				return nil, status.Error(codes.Unauthenticated, "missing authorization in header")
 			}
 
 			// token format: base64<username:password>
 			// token := strings.TrimPrefix(authorization[0], "Bearer ")
 			token := authStrArr[0]
+			if jwtToken, ok := ParseJWTToken(token); ok {
+				user, roles, err := VerifyJWT(ctx, jwtToken)
+				if err != nil {
+					log.Warn("fail to verify jwt", zap.Error(err))
+					recordAuthFailure(ctx, "", "invalid jwt: "+err.Error())
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check jwt is valid")
+				}
+				metrics.UserRPCCounter.WithLabelValues(user).Inc()
+				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				ctx = metadata.NewIncomingContext(ctx, md)
+				return NewContextWithTokenRoles(ctx, roles), nil
+			}
 			rawToken, err := crypto.Base64Decode(token)
 			if err != nil {
 				log.Warn("fail to decode the token", zap.Error(err))
+				recordAuthFailure(ctx, "", "invalid token format")
 				return nil, status.Error(codes.Unauthenticated, "invalid token format")
 			}
 
 			if !strings.Contains(rawToken, util.CredentialSeperator) {
-				user, err := VerifyAPIKey(rawToken)
+				user, apiKey, err := VerifyAPIKey(ctx, rawToken)
 				if err != nil {
 					log.Warn("fail to verify apikey", zap.Error(err))
+					recordAuthFailure(ctx, "", "invalid api key")
 					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check api key is correct")
 				}
 				metrics.UserRPCCounter.WithLabelValues(user).Inc()
 				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
 				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
 				ctx = metadata.NewIncomingContext(ctx, md)
+				if apiKey != nil {
+					ctx = NewContextWithAPIKey(ctx, apiKey)
+				}
 			} else {
 				// username+password authentication
 				username, password := parseMD(rawToken)
@@ -92,6 +111,12 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 					// NOTE: don't use the merr, because it will cause the wrong retry behavior in the sdk
 					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check username and password are correct")
 				}
+				if !isUpdateCredentialMethod(ctx) {
+					if err := CheckPasswordRotation(ctx, username); err != nil {
+						log.Warn("the password must be changed", zap.String("username", username), zap.Error(err))
+						return nil, status.Error(codes.Unauthenticated, err.Error())
+					}
+				}
 				metrics.UserRPCCounter.WithLabelValues(username).Inc()
 			}
 		}
@@ -2,11 +2,16 @@ package proxy
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus/internal/mocks"
@@ -142,3 +147,46 @@ func TestAuthenticationInterceptor(t *testing.T) {
 	}
 	hoo = defaultHook{}
 }
+
+func TestAuthenticationInterceptorWithJWT(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get()
+	key := newTestRSAKey(t, "rsa")
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, marshalJWKS(t, key), 0o600))
+	params.Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	params.Save(Params.CommonCfg.JWTEnabled.Key, "true")
+	params.Save(Params.CommonCfg.JWKSURI.Key, jwksFile)
+	params.Save(Params.CommonCfg.JWTRolesClaim.Key, "roles")
+	defer func() {
+		params.Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		params.Reset(Params.CommonCfg.JWTEnabled.Key)
+		params.Reset(Params.CommonCfg.JWKSURI.Key)
+		params.Reset(Params.CommonCfg.JWTRolesClaim.Key)
+		resetJWKS()
+	}()
+	resetJWKS()
+
+	rootCoord := &MockRootCoordClientInterface{}
+	queryCoord := &mocks.MockQueryCoordClient{}
+	err := InitMetaCache(ctx, rootCoord, queryCoord, newShardClientMgr())
+	assert.NoError(t, err)
+
+	token := key.sign(t, jwt.MapClaims{"sub": "alice", "roles": []string{"role1"}, "exp": time.Now().Add(time.Hour).Unix()})
+	for _, authorization := range []string{"Bearer " + token, crypto.Base64Encode(token)} {
+		authCtx, err := AuthenticationInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, authorization)))
+		assert.NoError(t, err)
+		user, err := GetCurUserFromContext(authCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", user)
+		assert.Equal(t, []string{"role1"}, GetTokenRolesFromContext(authCtx))
+	}
+
+	expired := key.sign(t, jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(-time.Hour).Unix()})
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, "Bearer "+expired)))
+	assert.Error(t, err)
+
+	// the password authentication still works
+	_, err = AuthenticationInterceptor(metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mockUser:mockPass"))))
+	assert.NoError(t, err)
+}
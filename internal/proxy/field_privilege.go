@@ -0,0 +1,221 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/casbin/casbin/v2"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// fieldPolicyCache keeps the enforcer and the objects of the policies, they're built once the policies change rather
+// than per request. The enforcer is never modified after it's built, so it's shared by the requests.
+type fieldPolicyCache struct {
+	mu       sync.RWMutex
+	policy   string
+	objects  []string
+	enforcer *casbin.Enforcer
+}
+
+var fieldPolicies = &fieldPolicyCache{}
+
+// get returns the objects and the enforcer of the policies, the policies are sorted in place.
+func (c *fieldPolicyCache) get(policyInfos []string) ([]string, *casbin.Enforcer, error) {
+	sort.Strings(policyInfos)
+	policy := fmt.Sprintf("[%s]", strings.Join(policyInfos, ","))
+	c.mu.RLock()
+	if c.enforcer != nil && c.policy == policy {
+		defer c.mu.RUnlock()
+		return c.objects, c.enforcer, nil
+	}
+	c.mu.RUnlock()
+
+	objects := make([]string, 0, len(policyInfos))
+	for _, policyInfo := range policyInfos {
+		p := struct{ V1 string }{}
+		if err := json.Unmarshal([]byte(policyInfo), &p); err != nil {
+			continue
+		}
+		objects = append(objects, p.V1)
+	}
+	e, err := newPrivilegeEnforcer(policy)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.policy, c.objects, c.enforcer = policy, objects, e
+	return objects, e, nil
+}
+
+// getUnreadableFields returns the restricted fields of the collection the current user isn't permitted to read,
+// the collection name must be the one of the schema rather than the alias.
+// A field is restricted once the ReadField privilege on it is granted to any role, then only the roles granted
+// the privilege, which may be granted on all the fields of the collection like `collection.*`, could read it.
+func getUnreadableFields(ctx context.Context, dbName, collectionName string) (typeutil.Set[string], error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() || globalMetaCache == nil {
+		return nil, nil
+	}
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	objects, e, err := fieldPolicies.get(globalMetaCache.GetPrivilegeInfo(ctx))
+	if err != nil {
+		return nil, err
+	}
+	prefix := funcutil.PolicyForResource(dbName, util.FieldObjectType, collectionName+".")
+	restricted := typeutil.NewSet[string]()
+	for _, object := range objects {
+		if fieldName, ok := strings.CutPrefix(object, prefix); ok && !util.IsAnyWord(fieldName) {
+			restricted.Insert(fieldName)
+		}
+	}
+	if restricted.Len() == 0 {
+		return nil, nil
+	}
+
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil, merr.WrapErrPrivilegeNotAuthenticated("%s", err.Error())
+	}
+	if username == util.UserRoot {
+		return nil, nil
+	}
+	roleNames, err := GetRole(username)
+	if err != nil {
+		return nil, err
+	}
+	roleNames = append(roleNames, GetTokenRolesFromContext(ctx)...)
+	roleNames = append(roleNames, util.RolePublic)
+
+	unreadable := typeutil.NewSet[string]()
+	for fieldName := range restricted {
+		object := funcutil.PolicyForResource(dbName, util.FieldObjectType, collectionName+"."+fieldName)
+		permitted := false
+		for _, roleName := range roleNames {
+			if permitted, err = e.Enforce(roleName, object, util.PrivilegeReadField); err != nil {
+				return nil, err
+			}
+			if permitted {
+				break
+			}
+		}
+		if !permitted {
+			unreadable.Insert(fieldName)
+		}
+	}
+	return unreadable, nil
+}
+
+// checkOutputFieldsReadable rejects the output fields naming the unreadable fields, the dynamic fields are
+// readable only if the dynamic field is.
+func checkOutputFieldsReadable(schema *schemapb.CollectionSchema, outputFields []string, unreadable typeutil.Set[string]) error {
+	if len(unreadable) == 0 {
+		return nil
+	}
+	fieldNames := typeutil.NewSet[string]()
+	for _, field := range schema.GetFields() {
+		fieldNames.Insert(field.GetName())
+	}
+	for _, name := range outputFields {
+		name = strings.TrimSpace(name)
+		if name == "*" || matchCountRule([]string{name}) {
+			continue
+		}
+		if !fieldNames.Contain(name) && schema.GetEnableDynamicField() {
+			name = common.MetaFieldName
+		}
+		if unreadable.Contain(name) {
+			return merr.WrapErrPrivilegeNotPermitted("the field %s is not readable for the current user", name)
+		}
+	}
+	return nil
+}
+
+// removeUnreadableFields removes the unreadable fields expanded from the wildcard output field.
+func removeUnreadableFields(fieldNames []string, unreadable typeutil.Set[string]) []string {
+	if len(unreadable) == 0 {
+		return fieldNames
+	}
+	readable := make([]string, 0, len(fieldNames))
+	for _, name := range fieldNames {
+		if !unreadable.Contain(name) {
+			readable = append(readable, name)
+		}
+	}
+	return readable
+}
+
+// checkExprFieldsReadable rejects the filter expression referring the unreadable fields, which
+// would reveal the values of them by the results.
+func checkExprFieldsReadable(schema *schemapb.CollectionSchema, expr *planpb.Expr, unreadable typeutil.Set[string]) error {
+	if len(unreadable) == 0 || expr == nil {
+		return nil
+	}
+	fieldIDs := typeutil.NewSet[int64]()
+	collectExprFieldIDs(expr, fieldIDs)
+	for _, field := range schema.GetFields() {
+		if fieldIDs.Contain(field.GetFieldID()) && unreadable.Contain(field.GetName()) {
+			return merr.WrapErrPrivilegeNotPermitted("the field %s is not readable for the current user, it can't be filtered by", field.GetName())
+		}
+	}
+	return nil
+}
+
+func collectExprFieldIDs(expr *planpb.Expr, fieldIDs typeutil.Set[int64]) {
+	addColumn := func(info *planpb.ColumnInfo) {
+		if info != nil {
+			fieldIDs.Insert(info.GetFieldId())
+		}
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		addColumn(e.TermExpr.GetColumnInfo())
+	case *planpb.Expr_UnaryExpr:
+		collectExprFieldIDs(e.UnaryExpr.GetChild(), fieldIDs)
+	case *planpb.Expr_BinaryExpr:
+		collectExprFieldIDs(e.BinaryExpr.GetLeft(), fieldIDs)
+		collectExprFieldIDs(e.BinaryExpr.GetRight(), fieldIDs)
+	case *planpb.Expr_CompareExpr:
+		addColumn(e.CompareExpr.GetLeftColumnInfo())
+		addColumn(e.CompareExpr.GetRightColumnInfo())
+	case *planpb.Expr_UnaryRangeExpr:
+		addColumn(e.UnaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryRangeExpr:
+		addColumn(e.BinaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		addColumn(e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithExpr:
+		collectExprFieldIDs(e.BinaryArithExpr.GetLeft(), fieldIDs)
+		collectExprFieldIDs(e.BinaryArithExpr.GetRight(), fieldIDs)
+	case *planpb.Expr_ColumnExpr:
+		addColumn(e.ColumnExpr.GetInfo())
+	case *planpb.Expr_ExistsExpr:
+		addColumn(e.ExistsExpr.GetInfo())
+	case *planpb.Expr_JsonContainsExpr:
+		addColumn(e.JsonContainsExpr.GetColumnInfo())
+	}
+}
+
+// readableFieldsProperty lists the fields readable for the current user, which is shown by DescribeCollection
+// only if some of the fields aren't readable.
+func readableFieldsProperty(fields []*schemapb.FieldSchema, unreadable typeutil.Set[string]) string {
+	readable := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if !unreadable.Contain(field.GetName()) {
+			readable = append(readable, field.GetName())
+		}
+	}
+	return strings.Join(readable, ",")
+}
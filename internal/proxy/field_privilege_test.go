@@ -0,0 +1,162 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestGetUnreadableFields(t *testing.T) {
+	paramtable.Init()
+	ctx := GetContext(context.Background(), "alice:123456")
+
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		fields, err := getUnreadableFields(ctx, "default", "col1")
+		assert.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	policies := []string{
+		funcutil.PolicyForPrivilege("r1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+		funcutil.PolicyForPrivilege("r1", util.FieldObjectType, "col1.email", util.PrivilegeReadField, "default"),
+		funcutil.PolicyForPrivilege("r2", util.FieldObjectType, "col1.*", util.PrivilegeReadField, "default"),
+		funcutil.PolicyForPrivilege("r3", util.FieldObjectType, "col1.text", util.PrivilegeReadField, "default"),
+		funcutil.PolicyForPrivilege("r3", util.FieldObjectType, "col2.text", util.PrivilegeReadField, "db1"),
+	}
+
+	t.Run("no restricted field", func(t *testing.T) {
+		cache.EXPECT().GetPrivilegeInfo(mock.Anything).Return(policies).Once()
+		fields, err := getUnreadableFields(ctx, "default", "col2")
+		assert.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	t.Run("no user", func(t *testing.T) {
+		cache.EXPECT().GetPrivilegeInfo(mock.Anything).Return(policies).Once()
+		_, err := getUnreadableFields(context.Background(), "default", "col1")
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		cache.EXPECT().GetPrivilegeInfo(mock.Anything).Return(policies).Once()
+		fields, err := getUnreadableFields(GetContext(context.Background(), "root:123456"), "default", "col1")
+		assert.NoError(t, err)
+		assert.Empty(t, fields)
+	})
+
+	cases := []struct {
+		roles      []string
+		unreadable []string
+	}{
+		{nil, []string{"email", "text"}},
+		{[]string{"r1"}, []string{"text"}},
+		{[]string{"r1", "r3"}, []string{}},
+		{[]string{"r2"}, []string{}},
+		{[]string{util.RoleAdmin}, []string{}},
+	}
+	for _, c := range cases {
+		cache.EXPECT().GetPrivilegeInfo(mock.Anything).Return(policies).Once()
+		cache.EXPECT().GetUserRole("alice").Return(c.roles).Once()
+		fields, err := getUnreadableFields(ctx, "default", "col1")
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, c.unreadable, fields.Collect(), c.roles)
+	}
+}
+
+func TestFieldPolicyCache(t *testing.T) {
+	c := &fieldPolicyCache{}
+	policies := []string{
+		funcutil.PolicyForPrivilege("r1", util.FieldObjectType, "col1.email", util.PrivilegeReadField, "default"),
+		funcutil.PolicyForPrivilege("r1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+	}
+	objects, e, err := c.get(policies)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		funcutil.PolicyForResource("default", util.FieldObjectType, "col1.email"),
+		funcutil.PolicyForResource("default", commonpb.ObjectType_Collection.String(), "col1"),
+	}, objects)
+
+	// the enforcer is reused by the same policies in any order
+	_, e2, err := c.get([]string{policies[1], policies[0]})
+	assert.NoError(t, err)
+	assert.Same(t, e, e2)
+
+	// the enforcer is rebuilt once the policies change
+	_, e3, err := c.get(policies[:1])
+	assert.NoError(t, err)
+	assert.NotSame(t, e, e3)
+	permitted, err := e3.Enforce("r1", funcutil.PolicyForResource("default", util.FieldObjectType, "col1.email"), util.PrivilegeReadField)
+	assert.NoError(t, err)
+	assert.True(t, permitted)
+}
+
+func TestCheckFieldsReadable(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:               "col1",
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "email", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 102, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 104, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	unreadable := typeutil.NewSet("email", common.MetaFieldName)
+
+	t.Run("output fields", func(t *testing.T) {
+		assert.NoError(t, checkOutputFieldsReadable(schema, []string{"email"}, nil))
+		assert.NoError(t, checkOutputFieldsReadable(schema, []string{"*", "id", "count(*)"}, unreadable))
+		assert.ErrorIs(t, checkOutputFieldsReadable(schema, []string{"id", " email "}, unreadable), merr.ErrPrivilegeNotPermitted)
+		// the dynamic keys are in the dynamic field
+		assert.ErrorIs(t, checkOutputFieldsReadable(schema, []string{"unknown"}, unreadable), merr.ErrPrivilegeNotPermitted)
+
+		assert.ElementsMatch(t, []string{"id", "meta", "vec"},
+			removeUnreadableFields([]string{"id", "email", "meta", "vec", common.MetaFieldName}, unreadable))
+		assert.Equal(t, []string{"email"}, removeUnreadableFields([]string{"email"}, nil))
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		for _, expr := range []string{"", "id > 1", "meta[\"a\"] == 1 and id in [1, 2]"} {
+			plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+			assert.NoError(t, err)
+			assert.NoError(t, checkExprFieldsReadable(schema, plan.GetQuery().GetPredicates(), unreadable), expr)
+		}
+		for _, expr := range []string{
+			"email == \"a\"",
+			"id > 1 and not (email like \"a%\")",
+			"id < 1 or email in [\"a\"]",
+			"json_contains(meta[\"tags\"], 1) or email > \"b\"",
+			// the dynamic keys are in the dynamic field
+			"unknown == 1",
+		} {
+			plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+			assert.NoError(t, err)
+			assert.ErrorIs(t, checkExprFieldsReadable(schema, plan.GetQuery().GetPredicates(), unreadable), merr.ErrPrivilegeNotPermitted, expr)
+			assert.NoError(t, checkExprFieldsReadable(schema, plan.GetQuery().GetPredicates(), nil), expr)
+		}
+	})
+
+	t.Run("readable fields property", func(t *testing.T) {
+		assert.Equal(t, "id,meta,vec", readableFieldsProperty(schema.GetFields(), unreadable))
+	})
+}
@@ -105,6 +105,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collectionName", request.CollectionName),
+		zap.Strings("collectionNames", request.GetCollectionNames()),
 		zap.Int64("collectionID", request.CollectionID),
 	)
 
@@ -118,9 +119,20 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 		if collectionName != "" {
 			globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName) // no need to return error, though collection may be not cached
 		}
+		for _, name := range request.GetCollectionNames() {
+			globalMetaCache.RemoveCollection(ctx, request.GetDbName(), name)
+		}
 		if request.CollectionID != UniqueID(0) {
 			aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
 		}
+		if collectionName == "" && collectionID == UniqueID(0) && request.GetBase().GetMsgType() == commonpb.MsgType_DropDatabase {
+			// the database is dropped or renamed, all its collections are invalid.
+			globalMetaCache.RemoveDatabase(ctx, request.GetDbName())
+		}
+	}
+	node.resultCache.invalidateCollection(collectionID)
+	if collectionID != 0 && node.lbPolicy != nil {
+		node.lbPolicy.InvalidateCollection(collectionID)
 	}
 	if request.GetBase().GetMsgType() == commonpb.MsgType_DropCollection {
 		// no need to handle error, since this Proxy may not create dml stream for the collection.
@@ -257,6 +269,93 @@ func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatab
 	return dct.result, nil
 }
 
+// AlterDatabase alters the database, like renaming it, the collections and grants of the database follow the new name.
+func (node *Proxy) AlterDatabase(ctx context.Context, request *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.DropDatabaseRequest{DbName: request.GetDbName()}); err != nil {
+		return merr.Status(err), nil
+	}
+	if request.GetNewDbName() != "" {
+		// the database is created under the new name
+		if err := checkPrivilegeInDatabase(ctx, request.GetNewDbName(), &milvuspb.CreateDatabaseRequest{DbName: request.GetNewDbName()}); err != nil {
+			return merr.Status(err), nil
+		}
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterDatabase")
+	defer sp.End()
+
+	method := "AlterDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	adt := &alterDatabaseTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		AlterDatabaseRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	log := log.With(
+		zap.String("traceID", sp.SpanContext().TraceID().String()),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()),
+		zap.String("newDBName", request.GetNewDbName()),
+	)
+
+	log.Info(rpcReceived(method))
+	if err := node.sched.ddQueue.Enqueue(adt); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcEnqueued(method))
+	if err := adt.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return adt.result, nil
+}
+
+// DescribeDatabase returns the database and its properties.
+func (node *Proxy) DescribeDatabase(ctx context.Context, request *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DescribeDatabase")
+	defer sp.End()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()))
+
+	log.Debug("DescribeDatabase")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.ListDatabasesRequest{}); err != nil {
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		DbName: request.GetDbName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("describe database fail", zap.Error(err))
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
 func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
 	resp := &milvuspb.ListDatabasesResponse{}
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -367,6 +466,8 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 		return merr.Status(err), nil
 	}
 
+	setDDLJobHeader(ctx, cct.ID())
+
 	log.Debug(
 		rpcEnqueued(method),
 		zap.Uint64("BeginTs", cct.BeginTs()),
@@ -445,6 +546,8 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 		return merr.Status(err), nil
 	}
 
+	setDDLJobHeader(ctx, dct.ID())
+
 	log.Debug(
 		"DropCollection enqueued",
 		zap.Uint64("BeginTs", dct.BeginTs()),
@@ -991,34 +1094,664 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 		zap.Int("len(CollectionNames)", len(request.CollectionNames)),
 		zap.Int("num_collections", len(sct.result.CollectionNames)))
 
-	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
-	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return sct.result, nil
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return sct.result, nil
+}
+
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterCollection")
+	defer sp.End()
+	method := "AlterCollection"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	act := &alterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	setDDLJobHeader(ctx, act.ID())
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.Uint64("timestamp", request.Base.Timestamp))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// AddCollectionField appends a field to the schema of the collection, the rows inserted before read
+// the default value of the field. It's permitted to the ones who could alter the collection.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.AlterCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionField")
+	defer sp.End()
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("field", request.GetField().GetName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	setDDLJobHeader(ctx, act.ID())
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// CloneCollection creates a collection sharing the data and the indexes of the source collection.
+func (node *Proxy) CloneCollection(ctx context.Context, request *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.CreateCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetNewCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+	// the clone exposes all the data of the source, so reading the source is required as well
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CloneCollection")
+	defer sp.End()
+	method := "CloneCollection"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &cloneCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		CloneCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("newCollection", request.GetNewCollectionName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// ListDroppedCollections lists the collections in the recycle bin of the database.
+func (node *Proxy) ListDroppedCollections(ctx context.Context, req *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListDroppedCollections")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()))
+
+	log.Debug("ListDroppedCollections")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListDroppedCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, req.GetDbName(), &milvuspb.ShowCollectionsRequest{DbName: req.GetDbName()}); err != nil {
+		return &internalpb.ListDroppedCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.ListDroppedCollections(ctx, &internalpb.ListDroppedCollectionsRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		DbName: req.GetDbName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("list dropped collections fail", zap.Error(err))
+		return &internalpb.ListDroppedCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.ListDroppedCollectionsResponse{
+		Status:      merr.Success(),
+		Collections: resp.GetCollections(),
+	}, nil
+}
+
+// UndropCollection restores a collection from the recycle bin.
+func (node *Proxy) UndropCollection(ctx context.Context, request *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.CreateCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-UndropCollection")
+	defer sp.End()
+	method := "UndropCollection"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &undropCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		UndropCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Int64("collectionID", request.GetCollectionID()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// UndropPartition restores a partition from the recycle bin.
+func (node *Proxy) UndropPartition(ctx context.Context, request *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	// the partition apis aren't guarded by any privilege, the restored rows are checked like the inserted ones
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.InsertRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		PartitionName:  request.GetPartitionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-UndropPartition")
+	defer sp.End()
+	method := "UndropPartition"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &undropPartitionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		UndropPartitionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("partition", request.GetPartitionName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// CreateSnapshot records the current flushed data and schema of the collection as a named snapshot.
+func (node *Proxy) CreateSnapshot(ctx context.Context, request *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.CreateCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+	// the snapshot could be restored to expose all the data of the collection, so reading it is required as well
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateSnapshot")
+	defer sp.End()
+	method := "CreateSnapshot"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &createSnapshotTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateSnapshotRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("snapshot", request.GetSnapshotName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// DropSnapshot drops the snapshot, the data only referred by it is garbage collected later.
+func (node *Proxy) DropSnapshot(ctx context.Context, request *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.DropCollectionRequest{DbName: request.GetDbName()}); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropSnapshot")
+	defer sp.End()
+	method := "DropSnapshot"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &dropSnapshotTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropSnapshotRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("snapshot", request.GetSnapshotName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return act.result, nil
+}
+
+// ListSnapshots lists the snapshots of the database, or of the collection if it's specified.
+func (node *Proxy) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListSnapshots")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Debug("ListSnapshots")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, req.GetDbName(), &milvuspb.ShowCollectionsRequest{DbName: req.GetDbName()}); err != nil {
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("list snapshots fail", zap.Error(err))
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: resp.GetSnapshots(),
+	}, nil
+}
+
+// checkSnapshotSourcePrivilege checks the privilege to read the source collection of the snapshot,
+// since the restored collection exposes all the data of it.
+func (node *Proxy) checkSnapshotSourcePrivilege(ctx context.Context, dbName string, snapshotName string) error {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	resp, err := node.rootCoord.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{
+		Base:   commonpbutil.NewMsgBase(),
+		DbName: dbName,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+	for _, snapshot := range resp.GetSnapshots() {
+		if snapshot.GetName() == snapshotName {
+			return checkPrivilegeInDatabase(ctx, dbName, &milvuspb.QueryRequest{
+				DbName:         dbName,
+				CollectionName: snapshot.GetCollectionName(),
+			})
+		}
+	}
+	// the restore fails with the proper error if the snapshot doesn't exist
+	return nil
 }
 
-func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+// RestoreSnapshot creates a new collection from the snapshot.
+func (node *Proxy) RestoreSnapshot(ctx context.Context, request *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.CreateCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetNewCollectionName(),
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := node.checkSnapshotSourcePrivilege(ctx, request.GetDbName(), request.GetSnapshotName()); err != nil {
+		return merr.Status(err), nil
+	}
 
-	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterCollection")
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RestoreSnapshot")
 	defer sp.End()
-	method := "AlterCollection"
+	method := "RestoreSnapshot"
 	tr := timerecord.NewTimeRecorder(method)
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
 
-	act := &alterCollectionTask{
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	act := &restoreSnapshotTask{
 		ctx:                    ctx,
 		Condition:              NewTaskCondition(ctx),
-		AlterCollectionRequest: request,
+		RestoreSnapshotRequest: request,
 		rootCoord:              node.rootCoord,
 	}
 
 	log := log.Ctx(ctx).With(
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
-		zap.String("collection", request.CollectionName))
+		zap.String("snapshot", request.GetSnapshotName()),
+		zap.String("newCollection", request.GetNewCollectionName()))
 
 	log.Debug(
 		rpcReceived(method))
@@ -1035,8 +1768,7 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 	log.Debug(
 		rpcEnqueued(method),
 		zap.Uint64("BeginTs", act.BeginTs()),
-		zap.Uint64("EndTs", act.EndTs()),
-		zap.Uint64("timestamp", request.Base.Timestamp))
+		zap.Uint64("EndTs", act.EndTs()))
 
 	if err := act.WaitToFinish(); err != nil {
 		log.Warn(
@@ -1059,6 +1791,76 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 	return act.result, nil
 }
 
+// GetDDLJob returns the progress of the DDL job.
+func (node *Proxy) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GetDDLJob")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("jobID", req.GetJobID()))
+
+	log.Debug("GetDDLJob")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		JobID: req.GetJobID(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("get ddl job fail", zap.Error(err))
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	// the request only carries the job id, the job is visible to the ones who could list the collections of its database
+	dbName := resp.GetJob().GetDbName()
+	if err := checkPrivilegeInDatabase(ctx, dbName, &milvuspb.ShowCollectionsRequest{DbName: dbName}); err != nil {
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.GetDDLJobResponse{
+		Status: merr.Success(),
+		Job:    resp.GetJob(),
+	}, nil
+}
+
+// ListDDLJobs lists the DDL jobs of the database, or of the collection if it's specified.
+func (node *Proxy) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListDDLJobs")
+	defer sp.End()
+
+	if req.GetDbName() == "" {
+		req.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Debug("ListDDLJobs")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, req.GetDbName(), &milvuspb.ShowCollectionsRequest{DbName: req.GetDbName()}); err != nil {
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		UnfinishedOnly: req.GetUnfinishedOnly(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("list ddl jobs fail", zap.Error(err))
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.ListDDLJobsResponse{
+		Status: merr.Success(),
+		Jobs:   resp.GetJobs(),
+	}, nil
+}
+
 // CreatePartition create a partition in specific collection.
 func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
@@ -1097,6 +1899,8 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 		return merr.Status(err), nil
 	}
 
+	setDDLJobHeader(ctx, cpt.ID())
+
 	log.Debug(
 		rpcEnqueued(method),
 		zap.Uint64("BeginTS", cpt.BeginTs()),
@@ -1163,6 +1967,8 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 		return merr.Status(err), nil
 	}
 
+	setDDLJobHeader(ctx, dpt.ID())
+
 	log.Debug(
 		rpcEnqueued(method),
 		zap.Uint64("BeginTS", dpt.BeginTs()),
@@ -2235,6 +3041,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		segIDAssigner: node.segAssigner,
 		chMgr:         node.chMgr,
 		chTicker:      node.chTicker,
+		qc:            node.queryCoord,
+		lb:            node.lbPolicy,
 	}
 
 	constructFailedResponse := func(err error) *milvuspb.MutationResult {
@@ -2268,6 +3076,8 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		return constructFailedResponse(err), nil
 	}
 
+	// rows skipped because of existing primary keys are reported by ErrIndex of a successful result
+	skippedCnt := int64(len(it.result.GetErrIndex()))
 	if it.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 		setErrorIndex := func() {
 			numRows := request.NumRows
@@ -2282,14 +3092,14 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		log.Warn("fail to insert data", zap.Uint32s("err_index", it.result.ErrIndex))
 	}
 
-	// InsertCnt always equals to the number of entities in the request
-	it.result.InsertCnt = int64(request.NumRows)
+	// InsertCnt always equals to the number of entities in the request except the skipped ones
+	it.result.InsertCnt = int64(request.NumRows) - skippedCnt
 
 	rateCol.Add(internalpb.RateType_DMLInsert.String(), float64(it.insertMsg.Size()))
 
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 		metrics.SuccessLabel).Inc()
-	successCnt := it.result.InsertCnt - int64(len(it.result.ErrIndex))
+	successCnt := int64(request.NumRows) - int64(len(it.result.ErrIndex))
 	metrics.ProxyInsertVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(successCnt))
 	metrics.ProxyMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	metrics.ProxyCollectionMutationLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel, request.CollectionName).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -2400,6 +3210,7 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 		commonpbutil.WithMsgType(commonpb.MsgType_Upsert),
 		commonpbutil.WithSourceID(paramtable.GetNodeID()),
 	)
+	partialUpdate, insertIfMissing := GetPartialUpdateFromContext(ctx)
 
 	it := &upsertTask{
 		baseMsg: msgstream.BaseMsg{
@@ -2415,10 +3226,14 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 			},
 		},
 
-		idAllocator:   node.rowIDAllocator,
-		segIDAssigner: node.segAssigner,
-		chMgr:         node.chMgr,
-		chTicker:      node.chTicker,
+		idAllocator:     node.rowIDAllocator,
+		segIDAssigner:   node.segAssigner,
+		chMgr:           node.chMgr,
+		chTicker:        node.chTicker,
+		partialUpdate:   partialUpdate,
+		insertIfMissing: insertIfMissing,
+		qc:              node.queryCoord,
+		lb:              node.lbPolicy,
 	}
 
 	log.Debug("Enqueue upsert request in Proxy",
@@ -2570,6 +3385,41 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 	log.Debug(rpcReceived(method))
 
+	var cacheKey *resultCacheKey
+	if node.resultCache.enabled(ctx) && !request.GetSearchByPrimaryKeys() {
+		if collectionID, scope, err := resultCacheScope(ctx, request.GetDbName(), request.GetCollectionName()); err == nil {
+			key := searchResultCacheKey(collectionID, request, scope)
+			readTs := resultCacheReadTs(ctx, request.GetDbName(), request.GetCollectionName(), request.GetGuaranteeTimestamp(),
+				request.GetConsistencyLevel(), request.GetUseDefaultConsistency())
+			if result, ok := node.resultCache.get(key, readTs, searchResultCacheName); ok {
+				log.Debug("search result cache hit")
+				// the cache hits are served searches as well, the cache hit ratio is counted by the cache stats
+				metrics.ProxyFunctionCall.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					method,
+					metrics.SuccessLabel,
+				).Inc()
+				searchResult := result.(*milvuspb.SearchResults)
+				metrics.ProxySearchVectors.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(searchResult.GetResults().GetNumQueries()))
+				searchDur := tr.ElapseSpan().Milliseconds()
+				metrics.ProxySQLatency.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					metrics.SearchLabel,
+				).Observe(float64(searchDur))
+				metrics.ProxyCollectionSQLatency.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					metrics.SearchLabel,
+					request.CollectionName,
+				).Observe(float64(searchDur))
+				sentSize := proto.Size(searchResult)
+				metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+				rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+				return searchResult, nil
+			}
+			cacheKey = &key
+		}
+	}
+
 	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
 		log.Warn(
 			rpcFailedToEnqueue(method),
@@ -2643,6 +3493,9 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+		if cacheKey != nil && merr.Ok(qt.result.GetStatus()) {
+			node.resultCache.put(*cacheKey, qt.serviceableTs, qt.result)
+		}
 	}
 	return qt.result, nil
 }
@@ -2837,6 +3690,38 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 		zap.Uint64("guarantee_timestamp", request.GuaranteeTimestamp),
 	)
 
+	var cacheKey *resultCacheKey
+	if node.resultCache.enabled(ctx) {
+		if collectionID, scope, err := resultCacheScope(ctx, request.GetDbName(), request.GetCollectionName()); err == nil {
+			key := queryResultCacheKey(collectionID, request, scope)
+			readTs := resultCacheReadTs(ctx, request.GetDbName(), request.GetCollectionName(), request.GetGuaranteeTimestamp(),
+				request.GetConsistencyLevel(), request.GetUseDefaultConsistency())
+			if result, ok := node.resultCache.get(key, readTs, queryResultCacheName); ok {
+				log.Debug("query result cache hit")
+				// the cache hits are served queries as well, the cache hit ratio is counted by the cache stats
+				metrics.ProxyFunctionCall.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					method,
+					metrics.SuccessLabel,
+				).Inc()
+				metrics.ProxySQLatency.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					metrics.QueryLabel,
+				).Observe(float64(tr.ElapseSpan().Milliseconds()))
+				metrics.ProxyCollectionSQLatency.WithLabelValues(
+					strconv.FormatInt(paramtable.GetNodeID(), 10),
+					metrics.QueryLabel,
+					request.CollectionName,
+				).Observe(float64(tr.ElapseSpan().Milliseconds()))
+				sentSize := proto.Size(result)
+				rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
+				metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
+				return result.(*milvuspb.QueryResults), nil
+			}
+			cacheKey = &key
+		}
+	}
+
 	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
 		log.Warn(
 			rpcFailedToEnqueue(method),
@@ -2898,6 +3783,9 @@ func (node *Proxy) query(ctx context.Context, qt *queryTask) (*milvuspb.QueryRes
 	rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 
+	if cacheKey != nil && merr.Ok(qt.result.GetStatus()) {
+		node.resultCache.put(*cacheKey, qt.serviceableTs, qt.result)
+	}
 	return qt.result, nil
 }
 
@@ -3123,6 +4011,79 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
+// BatchAlterAliases creates, alters and drops several aliases atomically, the aliases switch together.
+func (node *Proxy) BatchAlterAliases(ctx context.Context, request *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	// the alias apis aren't guarded by any privilege, an alias takes a collection name so it's checked like creating
+	// the collection
+	for _, op := range request.GetOperations() {
+		if err := checkPrivilegeInDatabase(ctx, request.GetDbName(), &milvuspb.CreateCollectionRequest{
+			DbName:         request.GetDbName(),
+			CollectionName: op.GetAlias(),
+		}); err != nil {
+			return merr.Status(err), nil
+		}
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-BatchAlterAliases")
+	defer sp.End()
+
+	method := "BatchAlterAliases"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	bat := &batchAlterAliasesTask{
+		ctx:                      ctx,
+		Condition:                NewTaskCondition(ctx),
+		BatchAlterAliasesRequest: request,
+		rootCoord:                node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.Int("operations", len(request.GetOperations())))
+
+	log.Debug(rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(bat); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", bat.BeginTs()),
+		zap.Uint64("EndTs", bat.EndTs()))
+
+	if err := bat.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", bat.BeginTs()),
+			zap.Uint64("EndTs", bat.EndTs()))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", bat.BeginTs()),
+		zap.Uint64("EndTs", bat.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return bat.result, nil
+}
+
 // CalcDistance calculates the distances between vectors.
 func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return &milvuspb.CalcDistanceResults{
@@ -3995,8 +4956,10 @@ func (node *Proxy) UpdateCredentialCache(ctx context.Context, request *proxypb.U
 	}
 
 	credInfo := &internalpb.CredentialInfo{
-		Username:       request.Username,
-		Sha256Password: request.Password,
+		Username:           request.Username,
+		Sha256Password:     request.Password,
+		PasswordUpdateTime: request.PasswordUpdateTime,
+		NeedPasswordChange: request.NeedPasswordChange,
 	}
 	if globalMetaCache != nil {
 		globalMetaCache.UpdateCredential(credInfo) // no need to return error, though credential may be not cached
@@ -4044,9 +5007,10 @@ func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCre
 	}
 
 	credInfo := &internalpb.CredentialInfo{
-		Username:          req.Username,
-		EncryptedPassword: encryptedPassword,
-		Sha256Password:    crypto.SHA256(rawPassword, req.Username),
+		Username:           req.Username,
+		EncryptedPassword:  encryptedPassword,
+		Sha256Password:     crypto.SHA256(rawPassword, req.Username),
+		NeedPasswordChange: isPasswordSetByOthers(ctx, req.Username),
 	}
 	result, err := node.rootCoord.CreateCredential(ctx, credInfo)
 	if err != nil { // for error like conntext timeout etc.
@@ -4103,6 +5067,10 @@ func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCre
 		err := merr.WrapErrPrivilegeNotAuthenticated("old password not correct for %s", req.GetUsername())
 		return merr.Status(err), nil
 	}
+	if err = checkPasswordReused(ctx, node.rootCoord, req.Username, rawNewPassword); err != nil {
+		log.Warn("illegal password", zap.Error(err))
+		return merr.Status(err), nil
+	}
 	// update meta data
 	encryptedPassword, err := crypto.PasswordEncrypt(rawNewPassword)
 	if err != nil {
@@ -4112,9 +5080,10 @@ func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCre
 		return merr.Status(err), nil
 	}
 	updateCredReq := &internalpb.CredentialInfo{
-		Username:          req.Username,
-		Sha256Password:    crypto.SHA256(rawNewPassword, req.Username),
-		EncryptedPassword: encryptedPassword,
+		Username:           req.Username,
+		Sha256Password:     crypto.SHA256(rawNewPassword, req.Username),
+		EncryptedPassword:  encryptedPassword,
+		NeedPasswordChange: isPasswordSetByOthers(ctx, req.Username),
 	}
 	result, err := node.rootCoord.UpdateCredential(ctx, updateCredReq)
 	if err != nil { // for error like conntext timeout etc.
@@ -4179,6 +5148,307 @@ func (node *Proxy) ListCredUsers(ctx context.Context, req *milvuspb.ListCredUser
 	}, nil
 }
 
+// CreateAPIKey creates an api key for the user, the raw key is returned only once and rootcoord stores it encrypted.
+func (node *Proxy) CreateAPIKey(ctx context.Context, req *internalpb.CreateAPIKeyRequest) (*internalpb.CreateAPIKeyResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", req.GetUsername()))
+
+	log.Debug("CreateAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.CreateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	username, err := apiKeyOwner(ctx, req.GetUsername())
+	if err != nil {
+		return &internalpb.CreateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	scope := &internalpb.APIKeyScope{
+		DbName:         req.GetScope().GetDbName(),
+		CollectionName: req.GetScope().GetCollectionName(),
+		ReadOnly:       req.GetScope().GetReadOnly(),
+		ExpireAt:       req.GetScope().GetExpireAt(),
+	}
+	if scope.GetCollectionName() != "" && scope.GetDbName() == "" {
+		scope.DbName = util.DefaultDBName
+	}
+	if scope.GetExpireAt() < 0 || (scope.GetExpireAt() > 0 && scope.GetExpireAt() <= time.Now().Unix()) {
+		err := merr.WrapErrParameterInvalidMsg("the expiry of the api key must be in the future but got %d", scope.GetExpireAt())
+		return &internalpb.CreateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	key, id, secret, err := crypto.NewAPIKey()
+	if err != nil {
+		log.Warn("generate api key fail", zap.Error(err))
+		return &internalpb.CreateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	info := &internalpb.APIKeyInfo{
+		Id:          id,
+		Username:    username,
+		Sha256Key:   crypto.SHA256(secret, id),
+		Scope:       scope,
+		Description: req.GetDescription(),
+		CreatedAt:   time.Now().Unix(),
+	}
+	status, err := node.rootCoord.CreateAPIKey(ctx, info)
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("create api key fail", zap.Error(err))
+		return &internalpb.CreateAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	log.Info("create api key done", zap.String("id", id))
+
+	info.Sha256Key = ""
+	return &internalpb.CreateAPIKeyResponse{
+		Status: merr.Success(),
+		ApiKey: key,
+		Info:   info,
+	}, nil
+}
+
+// ListAPIKeys lists the api keys of the user, the keys themselves are not returned.
+func (node *Proxy) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListAPIKeys")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", req.GetUsername()))
+
+	log.Debug("ListAPIKeys")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	username, err := apiKeyOwner(ctx, req.GetUsername())
+	if err != nil {
+		return &internalpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	resp, err := node.rootCoord.ListAPIKeys(ctx, &internalpb.ListAPIKeysRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Username: username,
+		Id:       req.GetId(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("list api keys fail", zap.Error(err))
+		return &internalpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	for _, apiKey := range resp.GetApiKeys() {
+		apiKey.Sha256Key = ""
+	}
+	return &internalpb.ListAPIKeysResponse{
+		Status:  merr.Success(),
+		ApiKeys: resp.GetApiKeys(),
+	}, nil
+}
+
+// RevokeAPIKey revokes the api key of the user, the proxies reject the key once it's revoked.
+func (node *Proxy) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RevokeAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", req.GetUsername()),
+		zap.String("id", req.GetId()))
+
+	log.Debug("RevokeAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetId() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the id of the api key is empty")), nil
+	}
+	username, err := apiKeyOwner(ctx, req.GetUsername())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	status, err := node.rootCoord.RevokeAPIKey(ctx, &internalpb.RevokeAPIKeyRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Username: username,
+		Id:       req.GetId(),
+	})
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("revoke api key fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("revoke api key done")
+	return merr.Success(), nil
+}
+
+// rowPolicyCollection resolves the collection of the row policy request.
+func rowPolicyCollection(ctx context.Context, dbName, collectionName string) (string, UniqueID, error) {
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	if err := validateCollectionName(collectionName); err != nil {
+		return "", 0, err
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return "", 0, err
+	}
+	return dbName, collectionID, nil
+}
+
+// GrantRowPolicy limits the rows of the collection visible to the role to the ones matching the expression,
+// the expression replaces the one granted before. It's permitted to the ones who manage the privileges.
+func (node *Proxy) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GrantRowPolicy")
+	defer sp.End()
+
+	policy := req.GetPolicy()
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("roleName", policy.GetRoleName()),
+		zap.String("db", policy.GetDbName()),
+		zap.String("collection", policy.GetCollectionName()))
+
+	log.Debug("GrantRowPolicy", zap.String("expr", policy.GetExpr()))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, policy.GetDbName(), &milvuspb.OperatePrivilegeRequest{
+		Entity: &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: policy.GetRoleName()},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: policy.GetCollectionName(),
+			DbName:     policy.GetDbName(),
+		},
+		Type: milvuspb.OperatePrivilegeType_Grant,
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+	if policy.GetRoleName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the role of the row policy is empty")), nil
+	}
+	dbName, collectionID, err := rowPolicyCollection(ctx, policy.GetDbName(), policy.GetCollectionName())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, policy.GetCollectionName())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+	if err := validateRowPolicy(schema, policy.GetExpr()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	status, err := node.rootCoord.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Policy: &internalpb.RowPolicy{
+			RoleName:       policy.GetRoleName(),
+			DbName:         dbName,
+			CollectionName: policy.GetCollectionName(),
+			CollectionID:   collectionID,
+			Expr:           policy.GetExpr(),
+		},
+	})
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("grant row policy fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("grant row policy done")
+	return merr.Success(), nil
+}
+
+// RevokeRowPolicy removes the row policy of the role on the collection, the role sees all the rows then.
+func (node *Proxy) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RevokeRowPolicy")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("roleName", req.GetRoleName()),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Debug("RevokeRowPolicy")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, req.GetDbName(), &milvuspb.OperatePrivilegeRequest{
+		Entity: &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: req.GetRoleName()},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: req.GetCollectionName(),
+			DbName:     req.GetDbName(),
+		},
+		Type: milvuspb.OperatePrivilegeType_Revoke,
+	}); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetRoleName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("the role of the row policy is empty")), nil
+	}
+	dbName, collectionID, err := rowPolicyCollection(ctx, req.GetDbName(), req.GetCollectionName())
+	if err != nil {
+		return merr.Status(err), nil
+	}
+
+	status, err := node.rootCoord.RevokeRowPolicy(ctx, &internalpb.RevokeRowPolicyRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		RoleName:       req.GetRoleName(),
+		DbName:         dbName,
+		CollectionName: req.GetCollectionName(),
+		CollectionID:   collectionID,
+	})
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("revoke row policy fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("revoke row policy done")
+	return merr.Success(), nil
+}
+
+// ListRowPolicies lists the row policies filtered by the role and collection.
+func (node *Proxy) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListRowPolicies")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("roleName", req.GetRoleName()),
+		zap.String("db", req.GetDbName()),
+		zap.String("collection", req.GetCollectionName()))
+
+	log.Debug("ListRowPolicies")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkPrivilegeInDatabase(ctx, req.GetDbName(), &milvuspb.SelectGrantRequest{
+		Entity: &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: req.GetRoleName()},
+			Object:     &milvuspb.ObjectEntity{Name: commonpb.ObjectType_Collection.String()},
+			ObjectName: req.GetCollectionName(),
+			DbName:     req.GetDbName(),
+		},
+	}); err != nil {
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	var collectionID UniqueID
+	if req.GetCollectionName() != "" {
+		var err error
+		if _, collectionID, err = rowPolicyCollection(ctx, req.GetDbName(), req.GetCollectionName()); err != nil {
+			return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+		}
+	}
+
+	resp, err := node.rootCoord.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		RoleName:     req.GetRoleName(),
+		CollectionID: collectionID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("list row policies fail", zap.Error(err))
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.ListRowPoliciesResponse{
+		Status:   merr.Success(),
+		Policies: resp.GetPolicies(),
+	}, nil
+}
+
 func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateRole")
 	defer sp.End()
@@ -4337,7 +5607,11 @@ func (node *Proxy) validPrivilegeParams(req *milvuspb.OperatePrivilegeRequest) e
 	if err := ValidateObjectType(req.Entity.Object.Name); err != nil {
 		return err
 	}
-	if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
+	if req.Entity.Object.Name == util.FieldObjectType {
+		if err := ValidateFieldObjectName(req.Entity.ObjectName); err != nil {
+			return err
+		}
+	} else if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
 		return err
 	}
 	if req.Entity.Role == nil {
@@ -4388,7 +5662,11 @@ func (node *Proxy) validGrantParams(req *milvuspb.SelectGrantRequest) error {
 			return err
 		}
 
-		if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
+		if req.Entity.Object.Name == util.FieldObjectType {
+			if err := ValidateFieldObjectName(req.Entity.ObjectName); err != nil {
+				return err
+			}
+		} else if err := ValidateObjectName(req.Entity.ObjectName); err != nil {
 			return err
 		}
 	}
@@ -4474,6 +5752,7 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 		resp = merr.Status(err)
 		return resp, nil
 	}
+	node.multiRateLimiter.SetTenantRates(request.GetDatabaseRates(), request.GetUserRates())
 
 	return resp, nil
 }
@@ -34,14 +34,17 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -70,6 +73,51 @@ func TestProxy_InvalidateCollectionMetaCache_remove_stream(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
 }
 
+func TestProxy_InvalidateCollectionMetaCache_lb_policy(t *testing.T) {
+	paramtable.Init()
+	cache := globalMetaCache
+	globalMetaCache = nil
+	defer func() { globalMetaCache = cache }()
+
+	chMgr := NewMockChannelsMgr(t)
+	chMgr.EXPECT().removeDMLStream(mock.Anything).Return()
+	lb := NewMockLBPolicy(t)
+	lb.EXPECT().InvalidateCollection(int64(1)).Return().Once()
+
+	node := &Proxy{chMgr: chMgr, lbPolicy: lb}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	// the latencies of the dropped collection are removed
+	status, err := node.InvalidateCollectionMetaCache(context.Background(), &proxypb.InvalidateCollMetaCacheRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+		CollectionID: 1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
+
+func TestProxy_InvalidateCollectionMetaCache_collection_names(t *testing.T) {
+	paramtable.Init()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().RemoveCollection(mock.Anything, "db", "alias1").Return().Twice()
+	mockCache.EXPECT().RemoveCollection(mock.Anything, "db", "alias2").Return().Once()
+	globalMetaCache = mockCache
+
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	status, err := node.InvalidateCollectionMetaCache(context.Background(), &proxypb.InvalidateCollMetaCacheRequest{
+		Base:            &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterAlias},
+		DbName:          "db",
+		CollectionName:  "alias1",
+		CollectionNames: []string{"alias1", "alias2"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+}
+
 func TestProxy_CheckHealth(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
@@ -1015,6 +1063,100 @@ func TestProxyDropDatabase(t *testing.T) {
 	})
 }
 
+func TestProxyAlterDatabase(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		ctx := context.Background()
+		resp, err := node.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceNotReady)
+	})
+
+	factory := dependency.NewDefaultFactory(true)
+	ctx := context.Background()
+
+	node, err := NewProxy(ctx, factory)
+	assert.NoError(t, err)
+	node.tsoAllocator = &timestampAllocator{
+		tso: newMockTimestampAllocatorInterface(),
+	}
+	node.multiRateLimiter = NewMultiRateLimiter()
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	node.sched, err = newTaskScheduler(ctx, node.tsoAllocator, node.factory)
+	node.sched.ddQueue.setMaxTaskNum(10)
+	assert.NoError(t, err)
+	err = node.sched.Start()
+	assert.NoError(t, err)
+	defer node.sched.Close()
+
+	t.Run("alter database fail", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.On("AlterDatabase", mock.Anything, mock.Anything).
+			Return(nil, errors.New("fail"))
+		node.rootCoord = rc
+		ctx := context.Background()
+		resp, err := node.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{DbName: "db", NewDbName: "db2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("alter database ok", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.On("AlterDatabase", mock.Anything, mock.Anything).
+			Return(merr.Success(), nil)
+		node.rootCoord = rc
+		ctx := context.Background()
+
+		resp, err := node.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{DbName: "db", NewDbName: "db2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestProxyDescribeDatabase(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.DescribeDatabase(context.Background(), &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("describe database fail", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).
+			Return(nil, errors.New("fail"))
+		node.rootCoord = rc
+		resp, err := node.DescribeDatabase(context.Background(), &internalpb.DescribeDatabaseRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("describe database ok", func(t *testing.T) {
+		rc := mocks.NewMockRootCoordClient(t)
+		rc.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).
+			Return(&internalpb.DescribeDatabaseResponse{
+				Status:     merr.Success(),
+				DbName:     util.DefaultDBName,
+				Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}},
+			}, nil)
+		node.rootCoord = rc
+		resp, err := node.DescribeDatabase(context.Background(), &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, util.DefaultDBName, resp.GetDbName())
+		assert.Len(t, resp.GetProperties(), 1)
+	})
+}
+
 func TestProxyListDatabase(t *testing.T) {
 	paramtable.Init()
 
@@ -0,0 +1,433 @@
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ContextTokenRoles is the key of the roles mapped from the JWT in the gin context,
+// the http server sets it since the gin context only resolves the string keys.
+const ContextTokenRoles = "tokenRoles"
+
+type tokenRolesContextKey struct{}
+
+// NewContextWithTokenRoles returns the context carrying the roles mapped from the JWT which authenticates the request.
+func NewContextWithTokenRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, tokenRolesContextKey{}, roles)
+}
+
+// GetTokenRolesFromContext returns the roles mapped from the JWT which authenticates the request,
+// they're granted to the user besides the roles bound by the RBAC.
+func GetTokenRolesFromContext(ctx context.Context) []string {
+	if roles, ok := ctx.Value(tokenRolesContextKey{}).([]string); ok {
+		return roles
+	}
+	if roles, ok := ctx.Value(ContextTokenRoles).([]string); ok {
+		return roles
+	}
+	return nil
+}
+
+// jwtSigningMethods are the accepted signing algorithms, the symmetric ones are excluded since the JWKS is public.
+var jwtSigningMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+var (
+	// jwksMinReloadInterval limits how often the tokens signed by the unknown keys reload the JWKS.
+	jwksMinReloadInterval = 10 * time.Second
+	// jwksStatInterval limits how often the JWKS file is checked for the modification.
+	jwksStatInterval = time.Second
+	jwksFetchTimeout = 10 * time.Second
+	jwksMaxSize      = int64(1 << 20)
+)
+
+// jwksCache caches the public keys of the JWKS by the key id, the keys are reloaded when the uri is changed,
+// the refresh interval elapses, the JWKS file is modified or a token is signed by an unknown key.
+type jwksCache struct {
+	mu        sync.RWMutex
+	uri       string
+	keys      map[string]interface{}
+	loadedAt  time.Time
+	modTime   time.Time
+	checkedAt atomic.Time
+}
+
+var globalJWKS = &jwksCache{}
+
+func isJWKSURL(uri string) bool {
+	return strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://")
+}
+
+// stale returns true if the cached keys should be reloaded regardless of the key id, it's called with the lock held.
+func (c *jwksCache) stale(uri string) bool {
+	if c.keys == nil || c.uri != uri ||
+		time.Since(c.loadedAt) >= Params.CommonCfg.JWKSRefreshInterval.GetAsDuration(time.Second) {
+		return true
+	}
+	if isJWKSURL(uri) || time.Since(c.checkedAt.Load()) < jwksStatInterval {
+		return false
+	}
+	c.checkedAt.Store(time.Now())
+	info, err := os.Stat(uri)
+	return err == nil && !info.ModTime().Equal(c.modTime)
+}
+
+func (c *jwksCache) getKey(ctx context.Context, kid string) (interface{}, error) {
+	uri := Params.CommonCfg.JWKSURI.GetValue()
+	if uri == "" {
+		return nil, merr.WrapErrServiceInternal("the jwks uri is not configured")
+	}
+
+	c.mu.RLock()
+	key, ok := c.lookup(kid)
+	stale := c.stale(uri)
+	loadedAt := c.loadedAt
+	c.mu.RUnlock()
+	if ok && !stale {
+		return key, nil
+	}
+	if !ok && !stale && time.Since(loadedAt) < jwksMinReloadInterval {
+		return nil, merr.WrapErrParameterInvalidMsg("jwt is signed by unknown key %s", kid)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the keys may be reloaded by the others while waiting for the lock
+	if !c.loadedAt.Equal(loadedAt) && c.uri == uri {
+		if key, ok := c.lookup(kid); ok {
+			return key, nil
+		}
+		return nil, merr.WrapErrParameterInvalidMsg("jwt is signed by unknown key %s", kid)
+	}
+	if err := c.reload(ctx, uri); err != nil {
+		if key, ok := c.lookup(kid); ok && c.uri == uri {
+			// keep using the cached key if the JWKS is temporarily unavailable
+			log.Warn("fail to reload the jwks, use the cached key", zap.String("uri", uri), zap.Error(err))
+			return key, nil
+		}
+		return nil, err
+	}
+	if key, ok := c.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("jwt is signed by unknown key %s", kid)
+}
+
+// lookup returns the key of kid, the only key is used if the token doesn't specify the key id.
+func (c *jwksCache) lookup(kid string) (interface{}, bool) {
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, true
+		}
+	}
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+func (c *jwksCache) reload(ctx context.Context, uri string) error {
+	var (
+		data    []byte
+		modTime time.Time
+		err     error
+	)
+	if isJWKSURL(uri) {
+		data, err = fetchJWKS(ctx, uri)
+	} else {
+		var info os.FileInfo
+		if info, err = os.Stat(uri); err == nil {
+			modTime = info.ModTime()
+			data, err = os.ReadFile(uri)
+		}
+	}
+	if err != nil {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("fail to load the jwks from %s", uri), err.Error())
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c.uri, c.keys, c.modTime, c.loadedAt = uri, keys, modTime, now
+	c.checkedAt.Store(now)
+	log.Info("jwks loaded", zap.String("uri", uri), zap.Int("keyNum", len(keys)))
+	return nil
+}
+
+func fetchJWKS(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, jwksFetchTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, jwksMaxSize))
+}
+
+// jsonWebKey is the public key in the JWKS, see RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func parseJWKS(data []byte) (map[string]interface{}, error) {
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid jwks: %s", err.Error())
+	}
+	keys := make(map[string]interface{}, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid key %s in jwks: %s", jwk.Kid, err.Error())
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	decode := func(name, value string) ([]byte, error) {
+		if value == "" {
+			return nil, fmt.Errorf("missing %s", name)
+		}
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		return b, nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode("n", k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode("e", k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() < 2 || exponent.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid e")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported crv %s", k.Crv)
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode("y", k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if _, err := key.ECDH(); err != nil {
+			return nil, err
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported crv %s", k.Crv)
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid x")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported kty %s", k.Kty)
+	}
+}
+
+// isJWTFormat returns true if the token consists of three parts and the first one is the JOSE header.
+func isJWTFormat(token string) bool {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return false
+	}
+	header, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[0], "="))
+	if err != nil {
+		return false
+	}
+	jose := struct {
+		Alg string `json:"alg"`
+	}{}
+	return json.Unmarshal(header, &jose) == nil && jose.Alg != ""
+}
+
+// ParseJWTToken returns the JWT in the authorization if the jwt authentication is enabled,
+// the JWT is passed either as the bearer token or base64 encoded like the other tokens.
+func ParseJWTToken(token string) (string, bool) {
+	if !Params.CommonCfg.JWTEnabled.GetAsBool() {
+		return "", false
+	}
+	token = strings.TrimPrefix(token, "Bearer ")
+	if isJWTFormat(token) {
+		return token, true
+	}
+	if rawToken, err := crypto.Base64Decode(token); err == nil && isJWTFormat(rawToken) {
+		return rawToken, true
+	}
+	return "", false
+}
+
+// getClaim returns the claim of the name, the nested claim is separated by dot unless the name itself is a claim.
+func getClaim(claims jwt.MapClaims, name string) (interface{}, bool) {
+	if value, ok := claims[name]; ok {
+		return value, true
+	}
+	var current interface{} = map[string]interface{}(claims)
+	for _, field := range strings.Split(name, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[field]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// getRolesClaim accepts either the array of the role names or the role names separated by space or comma.
+func getRolesClaim(claims jwt.MapClaims, name string) ([]string, error) {
+	value, ok := getClaim(claims, name)
+	if !ok {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool { return r == ' ' || r == ',' }), nil
+	case []interface{}:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			s, ok := role.(string)
+			if !ok {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid role %v in claim %s", role, name)
+			}
+			roles = append(roles, s)
+		}
+		return roles, nil
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("invalid claim %s", name)
+	}
+}
+
+// VerifyJWT verifies the JWT against the JWKS, and returns the user and the roles mapped from its claims.
+func VerifyJWT(ctx context.Context, token string) (string, []string, error) {
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(jwtSigningMethods))
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return globalJWKS.getKey(ctx, kid)
+	})
+	if err != nil {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("invalid jwt: %s", err.Error())
+	}
+
+	now := time.Now().Unix()
+	if !claims.VerifyExpiresAt(now, true) {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("jwt is expired or without exp")
+	}
+	if issuer := Params.CommonCfg.JWTIssuer.GetValue(); issuer != "" && !claims.VerifyIssuer(issuer, true) {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("jwt is not issued by %s", issuer)
+	}
+	audiences := Params.CommonCfg.JWTAudiences.GetAsStrings()
+	verified := true
+	for _, audience := range audiences {
+		if audience == "" {
+			continue
+		}
+		if verified = claims.VerifyAudience(audience, true); verified {
+			break
+		}
+	}
+	if !verified {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("jwt is not for audience %s", Params.CommonCfg.JWTAudiences.GetValue())
+	}
+
+	usernameClaim := Params.CommonCfg.JWTUsernameClaim.GetValue()
+	value, _ := getClaim(claims, usernameClaim)
+	username, _ := value.(string)
+	if username == "" || strings.Contains(username, util.CredentialSeperator) {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("invalid user %v in claim %s", value, usernameClaim)
+	}
+	// the identity provider shouldn't grant the superuser by naming a user, unless it's trusted explicitly
+	if isReservedUser(username) && !Params.CommonCfg.JWTAllowSuperUsers.GetAsBool() {
+		return "", nil, merr.WrapErrPrivilegeNotAuthenticated("user %s in claim %s is reserved", username, usernameClaim)
+	}
+	var roles []string
+	if rolesClaim := Params.CommonCfg.JWTRolesClaim.GetValue(); rolesClaim != "" {
+		if roles, err = getRolesClaim(claims, rolesClaim); err != nil {
+			return "", nil, merr.WrapErrPrivilegeNotAuthenticated("%s", err.Error())
+		}
+	}
+	return username, roles, nil
+}
+
+// isReservedUser returns whether the user is root or one of the super users, which are privileged over the others.
+func isReservedUser(username string) bool {
+	return username == util.UserRoot || typeutil.NewSet(Params.CommonCfg.SuperUsers.GetAsStrings()...).Contain(username)
+}
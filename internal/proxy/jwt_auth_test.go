@@ -0,0 +1,311 @@
+package proxy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type testJWTKey struct {
+	kid    string
+	method jwt.SigningMethod
+	key    interface{}
+}
+
+func newTestRSAKey(t *testing.T, kid string) *testJWTKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return &testJWTKey{kid: kid, method: jwt.SigningMethodRS256, key: key}
+}
+
+func newTestECKey(t *testing.T, kid string) *testJWTKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testJWTKey{kid: kid, method: jwt.SigningMethodES256, key: key}
+}
+
+func (k *testJWTKey) jwk() map[string]string {
+	encode := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	switch key := k.key.(type) {
+	case *rsa.PrivateKey:
+		return map[string]string{"kty": "RSA", "kid": k.kid, "use": "sig", "n": encode(key.N), "e": encode(big.NewInt(int64(key.E)))}
+	case *ecdsa.PrivateKey:
+		return map[string]string{"kty": "EC", "kid": k.kid, "crv": "P-256", "x": encode(key.X), "y": encode(key.Y)}
+	}
+	return nil
+}
+
+func (k *testJWTKey) sign(t *testing.T, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(k.method, claims)
+	token.Header["kid"] = k.kid
+	signed, err := token.SignedString(k.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func marshalJWKS(t *testing.T, keys ...*testJWTKey) []byte {
+	jwks := map[string][]map[string]string{"keys": {}}
+	for _, key := range keys {
+		jwks["keys"] = append(jwks["keys"], key.jwk())
+	}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	return data
+}
+
+func resetJWKS() {
+	globalJWKS = &jwksCache{}
+}
+
+func TestVerifyJWT(t *testing.T) {
+	paramtable.Init()
+	rsaKey := newTestRSAKey(t, "rsa")
+	ecKey := newTestECKey(t, "ec")
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, marshalJWKS(t, rsaKey, ecKey), 0o600))
+
+	params := paramtable.Get()
+	params.Save(Params.CommonCfg.JWKSURI.Key, jwksFile)
+	params.Save(Params.CommonCfg.JWTIssuer.Key, "https://idp.example.com")
+	params.Save(Params.CommonCfg.JWTAudiences.Key, "milvus,vectordb")
+	params.Save(Params.CommonCfg.JWTRolesClaim.Key, "realm_access.roles")
+	defer func() {
+		params.Reset(Params.CommonCfg.JWKSURI.Key)
+		params.Reset(Params.CommonCfg.JWTIssuer.Key)
+		params.Reset(Params.CommonCfg.JWTAudiences.Key)
+		params.Reset(Params.CommonCfg.JWTRolesClaim.Key)
+		params.Reset(Params.CommonCfg.JWTUsernameClaim.Key)
+		resetJWKS()
+	}()
+	resetJWKS()
+
+	ctx := context.Background()
+	claims := func(modify func(claims jwt.MapClaims)) jwt.MapClaims {
+		claims := jwt.MapClaims{
+			"sub": "alice",
+			"iss": "https://idp.example.com",
+			"aud": []string{"vectordb"},
+			"exp": time.Now().Add(time.Hour).Unix(),
+			"realm_access": map[string]interface{}{
+				"roles": []string{"role1", "role2"},
+			},
+		}
+		if modify != nil {
+			modify(claims)
+		}
+		return claims
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		for _, key := range []*testJWTKey{rsaKey, ecKey} {
+			user, roles, err := VerifyJWT(ctx, key.sign(t, claims(nil)))
+			assert.NoError(t, err)
+			assert.Equal(t, "alice", user)
+			assert.Equal(t, []string{"role1", "role2"}, roles)
+		}
+	})
+
+	t.Run("invalid claims", func(t *testing.T) {
+		for name, modify := range map[string]func(claims jwt.MapClaims){
+			"expired":        func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() },
+			"without exp":    func(claims jwt.MapClaims) { delete(claims, "exp") },
+			"wrong audience": func(claims jwt.MapClaims) { claims["aud"] = "others" },
+			"wrong issuer":   func(claims jwt.MapClaims) { claims["iss"] = "https://others.example.com" },
+			"without user":   func(claims jwt.MapClaims) { delete(claims, "sub") },
+			"invalid user":   func(claims jwt.MapClaims) { claims["sub"] = "alice:bob" },
+			"invalid roles":  func(claims jwt.MapClaims) { claims["realm_access"] = map[string]interface{}{"roles": []int{1}} },
+		} {
+			_, _, err := VerifyJWT(ctx, rsaKey.sign(t, claims(modify)))
+			assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated, name)
+		}
+	})
+
+	t.Run("claims mapping", func(t *testing.T) {
+		params.Save(Params.CommonCfg.JWTUsernameClaim.Key, "preferred_username")
+		params.Save(Params.CommonCfg.JWTRolesClaim.Key, "https://milvus.io/roles")
+		defer params.Save(Params.CommonCfg.JWTRolesClaim.Key, "realm_access.roles")
+		defer params.Reset(Params.CommonCfg.JWTUsernameClaim.Key)
+
+		user, roles, err := VerifyJWT(ctx, rsaKey.sign(t, claims(func(claims jwt.MapClaims) {
+			claims["preferred_username"] = "bob"
+			claims["https://milvus.io/roles"] = "role3 role4"
+		})))
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", user)
+		assert.Equal(t, []string{"role3", "role4"}, roles)
+	})
+
+	t.Run("reserved users", func(t *testing.T) {
+		params.Save(Params.CommonCfg.SuperUsers.Key, "admin")
+		defer params.Reset(Params.CommonCfg.SuperUsers.Key)
+
+		for _, user := range []string{util.UserRoot, "admin"} {
+			token := rsaKey.sign(t, claims(func(claims jwt.MapClaims) { claims["sub"] = user }))
+			_, _, err := VerifyJWT(ctx, token)
+			assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated, user)
+
+			params.Save(Params.CommonCfg.JWTAllowSuperUsers.Key, "true")
+			verified, _, err := VerifyJWT(ctx, token)
+			params.Reset(Params.CommonCfg.JWTAllowSuperUsers.Key)
+			assert.NoError(t, err)
+			assert.Equal(t, user, verified)
+		}
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		// signed by the key not in the jwks
+		otherKey := newTestRSAKey(t, "rsa")
+		_, _, err := VerifyJWT(ctx, otherKey.sign(t, claims(nil)))
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+
+		// the symmetric algorithms are rejected
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil))
+		token.Header["kid"] = "rsa"
+		signed, err := token.SignedString([]byte("secret"))
+		require.NoError(t, err)
+		_, _, err = VerifyJWT(ctx, signed)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("key rotation", func(t *testing.T) {
+		newKey := newTestECKey(t, "ec2")
+		token := newKey.sign(t, claims(nil))
+		// the jwks is reloaded at most once within the interval for the unknown keys
+		_, _, err := VerifyJWT(ctx, token)
+		assert.Error(t, err)
+
+		require.NoError(t, os.WriteFile(jwksFile, marshalJWKS(t, rsaKey, newKey), 0o600))
+		modTime := time.Now().Add(time.Second)
+		require.NoError(t, os.Chtimes(jwksFile, modTime, modTime))
+		globalJWKS.checkedAt.Store(time.Now().Add(-jwksStatInterval))
+		user, _, err := VerifyJWT(ctx, token)
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", user)
+
+		// the removed key is rejected
+		_, _, err = VerifyJWT(ctx, ecKey.sign(t, claims(nil)))
+		assert.Error(t, err)
+	})
+
+	t.Run("jwks not configured", func(t *testing.T) {
+		params.Save(Params.CommonCfg.JWKSURI.Key, "")
+		defer params.Save(Params.CommonCfg.JWKSURI.Key, jwksFile)
+		_, _, err := VerifyJWT(ctx, rsaKey.sign(t, claims(nil)))
+		assert.Error(t, err)
+	})
+}
+
+func TestJWKSCache(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	defer params.Reset(Params.CommonCfg.JWKSURI.Key)
+	defer params.Reset(Params.CommonCfg.JWKSRefreshInterval.Key)
+	ctx := context.Background()
+
+	key1 := newTestRSAKey(t, "key1")
+	key2 := newTestRSAKey(t, "key2")
+	jwks := atomic.NewString(string(marshalJWKS(t, key1)))
+	requests := atomic.NewInt32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Inc()
+		w.Write([]byte(jwks.Load()))
+	}))
+	defer server.Close()
+	params.Save(Params.CommonCfg.JWKSURI.Key, server.URL)
+
+	cache := &jwksCache{}
+	_, err := cache.getKey(ctx, "key1")
+	assert.NoError(t, err)
+	// the only key is used if the token doesn't specify the key id
+	_, err = cache.getKey(ctx, "")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+
+	// the unknown keys don't reload the jwks within the interval
+	jwks.Store(string(marshalJWKS(t, key1, key2)))
+	_, err = cache.getKey(ctx, "key2")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.EqualValues(t, 1, requests.Load())
+
+	cache.loadedAt = time.Now().Add(-jwksMinReloadInterval)
+	_, err = cache.getKey(ctx, "key2")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, requests.Load())
+
+	// the jwks is reloaded after the refresh interval, the cached keys are kept if it fails
+	params.Save(Params.CommonCfg.JWKSRefreshInterval.Key, "0")
+	jwks.Store("invalid")
+	_, err = cache.getKey(ctx, "key1")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, requests.Load())
+	_, err = cache.getKey(ctx, "key3")
+	assert.Error(t, err)
+}
+
+func TestParseJWKS(t *testing.T) {
+	_, err := parseJWKS([]byte("invalid"))
+	assert.Error(t, err)
+
+	keys, err := parseJWKS([]byte(`{"keys": [{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"}]}`))
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	for _, jwks := range []string{
+		`{"keys": [{"kty": "RSA", "kid": "k1", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "RSA", "kid": "k1", "n": "AQAB", "e": "AQ"}]}`,
+		`{"keys": [{"kty": "EC", "kid": "k1", "crv": "P-256", "x": "AQAB", "y": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "kid": "k1", "crv": "secp256k1", "x": "AQAB", "y": "AQAB"}]}`,
+		`{"keys": [{"kty": "OKP", "kid": "k1", "crv": "Ed25519", "x": "AQAB"}]}`,
+		`{"keys": [{"kty": "oct", "kid": "k1", "k": "AQAB"}]}`,
+	} {
+		_, err := parseJWKS([]byte(jwks))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, jwks)
+	}
+}
+
+func TestParseJWTToken(t *testing.T) {
+	paramtable.Init()
+	key := newTestECKey(t, "ec")
+	token := key.sign(t, jwt.MapClaims{"sub": "alice"})
+
+	_, ok := ParseJWTToken(token)
+	assert.False(t, ok)
+
+	paramtable.Get().Save(Params.CommonCfg.JWTEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.JWTEnabled.Key)
+	for _, authorization := range []string{token, "Bearer " + token, crypto.Base64Encode(token)} {
+		jwtToken, ok := ParseJWTToken(authorization)
+		assert.True(t, ok)
+		assert.Equal(t, token, jwtToken)
+	}
+	for _, authorization := range []string{
+		crypto.Base64Encode("alice:123456"),
+		crypto.Base64Encode("apikey"),
+		"id.secret",
+		"a.b.c",
+	} {
+		_, ok := ParseJWTToken(authorization)
+		assert.False(t, ok, authorization)
+	}
+}
@@ -0,0 +1,139 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+const (
+	// minHedgeLatencySamples is the least number of latency samples to compute the hedge delay
+	minHedgeLatencySamples = 100
+	// maxHedgeBudgetTokens limits the burst of hedged sub requests
+	maxHedgeBudgetTokens = 10
+	// latencyQuantileRefreshInterval is the number of new samples to recompute the cached quantile
+	latencyQuantileRefreshInterval = 64
+)
+
+type hedgeCommitKey struct{}
+
+// withHedgeCommitter marks the context shared by the hedged sub requests of one channel.
+func withHedgeCommitter(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgeCommitKey{}, atomic.NewBool(false))
+}
+
+// tryCommitShardResult returns whether the result of a sub request could be committed,
+// only the first finished one of the hedged sub requests on the same channel is allowed to commit.
+func tryCommitShardResult(ctx context.Context) bool {
+	committed, ok := ctx.Value(hedgeCommitKey{}).(*atomic.Bool)
+	if !ok {
+		return true
+	}
+	return committed.CompareAndSwap(false, true)
+}
+
+// latencyTracker keeps the latencies of the recent sub requests.
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples []time.Duration
+	next    int
+	full    bool
+
+	// the quantile is recomputed after enough new samples recorded
+	cachedQ       float64
+	cachedLatency time.Duration
+	staleness     int
+}
+
+func newLatencyTracker(size int) *latencyTracker {
+	if size <= 0 {
+		size = minHedgeLatencySamples
+	}
+	return &latencyTracker{
+		samples: make([]time.Duration, size),
+	}
+}
+
+func (t *latencyTracker) record(latency time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples[t.next] = latency
+	t.staleness++
+	t.next++
+	if t.next == len(t.samples) {
+		t.next = 0
+		t.full = true
+	}
+}
+
+// quantile returns the latency at the given quantile, false if there are not enough samples.
+func (t *latencyTracker) quantile(q float64) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	num := t.next
+	if t.full {
+		num = len(t.samples)
+	}
+	if num < minHedgeLatencySamples && num < len(t.samples) {
+		return 0, false
+	}
+	if t.cachedQ == q && t.staleness < latencyQuantileRefreshInterval {
+		return t.cachedLatency, true
+	}
+
+	sorted := make([]time.Duration, num)
+	copy(sorted, t.samples[:num])
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(math.Ceil(q*float64(num))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= num {
+		idx = num - 1
+	}
+	t.cachedQ, t.cachedLatency, t.staleness = q, sorted[idx], 0
+	return t.cachedLatency, true
+}
+
+// hedgeBudget is a token bucket which limits the ratio of hedged sub requests,
+// each sub request deposits ratio tokens and each hedged one withdraws one token.
+type hedgeBudget struct {
+	mu     sync.Mutex
+	tokens float64
+}
+
+func (b *hedgeBudget) deposit(ratio float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.tokens+ratio, maxHedgeBudgetTokens)
+}
+
+func (b *hedgeBudget) withdraw() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	tracker := newLatencyTracker(200)
+	_, ok := tracker.quantile(0.95)
+	assert.False(t, ok)
+
+	for i := 1; i <= minHedgeLatencySamples; i++ {
+		tracker.record(time.Duration(i) * time.Millisecond)
+	}
+	latency, ok := tracker.quantile(0.95)
+	assert.True(t, ok)
+	assert.Equal(t, 95*time.Millisecond, latency)
+
+	// cached until enough new samples recorded
+	tracker.record(time.Second)
+	latency, _ = tracker.quantile(0.95)
+	assert.Equal(t, 95*time.Millisecond, latency)
+	latency, _ = tracker.quantile(0.5)
+	assert.Equal(t, 51*time.Millisecond, latency)
+
+	// the oldest samples are overwritten
+	for i := 0; i < 200; i++ {
+		tracker.record(time.Second)
+	}
+	latency, _ = tracker.quantile(0.5)
+	assert.Equal(t, time.Second, latency)
+}
+
+func TestHedgeBudget(t *testing.T) {
+	budget := &hedgeBudget{}
+	assert.False(t, budget.withdraw())
+
+	for i := 0; i < 8; i++ {
+		budget.deposit(0.25)
+	}
+	assert.True(t, budget.withdraw())
+	assert.True(t, budget.withdraw())
+	assert.False(t, budget.withdraw())
+
+	for i := 0; i < 1000; i++ {
+		budget.deposit(1)
+	}
+	for i := 0; i < maxHedgeBudgetTokens; i++ {
+		assert.True(t, budget.withdraw())
+	}
+	assert.False(t, budget.withdraw())
+}
+
+func TestTryCommitShardResult(t *testing.T) {
+	assert.True(t, tryCommitShardResult(context.Background()))
+	assert.True(t, tryCommitShardResult(context.Background()))
+
+	ctx := withHedgeCommitter(context.Background())
+	assert.True(t, tryCommitShardResult(ctx))
+	assert.False(t, tryCommitShardResult(ctx))
+}
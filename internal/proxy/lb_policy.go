@@ -17,6 +17,8 @@ package proxy
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -27,7 +29,9 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -43,6 +47,9 @@ type ChannelWorkload struct {
 	nq             int64
 	exec           executeFunc
 	retryTimes     uint
+	// hedgeable means exec could run on two shard leaders at the same time,
+	// exec must commit its result only if tryCommitShardResult returns true
+	hedgeable bool
 }
 
 type CollectionWorkLoad struct {
@@ -51,12 +58,15 @@ type CollectionWorkLoad struct {
 	collectionID   int64
 	nq             int64
 	exec           executeFunc
+	hedgeable      bool
 }
 
 type LBPolicy interface {
 	Execute(ctx context.Context, workload CollectionWorkLoad) error
 	ExecuteWithRetry(ctx context.Context, workload ChannelWorkload) error
 	UpdateCostMetrics(node int64, cost *internalpb.CostAggregation)
+	// InvalidateCollection removes the states kept for the collection, such as the latencies for hedging
+	InvalidateCollection(collectionID int64)
 	Start(ctx context.Context)
 	Close()
 }
@@ -64,6 +74,10 @@ type LBPolicy interface {
 type LBPolicyImpl struct {
 	balancer  LBBalancer
 	clientMgr shardClientMgr
+
+	// collection id -> latencies of recent sub requests, for computing the hedge delay
+	latencies   *typeutil.ConcurrentMap[int64, *latencyTracker]
+	hedgeBudget hedgeBudget
 }
 
 func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
@@ -82,6 +96,7 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	return &LBPolicyImpl{
 		balancer:  balancer,
 		clientMgr: clientMgr,
+		latencies: typeutil.NewConcurrentMap[int64, *latencyTracker](),
 	}
 }
 
@@ -177,7 +192,7 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 			return lastErr
 		}
 
-		err = workload.exec(ctx, targetNode, client, workload.channel)
+		err = lb.execute(ctx, workload, targetNode, client, excludeNodes)
 		if err != nil {
 			log.Warn("search/query channel failed",
 				zap.Int64("nodeID", targetNode),
@@ -219,6 +234,7 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 				nq:             workload.nq,
 				exec:           workload.exec,
 				retryTimes:     uint(len(nodes) * retryOnReplica),
+				hedgeable:      workload.hedgeable,
 			})
 			return err
 		})
@@ -228,10 +244,135 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 	return err
 }
 
+// execute runs the workload on targetNode. If the workload is hedgeable and targetNode doesn't respond in the hedge delay,
+// the same workload is sent to another shard leader, the first succeeded one wins and the other one is cancelled.
+func (lb *LBPolicyImpl) execute(ctx context.Context, workload ChannelWorkload, targetNode int64, client types.QueryNodeClient, excludeNodes typeutil.UniqueSet) error {
+	tracker, ok := lb.latencies.Get(workload.collectionID)
+	if !ok {
+		tracker, _ = lb.latencies.GetOrInsert(workload.collectionID,
+			newLatencyTracker(Params.ProxyCfg.HedgeLatencySampleNum.GetAsInt()))
+	}
+	start := time.Now()
+
+	delay, ok := lb.hedgeDelay(workload, tracker)
+	if !ok {
+		err := workload.exec(ctx, targetNode, client, workload.channel)
+		if err == nil {
+			tracker.record(time.Since(start))
+		}
+		return err
+	}
+	lb.hedgeBudget.deposit(Params.ProxyCfg.HedgeBudgetRatio.GetAsFloat())
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", workload.collectionID),
+		zap.String("channelName", workload.channel),
+		zap.Int64("nodeID", targetNode),
+	)
+	ctx, cancel := context.WithCancel(withHedgeCommitter(ctx))
+	defer cancel()
+
+	type execResult struct {
+		node int64
+		err  error
+	}
+	// buffered to let the loser exit after the winner returns
+	resultCh := make(chan execResult, 2)
+	run := func(node int64, client types.QueryNodeClient) {
+		go func() {
+			resultCh <- execResult{node: node, err: workload.exec(ctx, node, client, workload.channel)}
+		}()
+	}
+	run(targetNode, client)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	hedgeNode := int64(-1)
+	// ExecuteWithRetry only cancels the workload of targetNode, the one of the hedge node is cancelled here
+	// whichever request wins.
+	defer func() {
+		if hedgeNode != -1 {
+			lb.balancer.CancelWorkload(hedgeNode, workload.nq)
+		}
+	}()
+	pending := 1
+	var lastErr error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if !lb.hedgeBudget.withdraw() {
+				metrics.ProxyHedgedRequests.WithLabelValues(nodeID, metrics.HedgeThrottledLabel).Inc()
+				continue
+			}
+			excluded := typeutil.NewUniqueSet(excludeNodes.Collect()...)
+			excluded.Insert(targetNode)
+			node, err := lb.selectNode(ctx, workload, excluded)
+			if err != nil {
+				log.Debug("no shard leader to send the hedged request", zap.Error(err))
+				continue
+			}
+			hedgeClient, err := lb.clientMgr.GetClient(ctx, node)
+			if err != nil {
+				log.Debug("failed to get shard leader for the hedged request", zap.Int64("hedgeNode", node), zap.Error(err))
+				lb.balancer.CancelWorkload(node, workload.nq)
+				continue
+			}
+			log.Debug("send hedged request", zap.Int64("hedgeNode", node), zap.Duration("delay", delay))
+			metrics.ProxyHedgedRequests.WithLabelValues(nodeID, metrics.HedgeIssuedLabel).Inc()
+			hedgeNode = node
+			pending++
+			run(node, hedgeClient)
+
+		case result := <-resultCh:
+			pending--
+			if result.err != nil {
+				log.Warn("search/query channel failed", zap.Int64("failedNode", result.node), zap.Error(result.err))
+				lastErr = result.err
+				continue
+			}
+
+			latency := time.Since(start)
+			tracker.record(latency)
+			if result.node == hedgeNode && pending > 0 {
+				// the primary node is at least as slow as the latency, let the balancer know it
+				metrics.ProxyHedgedRequests.WithLabelValues(nodeID, metrics.HedgeWonLabel).Inc()
+				lb.balancer.UpdateCostMetrics(targetNode, &internalpb.CostAggregation{
+					ResponseTime: latency.Milliseconds(),
+					ServiceTime:  latency.Milliseconds(),
+					TotalNQ:      workload.nq,
+				})
+			}
+			return nil
+		}
+	}
+	return lastErr
+}
+
+// hedgeDelay returns the delay to send the hedged request, false if the workload should not be hedged.
+func (lb *LBPolicyImpl) hedgeDelay(workload ChannelWorkload, tracker *latencyTracker) (time.Duration, bool) {
+	if !workload.hedgeable || !Params.ProxyCfg.HedgeEnabled.GetAsBool() || len(workload.shardLeaders) < 2 {
+		return 0, false
+	}
+	delay, ok := tracker.quantile(Params.ProxyCfg.HedgeDelayPercentile.GetAsFloat())
+	if !ok {
+		return 0, false
+	}
+	if minDelay := Params.ProxyCfg.HedgeMinDelay.GetAsDuration(time.Millisecond); delay < minDelay {
+		delay = minDelay
+	}
+	return delay, true
+}
+
 func (lb *LBPolicyImpl) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
 	lb.balancer.UpdateCostMetrics(node, cost)
 }
 
+// InvalidateCollection implements LBPolicy.
+func (lb *LBPolicyImpl) InvalidateCollection(collectionID int64) {
+	lb.latencies.Remove(collectionID)
+}
+
 func (lb *LBPolicyImpl) Close() {
 	lb.balancer.Close()
 }
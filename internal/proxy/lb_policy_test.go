@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -411,11 +412,138 @@ func (s *LBPolicySuite) TestExecute() {
 	s.ErrorIs(err, mockErr)
 }
 
+func (s *LBPolicySuite) TestExecuteWithHedge() {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.ProxyCfg.HedgeEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HedgeEnabled.Key)
+
+	tracker := newLatencyTracker(minHedgeLatencySamples)
+	for i := 0; i < minHedgeLatencySamples; i++ {
+		tracker.record(time.Millisecond)
+	}
+	s.lbPolicy.latencies.Insert(s.collectionID, tracker)
+	s.lbPolicy.hedgeBudget.tokens = maxHedgeBudgetTokens
+
+	s.lbBalancer.ExpectedCalls = nil
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(2, nil).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	// the slow primary node is reported to the balancer
+	s.lbBalancer.EXPECT().UpdateCostMetrics(int64(1), mock.Anything).Once()
+
+	committedBy := atomic.NewInt64(0)
+	err := s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.nodes,
+		nq:             1,
+		exec: func(ctx context.Context, nodeID UniqueID, qn types.QueryNodeClient, s ...string) error {
+			if nodeID == 1 {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			if tryCommitShardResult(ctx) {
+				committedBy.Store(nodeID)
+			}
+			return nil
+		},
+		retryTimes: 1,
+		hedgeable:  true,
+	})
+	s.NoError(err)
+	s.Equal(int64(2), committedBy.Load())
+
+	// no budget, wait for the primary node
+	s.lbPolicy.hedgeBudget.tokens = 0
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.nodes,
+		nq:             1,
+		exec: func(ctx context.Context, nodeID UniqueID, qn types.QueryNodeClient, s ...string) error {
+			time.Sleep(20 * time.Millisecond)
+			if tryCommitShardResult(ctx) {
+				committedBy.Store(nodeID)
+			}
+			return nil
+		},
+		retryTimes: 1,
+		hedgeable:  true,
+	})
+	s.NoError(err)
+	s.Equal(int64(1), committedBy.Load())
+}
+
+func (s *LBPolicySuite) TestExecuteWithHedgePrimaryWins() {
+	ctx := context.Background()
+	paramtable.Get().Save(Params.ProxyCfg.HedgeEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HedgeEnabled.Key)
+
+	tracker := newLatencyTracker(minHedgeLatencySamples)
+	for i := 0; i < minHedgeLatencySamples; i++ {
+		tracker.record(time.Millisecond)
+	}
+	s.lbPolicy.latencies.Insert(s.collectionID, tracker)
+	s.lbPolicy.hedgeBudget.tokens = maxHedgeBudgetTokens
+	balancer := NewLookAsideBalancer(s.mgr)
+	s.lbPolicy.balancer = balancer
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+
+	hedged := make(chan struct{})
+	calls := atomic.NewInt32(0)
+	err := s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.nodes,
+		nq:             10,
+		exec: func(ctx context.Context, nodeID UniqueID, qn types.QueryNodeClient, s ...string) error {
+			if calls.Inc() == 1 {
+				// the primary request returns after the hedged one is sent
+				<-hedged
+				return nil
+			}
+			close(hedged)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		retryTimes: 1,
+		hedgeable:  true,
+	})
+	s.NoError(err)
+	s.Equal(int32(2), calls.Load())
+	// the workloads of both the primary and the hedge node are cancelled
+	for _, node := range s.nodes {
+		nq, ok := balancer.executingTaskTotalNQ.Get(node)
+		if ok {
+			s.Equal(int64(0), nq.Load(), "node %d", node)
+		}
+	}
+}
+
 func (s *LBPolicySuite) TestUpdateCostMetrics() {
 	s.lbBalancer.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything)
 	s.lbPolicy.UpdateCostMetrics(1, &internalpb.CostAggregation{})
 }
 
+func (s *LBPolicySuite) TestInvalidateCollection() {
+	s.lbPolicy.latencies.Insert(s.collectionID, newLatencyTracker(minHedgeLatencySamples))
+	s.lbPolicy.latencies.Insert(s.collectionID+1, newLatencyTracker(minHedgeLatencySamples))
+
+	s.lbPolicy.InvalidateCollection(s.collectionID)
+	s.False(s.lbPolicy.latencies.Contain(s.collectionID))
+	s.True(s.lbPolicy.latencies.Contain(s.collectionID + 1))
+}
+
 func (s *LBPolicySuite) TestNewLBPolicy() {
 	policy := NewLBPolicyImpl(s.mgr)
 	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.LookAsideBalancer")
@@ -77,6 +77,15 @@ type Cache interface {
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
 	RemoveCredential(username string)
 	UpdateCredential(credInfo *internalpb.CredentialInfo)
+	// ReportLoginFailure reports the failed login to rootcoord, returns the unix time in seconds until which the user
+	// is locked out, the credential of the user is removed once locked out
+	ReportLoginFailure(ctx context.Context, username string) (int64, error)
+	// ReportLoginSuccess reports the successful login after the failures to rootcoord, the failures are cleared
+	ReportLoginSuccess(ctx context.Context, username string) error
+	// GetAPIKey returns the api key by id, the api keys of the user are removed along with the credential
+	GetAPIKey(ctx context.Context, id string) (*internalpb.APIKeyInfo, error)
+	// GetRowPolicies returns the row policies of the collection by role, the policies are removed along with the collection
+	GetRowPolicies(ctx context.Context, collectionID UniqueID) (map[string]string, error)
 
 	GetPrivilegeInfo(ctx context.Context) []string
 	GetUserRole(username string) []string
@@ -91,6 +100,7 @@ type collectionBasicInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	properties          []*commonpb.KeyValuePair
 	partInfo            map[string]*partitionInfo
 }
 
@@ -103,6 +113,7 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	properties          []*commonpb.KeyValuePair
 }
 
 // getBasicInfo get a basic info by deep copy.
@@ -113,6 +124,7 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdTimestamp:    info.createdTimestamp,
 		createdUtcTimestamp: info.createdUtcTimestamp,
 		consistencyLevel:    info.consistencyLevel,
+		properties:          info.properties,
 		partInfo:            make(map[string]*partitionInfo, len(info.partInfo)),
 	}
 	for s, info := range info.partInfo {
@@ -196,6 +208,8 @@ type MetaCache struct {
 
 	collInfo       map[string]map[string]*collectionInfo // database -> collection -> collection_info
 	credMap        map[string]*internalpb.CredentialInfo // cache for credential, lazy load
+	apiKeyMap      map[string]*internalpb.APIKeyInfo     // cache for api key, lazy load
+	rowPolicies    map[UniqueID]map[string]string        // collection id -> role -> row policy, lazy load
 	privilegeInfos map[string]struct{}                   // privileges cache
 	userToRoles    map[string]map[string]struct{}        // user to role cache
 	mu             sync.RWMutex
@@ -234,6 +248,8 @@ func NewMetaCache(rootCoord types.RootCoordClient, queryCoord types.QueryCoordCl
 		queryCoord:     queryCoord,
 		collInfo:       map[string]map[string]*collectionInfo{},
 		credMap:        map[string]*internalpb.CredentialInfo{},
+		apiKeyMap:      map[string]*internalpb.APIKeyInfo{},
+		rowPolicies:    map[UniqueID]map[string]string{},
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
@@ -440,6 +456,7 @@ func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse,
 	m.collInfo[database][collectionName].createdTimestamp = coll.CreatedTimestamp
 	m.collInfo[database][collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
 	m.collInfo[database][collectionName].consistencyLevel = coll.ConsistencyLevel
+	m.collInfo[database][collectionName].properties = coll.Properties
 }
 
 func (m *MetaCache) GetPartitionID(ctx context.Context, database, collectionName string, partitionName string) (typeutil.UniqueID, error) {
@@ -597,6 +614,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		DbName:               coll.GetDbName(),
+		Properties:           coll.Properties,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -671,6 +689,9 @@ func (m *MetaCache) RemoveCollection(ctx context.Context, database, collectionNa
 	defer m.mu.Unlock()
 	_, dbOk := m.collInfo[database]
 	if dbOk {
+		if coll, ok := m.collInfo[database][collectionName]; ok {
+			delete(m.rowPolicies, coll.collID)
+		}
 		delete(m.collInfo[database], collectionName)
 	}
 }
@@ -679,6 +700,7 @@ func (m *MetaCache) RemoveCollectionsByID(ctx context.Context, collectionID Uniq
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	var collNames []string
+	delete(m.rowPolicies, collectionID)
 	for database, db := range m.collInfo {
 		for k, v := range db {
 			if v.collID == collectionID {
@@ -732,8 +754,11 @@ func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*in
 			return &internalpb.CredentialInfo{}, err
 		}
 		credInfo = &internalpb.CredentialInfo{
-			Username:          resp.Username,
-			EncryptedPassword: resp.Password,
+			Username:           resp.Username,
+			EncryptedPassword:  resp.Password,
+			PasswordUpdateTime: resp.PasswordUpdateTime,
+			NeedPasswordChange: resp.NeedPasswordChange,
+			LockedUntil:        resp.LockedUntil,
 		}
 	}
 
@@ -745,6 +770,11 @@ func (m *MetaCache) RemoveCredential(username string) {
 	defer m.credMut.Unlock()
 	// delete pair in credMap
 	delete(m.credMap, username)
+	for id, apiKey := range m.apiKeyMap {
+		if apiKey.GetUsername() == username {
+			delete(m.apiKeyMap, id)
+		}
+	}
 }
 
 func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
@@ -759,6 +789,87 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	// Do not cache encrypted password content
 	m.credMap[username].Username = username
 	m.credMap[username].Sha256Password = credInfo.Sha256Password
+	m.credMap[username].PasswordUpdateTime = credInfo.PasswordUpdateTime
+	m.credMap[username].NeedPasswordChange = credInfo.NeedPasswordChange
+}
+
+// ReportLoginFailure reports the failed login of the user to rootcoord, which counts the failures of all the proxies
+func (m *MetaCache) ReportLoginFailure(ctx context.Context, username string) (int64, error) {
+	resp, err := m.rootCoord.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Username: username,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return 0, err
+	}
+	return resp.GetLockedUntil(), nil
+}
+
+// ReportLoginSuccess reports the successful login of the user to rootcoord, so that the failures are no longer consecutive
+func (m *MetaCache) ReportLoginSuccess(ctx context.Context, username string) error {
+	resp, err := m.rootCoord.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{
+		Base:      commonpbutil.NewMsgBase(),
+		Username:  username,
+		Succeeded: true,
+	})
+	return merr.CheckRPCCall(resp, err)
+}
+
+// GetAPIKey returns the api key related to provided id
+// If the cache missed, proxy will try to fetch from storage
+func (m *MetaCache) GetAPIKey(ctx context.Context, id string) (*internalpb.APIKeyInfo, error) {
+	m.credMut.RLock()
+	apiKey, ok := m.apiKeyMap[id]
+	m.credMut.RUnlock()
+	if ok {
+		return apiKey, nil
+	}
+
+	resp, err := m.rootCoord.ListAPIKeys(ctx, &internalpb.ListAPIKeysRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Id:   id,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	if len(resp.GetApiKeys()) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("api key not found: %s", id)
+	}
+	apiKey = resp.GetApiKeys()[0]
+
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.apiKeyMap[id] = apiKey
+	return apiKey, nil
+}
+
+// GetRowPolicies returns the row policies of the collection by role
+// If the cache missed, proxy will try to fetch from storage
+func (m *MetaCache) GetRowPolicies(ctx context.Context, collectionID UniqueID) (map[string]string, error) {
+	m.mu.RLock()
+	policies, ok := m.rowPolicies[collectionID]
+	m.mu.RUnlock()
+	if ok {
+		return policies, nil
+	}
+
+	resp, err := m.rootCoord.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	// the collections without row policy are cached as well
+	policies = make(map[string]string, len(resp.GetPolicies()))
+	for _, policy := range resp.GetPolicies() {
+		policies[policy.GetRoleName()] = policy.GetExpr()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rowPolicies[collectionID] = policies
+	return policies, nil
 }
 
 // GetShards update cache if withCache == false
@@ -989,5 +1100,8 @@ func (m *MetaCache) RefreshPolicyInfo(op typeutil.CacheOp) error {
 func (m *MetaCache) RemoveDatabase(ctx context.Context, database string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	for _, coll := range m.collInfo[database] {
+		delete(m.rowPolicies, coll.collID)
+	}
 	delete(m.collInfo, database)
 }
@@ -60,6 +60,61 @@ func (_c *MockCache_DeprecateShardCache_Call) RunAndReturn(run func(string, stri
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: ctx, id
+func (_m *MockCache) GetAPIKey(ctx context.Context, id string) (*internalpb.APIKeyInfo, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *internalpb.APIKeyInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*internalpb.APIKeyInfo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *internalpb.APIKeyInfo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.APIKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type MockCache_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockCache_Expecter) GetAPIKey(ctx interface{}, id interface{}) *MockCache_GetAPIKey_Call {
+	return &MockCache_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, id)}
+}
+
+func (_c *MockCache_GetAPIKey_Call) Run(run func(ctx context.Context, id string)) *MockCache_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetAPIKey_Call) Return(_a0 *internalpb.APIKeyInfo, _a1 error) *MockCache_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetAPIKey_Call) RunAndReturn(run func(context.Context, string) (*internalpb.APIKeyInfo, error)) *MockCache_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionID provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) GetCollectionID(ctx context.Context, database string, collectionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName)
@@ -548,6 +603,61 @@ func (_c *MockCache_GetPrivilegeInfo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetRowPolicies provides a mock function with given fields: ctx, collectionID
+func (_m *MockCache) GetRowPolicies(ctx context.Context, collectionID int64) (map[string]string, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (map[string]string, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) map[string]string); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRowPolicies'
+type MockCache_GetRowPolicies_Call struct {
+	*mock.Call
+}
+
+// GetRowPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *MockCache_Expecter) GetRowPolicies(ctx interface{}, collectionID interface{}) *MockCache_GetRowPolicies_Call {
+	return &MockCache_GetRowPolicies_Call{Call: _e.mock.On("GetRowPolicies", ctx, collectionID)}
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Run(run func(ctx context.Context, collectionID int64)) *MockCache_GetRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) Return(_a0 map[string]string, _a1 error) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetRowPolicies_Call) RunAndReturn(run func(context.Context, int64) (map[string]string, error)) *MockCache_GetRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShards provides a mock function with given fields: ctx, withCache, database, collectionName, collectionID
 func (_m *MockCache) GetShards(ctx context.Context, withCache bool, database string, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	ret := _m.Called(ctx, withCache, database, collectionName, collectionID)
@@ -909,6 +1019,102 @@ func (_c *MockCache_RemovePartition_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReportLoginFailure provides a mock function with given fields: ctx, username
+func (_m *MockCache) ReportLoginFailure(ctx context.Context, username string) (int64, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_ReportLoginFailure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportLoginFailure'
+type MockCache_ReportLoginFailure_Call struct {
+	*mock.Call
+}
+
+// ReportLoginFailure is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockCache_Expecter) ReportLoginFailure(ctx interface{}, username interface{}) *MockCache_ReportLoginFailure_Call {
+	return &MockCache_ReportLoginFailure_Call{Call: _e.mock.On("ReportLoginFailure", ctx, username)}
+}
+
+func (_c *MockCache_ReportLoginFailure_Call) Run(run func(ctx context.Context, username string)) *MockCache_ReportLoginFailure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_ReportLoginFailure_Call) Return(_a0 int64, _a1 error) *MockCache_ReportLoginFailure_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_ReportLoginFailure_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *MockCache_ReportLoginFailure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReportLoginSuccess provides a mock function with given fields: ctx, username
+func (_m *MockCache) ReportLoginSuccess(ctx context.Context, username string) error {
+	ret := _m.Called(ctx, username)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, username)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCache_ReportLoginSuccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportLoginSuccess'
+type MockCache_ReportLoginSuccess_Call struct {
+	*mock.Call
+}
+
+// ReportLoginSuccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *MockCache_Expecter) ReportLoginSuccess(ctx interface{}, username interface{}) *MockCache_ReportLoginSuccess_Call {
+	return &MockCache_ReportLoginSuccess_Call{Call: _e.mock.On("ReportLoginSuccess", ctx, username)}
+}
+
+func (_c *MockCache_ReportLoginSuccess_Call) Run(run func(ctx context.Context, username string)) *MockCache_ReportLoginSuccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_ReportLoginSuccess_Call) Return(_a0 error) *MockCache_ReportLoginSuccess_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_ReportLoginSuccess_Call) RunAndReturn(run func(context.Context, string) error) *MockCache_ReportLoginSuccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredential provides a mock function with given fields: credInfo
 func (_m *MockCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	_m.Called(credInfo)
@@ -140,6 +140,39 @@ func (_c *MockLBPolicy_ExecuteWithRetry_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// InvalidateCollection provides a mock function with given fields: collectionID
+func (_m *MockLBPolicy) InvalidateCollection(collectionID int64) {
+	_m.Called(collectionID)
+}
+
+// MockLBPolicy_InvalidateCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollection'
+type MockLBPolicy_InvalidateCollection_Call struct {
+	*mock.Call
+}
+
+// InvalidateCollection is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockLBPolicy_Expecter) InvalidateCollection(collectionID interface{}) *MockLBPolicy_InvalidateCollection_Call {
+	return &MockLBPolicy_InvalidateCollection_Call{Call: _e.mock.On("InvalidateCollection", collectionID)}
+}
+
+func (_c *MockLBPolicy_InvalidateCollection_Call) Run(run func(collectionID int64)) *MockLBPolicy_InvalidateCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockLBPolicy_InvalidateCollection_Call) Return() *MockLBPolicy_InvalidateCollection_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockLBPolicy_InvalidateCollection_Call) RunAndReturn(run func(int64)) *MockLBPolicy_InvalidateCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: ctx
 func (_m *MockLBPolicy) Start(ctx context.Context) {
 	_m.Called(ctx)
@@ -50,11 +50,16 @@ func GetQuotaErrorString(errCode commonpb.ErrorCode) string {
 }
 
 // MultiRateLimiter includes multilevel rate limiters, such as global rateLimiter,
-// collection level rateLimiter and so on. It also implements Limiter interface.
+// database, user and collection level rateLimiter and so on. It also implements Limiter interface.
 type MultiRateLimiter struct {
 	quotaStatesMu sync.RWMutex
 	// for DML and DQL
 	collectionLimiters map[int64]*rateLimiter
+	// for DML and DQL of tenants, keyed by database id and username
+	databaseLimiters map[int64]*rateLimiter
+	userLimiters     map[string]*rateLimiter
+	// the database id of the collections which are limited by their database
+	collectionDatabases map[int64]int64
 	// for DDL
 	globalDDLLimiter *rateLimiter
 }
@@ -62,14 +67,17 @@ type MultiRateLimiter struct {
 // NewMultiRateLimiter returns a new MultiRateLimiter.
 func NewMultiRateLimiter() *MultiRateLimiter {
 	m := &MultiRateLimiter{
-		collectionLimiters: make(map[int64]*rateLimiter, 0),
-		globalDDLLimiter:   newRateLimiter(true),
+		collectionLimiters:  make(map[int64]*rateLimiter, 0),
+		databaseLimiters:    make(map[int64]*rateLimiter, 0),
+		userLimiters:        make(map[string]*rateLimiter, 0),
+		collectionDatabases: make(map[int64]int64, 0),
+		globalDDLLimiter:    newRateLimiter(true),
 	}
 	return m
 }
 
 // Check checks if request would be limited or denied.
-func (m *MultiRateLimiter) Check(collectionID int64, rt internalpb.RateType, n int) error {
+func (m *MultiRateLimiter) Check(username string, collectionID int64, rt internalpb.RateType, n int) error {
 	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		return nil
 	}
@@ -92,18 +100,63 @@ func (m *MultiRateLimiter) Check(collectionID int64, rt internalpb.RateType, n i
 		return nil
 	}
 
+	checkTenantFunc := func(limiter *rateLimiter, tenantType string, tenant string) error {
+		if limiter == nil {
+			return nil
+		}
+
+		limit, rate := limiter.limit(rt, n)
+		if limit {
+			metrics.ProxyRateLimitHits.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
+				tenantType, tenant, rt.String()).Inc()
+			return merr.WrapErrServiceRateLimit(rate)
+		}
+		return nil
+	}
+
 	// first, check global level rate limits
 	ret := checkFunc(m.globalDDLLimiter)
+	if ret != nil || IsDDLRequest(rt) {
+		return ret
+	}
 
-	// second check collection level rate limits
-	if ret == nil && !IsDDLRequest(rt) {
-		// only dml and dql have collection level rate limits
-		ret = checkFunc(m.collectionLimiters[collectionID])
-		if ret != nil {
-			m.globalDDLLimiter.cancel(rt, n)
+	// only dml and dql have database, user and collection level rate limits,
+	// the tokens taken by the passed levels are given back if any level rejects the request
+	passed := []*rateLimiter{m.globalDDLLimiter}
+	cancelPassed := func() {
+		for _, limiter := range passed {
+			limiter.cancel(rt, n)
 		}
 	}
 
+	// second, check database level rate limits
+	var dbLimiter *rateLimiter
+	dbID, ok := m.collectionDatabases[collectionID]
+	if ok {
+		dbLimiter = m.databaseLimiters[dbID]
+	}
+	if ret = checkTenantFunc(dbLimiter, metrics.DatabaseTenantLabel, strconv.FormatInt(dbID, 10)); ret != nil {
+		cancelPassed()
+		return ret
+	}
+	if dbLimiter != nil {
+		passed = append(passed, dbLimiter)
+	}
+
+	// third, check user level rate limits
+	userLimiter := m.userLimiters[username]
+	if ret = checkTenantFunc(userLimiter, metrics.UserTenantLabel, username); ret != nil {
+		cancelPassed()
+		return ret
+	}
+	if userLimiter != nil {
+		passed = append(passed, userLimiter)
+	}
+
+	// last, check collection level rate limits
+	if ret = checkFunc(m.collectionLimiters[collectionID]); ret != nil {
+		cancelPassed()
+	}
 	return ret
 }
 
@@ -173,6 +226,34 @@ func (m *MultiRateLimiter) SetRates(rates []*proxypb.CollectionRate) error {
 	return nil
 }
 
+// SetTenantRates sets the rates of databases and users, the limiters of the tenants not in the rates are removed.
+// The databases are keyed by id, and the requests of a collection are limited by the database it belongs to.
+func (m *MultiRateLimiter) SetTenantRates(databaseRates []*proxypb.TenantRate, userRates []*proxypb.TenantRate) {
+	m.quotaStatesMu.Lock()
+	defer m.quotaStatesMu.Unlock()
+	m.collectionDatabases = make(map[int64]int64)
+	for _, dbRate := range databaseRates {
+		for _, collectionID := range dbRate.GetCollections() {
+			m.collectionDatabases[collectionID] = dbRate.GetId()
+		}
+	}
+	m.databaseLimiters = setTenantLimiters(m.databaseLimiters, databaseRates, (*proxypb.TenantRate).GetId)
+	m.userLimiters = setTenantLimiters(m.userLimiters, userRates, (*proxypb.TenantRate).GetName)
+}
+
+func setTenantLimiters[K comparable](limiters map[K]*rateLimiter, tenantRates []*proxypb.TenantRate, key func(*proxypb.TenantRate) K) map[K]*rateLimiter {
+	ret := make(map[K]*rateLimiter, len(tenantRates))
+	for _, tenantRate := range tenantRates {
+		limiter, ok := limiters[key(tenantRate)]
+		if !ok {
+			limiter = newTenantRateLimiter()
+		}
+		limiter.setTenantRates(tenantRate.GetRates())
+		ret[key(tenantRate)] = limiter
+	}
+	return ret
+}
+
 // rateLimiter implements Limiter.
 type rateLimiter struct {
 	limiters    *typeutil.ConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter]
@@ -189,6 +270,15 @@ func newRateLimiter(globalLevel bool) *rateLimiter {
 	return rl
 }
 
+// newTenantRateLimiter returns a new RateLimiter for a database or a user,
+// it limits nothing until the rates set.
+func newTenantRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		limiters:    typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter](),
+		quotaStates: typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode](),
+	}
+}
+
 // limit returns true, the request will be rejected.
 // Otherwise, the request will pass.
 func (rl *rateLimiter) limit(rt internalpb.RateType, n int) (bool, float64) {
@@ -238,6 +328,26 @@ func (rl *rateLimiter) setRates(collectionRate *proxypb.CollectionRate) error {
 	return nil
 }
 
+// setTenantRates sets the rates of a tenant, the rate types not in the rates are unlimited.
+func (rl *rateLimiter) setTenantRates(rates []*internalpb.Rate) {
+	rateTypes := typeutil.NewSet[internalpb.RateType]()
+	for _, r := range rates {
+		rateTypes.Insert(r.GetRt())
+		if limit, ok := rl.limiters.Get(r.GetRt()); ok {
+			limit.SetLimit(ratelimitutil.Limit(r.GetR()))
+		} else {
+			// use rate as burst, the same as the global and collection limiters
+			rl.limiters.Insert(r.GetRt(), ratelimitutil.NewLimiter(ratelimitutil.Limit(r.GetR()), r.GetR()))
+		}
+	}
+	rl.limiters.Range(func(rt internalpb.RateType, _ *ratelimitutil.Limiter) bool {
+		if !rateTypes.Contain(rt) {
+			rl.limiters.Remove(rt)
+		}
+		return true
+	})
+}
+
 func (rl *rateLimiter) getError(rt internalpb.RateType) error {
 	switch rt {
 	case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad:
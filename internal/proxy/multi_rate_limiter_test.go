@@ -21,15 +21,18 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -52,18 +55,18 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", collectionID, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", collectionID, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", collectionID, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", collectionID, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check("", collectionID, internalpb.RateType(rt), math.MaxInt)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(collectionID, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check("", collectionID, internalpb.RateType(rt), math.MaxInt)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
@@ -89,31 +92,118 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if IsDDLRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check(1, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", 1, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(1, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", 1, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(1, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check("", 1, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check(1, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check("", 1, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(2, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check("", 2, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check(3, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check("", 3, internalpb.RateType(rt), 1)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
 		Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
 	})
 
+	t.Run("test tenant limit", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.SetTenantRates([]*proxypb.TenantRate{
+			{Id: 1, Name: "db1", Collections: []int64{collectionID}, Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 1000}}},
+		}, []*proxypb.TenantRate{
+			{Name: "user1", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 1000}}},
+			{Name: "user2", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLQuery, R: 0}}},
+		})
+
+		// the collections not in the database are not limited by it
+		otherCollectionID := collectionID + 1
+		err := multiLimiter.Check("", collectionID, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.NoError(t, err)
+		err = multiLimiter.Check("", collectionID, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		err = multiLimiter.Check("", otherCollectionID, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.NoError(t, err)
+		err = multiLimiter.Check("", collectionID, internalpb.RateType_DMLDelete, math.MaxInt)
+		assert.NoError(t, err)
+
+		err = multiLimiter.Check("user1", otherCollectionID, internalpb.RateType_DQLSearch, math.MaxInt)
+		assert.NoError(t, err)
+		err = multiLimiter.Check("user1", otherCollectionID, internalpb.RateType_DQLSearch, math.MaxInt)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		err = multiLimiter.Check("user2", otherCollectionID, internalpb.RateType_DQLQuery, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+
+		// the tenants not in the rates are unlimited
+		multiLimiter.SetTenantRates(nil, nil)
+		err = multiLimiter.Check("user1", collectionID, internalpb.RateType_DMLInsert, math.MaxInt)
+		assert.NoError(t, err)
+		err = multiLimiter.Check("user2", otherCollectionID, internalpb.RateType_DQLQuery, 1)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test tenant limit cancel tokens", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.SetTenantRates([]*proxypb.TenantRate{
+			{Id: 1, Name: "db1", Collections: []int64{collectionID}, Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 2}}},
+		}, []*proxypb.TenantRate{
+			{Name: "user1", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 0}}},
+		})
+
+		// the tokens of database are given back if rejected by the user limit
+		hits := testutil.ToFloat64(metrics.ProxyRateLimitHits.WithLabelValues(
+			strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UserTenantLabel, "user1", internalpb.RateType_DMLInsert.String()))
+		for i := 0; i < 5; i++ {
+			err := multiLimiter.Check("user1", collectionID, internalpb.RateType_DMLInsert, 1)
+			assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		}
+		err := multiLimiter.Check("user2", collectionID, internalpb.RateType_DMLInsert, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, hits+5, testutil.ToFloat64(metrics.ProxyRateLimitHits.WithLabelValues(
+			strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UserTenantLabel, "user1", internalpb.RateType_DMLInsert.String())))
+	})
+
+	t.Run("test tenant limit hits", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.SetTenantRates([]*proxypb.TenantRate{
+			{Id: 101, Name: "db1", Collections: []int64{1}, Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DMLUpsert, R: 0}}},
+			{Id: 102, Name: "db2", Collections: []int64{2}, Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DMLUpsert, R: 0}}},
+		}, nil)
+
+		nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+		hits := func(dbID string) float64 {
+			return testutil.ToFloat64(metrics.ProxyRateLimitHits.WithLabelValues(
+				nodeID, metrics.DatabaseTenantLabel, dbID, internalpb.RateType_DMLUpsert.String()))
+		}
+		db1, db2 := hits("101"), hits("102")
+		for i := 0; i < 3; i++ {
+			assert.ErrorIs(t, multiLimiter.Check("", 1, internalpb.RateType_DMLUpsert, 1), merr.ErrServiceRateLimit)
+		}
+		assert.ErrorIs(t, multiLimiter.Check("", 2, internalpb.RateType_DMLUpsert, 1), merr.ErrServiceRateLimit)
+		// the hits are counted by database
+		assert.Equal(t, db1+3, hits("101"))
+		assert.Equal(t, db2+1, hits("102"))
+	})
+
 	t.Run("not enable quotaAndLimit", func(t *testing.T) {
 		multiLimiter := NewMultiRateLimiter()
 		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(false)
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
 		for _, rt := range internalpb.RateType_value {
-			err := multiLimiter.Check(collectionID, internalpb.RateType(rt), 1)
+			err := multiLimiter.Check("", collectionID, internalpb.RateType(rt), 1)
 			assert.NoError(t, err)
 		}
 		Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
@@ -126,7 +216,7 @@ func TestMultiRateLimiter(t *testing.T) {
 			multiLimiter := NewMultiRateLimiter()
 			bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 			paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
-			err := multiLimiter.Check(collectionID, internalpb.RateType_DMLInsert, 1*1024*1024)
+			err := multiLimiter.Check("", collectionID, internalpb.RateType_DMLInsert, 1*1024*1024)
 			assert.NoError(t, err)
 			Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
 			Params.Save(Params.QuotaConfig.DMLMaxInsertRate.Key, bakInsertRate)
@@ -287,6 +377,23 @@ func TestRateLimiter(t *testing.T) {
 		assert.Equal(t, limiter.getError(internalpb.RateType_DMLInsert), merr.ErrServiceDiskLimitExceeded)
 	})
 
+	t.Run("test setTenantRates", func(t *testing.T) {
+		limiter := newTenantRateLimiter()
+		limiter.setTenantRates([]*internalpb.Rate{
+			{Rt: internalpb.RateType_DMLInsert, R: 100},
+			{Rt: internalpb.RateType_DQLSearch, R: 100},
+		})
+		assert.Equal(t, 2, limiter.limiters.Len())
+
+		limiter.setTenantRates([]*internalpb.Rate{
+			{Rt: internalpb.RateType_DMLInsert, R: 10},
+		})
+		assert.Equal(t, 1, limiter.limiters.Len())
+		limit, ok := limiter.limiters.Get(internalpb.RateType_DMLInsert)
+		assert.True(t, ok)
+		assert.Equal(t, ratelimitutil.Limit(10), limit.Limit())
+	})
+
 	t.Run("tests refresh rate by config", func(t *testing.T) {
 		limiter := newRateLimiter(false)
 
@@ -0,0 +1,169 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// validatePasswordComplexity checks the password contains the kinds of the characters required by the password policy.
+func validatePasswordComplexity(password string) error {
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, c := range password {
+		switch {
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsLower(c):
+			hasLower = true
+		case unicode.IsDigit(c):
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+	if Params.CommonCfg.PasswordRequireUppercase.GetAsBool() && !hasUpper {
+		return merr.WrapErrParameterInvalidMsg("invalid password, it must contain an uppercase letter")
+	}
+	if Params.CommonCfg.PasswordRequireLowercase.GetAsBool() && !hasLower {
+		return merr.WrapErrParameterInvalidMsg("invalid password, it must contain a lowercase letter")
+	}
+	if Params.CommonCfg.PasswordRequireDigit.GetAsBool() && !hasDigit {
+		return merr.WrapErrParameterInvalidMsg("invalid password, it must contain a digit")
+	}
+	if Params.CommonCfg.PasswordRequireSpecialChar.GetAsBool() && !hasSpecial {
+		return merr.WrapErrParameterInvalidMsg("invalid password, it must contain a character other than the letters and digits")
+	}
+	return nil
+}
+
+// checkPasswordReused rejects the new password which is the same as one of the latest passwords of the history size,
+// the current password included.
+func checkPasswordReused(ctx context.Context, rootCoord types.RootCoordClient, username, rawPassword string) error {
+	historySize := Params.CommonCfg.PasswordHistorySize.GetAsInt()
+	if historySize <= 0 {
+		return nil
+	}
+	resp, err := rootCoord.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_GetCredential),
+		),
+		Username: username,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return err
+	}
+	passwords := append([]string{resp.GetPassword()}, resp.GetPasswordHistory()...)
+	if len(passwords) > historySize {
+		passwords = passwords[:historySize]
+	}
+	for _, encryptedPassword := range passwords {
+		if bcrypt.CompareHashAndPassword([]byte(encryptedPassword), []byte(rawPassword)) == nil {
+			return merr.WrapErrParameterInvalidMsg("invalid password, it can't be the same as the latest %d passwords", historySize)
+		}
+	}
+	return nil
+}
+
+// CheckPasswordRotation rejects the user who must change the password before any other operation, since the password
+// is created or reset by the others, or it's expired. The credentials saved before the password policy never expire.
+func CheckPasswordRotation(ctx context.Context, username string) error {
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		return err
+	}
+	if credInfo.GetNeedPasswordChange() {
+		recordAuthFailure(ctx, username, "the password must be changed")
+		return merr.WrapErrPrivilegeNotAuthenticated("the password of user %s must be changed before any other operation", username)
+	}
+	maxAge := Params.CommonCfg.PasswordMaxAge.GetAsInt()
+	if maxAge > 0 && credInfo.GetPasswordUpdateTime() > 0 &&
+		time.Since(time.Unix(credInfo.GetPasswordUpdateTime(), 0)) > time.Duration(maxAge)*24*time.Hour {
+		recordAuthFailure(ctx, username, "the password is expired")
+		return merr.WrapErrPrivilegeNotAuthenticated("the password of user %s is expired, it must be changed before any other operation", username)
+	}
+	return nil
+}
+
+// isPasswordSetByOthers checks whether the password of the user is created or reset by the other user, who is
+// the current user, then the user must change it if the password policy requires.
+func isPasswordSetByOthers(ctx context.Context, username string) bool {
+	if !Params.CommonCfg.PasswordForceChangeOnReset.GetAsBool() {
+		return false
+	}
+	currentUser, _ := GetCurUserFromContext(ctx)
+	return currentUser != "" && currentUser != username
+}
+
+// isUpdateCredentialMethod checks whether the grpc request updates the password,
+// which is permitted to the user who must change the password.
+func isUpdateCredentialMethod(ctx context.Context) bool {
+	method, ok := grpc.Method(ctx)
+	return ok && strings.HasSuffix(method, "/UpdateCredential")
+}
+
+// loginFailedUsers are the users whose failed logins are reported by the proxy, the next successful login of them
+// is reported as well, so that the failures counted by rootcoord are consecutive ones.
+var loginFailedUsers = typeutil.NewConcurrentSet[string]()
+
+// isLoginLockoutEnabled returns whether the user is locked out after too many failed logins.
+func isLoginLockoutEnabled(username string) bool {
+	if Params.CommonCfg.LoginMaxFailedAttempts.GetAsInt() <= 0 {
+		return false
+	}
+	return username != util.UserRoot || !Params.CommonCfg.LoginLockoutExemptRoot.GetAsBool()
+}
+
+// reportLoginFailure records the failed login in the event log, and reports it to rootcoord which locks out
+// the user after too many failures.
+func reportLoginFailure(ctx context.Context, username string, cache Cache) {
+	recordAuthFailure(ctx, username, "wrong password")
+	if !isLoginLockoutEnabled(username) {
+		return
+	}
+	lockedUntil, err := cache.ReportLoginFailure(ctx, username)
+	if err != nil {
+		log.Warn("fail to report the failed login", zap.String("username", username), zap.Error(err))
+		return
+	}
+	loginFailedUsers.Insert(username)
+	if lockedUntil > 0 {
+		log.Warn("the user is locked out due to too many failed logins", zap.String("username", username),
+			zap.Time("lockedUntil", time.Unix(lockedUntil, 0)))
+	}
+}
+
+// reportLoginSuccess reports the successful login to rootcoord if the failed logins of the user were reported,
+// it's kept to report on the next login if the report fails.
+func reportLoginSuccess(ctx context.Context, username string, cache Cache) {
+	if !loginFailedUsers.Contain(username) {
+		return
+	}
+	if err := cache.ReportLoginSuccess(ctx, username); err != nil {
+		log.Warn("fail to report the successful login", zap.String("username", username), zap.Error(err))
+		return
+	}
+	loginFailedUsers.Remove(username)
+}
+
+// recordAuthFailure records the failed login in the event log and the audit log.
+func recordAuthFailure(ctx context.Context, username string, reason string) {
+	eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn, fmt.Sprintf("authentication of user %s failed: %s", username, reason)))
+	accesslog.PrintAuditLoginFailure(ctx, username, reason)
+}
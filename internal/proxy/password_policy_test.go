@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestValidatePasswordComplexity(t *testing.T) {
+	paramtable.Init()
+	assert.NoError(t, ValidatePassword("password"))
+
+	for _, key := range []string{
+		Params.CommonCfg.PasswordRequireUppercase.Key,
+		Params.CommonCfg.PasswordRequireLowercase.Key,
+		Params.CommonCfg.PasswordRequireDigit.Key,
+		Params.CommonCfg.PasswordRequireSpecialChar.Key,
+	} {
+		paramtable.Get().Save(key, "true")
+		defer paramtable.Get().Reset(key)
+	}
+	for _, password := range []string{"password1!", "PASSWORD1!", "Password!", "Password1"} {
+		assert.ErrorIs(t, ValidatePassword(password), merr.ErrParameterInvalid, password)
+	}
+	assert.NoError(t, ValidatePassword("Password1!"))
+}
+
+func TestCheckPasswordReused(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	current, err := crypto.PasswordEncrypt("password2")
+	assert.NoError(t, err)
+	previous, err := crypto.PasswordEncrypt("password1")
+	assert.NoError(t, err)
+	oldest, err := crypto.PasswordEncrypt("password0")
+	assert.NoError(t, err)
+
+	rootCoord := newMockRootCoord()
+	rootCoord.GetGetCredentialFunc = func(ctx context.Context, req *rootcoordpb.GetCredentialRequest, opts ...grpc.CallOption) (*rootcoordpb.GetCredentialResponse, error) {
+		return &rootcoordpb.GetCredentialResponse{
+			Status:          merr.Success(),
+			Username:        req.GetUsername(),
+			Password:        current,
+			PasswordHistory: []string{previous, oldest},
+		}, nil
+	}
+
+	// not checked by default
+	assert.NoError(t, checkPasswordReused(ctx, rootCoord, "user1", "password2"))
+
+	paramtable.Get().Save(Params.CommonCfg.PasswordHistorySize.Key, "2")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordHistorySize.Key)
+	assert.ErrorIs(t, checkPasswordReused(ctx, rootCoord, "user1", "password2"), merr.ErrParameterInvalid)
+	assert.ErrorIs(t, checkPasswordReused(ctx, rootCoord, "user1", "password1"), merr.ErrParameterInvalid)
+	assert.NoError(t, checkPasswordReused(ctx, rootCoord, "user1", "password0"))
+	assert.NoError(t, checkPasswordReused(ctx, rootCoord, "user1", "password3"))
+}
+
+func TestCheckPasswordRotation(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+
+	cache.EXPECT().GetCredentialInfo(mock.Anything, "user1").Return(&internalpb.CredentialInfo{
+		Username:           "user1",
+		PasswordUpdateTime: time.Now().Add(-48 * time.Hour).Unix(),
+	}, nil)
+	cache.EXPECT().GetCredentialInfo(mock.Anything, "user2").Return(&internalpb.CredentialInfo{
+		Username:           "user2",
+		NeedPasswordChange: true,
+	}, nil)
+
+	assert.NoError(t, CheckPasswordRotation(ctx, "user1"))
+	assert.ErrorIs(t, CheckPasswordRotation(ctx, "user2"), merr.ErrPrivilegeNotAuthenticated)
+
+	paramtable.Get().Save(Params.CommonCfg.PasswordMaxAge.Key, "1")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordMaxAge.Key)
+	assert.ErrorIs(t, CheckPasswordRotation(ctx, "user1"), merr.ErrPrivilegeNotAuthenticated)
+}
+
+func TestIsPasswordSetByOthers(t *testing.T) {
+	paramtable.Init()
+	ctx := GetContext(context.Background(), "root:123456")
+	assert.False(t, isPasswordSetByOthers(ctx, "user1"))
+
+	paramtable.Get().Save(Params.CommonCfg.PasswordForceChangeOnReset.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.PasswordForceChangeOnReset.Key)
+	assert.True(t, isPasswordSetByOthers(ctx, "user1"))
+	assert.False(t, isPasswordSetByOthers(ctx, "root"))
+	assert.False(t, isPasswordSetByOthers(context.Background(), "user1"))
+}
+
+func TestPasswordVerifyLockout(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	cache := NewMockCache(t)
+
+	cache.EXPECT().GetCredentialInfo(mock.Anything, "locked").Return(&internalpb.CredentialInfo{
+		Username:       "locked",
+		Sha256Password: crypto.SHA256("password", "locked"),
+		LockedUntil:    time.Now().Add(time.Minute).Unix(),
+	}, nil)
+	assert.False(t, passwordVerify(ctx, "locked", "password", cache))
+
+	cache.EXPECT().GetCredentialInfo(mock.Anything, "user1").Return(&internalpb.CredentialInfo{
+		Username:       "user1",
+		Sha256Password: crypto.SHA256("password", "user1"),
+	}, nil)
+	// the failures aren't reported if the lockout is disabled
+	assert.False(t, passwordVerify(ctx, "user1", "wrong", cache))
+
+	paramtable.Get().Save(Params.CommonCfg.LoginMaxFailedAttempts.Key, "3")
+	defer paramtable.Get().Reset(Params.CommonCfg.LoginMaxFailedAttempts.Key)
+	cache.EXPECT().ReportLoginFailure(mock.Anything, "user1").Return(0, nil).Once()
+	assert.False(t, passwordVerify(ctx, "user1", "wrong", cache))
+	// the successful login after the failures is reported once
+	cache.EXPECT().ReportLoginSuccess(mock.Anything, "user1").Return(nil).Once()
+	assert.True(t, passwordVerify(ctx, "user1", "password", cache))
+	assert.True(t, passwordVerify(ctx, "user1", "password", cache))
+
+	// root isn't locked out unless it's configured
+	cache.EXPECT().GetCredentialInfo(mock.Anything, util.UserRoot).Return(&internalpb.CredentialInfo{
+		Username:       util.UserRoot,
+		Sha256Password: crypto.SHA256("password", util.UserRoot),
+	}, nil)
+	assert.False(t, passwordVerify(ctx, util.UserRoot, "wrong", cache))
+	paramtable.Get().Save(Params.CommonCfg.LoginLockoutExemptRoot.Key, "false")
+	defer paramtable.Get().Reset(Params.CommonCfg.LoginLockoutExemptRoot.Key)
+	cache.EXPECT().ReportLoginFailure(mock.Anything, util.UserRoot).Return(0, nil).Once()
+	assert.False(t, passwordVerify(ctx, util.UserRoot, "wrong", cache))
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -24,7 +25,7 @@ type PrivilegeFunc func(ctx context.Context, req interface{}) (context.Context,
 
 const (
 	// sub -> role name, like admin, public
-	// obj -> contact object with object name, like Global-*, Collection-col1
+	// obj -> contact object with object name, like Global-*, Collection-col1, Field-db1.col1.field1
 	// act -> privilege, like CreateCollection, DescribeCollection
 	ModelStr = `
 [request_definition]
@@ -77,6 +78,10 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		log.Warn("GetCurUserFromContext fail", zap.Error(err))
 		return ctx, err
 	}
+	if err := checkAPIKeyScope(ctx, req, &privilegeExt); err != nil {
+		log.Info("api key scope check fail", zap.String("username", username), zap.Error(err))
+		return ctx, err
+	}
 	if username == util.UserRoot {
 		return ctx, nil
 	}
@@ -85,6 +90,7 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		log.Warn("GetRole fail", zap.String("username", username), zap.Error(err))
 		return ctx, err
 	}
+	roleNames = append(roleNames, GetTokenRolesFromContext(ctx)...)
 	roleNames = append(roleNames, util.RolePublic)
 	objectType := privilegeExt.ObjectType.String()
 	objectNameIndex := privilegeExt.ObjectNameIndex
@@ -106,16 +112,11 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		zap.String("policy_info", policyInfo))
 
 	policy := fmt.Sprintf("[%s]", policyInfo)
-	b := []byte(policy)
-	a := jsonadapter.NewAdapter(&b)
-	// the `templateModel` object isn't safe in the concurrent situation
-	casbinModel := templateModel.Copy()
-	e, err := casbin.NewEnforcer(casbinModel, a)
+	e, err := newPrivilegeEnforcer(policy)
 	if err != nil {
 		log.Warn("NewEnforcer fail", zap.String("policy", policy), zap.Error(err))
 		return ctx, err
 	}
-	e.AddFunction("dbMatch", DBMatchFunc)
 	for _, roleName := range roleNames {
 		permitFunc := func(resName string) (bool, error) {
 			object := funcutil.PolicyForResource(dbName, objectType, resName)
@@ -162,6 +163,38 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	return ctx, status.Error(codes.PermissionDenied, fmt.Sprintf("%s: permission deny", objectPrivilege))
 }
 
+// checkPrivilegeInDatabase checks the privilege of the apis out of the milvus proto by the public request requiring the
+// same privilege, which carries the database and the collection of the api. The grants are per database, so the database
+// of the api replaces the one of the context, the one of the context is used if it's empty.
+func checkPrivilegeInDatabase(ctx context.Context, dbName string, req interface{}) error {
+	if dbName == "" {
+		dbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(util.HeaderDBName, dbName)
+	_, err := PrivilegeInterceptor(metadata.NewIncomingContext(ctx, md), req)
+	return err
+}
+
+// newPrivilegeEnforcer creates the casbin enforcer of the policies, which is a json array of the policies.
+func newPrivilegeEnforcer(policy string) (*casbin.Enforcer, error) {
+	b := []byte(policy)
+	a := jsonadapter.NewAdapter(&b)
+	// the `templateModel` object isn't safe in the concurrent situation
+	casbinModel := templateModel.Copy()
+	e, err := casbin.NewEnforcer(casbinModel, a)
+	if err != nil {
+		return nil, err
+	}
+	e.AddFunction("dbMatch", DBMatchFunc)
+	return e, nil
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
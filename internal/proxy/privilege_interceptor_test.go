@@ -221,3 +221,64 @@ func TestResourceGroupPrivilege(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestPrivilegeInterceptorWithTokenRoles(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "col1", commonpb.ObjectPrivilege_PrivilegeLoad.String(), "default"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(ctx, client, &mocks.MockQueryCoordClient{}, newShardClientMgr())
+	assert.NoError(t, err)
+
+	// the user isn't bound to any role, the roles come from the jwt
+	bobCtx := GetContext(ctx, "bob:___")
+	_, err = PrivilegeInterceptor(bobCtx, &milvuspb.LoadCollectionRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(NewContextWithTokenRoles(bobCtx, []string{"role1"}), &milvuspb.LoadCollectionRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(NewContextWithTokenRoles(bobCtx, []string{"role1"}), &milvuspb.LoadCollectionRequest{CollectionName: "col2"})
+	assert.Error(t, err)
+}
+
+func TestCheckPrivilegeInDatabase(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Global.String(), "*", commonpb.ObjectPrivilege_PrivilegeCreateCollection.String(), "db1"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("carol", "role1"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(ctx, client, &mocks.MockQueryCoordClient{}, newShardClientMgr())
+	assert.NoError(t, err)
+
+	// the database of the request is checked instead of the one of the context
+	carolCtx := GetContext(ctx, "carol:123456")
+	err = checkPrivilegeInDatabase(carolCtx, "db1", &milvuspb.CreateCollectionRequest{DbName: "db1", CollectionName: "col1"})
+	assert.NoError(t, err)
+	err = checkPrivilegeInDatabase(carolCtx, "db2", &milvuspb.CreateCollectionRequest{DbName: "db2", CollectionName: "col1"})
+	assert.Error(t, err)
+
+	// the one of the context is used if the request doesn't carry the database
+	err = checkPrivilegeInDatabase(GetContextWithDB(ctx, "carol:123456", "db1"), "", &milvuspb.CreateCollectionRequest{CollectionName: "col1"})
+	assert.NoError(t, err)
+	err = checkPrivilegeInDatabase(carolCtx, "", &milvuspb.CreateCollectionRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+}
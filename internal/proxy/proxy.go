@@ -116,6 +116,9 @@ type Proxy struct {
 	// for load balance in replicas
 	lbPolicy LBPolicy
 
+	// cache of search and query results
+	resultCache *resultCache
+
 	// resource manager
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
@@ -220,6 +223,9 @@ func (node *Proxy) Init() error {
 	accesslog.SetupAccseeLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	accesslog.SetupAuditLog(&Params.ProxyCfg.AuditLog, &Params.MinioCfg)
+	log.Debug("init audit log for Proxy done")
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
@@ -287,6 +293,9 @@ func (node *Proxy) Init() error {
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 	log.Debug("create metrics cache manager done", zap.String("role", typeutil.ProxyRole))
 
+	node.resultCache = newResultCache(node.chMgr.getChannels, node.sched.dmQueue.getLastMutationTs)
+	log.Debug("create result cache done", zap.String("role", typeutil.ProxyRole))
+
 	if err := InitMetaCache(node.ctx, node.rootCoord, node.queryCoord, node.shardMgr); err != nil {
 		log.Warn("failed to init meta cache", zap.String("role", typeutil.ProxyRole), zap.Error(err))
 		return err
@@ -467,6 +476,8 @@ func (node *Proxy) Stop() error {
 		node.lbPolicy.Close()
 	}
 
+	node.resultCache.close()
+
 	if node.resourceManager != nil {
 		node.resourceManager.Close()
 	}
@@ -0,0 +1,247 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// queryPageBuffer keeps the rows with the smallest primary keys among the streamed batches,
+// at most size rows are kept, the rows with duplicated primary keys are dropped.
+type queryPageBuffer struct {
+	mu     sync.Mutex
+	size   int
+	result *internalpb.RetrieveResults
+}
+
+func newQueryPageBuffer(size int) *queryPageBuffer {
+	return &queryPageBuffer{size: size}
+}
+
+func (b *queryPageBuffer) num() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return sizeOfResult(b.result)
+}
+
+func sizeOfResult(result *internalpb.RetrieveResults) int {
+	if result.GetIds() == nil {
+		return 0
+	}
+	return typeutil.GetSizeOfIDs(result.GetIds())
+}
+
+func (b *queryPageBuffer) consume(result *internalpb.RetrieveResults) error {
+	b.merge(result)
+	return nil
+}
+
+// merge merges the batch into the buffer, the rows of the buffer are kept in the order of primary keys.
+func (b *queryPageBuffer) merge(batch *internalpb.RetrieveResults) {
+	batchNum := sizeOfResult(batch)
+	if batchNum == 0 {
+		return
+	}
+	order := lo.Range(batchNum)
+	sort.Slice(order, func(i, j int) bool {
+		return typeutil.ComparePKInSlice(batch.GetIds(), order[i], order[j])
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	current := b.result
+	currentNum := sizeOfResult(current)
+	merged := &internalpb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, len(batch.GetFieldsData())),
+	}
+
+	var last interface{}
+	count := 0
+	i, j := 0, 0
+	for count < b.size && (i < currentNum || j < batchNum) {
+		src, idx := current, i
+		if i >= currentNum || (j < batchNum && typeutil.ComparePK(typeutil.GetPK(batch.GetIds(), int64(order[j])), typeutil.GetPK(current.GetIds(), int64(i)))) {
+			src, idx = batch, order[j]
+			j++
+		} else {
+			i++
+		}
+		pk := typeutil.GetPK(src.GetIds(), int64(idx))
+		if last != nil && pk == last {
+			continue
+		}
+		typeutil.AppendPKs(merged.Ids, pk)
+		typeutil.AppendFieldData(merged.FieldsData, src.GetFieldsData(), int64(idx))
+		last = pk
+		count++
+	}
+	b.result = merged
+}
+
+// pageExpr restricts the expression to the rows whose primary keys are greater than the cursor.
+func pageExpr(expr string, pkField *schemapb.FieldSchema, cursor string) (string, error) {
+	if cursor == "" {
+		return expr, nil
+	}
+	var bound string
+	switch pkField.GetDataType() {
+	case schemapb.DataType_Int64:
+		if _, err := strconv.ParseInt(cursor, 10, 64); err != nil {
+			return "", merr.WrapErrParameterInvalid("int64 primary key", cursor, "invalid cursor")
+		}
+		bound = cursor
+	case schemapb.DataType_VarChar:
+		bound = strconv.Quote(cursor)
+	default:
+		return "", merr.WrapErrParameterInvalidMsg("unsupported primary key type %s", pkField.GetDataType().String())
+	}
+	pkExpr := fmt.Sprintf("%s > %s", pkField.GetName(), bound)
+	if expr == "" {
+		return pkExpr, nil
+	}
+	return fmt.Sprintf("(%s) and %s", expr, pkExpr), nil
+}
+
+// pageQueryParams replaces the limit and offset of the query with the page size, the segments return the rows
+// with the smallest primary keys as the limit is applied in the order of primary keys.
+func pageQueryParams(params []*commonpb.KeyValuePair, pageSize int64) []*commonpb.KeyValuePair {
+	ret := lo.Filter(params, func(kv *commonpb.KeyValuePair, _ int) bool {
+		return kv.GetKey() != LimitKey && kv.GetKey() != OffsetKey
+	})
+	return append(ret, &commonpb.KeyValuePair{Key: LimitKey, Value: strconv.FormatInt(pageSize, 10)})
+}
+
+// QueryPages retrieves the results of the query page by page in the order of primary keys,
+// each page holds at most pageSize rows and is passed to send before the next one is retrieved.
+// The pages are retrieved by the streaming query of querynodes, so the memory is bounded by the page size
+// no matter how many rows match. The rows start after the cursor, which is the primary key of the last row
+// the caller received, and all the pages read the same snapshot.
+// Both the cursor and the page size are pushed down into the plan, the segments scan their primary keys in order
+// and stop at the page size, so a page costs no more than pageSize rows of each segment.
+func (node *Proxy) QueryPages(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return err
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-QueryPages")
+	defer sp.End()
+	tr := timerecord.NewTimeRecorder("QueryPages")
+
+	method := "QueryPages"
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("expr", request.GetExpr()),
+		zap.String("cursor", cursor),
+		zap.Int64("pageSize", pageSize))
+	log.Debug(rpcReceived(method))
+
+	pages, rows, err := node.queryPages(ctx, request, cursor, pageSize, send)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Int("pages", pages), zap.Int("rows", rows), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return err
+	}
+
+	log.Debug(rpcDone(method), zap.Int("pages", pages), zap.Int("rows", rows))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return nil
+}
+
+func (node *Proxy) queryPages(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) (pages int, rows int, err error) {
+	if pageSize <= 0 {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("page size must be positive but got %d", pageSize)
+	}
+	if matchCountRule(request.GetOutputFields()) {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("count(*) is not supported by the paging query")
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return 0, 0, err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return 0, 0, err
+	}
+	ts, err := node.tsoAllocator.AllocOne(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	queryParams := pageQueryParams(request.GetQueryParams(), pageSize)
+	for {
+		pageReq := proto.Clone(request).(*milvuspb.QueryRequest)
+		pageReq.QueryParams = queryParams
+		pageReq.Expr, err = pageExpr(request.GetExpr(), pkField, cursor)
+		if err != nil {
+			return pages, rows, err
+		}
+
+		buffer := newQueryPageBuffer(int(pageSize))
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+				),
+				ReqID: paramtable.GetNodeID(),
+			},
+			request:        pageReq,
+			qc:             node.queryCoord,
+			lb:             node.lbPolicy,
+			streamConsumer: buffer,
+		}
+		qt.SetTs(ts)
+		if err := qt.PreExecute(ctx); err != nil {
+			return pages, rows, err
+		}
+		if err := qt.Execute(ctx); err != nil {
+			return pages, rows, err
+		}
+		if buffer.result != nil {
+			qt.resultBuf.Insert(buffer.result)
+		}
+		if err := qt.PostExecute(ctx); err != nil {
+			return pages, rows, err
+		}
+
+		num := buffer.num()
+		if num > 0 {
+			if err := send(qt.result); err != nil {
+				return pages, rows, err
+			}
+			pages++
+			rows += num
+		}
+		if int64(num) < pageSize {
+			return pages, rows, nil
+		}
+		cursor = fmt.Sprint(typeutil.GetPK(buffer.result.GetIds(), int64(num-1)))
+	}
+}
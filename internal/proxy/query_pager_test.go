@@ -0,0 +1,90 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func genPageBatch(pks []int64, values []int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{{
+			Type:      schemapb.DataType_Int64,
+			FieldName: "value",
+			FieldId:   101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			}},
+		}},
+	}
+}
+
+func TestQueryPageBuffer(t *testing.T) {
+	t.Run("keep the smallest primary keys", func(t *testing.T) {
+		buffer := newQueryPageBuffer(3)
+		buffer.merge(genPageBatch([]int64{5, 1, 9}, []int64{50, 10, 90}))
+		buffer.merge(&internalpb.RetrieveResults{})
+		buffer.merge(genPageBatch([]int64{7, 2}, []int64{70, 20}))
+		assert.Equal(t, 3, buffer.num())
+		assert.Equal(t, []int64{1, 2, 5}, buffer.result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 50}, buffer.result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("drop duplicated primary keys", func(t *testing.T) {
+		buffer := newQueryPageBuffer(10)
+		buffer.merge(genPageBatch([]int64{3, 1}, []int64{30, 10}))
+		buffer.merge(genPageBatch([]int64{1, 2, 3}, []int64{11, 20, 31}))
+		assert.Equal(t, []int64{1, 2, 3}, buffer.result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 20, 30}, buffer.result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("varchar primary keys", func(t *testing.T) {
+		buffer := newQueryPageBuffer(2)
+		buffer.merge(&internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"c", "a", "b"}}}},
+		})
+		assert.Equal(t, []string{"a", "b"}, buffer.result.GetIds().GetStrId().GetData())
+	})
+}
+
+func TestPageExpr(t *testing.T) {
+	int64PK := &schemapb.FieldSchema{Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}
+	varCharPK := &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true}
+
+	expr, err := pageExpr("age > 10", int64PK, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "age > 10", expr)
+
+	expr, err = pageExpr("age > 10", int64PK, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, "(age > 10) and id > 100", expr)
+
+	expr, err = pageExpr("", varCharPK, `a"b`)
+	assert.NoError(t, err)
+	assert.Equal(t, `name > "a\"b"`, expr)
+
+	_, err = pageExpr("", int64PK, "abc")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestPageQueryParams(t *testing.T) {
+	params := pageQueryParams([]*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+		{Key: IgnoreGrowingKey, Value: "true"},
+	}, 100)
+	assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+		{Key: IgnoreGrowingKey, Value: "true"},
+		{Key: LimitKey, Value: "100"},
+	}, params)
+
+	// the page size is validated as the limit
+	_, err := parseQueryParams(pageQueryParams(nil, 100000))
+	assert.Error(t, err)
+}
@@ -0,0 +1,218 @@
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// queryStreamSender forwards the batches of the streaming query to the client as they arrive.
+// The querynodes stream the results segment by segment, the segments sent already are skipped,
+// so the rows are sent once even if the shard is retried on another replica halfway.
+// The proxy keeps the ids of the segments sent rather than the primary keys, so the memory is bounded
+// no matter how many rows match.
+// The batches are sent one at a time, the shards wait while the client is slow to receive.
+type queryStreamSender struct {
+	mu           sync.Mutex
+	task         *queryTask
+	stream       proxypb.ProxyStream_QueryStreamServer
+	batchSize    int
+	sentSegments typeutil.UniqueSet
+	rows         int
+}
+
+func newQueryStreamSender(task *queryTask, stream proxypb.ProxyStream_QueryStreamServer, batchSize int) *queryStreamSender {
+	return &queryStreamSender{
+		task:         task,
+		stream:       stream,
+		batchSize:    batchSize,
+		sentSegments: typeutil.NewUniqueSet(),
+	}
+}
+
+func newStreamBatch(fieldNum int) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, fieldNum),
+	}
+}
+
+func (s *queryStreamSender) consume(result *internalpb.RetrieveResults) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segmentID := result.GetSegmentID()
+	if segmentID != 0 && s.sentSegments.Contain(segmentID) {
+		return nil
+	}
+
+	batch := newStreamBatch(len(result.GetFieldsData()))
+	batchNum := 0
+	for _, i := range newestRowsOfSegment(result) {
+		typeutil.AppendPKs(batch.Ids, typeutil.GetPK(result.GetIds(), int64(i)))
+		typeutil.AppendFieldData(batch.FieldsData, result.GetFieldsData(), int64(i))
+		batchNum++
+		if batchNum >= s.batchSize {
+			if err := s.send(batch, batchNum); err != nil {
+				return err
+			}
+			batch = newStreamBatch(len(result.GetFieldsData()))
+			batchNum = 0
+		}
+	}
+	if batchNum > 0 {
+		if err := s.send(batch, batchNum); err != nil {
+			return err
+		}
+	}
+	if segmentID != 0 {
+		s.sentSegments.Insert(segmentID)
+	}
+	return nil
+}
+
+// newestRowsOfSegment returns the offsets of the rows to send in the results of a segment,
+// the copy with the newest timestamp is kept if a primary key appears more than once.
+func newestRowsOfSegment(result *internalpb.RetrieveResults) []int {
+	num := sizeOfResult(result)
+	var timestamps []int64
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == common.TimeStampField {
+			timestamps = fieldData.GetScalars().GetLongData().GetData()
+			break
+		}
+	}
+
+	kept := make(map[interface{}]int, num)
+	offsets := make([]int, 0, num)
+	for i := 0; i < num; i++ {
+		pk := typeutil.GetPK(result.GetIds(), int64(i))
+		j, ok := kept[pk]
+		if !ok {
+			kept[pk] = len(offsets)
+			offsets = append(offsets, i)
+			continue
+		}
+		if len(timestamps) == num && timestamps[i] > timestamps[offsets[j]] {
+			offsets[j] = i
+		}
+	}
+	return offsets
+}
+
+func (s *queryStreamSender) send(batch *internalpb.RetrieveResults, num int) error {
+	t := s.task
+	reducer := createMilvusReducer(s.stream.Context(), t.queryParams, t.RetrieveRequest, t.schema, t.plan, t.collectionName)
+	result, err := reducer.Reduce([]*internalpb.RetrieveResults{batch})
+	if err != nil {
+		return err
+	}
+	result.OutputFields = t.userOutputFields
+	if err := s.stream.Send(result); err != nil {
+		return err
+	}
+	s.rows += num
+	return nil
+}
+
+// QueryStream retrieves the results of the query by the streaming query of querynodes and sends them to the client
+// batch by batch as they arrive, the deleted rows are filtered by querynodes segment by segment and the rows with
+// duplicated primary keys in a segment are dropped. The proxy keeps the segments sent rather than the rows no matter
+// how many rows match, and the query stops once the client disconnects. The last message carries the error status if the query fails.
+func (node *Proxy) QueryStream(request *milvuspb.QueryRequest, stream proxypb.ProxyStream_QueryStreamServer) error {
+	ctx := stream.Context()
+	rateCol.Add(internalpb.RateType_DQLQuery.String(), 1)
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return stream.Send(&milvuspb.QueryResults{Status: merr.Status(err)})
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-QueryStream")
+	defer sp.End()
+	tr := timerecord.NewTimeRecorder("QueryStream")
+
+	method := "QueryStream"
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Strings("partitions", request.GetPartitionNames()),
+		zap.String("expr", request.GetExpr()))
+	log.Debug(rpcReceived(method))
+
+	rows, err := node.queryStream(ctx, request, stream)
+	if err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Int("rows", rows), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		if ctx.Err() != nil {
+			// the client has gone
+			return ctx.Err()
+		}
+		return stream.Send(&milvuspb.QueryResults{Status: merr.Status(err)})
+	}
+
+	log.Debug(rpcDone(method), zap.Int("rows", rows))
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxySQLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return nil
+}
+
+func (node *Proxy) queryStream(ctx context.Context, request *milvuspb.QueryRequest, stream proxypb.ProxyStream_QueryStreamServer) (int, error) {
+	if matchCountRule(request.GetOutputFields()) {
+		return 0, merr.WrapErrParameterInvalidMsg("count(*) is not supported by the streaming query")
+	}
+	for _, key := range []string{LimitKey, OffsetKey} {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(key, request.GetQueryParams()); err == nil {
+			return 0, merr.WrapErrParameterInvalidMsg("%s is not supported by the streaming query", key)
+		}
+	}
+	ts, err := node.tsoAllocator.AllocOne(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// the task is executed directly rather than in the dql queue, since the stream lasts until the client receives all the rows
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		qc:      node.queryCoord,
+		lb:      node.lbPolicy,
+	}
+	sender := newQueryStreamSender(qt, stream, Params.ProxyCfg.QueryStreamBatchSize.GetAsInt())
+	qt.streamConsumer = sender
+	qt.SetTs(ts)
+	if err := qt.PreExecute(ctx); err != nil {
+		return 0, err
+	}
+	if err := qt.Execute(ctx); err != nil {
+		return sender.rows, err
+	}
+	return sender.rows, nil
+}
@@ -0,0 +1,116 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+type mockQueryStreamServer struct {
+	grpc.ServerStream
+	ctx     context.Context
+	results []*milvuspb.QueryResults
+	err     error
+}
+
+func (s *mockQueryStreamServer) Send(result *milvuspb.QueryResults) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.results = append(s.results, result)
+	return nil
+}
+
+func (s *mockQueryStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func genStreamBatch(pks []int64) *internalpb.RetrieveResults {
+	values := make([]int64, len(pks))
+	for i, pk := range pks {
+		values[i] = pk * 10
+	}
+	batch := genPageBatch(pks, values)
+	batch.FieldsData = append([]*schemapb.FieldData{{
+		Type:    schemapb.DataType_Int64,
+		FieldId: 100,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+		}},
+	}}, batch.FieldsData...)
+	return batch
+}
+
+func TestQueryStreamSender(t *testing.T) {
+	task := &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{OutputFieldsId: []int64{100, 101}},
+		schema: &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "value", DataType: schemapb.DataType_Int64},
+			},
+		},
+		collectionName:   "test",
+		userOutputFields: []string{"value"},
+	}
+
+	t.Run("split batches and skip the segments sent", func(t *testing.T) {
+		stream := &mockQueryStreamServer{ctx: context.Background()}
+		sender := newQueryStreamSender(task, stream, 2)
+		segment1 := genStreamBatch([]int64{1, 2, 3})
+		segment1.SegmentID = 1
+		require.NoError(t, sender.consume(segment1))
+		require.NoError(t, sender.consume(&internalpb.RetrieveResults{}))
+		// the segment was sent before the shard was retried
+		require.NoError(t, sender.consume(segment1))
+		segment2 := genStreamBatch([]int64{4})
+		segment2.SegmentID = 2
+		require.NoError(t, sender.consume(segment2))
+		assert.Equal(t, 4, sender.rows)
+		assert.Equal(t, 2, sender.sentSegments.Len())
+
+		require.Len(t, stream.results, 3)
+		expected := [][]int64{{10, 20}, {30}, {40}}
+		for i, result := range stream.results {
+			assert.Equal(t, "test", result.GetCollectionName())
+			assert.Equal(t, []string{"value"}, result.GetOutputFields())
+			require.Len(t, result.GetFieldsData(), 2)
+			assert.Equal(t, "value", result.GetFieldsData()[1].GetFieldName())
+			assert.Equal(t, expected[i], result.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+		}
+	})
+
+	t.Run("client gone", func(t *testing.T) {
+		stream := &mockQueryStreamServer{ctx: context.Background(), err: errors.New("transport is closing")}
+		sender := newQueryStreamSender(task, stream, 2)
+		assert.Error(t, sender.consume(genStreamBatch([]int64{1})))
+		assert.Equal(t, 0, sender.rows)
+	})
+}
+
+func TestNewestRowsOfSegment(t *testing.T) {
+	result := genStreamBatch([]int64{1, 2, 1, 3, 2})
+	assert.Equal(t, []int{0, 1, 3}, newestRowsOfSegment(result))
+
+	// the copy with the newest timestamp is kept
+	result.FieldsData = append(result.FieldsData, &schemapb.FieldData{
+		Type:    schemapb.DataType_Int64,
+		FieldId: common.TimeStampField,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{10, 30, 20, 10, 20}}},
+		}},
+	})
+	assert.Equal(t, []int{2, 1, 3}, newestRowsOfSegment(result))
+
+	assert.Empty(t, newestRowsOfSegment(&internalpb.RetrieveResults{}))
+}
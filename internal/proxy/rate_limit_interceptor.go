@@ -39,7 +39,8 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		err = limiter.Check(collectionID, rt, n)
+		username, _ := GetCurUserFromContext(ctx)
+		err = limiter.Check(username, collectionID, rt, n)
 		if err != nil {
 			rsp := getFailedResponse(req, rt, err, info.FullMethod)
 			if rsp != nil {
@@ -114,6 +115,14 @@ func getRequestInfo(req interface{}) (int64, internalpb.RateType, int, error) {
 	}
 }
 
+// getRequestDBName returns the database of request, the one in the context or the default one is used if not specified.
+func getRequestDBName(ctx context.Context, req interface{}) string {
+	if r, ok := req.(interface{ GetDbName() string }); ok && r.GetDbName() != "" {
+		return r.GetDbName()
+	}
+	return GetCurDBNameFromContextOrDefault(ctx)
+}
+
 // failedMutationResult returns failed mutation result.
 func failedMutationResult(err error) *milvuspb.MutationResult {
 	return &milvuspb.MutationResult{
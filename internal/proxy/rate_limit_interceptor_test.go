@@ -24,10 +24,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
@@ -38,7 +40,7 @@ type limiterMock struct {
 	quotaStateReasons []commonpb.ErrorCode
 }
 
-func (l *limiterMock) Check(collection int64, rt internalpb.RateType, n int) error {
+func (l *limiterMock) Check(username string, collection int64, rt internalpb.RateType, n int) error {
 	if l.rate == 0 {
 		return merr.ErrServiceForceDeny
 	}
@@ -165,6 +167,15 @@ func TestRateLimitInterceptor(t *testing.T) {
 		assert.Equal(t, collection, int64(0))
 	})
 
+	t.Run("test getRequestDBName", func(t *testing.T) {
+		assert.Equal(t, "db1", getRequestDBName(context.Background(), &milvuspb.InsertRequest{DbName: "db1"}))
+		assert.Equal(t, util.DefaultDBName, getRequestDBName(context.Background(), &milvuspb.InsertRequest{}))
+		assert.Equal(t, util.DefaultDBName, getRequestDBName(context.Background(), &milvuspb.FlushRequest{}))
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, "db2"))
+		assert.Equal(t, "db2", getRequestDBName(ctx, &milvuspb.QueryRequest{}))
+		assert.Equal(t, "db1", getRequestDBName(ctx, &milvuspb.QueryRequest{DbName: "db1"}))
+	})
+
 	t.Run("test getFailedResponse", func(t *testing.T) {
 		testGetFailedResponse := func(req interface{}, rt internalpb.RateType, err error, fullMethod string) {
 			rsp := getFailedResponse(req, rt, err, fullMethod)
@@ -0,0 +1,256 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/cache"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+const (
+	searchResultCacheName = "SearchResult"
+	queryResultCacheName  = "QueryResult"
+)
+
+// resultCacheKey identifies a cached result by the collection and the digest of the request.
+type resultCacheKey struct {
+	collectionID UniqueID
+	digest       string
+}
+
+// Sum64 implements cache.Hash.
+func (k resultCacheKey) Sum64() uint64 {
+	return binary.LittleEndian.Uint64([]byte(k.digest)[:8]) ^ uint64(k.collectionID)
+}
+
+// resultCacheEntry is a cached result, it reflects all the mutations of its pchannels before ts,
+// which is the serviceable timestamp reported by the shard delegators.
+type resultCacheEntry struct {
+	ts        Timestamp
+	pChannels []pChan
+	result    proto.Message
+}
+
+type (
+	getCollectionPChannelsFunc func(collectionID UniqueID) ([]pChan, error)
+	getLastMutationTsFunc      func(pChannels []pChan) Timestamp
+)
+
+// resultCache caches the search and query results in proxy.
+// A result serves the later requests only if it reflects the mutations their consistency levels require,
+// so the mutations through other proxies are visible once their guarantee timestamps pass the serviceable
+// timestamp of the result. The mutations through this proxy invalidate the results by the timestamps of dml tasks.
+// The hits are recorded by the latency, nq and throughput metrics of search and query like the other requests,
+// and are counted along with the misses by ProxyCacheStatsCounter.
+type resultCache struct {
+	cache             cache.Cache[resultCacheKey, *resultCacheEntry]
+	getPChannels      getCollectionPChannelsFunc
+	getLastMutationTs getLastMutationTsFunc
+}
+
+func newResultCache(getPChannels getCollectionPChannelsFunc, getLastMutationTs getLastMutationTsFunc) *resultCache {
+	return &resultCache{
+		cache: cache.NewCache[resultCacheKey, *resultCacheEntry](
+			cache.WithMaximumSize[resultCacheKey, *resultCacheEntry](paramtable.Get().ProxyCfg.ResultCacheMaxEntries.GetAsInt64()),
+		),
+		getPChannels:      getPChannels,
+		getLastMutationTs: getLastMutationTs,
+	}
+}
+
+// enabled returns whether the request should go through the result cache.
+func (c *resultCache) enabled(ctx context.Context) bool {
+	return c != nil &&
+		paramtable.Get().ProxyCfg.ResultCacheEnabled.GetAsBool() &&
+		!getBoolFromContextHeader(ctx, util.HeaderSkipResultCache)
+}
+
+// get returns the cached result if it reflects all the mutations before readTs.
+func (c *resultCache) get(key resultCacheKey, readTs Timestamp, cacheName string) (proto.Message, bool) {
+	entry, ok := c.cache.GetIfPresent(key)
+	if ok && (entry.ts < readTs || c.getLastMutationTs(entry.pChannels) > entry.ts) {
+		c.cache.Invalidate(key)
+		ok = false
+	}
+	if !ok {
+		metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), cacheName, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), cacheName, metrics.CacheHitLabel).Inc()
+	return proto.Clone(entry.result), true
+}
+
+// put caches the result which reflects all the mutations before serviceableTs.
+func (c *resultCache) put(key resultCacheKey, serviceableTs Timestamp, result proto.Message) {
+	// the shards didn't report the position the result reflects
+	if serviceableTs == 0 {
+		return
+	}
+	if proto.Size(result) > paramtable.Get().ProxyCfg.ResultCacheMaxBytes.GetAsInt() {
+		return
+	}
+	pChannels, err := c.getPChannels(key.collectionID)
+	if err != nil {
+		return
+	}
+	// the mutations after serviceableTs may be invisible to the result
+	if c.getLastMutationTs(pChannels) > serviceableTs {
+		return
+	}
+	c.cache.Put(key, &resultCacheEntry{
+		ts:        serviceableTs,
+		pChannels: pChannels,
+		result:    proto.Clone(result),
+	})
+}
+
+// invalidateCollection removes the results of the collection, all the results are removed if collectionID is 0.
+func (c *resultCache) invalidateCollection(collectionID UniqueID) {
+	if c == nil {
+		return
+	}
+	if collectionID == 0 {
+		c.cache.InvalidateAll()
+		return
+	}
+	for key := range c.cache.Scan(func(key resultCacheKey, _ *resultCacheEntry) bool {
+		return key.collectionID == collectionID
+	}) {
+		c.cache.Invalidate(key)
+	}
+}
+
+func (c *resultCache) close() {
+	if c != nil {
+		c.cache.Close()
+	}
+}
+
+// resultCacheReadTs returns the timestamp a cached result must reflect to serve the request, which is the guarantee
+// timestamp of its consistency level as of now. The eventually consistent requests are bounded by the graceful time
+// like the bounded ones, otherwise the mutations through other proxies would never be visible to the cached results.
+// The requests whose default consistency level is unknown are taken as strong ones.
+func resultCacheReadTs(ctx context.Context, dbName, collectionName string, guaranteeTs Timestamp,
+	consistencyLevel commonpb.ConsistencyLevel, useDefaultConsistency bool,
+) Timestamp {
+	now := tsoutil.GetCurrentTime()
+	if useDefaultConsistency {
+		collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, 0)
+		if err != nil {
+			return now
+		}
+		consistencyLevel = collectionInfo.consistencyLevel
+		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, now, consistencyLevel)
+	} else if consistencyLevel == 0 && guaranteeTs > 0 {
+		// compatible with the legacy guarantee timestamps
+		guaranteeTs = parseGuaranteeTs(guaranteeTs, now)
+	} else {
+		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, now, consistencyLevel)
+	}
+	if guaranteeTs <= 1 {
+		guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, now, commonpb.ConsistencyLevel_Bounded)
+	}
+	return guaranteeTs
+}
+
+// resultCacheDigest hashes the parts of a request, each part is prefixed by its length to avoid ambiguity.
+func resultCacheDigest(parts ...[]byte) string {
+	h := sha256.New()
+	for _, part := range parts {
+		binary.Write(h, binary.LittleEndian, uint64(len(part)))
+		h.Write(part)
+	}
+	return string(h.Sum(nil))
+}
+
+func kvPairsForDigest(kvs []*commonpb.KeyValuePair) []byte {
+	pairs := make([]string, 0, len(kvs))
+	for _, kv := range kvs {
+		pairs = append(pairs, kv.GetKey()+"="+kv.GetValue())
+	}
+	sort.Strings(pairs)
+	return []byte(fmt.Sprint(pairs))
+}
+
+func sortedStringsForDigest(strs []string) []byte {
+	sorted := append([]string{}, strs...)
+	sort.Strings(sorted)
+	return []byte(fmt.Sprint(sorted))
+}
+
+// resultCacheScope returns the collection id along with the scope of the results visible to the current user,
+// which consists of the row policy and the unreadable fields. The users share the cache but may see different
+// rows and fields, so the scope is a part of the key.
+func resultCacheScope(ctx context.Context, dbName, collectionName string) (UniqueID, string, error) {
+	collectionID, rowPolicy, err := getCollectionRowPolicy(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, "", err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, "", err
+	}
+	unreadableFields, err := getUnreadableFields(ctx, dbName, schema.GetName())
+	if err != nil {
+		return 0, "", err
+	}
+	return collectionID, fmt.Sprintf("%q%s", rowPolicy, sortedStringsForDigest(unreadableFields.Collect())), nil
+}
+
+// searchResultCacheKey identifies the result of the search request within the scope of the user.
+func searchResultCacheKey(collectionID UniqueID, request *milvuspb.SearchRequest, scope string) resultCacheKey {
+	return resultCacheKey{
+		collectionID: collectionID,
+		digest: resultCacheDigest(
+			[]byte(request.GetDsl()),
+			[]byte(request.GetDslType().String()),
+			request.GetPlaceholderGroup(),
+			kvPairsForDigest(request.GetSearchParams()),
+			sortedStringsForDigest(request.GetPartitionNames()),
+			[]byte(fmt.Sprint(request.GetOutputFields())),
+			[]byte(fmt.Sprint(request.GetNq(), request.GetConsistencyLevel(), request.GetUseDefaultConsistency(), request.GetNotReturnAllMeta())),
+			[]byte(scope),
+		),
+	}
+}
+
+func queryResultCacheKey(collectionID UniqueID, request *milvuspb.QueryRequest, scope string) resultCacheKey {
+	return resultCacheKey{
+		collectionID: collectionID,
+		digest: resultCacheDigest(
+			[]byte(request.GetExpr()),
+			kvPairsForDigest(request.GetQueryParams()),
+			sortedStringsForDigest(request.GetPartitionNames()),
+			[]byte(fmt.Sprint(request.GetOutputFields())),
+			[]byte(fmt.Sprint(request.GetConsistencyLevel(), request.GetUseDefaultConsistency(), request.GetNotReturnAllMeta())),
+			[]byte(scope),
+		),
+	}
+}
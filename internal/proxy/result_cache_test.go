@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestResultCache(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+
+	lastMutationTs := map[pChan]Timestamp{}
+	c := newResultCache(func(collectionID UniqueID) ([]pChan, error) {
+		if collectionID == 0 {
+			return nil, errors.New("mock")
+		}
+		return []pChan{"ch1", "ch2"}, nil
+	}, func(pChannels []pChan) Timestamp {
+		var ret Timestamp
+		for _, ch := range pChannels {
+			if lastMutationTs[ch] > ret {
+				ret = lastMutationTs[ch]
+			}
+		}
+		return ret
+	})
+	defer c.close()
+
+	t.Run("enabled", func(t *testing.T) {
+		var nilCache *resultCache
+		assert.False(t, nilCache.enabled(context.Background()))
+		assert.False(t, c.enabled(context.Background()))
+
+		params.Save(params.ProxyCfg.ResultCacheEnabled.Key, "true")
+		defer params.Reset(params.ProxyCfg.ResultCacheEnabled.Key)
+		assert.True(t, c.enabled(context.Background()))
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderSkipResultCache, "true"))
+		assert.False(t, c.enabled(ctx))
+	})
+
+	t.Run("key", func(t *testing.T) {
+		request := &milvuspb.SearchRequest{
+			Dsl:              "a > 1",
+			PlaceholderGroup: []byte{1, 2, 3},
+			PartitionNames:   []string{"p1", "p2"},
+			SearchParams: []*commonpb.KeyValuePair{
+				{Key: "topk", Value: "10"},
+				{Key: "params", Value: "{}"},
+			},
+		}
+		key := searchResultCacheKey(1, request, "")
+		// the order of partitions and search params doesn't matter
+		assert.Equal(t, key, searchResultCacheKey(1, &milvuspb.SearchRequest{
+			Dsl:              "a > 1",
+			PlaceholderGroup: []byte{1, 2, 3},
+			PartitionNames:   []string{"p2", "p1"},
+			SearchParams: []*commonpb.KeyValuePair{
+				{Key: "params", Value: "{}"},
+				{Key: "topk", Value: "10"},
+			},
+		}, ""))
+		assert.NotEqual(t, key, searchResultCacheKey(2, request, ""))
+		assert.NotEqual(t, key, searchResultCacheKey(1, &milvuspb.SearchRequest{
+			Dsl:              "a > 1",
+			PlaceholderGroup: []byte{1, 2, 4},
+			PartitionNames:   []string{"p1", "p2"},
+			SearchParams:     request.GetSearchParams(),
+		}, ""))
+		// the users with different row policies don't share the results
+		assert.NotEqual(t, key, searchResultCacheKey(1, request, "tenant == 'a'"))
+		assert.NotEqual(t, queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 1"}, ""),
+			queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 2"}, ""))
+		assert.NotEqual(t, queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 1"}, "tenant == 'a'"),
+			queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 1"}, "tenant == 'b'"))
+	})
+
+	t.Run("get and put", func(t *testing.T) {
+		key := queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 1"}, "")
+		result := &milvuspb.QueryResults{Status: merr.Success(), CollectionName: "test"}
+
+		_, ok := c.get(key, 0, queryResultCacheName)
+		assert.False(t, ok)
+
+		// the results without serviceable timestamp are not cached
+		c.put(key, 0, result)
+		_, ok = c.get(key, 0, queryResultCacheName)
+		assert.False(t, ok)
+
+		// the results reflecting no latest mutations are not cached
+		lastMutationTs["ch1"] = 100
+		c.put(key, 99, result)
+		_, ok = c.get(key, 0, queryResultCacheName)
+		assert.False(t, ok)
+
+		c.put(key, 100, result)
+		cached, ok := c.get(key, 100, queryResultCacheName)
+		assert.True(t, ok)
+		assert.Equal(t, "test", cached.(*milvuspb.QueryResults).GetCollectionName())
+
+		// the requests requiring the mutations after the serviceable timestamp miss the result
+		_, ok = c.get(key, 101, queryResultCacheName)
+		assert.False(t, ok)
+
+		// mutations after the timestamp invalidate the result
+		c.put(key, 110, result)
+		_, ok = c.get(key, 100, queryResultCacheName)
+		assert.True(t, ok)
+		lastMutationTs["ch2"] = 111
+		_, ok = c.get(key, 100, queryResultCacheName)
+		assert.False(t, ok)
+
+		// collection channels not found
+		c.put(queryResultCacheKey(0, &milvuspb.QueryRequest{}, ""), 200, result)
+		_, ok = c.get(queryResultCacheKey(0, &milvuspb.QueryRequest{}, ""), 0, queryResultCacheName)
+		assert.False(t, ok)
+
+		// too large to cache
+		params.Save(params.ProxyCfg.ResultCacheMaxBytes.Key, "1")
+		c.put(key, 200, result)
+		params.Reset(params.ProxyCfg.ResultCacheMaxBytes.Key)
+		_, ok = c.get(key, 0, queryResultCacheName)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		result := &milvuspb.QueryResults{Status: merr.Success()}
+		key1 := queryResultCacheKey(1, &milvuspb.QueryRequest{Expr: "a > 1"}, "")
+		key2 := queryResultCacheKey(2, &milvuspb.QueryRequest{Expr: "a > 1"}, "")
+		c.put(key1, 200, result)
+		c.put(key2, 200, result)
+
+		c.invalidateCollection(1)
+		_, ok := c.get(key1, 0, queryResultCacheName)
+		assert.False(t, ok)
+		_, ok = c.get(key2, 0, queryResultCacheName)
+		assert.True(t, ok)
+
+		c.invalidateCollection(0)
+		_, ok = c.get(key2, 0, queryResultCacheName)
+		assert.False(t, ok)
+	})
+
+	t.Run("read ts", func(t *testing.T) {
+		ctx := context.Background()
+		gracefulTime := params.CommonCfg.GracefulTime.GetAsDuration(time.Millisecond)
+		before := tsoutil.GetCurrentTime()
+		strongTs := resultCacheReadTs(ctx, "", "test", 0, commonpb.ConsistencyLevel_Strong, false)
+		boundedTs := resultCacheReadTs(ctx, "", "test", 0, commonpb.ConsistencyLevel_Bounded, false)
+		eventuallyTs := resultCacheReadTs(ctx, "", "test", 0, commonpb.ConsistencyLevel_Eventually, false)
+		after := tsoutil.GetCurrentTime()
+
+		assert.True(t, strongTs >= before && strongTs <= after)
+		assert.True(t, boundedTs >= tsoutil.AddPhysicalDurationOnTs(before, -gracefulTime))
+		assert.True(t, boundedTs <= tsoutil.AddPhysicalDurationOnTs(after, -gracefulTime))
+		// eventually consistent requests are bounded by the graceful time as well
+		assert.True(t, eventuallyTs >= tsoutil.AddPhysicalDurationOnTs(before, -gracefulTime))
+		// session consistency requires the timestamp of the last write
+		assert.Equal(t, Timestamp(100), resultCacheReadTs(ctx, "", "test", 100, commonpb.ConsistencyLevel_Session, false))
+
+		// the default consistency level of the collection
+		cache := NewMockCache(t)
+		cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, "test", mock.Anything).
+			Return(&collectionBasicInfo{consistencyLevel: commonpb.ConsistencyLevel_Session}, nil).Once()
+		cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, "unknown", mock.Anything).
+			Return(nil, errors.New("mock")).Once()
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+		assert.Equal(t, Timestamp(100), resultCacheReadTs(ctx, "", "test", 100, commonpb.ConsistencyLevel_Strong, true))
+		// taken as a strong request if the collection is unknown
+		ts := resultCacheReadTs(ctx, "", "unknown", 100, commonpb.ConsistencyLevel_Eventually, true)
+		assert.True(t, ts >= after)
+	})
+}
+
+func TestProxy_QueryResultCacheHit(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.ResultCacheEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.ResultCacheEnabled.Key)
+
+	oldRateCol := rateCol
+	var err error
+	rateCol, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
+	assert.NoError(t, err)
+	defer func() { rateCol = oldRateCol }()
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(1, nil)
+	cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "col").Return(&schemapb.CollectionSchema{Name: "col"}, nil)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+
+	node := &Proxy{resultCache: newResultCache(func(collectionID UniqueID) ([]pChan, error) {
+		return []pChan{"ch1"}, nil
+	}, func(pChannels []pChan) Timestamp {
+		return 0
+	})}
+	defer node.resultCache.close()
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	ctx := context.Background()
+	request := &milvuspb.QueryRequest{
+		CollectionName:   "col",
+		Expr:             "id > 1",
+		ConsistencyLevel: commonpb.ConsistencyLevel_Strong,
+	}
+	result := &milvuspb.QueryResults{Status: merr.Success(), CollectionName: "col"}
+	collectionID, scope, err := resultCacheScope(ctx, "", "col")
+	assert.NoError(t, err)
+	node.resultCache.put(queryResultCacheKey(collectionID, request, scope), tsoutil.ComposeTSByTime(time.Now().Add(time.Hour), 0), result)
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	latencyCount := func() uint64 {
+		m := &dto.Metric{}
+		assert.NoError(t, metrics.ProxySQLatency.WithLabelValues(nodeID, metrics.QueryLabel).(prometheus.Metric).Write(m))
+		return m.GetHistogram().GetSampleCount()
+	}
+	sentBytes := testutil.ToFloat64(metrics.ProxyReadReqSendBytes.WithLabelValues(nodeID))
+	latencies := latencyCount()
+
+	// the cache hit is recorded by the metrics of the query as well
+	resp, err := node.Query(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, "col", resp.GetCollectionName())
+	assert.Equal(t, latencies+1, latencyCount())
+	assert.Equal(t, sentBytes+float64(proto.Size(result)), testutil.ToFloat64(metrics.ProxyReadReqSendBytes.WithLabelValues(nodeID)))
+}
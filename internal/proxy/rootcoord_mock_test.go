@@ -994,6 +994,34 @@ func (coord *RootCoordMock) GetCredential(ctx context.Context, req *rootcoordpb.
 	return &rootcoordpb.GetCredentialResponse{}, nil
 }
 
+func (coord *RootCoordMock) CreateAPIKey(ctx context.Context, req *internalpb.APIKeyInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListAPIKeys(ctx context.Context, req *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error) {
+	return &internalpb.ListAPIKeysResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) RevokeAPIKey(ctx context.Context, req *internalpb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ReportLoginFailure(ctx context.Context, req *internalpb.ReportLoginFailureRequest, opts ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error) {
+	return &internalpb.ReportLoginFailureResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) GrantRowPolicy(ctx context.Context, req *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) RevokeRowPolicy(ctx context.Context, req *internalpb.RevokeRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListRowPolicies(ctx context.Context, req *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	return &internalpb.ListRowPoliciesResponse{Status: merr.Success()}, nil
+}
+
 func (coord *RootCoordMock) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, nil
 }
@@ -1042,6 +1070,18 @@ func (coord *RootCoordMock) ListDatabases(ctx context.Context, in *milvuspb.List
 	return &milvuspb.ListDatabasesResponse{}, nil
 }
 
+func (coord *RootCoordMock) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	return &internalpb.DescribeDatabaseResponse{Status: merr.Success(), DbName: in.GetDbName()}, nil
+}
+
+func (coord *RootCoordMock) BatchAlterAliases(ctx context.Context, in *internalpb.BatchAlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (coord *RootCoordMock) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	if coord.checkHealthFunc != nil {
 		return coord.checkHealthFunc(ctx, req)
@@ -1053,6 +1093,50 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) CloneCollection(ctx context.Context, req *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListDroppedCollections(ctx context.Context, req *internalpb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error) {
+	return &internalpb.ListDroppedCollectionsResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) UndropCollection(ctx context.Context, req *internalpb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) UndropPartition(ctx context.Context, req *internalpb.UndropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) CreateSnapshot(ctx context.Context, req *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropSnapshot(ctx context.Context, req *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) ListSnapshots(ctx context.Context, req *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	return &internalpb.ListSnapshotsResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) RestoreSnapshot(ctx context.Context, req *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) GetDDLJob(ctx context.Context, req *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	return &internalpb.GetDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) ListDDLJobs(ctx context.Context, req *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	return &internalpb.ListDDLJobsResponse{Status: merr.Success()}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
 
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
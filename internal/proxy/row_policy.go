@@ -0,0 +1,493 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// getRowPolicyExpr returns the filter expression limiting the rows of the collection visible to the current user,
+// it's empty if the user isn't limited. The policies granted to the roles of the user are ORed, the roles without
+// a policy don't lift the limits of the others.
+func getRowPolicyExpr(ctx context.Context, collectionID UniqueID) (string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() || globalMetaCache == nil {
+		return "", nil
+	}
+	policies, err := globalMetaCache.GetRowPolicies(ctx, collectionID)
+	if err != nil {
+		return "", err
+	}
+	if len(policies) == 0 {
+		return "", nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return "", merr.WrapErrPrivilegeNotAuthenticated("%s", err.Error())
+	}
+	if username == util.UserRoot {
+		return "", nil
+	}
+	roleNames := globalMetaCache.GetUserRole(username)
+	roleNames = append(roleNames, GetTokenRolesFromContext(ctx)...)
+	roleNames = append(roleNames, util.RolePublic)
+
+	exprs := typeutil.NewSet[string]()
+	for _, roleName := range roleNames {
+		if expr, ok := policies[roleName]; ok {
+			exprs.Insert(expr)
+		}
+	}
+	if exprs.Len() == 0 {
+		return "", nil
+	}
+	sorted := exprs.Collect()
+	sort.Strings(sorted)
+	if len(sorted) == 1 {
+		return sorted[0], nil
+	}
+	return "(" + strings.Join(sorted, ") or (") + ")", nil
+}
+
+// getCollectionRowPolicy returns the collection id along with the row policy applied to the current user.
+func getCollectionRowPolicy(ctx context.Context, dbName, collectionName string) (UniqueID, string, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, "", err
+	}
+	rowPolicy, err := getRowPolicyExpr(ctx, collectionID)
+	if err != nil {
+		return 0, "", err
+	}
+	return collectionID, rowPolicy, nil
+}
+
+// applyRowPolicy ANDs the row policy into the predicates of the plan. The policy is parsed on its own and
+// combined with the parsed predicates, so that no expression of the request could escape it.
+func applyRowPolicy(plan *planpb.PlanNode, schema *schemapb.CollectionSchema, rowPolicy string) error {
+	if rowPolicy == "" {
+		return nil
+	}
+	policyPlan, err := planparserv2.CreateRetrievePlan(schema, rowPolicy)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid row policy %s: %s", rowPolicy, err.Error())
+	}
+	policyExpr := policyPlan.GetQuery().GetPredicates()
+
+	combine := func(predicates *planpb.Expr) *planpb.Expr {
+		if predicates == nil {
+			return policyExpr
+		}
+		if _, ok := predicates.GetExpr().(*planpb.Expr_AlwaysTrueExpr); ok {
+			return policyExpr
+		}
+		return &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  predicates,
+					Right: policyExpr,
+				},
+			},
+		}
+	}
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_VectorAnns:
+		node.VectorAnns.Predicates = combine(node.VectorAnns.GetPredicates())
+	case *planpb.PlanNode_Query:
+		node.Query.Predicates = combine(node.Query.GetPredicates())
+	case *planpb.PlanNode_Predicates:
+		node.Predicates = combine(node.Predicates)
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported plan node %T for row policy", node)
+	}
+	return nil
+}
+
+// validateRowPolicy checks the expression is a valid filter of the collection and could be evaluated
+// on the inserted rows by proxy.
+func validateRowPolicy(schema *schemapb.CollectionSchema, expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return merr.WrapErrParameterInvalidMsg("the expression of the row policy is empty")
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, expr)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid row policy %s: %s", expr, err.Error())
+	}
+	if err := checkRowPolicyExpr(plan.GetQuery().GetPredicates()); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid row policy %s: %s", expr, err.Error())
+	}
+	return nil
+}
+
+// checkRowPolicyExpr checks the expression only consists of the parts supported by evalRowPolicyExpr.
+func checkRowPolicyExpr(expr *planpb.Expr) error {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_AlwaysTrueExpr:
+		return nil
+	case *planpb.Expr_UnaryExpr:
+		return checkRowPolicyExpr(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		if err := checkRowPolicyExpr(e.BinaryExpr.GetLeft()); err != nil {
+			return err
+		}
+		return checkRowPolicyExpr(e.BinaryExpr.GetRight())
+	case *planpb.Expr_TermExpr:
+		if e.TermExpr.GetIsInField() {
+			return fmt.Errorf("term expression on the array field is not supported")
+		}
+		return checkRowPolicyColumn(e.TermExpr.GetColumnInfo())
+	case *planpb.Expr_UnaryRangeExpr:
+		switch e.UnaryRangeExpr.GetOp() {
+		case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan, planpb.OpType_LessEqual,
+			planpb.OpType_Equal, planpb.OpType_NotEqual, planpb.OpType_PrefixMatch:
+		default:
+			return fmt.Errorf("operator %s is not supported", e.UnaryRangeExpr.GetOp().String())
+		}
+		return checkRowPolicyColumn(e.UnaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryRangeExpr:
+		return checkRowPolicyColumn(e.BinaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_CompareExpr:
+		switch e.CompareExpr.GetOp() {
+		case planpb.OpType_GreaterThan, planpb.OpType_GreaterEqual, planpb.OpType_LessThan, planpb.OpType_LessEqual,
+			planpb.OpType_Equal, planpb.OpType_NotEqual:
+		default:
+			return fmt.Errorf("operator %s is not supported", e.CompareExpr.GetOp().String())
+		}
+		if err := checkRowPolicyColumn(e.CompareExpr.GetLeftColumnInfo()); err != nil {
+			return err
+		}
+		return checkRowPolicyColumn(e.CompareExpr.GetRightColumnInfo())
+	case *planpb.Expr_ExistsExpr:
+		return checkRowPolicyColumn(e.ExistsExpr.GetInfo())
+	default:
+		return fmt.Errorf("expression %T is not supported", e)
+	}
+}
+
+func checkRowPolicyColumn(info *planpb.ColumnInfo) error {
+	switch info.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar, schemapb.DataType_JSON:
+		return nil
+	default:
+		return fmt.Errorf("field of type %s is not supported", info.GetDataType().String())
+	}
+}
+
+// checkRowPolicyOnRows checks all the rows to write satisfy the row policy, so that no one could write the rows
+// invisible to itself. The field ids of the field data must be filled.
+func checkRowPolicyOnRows(schema *schemapb.CollectionSchema, rowPolicy string, fieldsData []*schemapb.FieldData, numRows int) error {
+	if rowPolicy == "" {
+		return nil
+	}
+	plan, err := planparserv2.CreateRetrievePlan(schema, rowPolicy)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid row policy %s: %s", rowPolicy, err.Error())
+	}
+	expr := plan.GetQuery().GetPredicates()
+	row := &rowPolicyRow{
+		fields: make(map[int64]*schemapb.FieldData, len(fieldsData)),
+		jsons:  make(map[int64]map[string]interface{}),
+	}
+	for _, fieldData := range fieldsData {
+		row.fields[fieldData.GetFieldId()] = fieldData
+	}
+	for i := 0; i < numRows; i++ {
+		row.offset = i
+		for k := range row.jsons {
+			delete(row.jsons, k)
+		}
+		ok, err := evalRowPolicyExpr(expr, row)
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("fail to check row %d against the row policy: %s", i, err.Error())
+		}
+		if !ok {
+			return merr.WrapErrPrivilegeNotPermitted("row %d doesn't satisfy the row policy of the current user", i)
+		}
+	}
+	return nil
+}
+
+// rowPolicyRow is the row of the column based field data the row policy is evaluated on.
+type rowPolicyRow struct {
+	fields map[int64]*schemapb.FieldData
+	jsons  map[int64]map[string]interface{} // the decoded json fields of the row
+	offset int
+}
+
+// value returns the value of the column in the row, ok is false if the json path doesn't exist.
+// The numbers are returned as int64 or float64, the json numbers are float64.
+func (r *rowPolicyRow) value(info *planpb.ColumnInfo) (v interface{}, ok bool, err error) {
+	fieldData, found := r.fields[info.GetFieldId()]
+	if !found {
+		return nil, false, fmt.Errorf("field %d not found", info.GetFieldId())
+	}
+	scalars := fieldData.GetScalars()
+	i := r.offset
+	switch info.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := scalars.GetBoolData().GetData()
+		if i < len(data) {
+			return data[i], true, nil
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := scalars.GetIntData().GetData()
+		if i < len(data) {
+			return int64(data[i]), true, nil
+		}
+	case schemapb.DataType_Int64:
+		data := scalars.GetLongData().GetData()
+		if i < len(data) {
+			return data[i], true, nil
+		}
+	case schemapb.DataType_Float:
+		data := scalars.GetFloatData().GetData()
+		if i < len(data) {
+			return float64(data[i]), true, nil
+		}
+	case schemapb.DataType_Double:
+		data := scalars.GetDoubleData().GetData()
+		if i < len(data) {
+			return data[i], true, nil
+		}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := scalars.GetStringData().GetData()
+		if i < len(data) {
+			return data[i], true, nil
+		}
+	case schemapb.DataType_JSON:
+		doc, err := r.json(info.GetFieldId(), scalars.GetJsonData().GetData())
+		if err != nil {
+			return nil, false, err
+		}
+		var cur interface{} = doc
+		for _, key := range info.GetNestedPath() {
+			switch node := cur.(type) {
+			case map[string]interface{}:
+				if cur, ok = node[key]; !ok {
+					return nil, false, nil
+				}
+			case []interface{}:
+				idx, err := strconv.Atoi(key)
+				if err != nil || idx < 0 || idx >= len(node) {
+					return nil, false, nil
+				}
+				cur = node[idx]
+			default:
+				return nil, false, nil
+			}
+		}
+		if cur == nil {
+			return nil, false, nil
+		}
+		return cur, true, nil
+	default:
+		return nil, false, fmt.Errorf("field of type %s is not supported", info.GetDataType().String())
+	}
+	return nil, false, fmt.Errorf("the data of field %d is less than %d rows", info.GetFieldId(), i+1)
+}
+
+func (r *rowPolicyRow) json(fieldID int64, data [][]byte) (map[string]interface{}, error) {
+	if doc, ok := r.jsons[fieldID]; ok {
+		return doc, nil
+	}
+	if r.offset >= len(data) {
+		return nil, fmt.Errorf("the data of field %d is less than %d rows", fieldID, r.offset+1)
+	}
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(data[r.offset], &doc); err != nil {
+		return nil, err
+	}
+	r.jsons[fieldID] = doc
+	return doc, nil
+}
+
+func evalRowPolicyExpr(expr *planpb.Expr, row *rowPolicyRow) (bool, error) {
+	switch e := expr.GetExpr().(type) {
+	case nil, *planpb.Expr_AlwaysTrueExpr:
+		return true, nil
+	case *planpb.Expr_UnaryExpr:
+		ok, err := evalRowPolicyExpr(e.UnaryExpr.GetChild(), row)
+		if err != nil {
+			return false, err
+		}
+		if e.UnaryExpr.GetOp() == planpb.UnaryExpr_Not {
+			return !ok, nil
+		}
+		return ok, nil
+	case *planpb.Expr_BinaryExpr:
+		left, err := evalRowPolicyExpr(e.BinaryExpr.GetLeft(), row)
+		if err != nil {
+			return false, err
+		}
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			if !left {
+				return false, nil
+			}
+		case planpb.BinaryExpr_LogicalOr:
+			if left {
+				return true, nil
+			}
+		default:
+			return false, fmt.Errorf("operator %s is not supported", e.BinaryExpr.GetOp().String())
+		}
+		return evalRowPolicyExpr(e.BinaryExpr.GetRight(), row)
+	case *planpb.Expr_TermExpr:
+		v, ok, err := row.value(e.TermExpr.GetColumnInfo())
+		if err != nil || !ok {
+			return false, err
+		}
+		for _, term := range e.TermExpr.GetValues() {
+			if cmp, ok := compareRowPolicyValue(v, genericValue(term)); ok && cmp == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	case *planpb.Expr_UnaryRangeExpr:
+		v, ok, err := row.value(e.UnaryRangeExpr.GetColumnInfo())
+		if err != nil || !ok {
+			return false, err
+		}
+		target := genericValue(e.UnaryRangeExpr.GetValue())
+		if e.UnaryRangeExpr.GetOp() == planpb.OpType_PrefixMatch {
+			str, isStr := v.(string)
+			prefix, isPrefixStr := target.(string)
+			return isStr && isPrefixStr && strings.HasPrefix(str, prefix), nil
+		}
+		return evalRowPolicyCompare(e.UnaryRangeExpr.GetOp(), v, target)
+	case *planpb.Expr_BinaryRangeExpr:
+		v, ok, err := row.value(e.BinaryRangeExpr.GetColumnInfo())
+		if err != nil || !ok {
+			return false, err
+		}
+		lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+		if e.BinaryRangeExpr.GetLowerInclusive() {
+			lowerOp = planpb.OpType_GreaterEqual
+		}
+		if e.BinaryRangeExpr.GetUpperInclusive() {
+			upperOp = planpb.OpType_LessEqual
+		}
+		ok, err = evalRowPolicyCompare(lowerOp, v, genericValue(e.BinaryRangeExpr.GetLowerValue()))
+		if err != nil || !ok {
+			return false, err
+		}
+		return evalRowPolicyCompare(upperOp, v, genericValue(e.BinaryRangeExpr.GetUpperValue()))
+	case *planpb.Expr_CompareExpr:
+		left, ok, err := row.value(e.CompareExpr.GetLeftColumnInfo())
+		if err != nil || !ok {
+			return false, err
+		}
+		right, ok, err := row.value(e.CompareExpr.GetRightColumnInfo())
+		if err != nil || !ok {
+			return false, err
+		}
+		return evalRowPolicyCompare(e.CompareExpr.GetOp(), left, right)
+	case *planpb.Expr_ExistsExpr:
+		_, ok, err := row.value(e.ExistsExpr.GetInfo())
+		return ok, err
+	default:
+		return false, fmt.Errorf("expression %T is not supported", e)
+	}
+}
+
+func evalRowPolicyCompare(op planpb.OpType, v, target interface{}) (bool, error) {
+	cmp, ok := compareRowPolicyValue(v, target)
+	if !ok {
+		// the values of the different types never match, like the json values of an unexpected type
+		return op == planpb.OpType_NotEqual, nil
+	}
+	switch op {
+	case planpb.OpType_GreaterThan:
+		return cmp > 0, nil
+	case planpb.OpType_GreaterEqual:
+		return cmp >= 0, nil
+	case planpb.OpType_LessThan:
+		return cmp < 0, nil
+	case planpb.OpType_LessEqual:
+		return cmp <= 0, nil
+	case planpb.OpType_Equal:
+		return cmp == 0, nil
+	case planpb.OpType_NotEqual:
+		return cmp != 0, nil
+	default:
+		return false, fmt.Errorf("operator %s is not supported", op.String())
+	}
+}
+
+func genericValue(v *planpb.GenericValue) interface{} {
+	switch val := v.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return val.BoolVal
+	case *planpb.GenericValue_Int64Val:
+		return val.Int64Val
+	case *planpb.GenericValue_FloatVal:
+		return val.FloatVal
+	case *planpb.GenericValue_StringVal:
+		return val.StringVal
+	default:
+		return nil
+	}
+}
+
+// compareRowPolicyValue compares the values of the same kind, ok is false if they are not comparable.
+// The bools are only equal or not, 0 is returned if equal and 1 otherwise.
+func compareRowPolicyValue(a, b interface{}) (int, bool) {
+	switch x := a.(type) {
+	case bool:
+		y, ok := b.(bool)
+		if !ok {
+			return 0, false
+		}
+		if x == y {
+			return 0, true
+		}
+		return 1, true
+	case string:
+		y, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(x, y), true
+	case int64:
+		if y, ok := b.(int64); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			}
+			return 0, true
+		}
+		return compareRowPolicyValue(float64(x), b)
+	case float64:
+		var y float64
+		switch n := b.(type) {
+		case int64:
+			y = float64(n)
+		case float64:
+			y = n
+		default:
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		}
+		return 0, true
+	default:
+		return 0, false
+	}
+}
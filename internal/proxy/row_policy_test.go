@@ -0,0 +1,355 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func rowPolicyTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "row_policy",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tenant", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "64"}}},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+			{FieldID: 104, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 105, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func rowPolicyTestFieldsData() []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{FieldId: 101, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 1, 2}}},
+		}}},
+		{FieldId: 102, Type: schemapb.DataType_VarChar, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"alice", "bob", "carol"}}},
+		}}},
+		{FieldId: 103, Type: schemapb.DataType_JSON, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{
+				[]byte(`{"region": "eu", "level": 3, "tags": ["a", "b"]}`),
+				[]byte(`{"region": "us", "level": 1}`),
+				[]byte(`{"level": "high"}`),
+			}}},
+		}}},
+		{FieldId: 104, Type: schemapb.DataType_Double, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: []float64{0.5, 1.5, 2.5}}},
+		}}},
+	}
+}
+
+func TestGetRowPolicyExpr(t *testing.T) {
+	paramtable.Init()
+	ctx := GetContext(context.Background(), "alice:123456")
+
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		expr, err := getRowPolicyExpr(ctx, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, expr)
+	})
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	t.Run("fail to get policies", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(nil, errors.New("mock")).Once()
+		_, err := getRowPolicyExpr(ctx, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("no policy", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(nil, nil).Once()
+		expr, err := getRowPolicyExpr(ctx, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, expr)
+	})
+
+	policies := map[string]string{"r1": "tenant == 1", "r2": "tenant == 2", "r3": "tenant == 3"}
+
+	t.Run("no user", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(policies, nil).Once()
+		_, err := getRowPolicyExpr(context.Background(), 1)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(policies, nil).Once()
+		expr, err := getRowPolicyExpr(GetContext(context.Background(), "root:123456"), 1)
+		assert.NoError(t, err)
+		assert.Empty(t, expr)
+	})
+
+	t.Run("role without policy", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(policies, nil).Once()
+		cache.EXPECT().GetUserRole("alice").Return([]string{"r4"}).Once()
+		expr, err := getRowPolicyExpr(ctx, 1)
+		assert.NoError(t, err)
+		assert.Empty(t, expr)
+	})
+
+	t.Run("one role", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(policies, nil).Once()
+		cache.EXPECT().GetUserRole("alice").Return([]string{"r1", "r4"}).Once()
+		expr, err := getRowPolicyExpr(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "tenant == 1", expr)
+	})
+
+	t.Run("roles ored", func(t *testing.T) {
+		cache.EXPECT().GetRowPolicies(mock.Anything, int64(1)).Return(policies, nil).Once()
+		cache.EXPECT().GetUserRole("alice").Return([]string{"r3", "r1"}).Once()
+		expr, err := getRowPolicyExpr(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "(tenant == 1) or (tenant == 3)", expr)
+	})
+}
+
+func TestApplyRowPolicy(t *testing.T) {
+	schema := rowPolicyTestSchema()
+
+	t.Run("no policy", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id > 0")
+		assert.NoError(t, err)
+		predicates := plan.GetQuery().GetPredicates()
+		assert.NoError(t, applyRowPolicy(plan, schema, ""))
+		assert.Equal(t, predicates, plan.GetQuery().GetPredicates())
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id > 0")
+		assert.NoError(t, err)
+		assert.ErrorIs(t, applyRowPolicy(plan, schema, "unknown == 1"), merr.ErrParameterInvalid)
+	})
+
+	t.Run("query", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id > 0")
+		assert.NoError(t, err)
+		predicates := plan.GetQuery().GetPredicates()
+		assert.NoError(t, applyRowPolicy(plan, schema, "tenant == 1"))
+		binary := plan.GetQuery().GetPredicates().GetBinaryExpr()
+		assert.NotNil(t, binary)
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binary.GetOp())
+		assert.Equal(t, predicates, binary.GetLeft())
+		assert.Equal(t, int64(101), binary.GetRight().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	})
+
+	t.Run("request expression can't escape", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id > 0 or id < 0")
+		assert.NoError(t, err)
+		assert.NoError(t, applyRowPolicy(plan, schema, "tenant == 1"))
+		binary := plan.GetQuery().GetPredicates().GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binary.GetOp())
+		assert.Equal(t, planpb.BinaryExpr_LogicalOr, binary.GetLeft().GetBinaryExpr().GetOp())
+	})
+
+	t.Run("always true", func(t *testing.T) {
+		plan, err := planparserv2.CreateRetrievePlan(schema, "")
+		assert.NoError(t, err)
+		assert.NoError(t, applyRowPolicy(plan, schema, "tenant == 1"))
+		assert.NotNil(t, plan.GetQuery().GetPredicates().GetUnaryRangeExpr())
+	})
+
+	t.Run("search", func(t *testing.T) {
+		plan := &planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{}}}
+		assert.NoError(t, applyRowPolicy(plan, schema, "tenant == 1"))
+		assert.NotNil(t, plan.GetVectorAnns().GetPredicates().GetUnaryRangeExpr())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		plan := &planpb.PlanNode{Node: &planpb.PlanNode_Predicates{Predicates: &planpb.Expr{
+			Expr: &planpb.Expr_AlwaysTrueExpr{AlwaysTrueExpr: &planpb.AlwaysTrueExpr{}},
+		}}}
+		assert.NoError(t, applyRowPolicy(plan, schema, "tenant == 1"))
+		assert.NotNil(t, plan.GetPredicates().GetUnaryRangeExpr())
+	})
+
+	t.Run("unsupported node", func(t *testing.T) {
+		assert.Error(t, applyRowPolicy(&planpb.PlanNode{}, schema, "tenant == 1"))
+	})
+}
+
+func TestValidateRowPolicy(t *testing.T) {
+	schema := rowPolicyTestSchema()
+	for _, expr := range []string{
+		"tenant == 1",
+		"tenant in [1, 2] and name like \"a%\"",
+		"not (tenant != 1) or 1 <= score < 2",
+		"meta[\"region\"] == \"eu\" and exists meta[\"level\"]",
+		"tenant < id",
+	} {
+		assert.NoError(t, validateRowPolicy(schema, expr), expr)
+	}
+	for _, expr := range []string{
+		"",
+		"  ",
+		"unknown == 1",
+		"tenant + 1 == 2",
+		"json_contains(meta[\"tags\"], \"a\")",
+	} {
+		assert.ErrorIs(t, validateRowPolicy(schema, expr), merr.ErrParameterInvalid, expr)
+	}
+}
+
+func TestCheckRowPolicyOnRows(t *testing.T) {
+	schema := rowPolicyTestSchema()
+	fieldsData := rowPolicyTestFieldsData()
+
+	cases := []struct {
+		expr    string
+		numRows int
+		ok      bool
+	}{
+		{"", 3, true},
+		{"tenant == 1", 2, true},
+		{"tenant == 1", 3, false},
+		{"tenant in [1, 2]", 3, true},
+		{"tenant not in [2]", 3, false},
+		{"name like \"a%\"", 1, true},
+		{"name like \"a%\"", 2, false},
+		{"name > \"a\" and name < \"c\"", 2, true},
+		{"0 < score <= 1.5", 2, true},
+		{"0 < score < 1.5", 2, false},
+		{"score < tenant", 1, true},
+		{"score < tenant", 2, false},
+		{"not (tenant == 2)", 2, true},
+		{"tenant == 2 or name == \"alice\"", 1, true},
+		{"meta[\"region\"] in [\"eu\", \"us\"]", 2, true},
+		{"meta[\"region\"] in [\"eu\", \"us\"]", 3, false},
+		{"meta[\"tags\"][0] == \"a\"", 1, true},
+		{"meta[\"tags\"][0] == \"a\"", 2, false},
+		{"meta[\"level\"] >= 1", 2, true},
+		// the value of an unexpected type never matches
+		{"meta[\"level\"] >= 1", 3, false},
+		{"meta[\"level\"] != 2", 3, true},
+		{"exists meta[\"level\"]", 3, true},
+		{"exists meta[\"region\"]", 3, false},
+	}
+	for _, c := range cases {
+		err := checkRowPolicyOnRows(schema, c.expr, fieldsData, c.numRows)
+		if c.ok {
+			assert.NoError(t, err, c.expr)
+		} else {
+			assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted, c.expr)
+		}
+	}
+
+	// the policy refers to a field not written
+	assert.ErrorIs(t, checkRowPolicyOnRows(schema, "id > 0", fieldsData, 1), merr.ErrParameterInvalid)
+	// less data than rows
+	assert.ErrorIs(t, checkRowPolicyOnRows(schema, "tenant > 0", fieldsData, 4), merr.ErrParameterInvalid)
+}
+
+func TestProxy_RowPolicy(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	rootCtx := GetContext(context.Background(), "root:123456")
+
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "col1").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "default", "col2").Return(0, merr.WrapErrCollectionNotFound("col2")).Maybe()
+	cache.EXPECT().GetCollectionSchema(mock.Anything, "default", "col1").Return(rowPolicyTestSchema(), nil).Maybe()
+
+	t.Run("grant", func(t *testing.T) {
+		rootCoord.EXPECT().GrantRowPolicy(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+				assert.Equal(t, "r1", req.GetPolicy().GetRoleName())
+				assert.Equal(t, "default", req.GetPolicy().GetDbName())
+				assert.Equal(t, int64(1), req.GetPolicy().GetCollectionID())
+				return merr.Success(), nil
+			}).Once()
+		status, err := node.GrantRowPolicy(rootCtx, &internalpb.GrantRowPolicyRequest{
+			Policy: &internalpb.RowPolicy{RoleName: "r1", CollectionName: "col1", Expr: "tenant == 1"},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+	})
+
+	t.Run("grant invalid", func(t *testing.T) {
+		status, err := node.GrantRowPolicy(rootCtx, &internalpb.GrantRowPolicyRequest{
+			Policy: &internalpb.RowPolicy{CollectionName: "col1", Expr: "tenant == 1"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		status, err = node.GrantRowPolicy(rootCtx, &internalpb.GrantRowPolicyRequest{
+			Policy: &internalpb.RowPolicy{RoleName: "r1", CollectionName: "col2", Expr: "tenant == 1"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrCollectionNotFound)
+
+		status, err = node.GrantRowPolicy(rootCtx, &internalpb.GrantRowPolicyRequest{
+			Policy: &internalpb.RowPolicy{RoleName: "r1", CollectionName: "col1", Expr: "unknown == 1"},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		rootCoord.EXPECT().RevokeRowPolicy(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock")), nil).Once()
+		status, err := node.RevokeRowPolicy(rootCtx, &internalpb.RevokeRowPolicyRequest{RoleName: "r1", CollectionName: "col1"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+
+		rootCoord.EXPECT().RevokeRowPolicy(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		status, err = node.RevokeRowPolicy(rootCtx, &internalpb.RevokeRowPolicyRequest{RoleName: "r1", CollectionName: "col1"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		rootCoord.EXPECT().ListRowPolicies(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+				assert.Equal(t, "r1", req.GetRoleName())
+				assert.Equal(t, int64(0), req.GetCollectionID())
+				return &internalpb.ListRowPoliciesResponse{
+					Status:   merr.Success(),
+					Policies: []*internalpb.RowPolicy{{RoleName: "r1", CollectionID: 1, Expr: "tenant == 1"}},
+				}, nil
+			}).Once()
+		resp, err := node.ListRowPolicies(rootCtx, &internalpb.ListRowPoliciesRequest{RoleName: "r1"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetPolicies(), 1)
+	})
+
+	t.Run("unhealthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		status, err := node.GrantRowPolicy(rootCtx, &internalpb.GrantRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+		status, err = node.RevokeRowPolicy(rootCtx, &internalpb.RevokeRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+		resp, err := node.ListRowPolicies(rootCtx, &internalpb.ListRowPoliciesRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+}
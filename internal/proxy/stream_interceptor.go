@@ -0,0 +1,145 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+// StreamRequestInterceptor returns a new stream server interceptor that applies the checks of the unary interceptors
+// to the request of the server-streaming calls: the database is filled, the privilege and the rate are checked
+// once the request is received.
+func StreamRequestInterceptor(limiter types.Limiter) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &requestCheckedStream{ServerStream: ss, limiter: limiter})
+	}
+}
+
+type requestCheckedStream struct {
+	grpc.ServerStream
+	limiter types.Limiter
+	ctx     context.Context
+}
+
+func (s *requestCheckedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *requestCheckedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	ctx, req := fillDatabase(s.ServerStream.Context(), m)
+	ctx, err := PrivilegeInterceptor(ctx, req)
+	if err != nil {
+		return err
+	}
+	if collectionID, rt, n, err := getRequestInfo(req); err == nil {
+		username, _ := GetCurUserFromContext(ctx)
+		if err := s.limiter.Check(username, collectionID, rt, n); err != nil {
+			return err
+		}
+	}
+	s.ctx = ctx
+	return nil
+}
+
+// errStreamIntercepted ends the streaming call whose request is answered by the interceptor directly.
+var errStreamIntercepted = errors.New("the stream is intercepted")
+
+// UnaryServerInterceptorToStream adapts the unary server interceptor to the server-streaming calls, whose clients
+// send a single request. The interceptor intercepts the request once the handler receives it, the context passed on
+// by the interceptor becomes the context of the stream, and the last message sent is taken as the response.
+func UnaryServerInterceptorToStream(interceptor grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		s := &unaryInterceptedStream{
+			ServerStream: ss,
+			interceptor:  interceptor,
+			info:         &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod},
+		}
+		return s.finish(handler(srv, s))
+	}
+}
+
+type unaryInterceptedStream struct {
+	grpc.ServerStream
+	interceptor grpc.UnaryServerInterceptor
+	info        *grpc.UnaryServerInfo
+	ctx         context.Context
+	lastResp    interface{}
+
+	received    bool
+	rejected    bool
+	handlerDone chan error
+	intercepted chan error
+}
+
+func (s *unaryInterceptedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *unaryInterceptedStream) SendMsg(m interface{}) error {
+	s.lastResp = m
+	return s.ServerStream.SendMsg(m)
+}
+
+// RecvMsg runs the interceptor in another goroutine once the request is received, which waits in the handler
+// passed to the interceptor until the streaming handler returns.
+func (s *unaryInterceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil || s.received {
+		return err
+	}
+	s.received = true
+	passed := make(chan context.Context, 1)
+	s.handlerDone = make(chan error, 1)
+	s.intercepted = make(chan error, 1)
+	go func() {
+		resp, err := s.interceptor(s.Context(), m, s.info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			passed <- ctx
+			err := <-s.handlerDone
+			return s.lastResp, err
+		})
+		if resp != nil && resp != s.lastResp {
+			// the request is answered by the interceptor, e.g. mocked by the hook
+			if sendErr := s.ServerStream.SendMsg(resp); sendErr != nil && err == nil {
+				err = sendErr
+			}
+		}
+		s.intercepted <- err
+	}()
+
+	select {
+	case ctx := <-passed:
+		s.ctx = ctx
+		return nil
+	case err := <-s.intercepted:
+		s.rejected = true
+		if err != nil {
+			return err
+		}
+		return errStreamIntercepted
+	}
+}
+
+func (s *unaryInterceptedStream) finish(err error) error {
+	if !s.received {
+		return err
+	}
+	if s.rejected {
+		if errors.Is(err, errStreamIntercepted) {
+			return nil
+		}
+		return err
+	}
+	s.handlerDone <- err
+	return <-s.intercepted
+}
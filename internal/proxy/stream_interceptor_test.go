@@ -0,0 +1,125 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockRecvServerStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  proto.Message
+	sent []interface{}
+}
+
+func (s *mockRecvServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *mockRecvServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockRecvServerStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestStreamRequestInterceptor(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+	mockCache := NewMockCache(t)
+	mockCache.On("GetCollectionID", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil).Maybe()
+	globalMetaCache = mockCache
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderDBName, "db1"))
+	serve := func(limiter *limiterMock) (*milvuspb.QueryRequest, error) {
+		interceptor := StreamRequestInterceptor(limiter)
+		ss := &mockRecvServerStream{ctx: ctx, req: &milvuspb.QueryRequest{CollectionName: "col1"}}
+		received := &milvuspb.QueryRequest{}
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+			return stream.RecvMsg(received)
+		})
+		return received, err
+	}
+
+	t.Run("fill database", func(t *testing.T) {
+		req, err := serve(&limiterMock{rate: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, "db1", req.GetDbName())
+		assert.Equal(t, "col1", req.GetCollectionName())
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		_, err := serve(&limiterMock{rate: 100, limit: true})
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	})
+}
+
+func TestUnaryServerInterceptorToStream(t *testing.T) {
+	type ctxKey struct{}
+	query := func(srv interface{}, stream grpc.ServerStream) error {
+		req := &milvuspb.QueryRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		if stream.Context().Value(ctxKey{}) == nil {
+			return errors.New("the context isn't passed on")
+		}
+		if err := stream.SendMsg(&milvuspb.QueryResults{CollectionName: req.GetCollectionName()}); err != nil {
+			return err
+		}
+		return stream.SendMsg(&milvuspb.QueryResults{Status: merr.Status(merr.ErrServiceInternal)})
+	}
+
+	t.Run("intercept the request and the last response", func(t *testing.T) {
+		var resp interface{}
+		var req interface{}
+		interceptor := func(ctx context.Context, r interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			req = r
+			var err error
+			resp, err = handler(context.WithValue(ctx, ctxKey{}, info.FullMethod), r)
+			return resp, err
+		}
+		ss := &mockRecvServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{CollectionName: "col1"}}
+		err := UnaryServerInterceptorToStream(interceptor)(nil, ss, &grpc.StreamServerInfo{FullMethod: "/QueryStream"}, query)
+		assert.NoError(t, err)
+		assert.Equal(t, "col1", req.(*milvuspb.QueryRequest).GetCollectionName())
+		assert.Len(t, ss.sent, 2)
+		assert.Same(t, ss.sent[1], resp)
+	})
+
+	t.Run("rejected", func(t *testing.T) {
+		interceptor := func(ctx context.Context, r interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return nil, merr.ErrPrivilegeNotPermitted
+		}
+		ss := &mockRecvServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{}}
+		err := UnaryServerInterceptorToStream(interceptor)(nil, ss, &grpc.StreamServerInfo{}, query)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+		assert.Empty(t, ss.sent)
+	})
+
+	t.Run("answered by the interceptor", func(t *testing.T) {
+		mocked := &milvuspb.QueryResults{CollectionName: "mocked"}
+		interceptor := func(ctx context.Context, r interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return mocked, nil
+		}
+		ss := &mockRecvServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{}}
+		err := UnaryServerInterceptorToStream(interceptor)(nil, ss, &grpc.StreamServerInfo{}, query)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{mocked}, ss.sent)
+	})
+}
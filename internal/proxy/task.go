@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -72,7 +73,15 @@ const (
 	CreateAliasTaskName           = "CreateAliasTask"
 	DropAliasTaskName             = "DropAliasTask"
 	AlterAliasTaskName            = "AlterAliasTask"
+	BatchAlterAliasesTaskName     = "BatchAlterAliasesTask"
 	AlterCollectionTaskName       = "AlterCollectionTask"
+	AddCollectionFieldTaskName    = "AddCollectionFieldTask"
+	CloneCollectionTaskName       = "CloneCollectionTask"
+	UndropCollectionTaskName      = "UndropCollectionTask"
+	UndropPartitionTaskName       = "UndropPartitionTask"
+	CreateSnapshotTaskName        = "CreateSnapshotTask"
+	DropSnapshotTaskName          = "DropSnapshotTask"
+	RestoreSnapshotTaskName       = "RestoreSnapshotTask"
 	UpsertTaskName                = "UpsertTask"
 	CreateResourceGroupTaskName   = "CreateResourceGroupTask"
 	DropResourceGroupTaskName     = "DropResourceGroupTask"
@@ -84,6 +93,7 @@ const (
 	CreateDatabaseTaskName = "CreateCollectionTask"
 	DropDatabaseTaskName   = "DropDatabaseTaskName"
 	ListDatabaseTaskName   = "ListDatabaseTaskName"
+	AlterDatabaseTaskName  = "AlterDatabaseTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -210,6 +220,7 @@ func (t *createCollectionTask) validatePartitionKey() error {
 func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_CreateCollection
 	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
 
 	t.schema = &schemapb.CollectionSchema{}
 	err := proto.Unmarshal(t.Schema, t.schema)
@@ -365,6 +376,7 @@ func (t *dropCollectionTask) OnEnqueue() error {
 func (t *dropCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_DropCollection
 	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
 
 	if err := validateCollectionName(t.CollectionName); err != nil {
 		return err
@@ -582,6 +594,17 @@ func (t *describeCollectionTask) Execute(ctx context.Context) error {
 				})
 			}
 		}
+
+		unreadableFields, err := getUnreadableFields(ctx, result.GetDbName(), result.GetSchema().GetName())
+		if err != nil {
+			return err
+		}
+		if len(unreadableFields) != 0 {
+			t.result.Properties = append(append([]*commonpb.KeyValuePair{}, result.Properties...), &commonpb.KeyValuePair{
+				Key:   common.ReadableFieldsKey,
+				Value: readableFieldsProperty(t.result.Schema.Fields, unreadableFields),
+			})
+		}
 	}
 	return nil
 }
@@ -799,7 +822,11 @@ func (t *alterCollectionTask) OnEnqueue() error {
 func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_AlterCollection
 	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
 
+	if _, err := common.GetPKUniquenessPolicy(t.GetProperties()...); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
 	return nil
 }
 
@@ -813,6 +840,523 @@ func (t *alterCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type addCollectionFieldTask struct {
+	Condition
+	*internalpb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *addCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *addCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *addCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (t *addCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *addCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *addCollectionFieldTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
+
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	if t.GetField() == nil {
+		return merr.WrapErrParameterInvalidMsg("the field to add is empty")
+	}
+	if err := validateFieldName(t.GetField().GetName()); err != nil {
+		return err
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	t.CollectionID = collectionID
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetDbName(), t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	userFieldNum := 0
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID && !field.GetIsDynamic() {
+			userFieldNum++
+		}
+	}
+	if userFieldNum+1 > Params.ProxyCfg.MaxFieldNum.GetAsInt() {
+		return merr.WrapErrParameterInvalidMsg("maximum field's number should be limited to %d", Params.ProxyCfg.MaxFieldNum.GetAsInt())
+	}
+	return nil
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AddCollectionField(ctx, t.AddCollectionFieldRequest)
+	return err
+}
+
+func (t *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type cloneCollectionTask struct {
+	Condition
+	*internalpb.CloneCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *cloneCollectionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *cloneCollectionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *cloneCollectionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *cloneCollectionTask) Name() string {
+	return CloneCollectionTaskName
+}
+
+func (t *cloneCollectionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *cloneCollectionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *cloneCollectionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *cloneCollectionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *cloneCollectionTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_CreateCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	if err := validateCollectionName(t.GetNewCollectionName()); err != nil {
+		return err
+	}
+	if _, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *cloneCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.CloneCollection(ctx, t.CloneCollectionRequest)
+	return err
+}
+
+func (t *cloneCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type undropCollectionTask struct {
+	Condition
+	*internalpb.UndropCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *undropCollectionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *undropCollectionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *undropCollectionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *undropCollectionTask) Name() string {
+	return UndropCollectionTaskName
+}
+
+func (t *undropCollectionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *undropCollectionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *undropCollectionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *undropCollectionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *undropCollectionTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *undropCollectionTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_CreateCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	// the dropped collection isn't in the meta cache, rootcoord checks whether it's in the recycle bin.
+	if t.GetCollectionName() == "" {
+		if t.GetCollectionID() == 0 {
+			return merr.WrapErrParameterInvalidMsg("neither collection name nor collection id is specified")
+		}
+		return nil
+	}
+	return validateCollectionName(t.GetCollectionName())
+}
+
+func (t *undropCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.UndropCollection(ctx, t.UndropCollectionRequest)
+	return err
+}
+
+func (t *undropCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type undropPartitionTask struct {
+	Condition
+	*internalpb.UndropPartitionRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *undropPartitionTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *undropPartitionTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *undropPartitionTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *undropPartitionTask) Name() string {
+	return UndropPartitionTaskName
+}
+
+func (t *undropPartitionTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *undropPartitionTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *undropPartitionTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *undropPartitionTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *undropPartitionTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *undropPartitionTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_CreatePartition
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	if err := validatePartitionTag(t.GetPartitionName(), true); err != nil {
+		return err
+	}
+	if _, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *undropPartitionTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.UndropPartition(ctx, t.UndropPartitionRequest)
+	return err
+}
+
+func (t *undropPartitionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type createSnapshotTask struct {
+	Condition
+	*internalpb.CreateSnapshotRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *createSnapshotTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *createSnapshotTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *createSnapshotTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *createSnapshotTask) Name() string {
+	return CreateSnapshotTaskName
+}
+
+func (t *createSnapshotTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *createSnapshotTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *createSnapshotTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *createSnapshotTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *createSnapshotTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *createSnapshotTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_CreateCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("snapshot name is empty")
+	}
+	if err := validateCollectionName(t.GetCollectionName()); err != nil {
+		return err
+	}
+	if _, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.GetCollectionName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (t *createSnapshotTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.CreateSnapshot(ctx, t.CreateSnapshotRequest)
+	return err
+}
+
+func (t *createSnapshotTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type dropSnapshotTask struct {
+	Condition
+	*internalpb.DropSnapshotRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *dropSnapshotTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *dropSnapshotTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *dropSnapshotTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *dropSnapshotTask) Name() string {
+	return DropSnapshotTaskName
+}
+
+func (t *dropSnapshotTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *dropSnapshotTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropSnapshotTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *dropSnapshotTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *dropSnapshotTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *dropSnapshotTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_DropCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("snapshot name is empty")
+	}
+	return nil
+}
+
+func (t *dropSnapshotTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.DropSnapshot(ctx, t.DropSnapshotRequest)
+	return err
+}
+
+func (t *dropSnapshotTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type restoreSnapshotTask struct {
+	Condition
+	*internalpb.RestoreSnapshotRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *restoreSnapshotTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *restoreSnapshotTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *restoreSnapshotTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *restoreSnapshotTask) Name() string {
+	return RestoreSnapshotTaskName
+}
+
+func (t *restoreSnapshotTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *restoreSnapshotTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *restoreSnapshotTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *restoreSnapshotTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *restoreSnapshotTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *restoreSnapshotTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_CreateCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("snapshot name is empty")
+	}
+	return validateCollectionName(t.GetNewCollectionName())
+}
+
+func (t *restoreSnapshotTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.RestoreSnapshot(ctx, t.RestoreSnapshotRequest)
+	return err
+}
+
+func (t *restoreSnapshotTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
@@ -863,6 +1407,7 @@ func (t *createPartitionTask) OnEnqueue() error {
 func (t *createPartitionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_CreatePartition
 	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
 
 	collName, partitionTag := t.CollectionName, t.PartitionName
 
@@ -951,6 +1496,7 @@ func (t *dropPartitionTask) OnEnqueue() error {
 func (t *dropPartitionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_DropPartition
 	t.Base.SourceID = paramtable.GetNodeID()
+	setAsyncDDL(ctx, t.Base)
 
 	collName, partitionTag := t.CollectionName, t.PartitionName
 
@@ -1416,11 +1962,6 @@ func (t *loadCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	// To compat with LoadCollcetion before Milvus@2.1
-	if t.ReplicaNumber == 0 {
-		t.ReplicaNumber = 1
-	}
-
 	return nil
 }
 
@@ -2090,6 +2631,87 @@ func (t *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+// batchAlterAliasesTask swaps several aliases at once, rootcoord checks the collections the aliases switch to
+// are fully loaded, so that the searches through the aliases are never served by an unloaded collection.
+type batchAlterAliasesTask struct {
+	Condition
+	*internalpb.BatchAlterAliasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (t *batchAlterAliasesTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *batchAlterAliasesTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *batchAlterAliasesTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *batchAlterAliasesTask) Name() string {
+	return BatchAlterAliasesTaskName
+}
+
+func (t *batchAlterAliasesTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *batchAlterAliasesTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *batchAlterAliasesTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *batchAlterAliasesTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *batchAlterAliasesTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *batchAlterAliasesTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterAlias
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if len(t.GetOperations()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no alias operation")
+	}
+	for _, op := range t.GetOperations() {
+		// collection alias uses the same format as collection name
+		if err := ValidateCollectionAlias(op.GetAlias()); err != nil {
+			return err
+		}
+		if op.GetType() == internalpb.AliasOperationType_AliasOperationDrop {
+			continue
+		}
+		if err := validateCollectionName(op.GetCollectionName()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *batchAlterAliasesTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.BatchAlterAliases(ctx, t.BatchAlterAliasesRequest)
+	return err
+}
+
+func (t *batchAlterAliasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type CreateResourceGroupTask struct {
 	Condition
 	*milvuspb.CreateResourceGroupRequest
@@ -5,6 +5,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
@@ -209,3 +210,74 @@ func (ldt *listDatabaseTask) Execute(ctx context.Context) error {
 func (ldt *listDatabaseTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type alterDatabaseTask struct {
+	Condition
+	*internalpb.AlterDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (adt *alterDatabaseTask) TraceCtx() context.Context {
+	return adt.ctx
+}
+
+func (adt *alterDatabaseTask) ID() UniqueID {
+	return adt.Base.MsgID
+}
+
+func (adt *alterDatabaseTask) SetID(uid UniqueID) {
+	adt.Base.MsgID = uid
+}
+
+func (adt *alterDatabaseTask) Name() string {
+	return AlterDatabaseTaskName
+}
+
+func (adt *alterDatabaseTask) Type() commonpb.MsgType {
+	return adt.Base.MsgType
+}
+
+func (adt *alterDatabaseTask) BeginTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *alterDatabaseTask) EndTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *alterDatabaseTask) SetTs(ts Timestamp) {
+	adt.Base.Timestamp = ts
+}
+
+func (adt *alterDatabaseTask) OnEnqueue() error {
+	if adt.Base == nil {
+		adt.Base = commonpbutil.NewMsgBase()
+	}
+	adt.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (adt *alterDatabaseTask) PreExecute(ctx context.Context) error {
+	if err := ValidateDatabaseName(adt.GetDbName()); err != nil {
+		return err
+	}
+	if adt.GetNewDbName() != "" {
+		return ValidateDatabaseName(adt.GetNewDbName())
+	}
+	return nil
+}
+
+func (adt *alterDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	adt.result, err = adt.rootCoord.AlterDatabase(ctx, adt.AlterDatabaseRequest)
+	if adt.result != nil && adt.result.ErrorCode == commonpb.ErrorCode_Success {
+		globalMetaCache.RemoveDatabase(ctx, adt.DbName)
+	}
+	return err
+}
+
+func (adt *alterDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -113,6 +114,65 @@ func TestDropDatabaseTask(t *testing.T) {
 	})
 }
 
+func TestAlterDatabaseTask(t *testing.T) {
+	paramtable.Init()
+	rc := NewRootCoordMock()
+	defer rc.Close()
+
+	ctx := context.Background()
+	task := &alterDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		AlterDatabaseRequest: &internalpb.AlterDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_DropDatabase,
+				MsgID:     100,
+				Timestamp: 100,
+			},
+			DbName:    "db",
+			NewDbName: "db2",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+
+	cache := NewMockCache(t)
+	cache.On("RemoveDatabase",
+		mock.Anything, // context.Context
+		"db",
+	).Once()
+	globalMetaCache = cache
+
+	t.Run("ok", func(t *testing.T) {
+		err := task.PreExecute(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, AlterDatabaseTaskName, task.Name())
+		assert.Equal(t, UniqueID(100), task.ID())
+		assert.Equal(t, Timestamp(100), task.BeginTs())
+		assert.Equal(t, Timestamp(100), task.EndTs())
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+
+		task.Base = nil
+		err = task.OnEnqueue()
+		assert.NoError(t, err)
+		assert.Equal(t, paramtable.GetNodeID(), task.GetBase().GetSourceID())
+		assert.Equal(t, UniqueID(0), task.ID())
+	})
+
+	t.Run("pre execute fail", func(t *testing.T) {
+		task.NewDbName = "#0xc0de"
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+
+		task.DbName = "#0xc0de"
+		task.NewDbName = "db2"
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+	})
+}
+
 func TestListDatabaseTask(t *testing.T) {
 	paramtable.Init()
 	rc := NewRootCoordMock()
@@ -57,6 +57,7 @@ type deleteTask struct {
 	partitionID      UniqueID
 	count            int
 	partitionKeyMode bool
+	rowPolicy        string
 }
 
 func (dt *deleteTask) TraceCtx() context.Context {
@@ -205,6 +206,26 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 	}
 	dt.schema = schema
 
+	// the delete expr can't filter by the unreadable fields, the count of the deleted rows reveals the values of them
+	unreadableFields, err := getUnreadableFields(ctx, dt.req.GetDbName(), schema.GetName())
+	if err != nil {
+		return ErrWithLog(log, "Failed to get unreadable fields", err)
+	}
+	if len(unreadableFields) != 0 && len(dt.req.GetExpr()) != 0 {
+		plan, err := planparserv2.CreateRetrievePlan(dt.schema, dt.req.GetExpr())
+		if err != nil {
+			return ErrWithLog(log, "Failed to create expr plan", err)
+		}
+		if err := checkExprFieldsReadable(dt.schema, plan.GetQuery().GetPredicates(), unreadableFields); err != nil {
+			return err
+		}
+	}
+
+	dt.rowPolicy, err = getRowPolicyExpr(ctx, dt.collectionID)
+	if err != nil {
+		return ErrWithLog(log, "Failed to get row policy", err)
+	}
+
 	// hash primary keys to channels
 	channelNames, err := dt.chMgr.getVChannels(dt.collectionID)
 	if err != nil {
@@ -236,6 +257,11 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to create expr plan, expr = %s", dt.req.GetExpr())
 	}
+	// the rows out of the row policy are never deleted,
+	// the deletion by primary keys turns into a complex one if the policy applies.
+	if err := applyRowPolicy(plan, dt.schema, dt.rowPolicy); err != nil {
+		return err
+	}
 
 	isSimple, termExp := getExpr(plan)
 	if isSimple {
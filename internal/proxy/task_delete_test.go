@@ -22,6 +22,8 @@ import (
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -237,6 +239,28 @@ func TestDeleteTask_PreExecute(t *testing.T) {
 		).Return(int64(100001), nil)
 		assert.Error(t, dt.PreExecute(context.Background()))
 	})
+
+	t.Run("unreadable field in expr", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		cache := NewMockCache(t)
+		cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(int64(10000), nil)
+		cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(schema, nil)
+		cache.EXPECT().GetPrivilegeInfo(mock.Anything).Return([]string{
+			funcutil.PolicyForPrivilege("r1", util.FieldObjectType, "test_delete.non_pk", util.PrivilegeReadField, "db_1"),
+		})
+		cache.EXPECT().GetUserRole("alice").Return(nil)
+		globalMetaCache = cache
+
+		ctx := GetContext(context.Background(), "alice:123456")
+		dt := deleteTask{req: &milvuspb.DeleteRequest{
+			CollectionName: "foo",
+			DbName:         "db_1",
+			Expr:           "non_pk in [1, 2, 3]",
+		}}
+		assert.ErrorIs(t, dt.PreExecute(ctx), merr.ErrPrivilegeNotPermitted)
+	})
 }
 
 func TestDeleteTask_Execute(t *testing.T) {
@@ -12,6 +12,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -36,6 +38,8 @@ type insertTask struct {
 	pChannels     []pChan
 	schema        *schemapb.CollectionSchema
 	partitionKeys *schemapb.FieldData
+	qc            types.QueryCoordClient
+	lb            LBPolicy
 }
 
 // TraceCtx returns insertTask context
@@ -171,6 +175,15 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := it.checkPKUniqueness(ctx); err != nil {
+		log.Warn("check primary key uniqueness failed", zap.Error(err))
+		return err
+	}
+	if it.insertMsg.NRows() == 0 {
+		log.Info("all rows are skipped because of existing primary keys")
+		return nil
+	}
+
 	partitionKeyMode, err := isPartitionKeyMode(ctx, it.insertMsg.GetDbName(), collectionName)
 	if err != nil {
 		log.Warn("check partition key mode failed", zap.String("collectionName", collectionName), zap.Error(err))
@@ -203,15 +216,157 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	_, rowPolicy, err := getCollectionRowPolicy(ctx, it.insertMsg.GetDbName(), collectionName)
+	if err != nil {
+		log.Warn("get row policy failed", zap.Error(err))
+		return err
+	}
+	if err := checkRowPolicyOnRows(schema, rowPolicy, it.insertMsg.GetFieldsData(), int(it.insertMsg.NRows())); err != nil {
+		log.Warn("check row policy failed", zap.Error(err))
+		return err
+	}
+
 	log.Debug("Proxy Insert PreExecute done")
 
 	return nil
 }
 
+// checkPKUniqueness finds the rows whose primary keys exist already, including the ones
+// duplicated inside the request, and handles them per the uniqueness policy of the collection.
+// The check is best-effort: it reads the data visible right before this insert, so concurrent
+// inserts of the same primary keys through other proxies or tasks may still land duplicates.
+// The existing primary keys are only readable from the loaded collections, rows inserted into
+// an unloaded collection are checked against the other rows of the same request only.
+func (it *insertTask) checkPKUniqueness(ctx context.Context) error {
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(it.schema)
+	if err != nil {
+		return err
+	}
+	// auto generated primary keys are unique
+	if primaryFieldSchema.GetAutoID() {
+		return nil
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, it.insertMsg.GetDbName(), it.insertMsg.GetCollectionName(), 0)
+	if err != nil {
+		return err
+	}
+	policy, err := common.GetPKUniquenessPolicy(collectionInfo.properties...)
+	if err != nil {
+		return err
+	}
+	if policy == "" {
+		return nil
+	}
+
+	existPKs := typeutil.NewSet[interface{}]()
+	loaded, err := isCollectionLoaded(ctx, it.qc, collectionInfo.collID)
+	if err != nil {
+		return err
+	}
+	if !loaded {
+		log.Ctx(ctx).RatedInfo(60, "collection not loaded, only check the primary keys inside the request",
+			zap.String("collectionName", it.insertMsg.GetCollectionName()),
+			zap.String("policy", policy))
+		return it.applyPKUniqueness(policy, existPKs)
+	}
+
+	// read at the timestamp right before this insert, all the prior mutations are visible then
+	existing, err := retrieveByPKs(ctx, it.qc, it.lb, &milvuspb.QueryRequest{
+		DbName:         it.insertMsg.GetDbName(),
+		CollectionName: it.insertMsg.GetCollectionName(),
+		OutputFields:   []string{primaryFieldSchema.GetName()},
+	}, it.result.GetIDs(), it.BeginTs()-1)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range existing.GetFieldsData() {
+		if fieldData.GetFieldName() != primaryFieldSchema.GetName() {
+			continue
+		}
+		ids, err := parsePrimaryFieldData2IDs(fieldData)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(ids); i++ {
+			existPKs.Insert(typeutil.GetPK(ids, int64(i)))
+		}
+	}
+	return it.applyPKUniqueness(policy, existPKs)
+}
+
+// applyPKUniqueness rejects or skips the rows whose primary keys are in existPKs or duplicated
+// inside the request. Under the ignore policy a request whose rows are all duplicated succeeds
+// with nothing inserted.
+func (it *insertTask) applyPKUniqueness(policy string, existPKs typeutil.Set[interface{}]) error {
+	duplicated := make([]uint32, 0)
+	duplicatedPKs := make([]interface{}, 0)
+	for i := 0; i < typeutil.GetSizeOfIDs(it.result.GetIDs()); i++ {
+		pk := typeutil.GetPK(it.result.GetIDs(), int64(i))
+		if existPKs.Contain(pk) {
+			duplicated = append(duplicated, uint32(i))
+			duplicatedPKs = append(duplicatedPKs, pk)
+			continue
+		}
+		// the latter rows with the same primary key in one request are duplicated as well
+		existPKs.Insert(pk)
+	}
+	if len(duplicated) == 0 {
+		return nil
+	}
+	metrics.ProxyDuplicatedPrimaryKeyRows.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), policy).Add(float64(len(duplicated)))
+
+	if policy == common.PKUniquenessReject {
+		return merr.WrapErrParameterInvalidMsg("primary keys %v exist already, collection %s doesn't allow duplicated primary keys",
+			duplicatedPKs, it.insertMsg.GetCollectionName())
+	}
+	return it.skipRows(duplicated)
+}
+
+// skipRows removes the rows at the given offsets from the insert message,
+// the skipped rows are reported by ErrIndex of the result.
+func (it *insertTask) skipRows(offsets []uint32) error {
+	skipped := typeutil.NewSet(offsets...)
+	numRows := it.insertMsg.NRows()
+	fieldsData := make([]*schemapb.FieldData, len(it.insertMsg.GetFieldsData()))
+	ids := &schemapb.IDs{}
+	rowIDs := make([]UniqueID, 0, int(numRows)-len(offsets))
+	timestamps := make([]uint64, 0, int(numRows)-len(offsets))
+	hashValues := make([]uint32, 0, len(it.insertMsg.HashValues))
+	succIndex := make([]uint32, 0, int(numRows)-len(offsets))
+	for i := uint64(0); i < numRows; i++ {
+		if skipped.Contain(uint32(i)) {
+			continue
+		}
+		typeutil.AppendFieldData(fieldsData, it.insertMsg.GetFieldsData(), int64(i))
+		typeutil.AppendIDs(ids, it.result.GetIDs(), int(i))
+		rowIDs = append(rowIDs, it.insertMsg.RowIDs[i])
+		timestamps = append(timestamps, it.insertMsg.Timestamps[i])
+		if len(it.insertMsg.HashValues) == int(numRows) {
+			hashValues = append(hashValues, it.insertMsg.HashValues[i])
+		}
+		succIndex = append(succIndex, uint32(i))
+	}
+
+	it.insertMsg.FieldsData = fieldsData
+	it.insertMsg.NumRows = uint64(len(succIndex))
+	it.insertMsg.RowIDs = rowIDs
+	it.insertMsg.Timestamps = timestamps
+	it.insertMsg.HashValues = hashValues
+	it.result.IDs = ids
+	it.result.SuccIndex = succIndex
+	it.result.ErrIndex = offsets
+	return nil
+}
+
 func (it *insertTask) Execute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Insert-Execute")
 	defer sp.End()
 
+	// nothing left to insert, all the rows were skipped by the primary key uniqueness check
+	if it.insertMsg.NRows() == 0 {
+		return nil
+	}
+
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute insert %d", it.ID()))
 
 	collectionName := it.insertMsg.CollectionName
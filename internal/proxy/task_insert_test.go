@@ -8,9 +8,15 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func TestInsertTask_CheckAligned(t *testing.T) {
@@ -253,3 +259,248 @@ func TestInsertTask(t *testing.T) {
 		assert.ElementsMatch(t, channels, it.pChannels)
 	})
 }
+
+func TestInsertTask_SkipRows(t *testing.T) {
+	it := insertTask{
+		insertMsg: &BaseInsertTask{
+			InsertRequest: msgpb.InsertRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Insert,
+				},
+				Version:    msgpb.InsertDataVersion_ColumnBased,
+				NumRows:    3,
+				RowIDs:     []int64{10, 11, 12},
+				Timestamps: []uint64{100, 100, 100},
+				FieldsData: []*schemapb.FieldData{
+					{
+						FieldName: "pk",
+						FieldId:   100,
+						Type:      schemapb.DataType_Int64,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{
+									LongData: &schemapb.LongArray{Data: []int64{1, 2, 1}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 1}}},
+			},
+		},
+	}
+
+	err := it.skipRows([]uint32{2})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), it.insertMsg.NRows())
+	assert.Equal(t, []int64{10, 11}, it.insertMsg.RowIDs)
+	assert.Equal(t, []uint64{100, 100}, it.insertMsg.Timestamps)
+	assert.Equal(t, []int64{1, 2}, it.insertMsg.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, int64(100), it.insertMsg.GetFieldsData()[0].GetFieldId())
+	assert.Equal(t, []int64{1, 2}, it.result.GetIDs().GetIntId().GetData())
+	assert.Equal(t, []uint32{0, 1}, it.result.GetSuccIndex())
+	assert.Equal(t, []uint32{2}, it.result.GetErrIndex())
+	assert.NoError(t, it.insertMsg.CheckAligned())
+}
+
+func newPKUniquenessTask(pks []int64) *insertTask {
+	rowIDs := make([]int64, len(pks))
+	timestamps := make([]uint64, len(pks))
+	succIndex := make([]uint32, len(pks))
+	for i := range pks {
+		rowIDs[i] = int64(10 + i)
+		timestamps[i] = 100
+		succIndex[i] = uint32(i)
+	}
+	return &insertTask{
+		insertMsg: &BaseInsertTask{
+			InsertRequest: msgpb.InsertRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Insert,
+				},
+				CollectionName: "test_collection",
+				Version:        msgpb.InsertDataVersion_ColumnBased,
+				NumRows:        uint64(len(pks)),
+				RowIDs:         rowIDs,
+				Timestamps:     timestamps,
+				FieldsData: []*schemapb.FieldData{
+					{
+						FieldName: "pk",
+						FieldId:   100,
+						Type:      schemapb.DataType_Int64,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_LongData{
+									LongData: &schemapb.LongArray{Data: pks},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		result: &milvuspb.MutationResult{
+			IDs: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}},
+			},
+			SuccIndex: succIndex,
+		},
+	}
+}
+
+func TestInsertTask_ApplyPKUniqueness(t *testing.T) {
+	cases := []struct {
+		name      string
+		policy    string
+		pks       []int64
+		existPKs  []interface{}
+		expectErr bool
+		expectPKs []int64
+		errIndex  []uint32
+	}{
+		{
+			name:      "reject no duplicates",
+			policy:    common.PKUniquenessReject,
+			pks:       []int64{1, 2, 3},
+			existPKs:  []interface{}{int64(4)},
+			expectPKs: []int64{1, 2, 3},
+		},
+		{
+			name:      "reject existing",
+			policy:    common.PKUniquenessReject,
+			pks:       []int64{1, 2, 3},
+			existPKs:  []interface{}{int64(2)},
+			expectErr: true,
+		},
+		{
+			name:      "reject duplicated in request",
+			policy:    common.PKUniquenessReject,
+			pks:       []int64{1, 2, 1},
+			expectErr: true,
+		},
+		{
+			name:      "ignore existing",
+			policy:    common.PKUniquenessIgnore,
+			pks:       []int64{1, 2, 3},
+			existPKs:  []interface{}{int64(2)},
+			expectPKs: []int64{1, 3},
+			errIndex:  []uint32{1},
+		},
+		{
+			name:      "ignore duplicated in request",
+			policy:    common.PKUniquenessIgnore,
+			pks:       []int64{1, 2, 1},
+			expectPKs: []int64{1, 2},
+			errIndex:  []uint32{2},
+		},
+		{
+			name:      "ignore all duplicated",
+			policy:    common.PKUniquenessIgnore,
+			pks:       []int64{1, 2},
+			existPKs:  []interface{}{int64(1), int64(2)},
+			expectPKs: nil,
+			errIndex:  []uint32{0, 1},
+		},
+		{
+			name:      "reject all duplicated",
+			policy:    common.PKUniquenessReject,
+			pks:       []int64{1, 2},
+			existPKs:  []interface{}{int64(1), int64(2)},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			it := newPKUniquenessTask(c.pks)
+			err := it.applyPKUniqueness(c.policy, typeutil.NewSet(c.existPKs...))
+			if c.expectErr {
+				assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, uint64(len(c.expectPKs)), it.insertMsg.NRows())
+			assert.Equal(t, len(c.expectPKs), typeutil.GetSizeOfIDs(it.result.GetIDs()))
+			if len(c.expectPKs) > 0 {
+				assert.Equal(t, c.expectPKs, it.result.GetIDs().GetIntId().GetData())
+				assert.Equal(t, c.expectPKs, it.insertMsg.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+				assert.NoError(t, it.insertMsg.CheckAligned())
+			}
+			if len(c.errIndex) > 0 {
+				assert.Equal(t, c.errIndex, it.result.GetErrIndex())
+			}
+		})
+	}
+}
+
+func TestInsertTask_CheckPKUniquenessNotLoaded(t *testing.T) {
+	cache := NewMockCache(t)
+	globalMetaCache = cache
+	defer func() { globalMetaCache = nil }()
+	cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&collectionBasicInfo{
+		collID:     1,
+		properties: []*commonpb.KeyValuePair{{Key: common.CollectionPKUniquenessKey, Value: common.PKUniquenessReject}},
+	}, nil)
+
+	// no collection is loaded, the existing primary keys are not queried
+	qc := mocks.NewMockQueryCoordClient(t)
+	qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+		Status:        merr.Success(),
+		CollectionIDs: []int64{2},
+	}, nil)
+
+	newTask := func(pks []int64) *insertTask {
+		it := newPKUniquenessTask(pks)
+		it.qc = qc
+		it.schema = &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		}
+		return it
+	}
+
+	t.Run("no duplicates", func(t *testing.T) {
+		it := newTask([]int64{1, 2, 3})
+		assert.NoError(t, it.checkPKUniqueness(context.Background()))
+		assert.Equal(t, uint64(3), it.insertMsg.NRows())
+	})
+
+	t.Run("duplicated in request", func(t *testing.T) {
+		it := newTask([]int64{1, 2, 1})
+		err := it.checkPKUniqueness(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("show collections failed", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(nil, merr.WrapErrServiceNotReady("querycoord", 0, "initializing"))
+		it := newTask([]int64{1, 2, 3})
+		it.qc = qc
+		assert.Error(t, it.checkPKUniqueness(context.Background()))
+	})
+}
+
+func TestInsertTask_ExecuteNoRows(t *testing.T) {
+	it := insertTask{
+		ctx: context.Background(),
+		insertMsg: &BaseInsertTask{
+			InsertRequest: msgpb.InsertRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_Insert,
+				},
+				CollectionName: "test_collection",
+				NumRows:        0,
+			},
+		},
+		result: &milvuspb.MutationResult{
+			Status: merr.Success(),
+		},
+	}
+	// all the rows were skipped, nothing is sent to the dml channels
+	assert.NoError(t, it.Execute(context.Background()))
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -55,12 +57,25 @@ type queryTask struct {
 	schema         *schemapb.CollectionSchema
 
 	userOutputFields []string
+	// unreadableFields are the restricted fields the user isn't permitted to read
+	unreadableFields typeutil.Set[string]
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.RetrieveResults]
+	// serviceableTs is the timestamp the result reflects all the mutations before
+	serviceableTs Timestamp
 
 	plan             *planpb.PlanNode
 	partitionKeyMode bool
 	lb               LBPolicy
+
+	// streamConsumer is set when the results are retrieved by the streaming query of querynodes,
+	// the batches are passed to it as they arrive instead of being reduced in PostExecute.
+	streamConsumer queryStreamConsumer
+}
+
+// queryStreamConsumer consumes the batches of the streaming query, it's called by the shards concurrently.
+type queryStreamConsumer interface {
+	consume(result *internalpb.RetrieveResults) error
 }
 
 type queryParams struct {
@@ -223,6 +238,8 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.request.OutputFields = removeUnreadableFields(t.request.OutputFields, t.unreadableFields)
+	t.userOutputFields = removeUnreadableFields(t.userOutputFields, t.unreadableFields)
 
 	outputFieldIDs, err := translateToOutputFieldIDs(t.request.GetOutputFields(), schema)
 	if err != nil {
@@ -306,6 +323,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.collectionName)
 	t.schema = schema
 
+	// the row policy applies to the requests of the users, the internal lookups and
+	// the requeries of the searches, which are limited already, bypass it.
+	applyPolicy := t.plan == nil && t.ids == nil
 	if t.ids != nil {
 		pkField := ""
 		for _, field := range schema.Fields {
@@ -313,7 +333,23 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 				pkField = field.Name
 			}
 		}
-		t.request.Expr = IDs2Expr(pkField, t.ids)
+		// the internal lookups may narrow the rows further by the expression
+		if t.request.GetExpr() != "" {
+			t.request.Expr = IDs2Expr(pkField, t.ids) + " and (" + t.request.GetExpr() + ")"
+		} else {
+			t.request.Expr = IDs2Expr(pkField, t.ids)
+		}
+	}
+
+	if applyPolicy {
+		t.unreadableFields, err = getUnreadableFields(ctx, t.request.GetDbName(), schema.GetName())
+		if err != nil {
+			log.Warn("failed to get unreadable fields", zap.Error(err))
+			return err
+		}
+		if err := checkOutputFieldsReadable(schema, t.request.GetOutputFields(), t.unreadableFields); err != nil {
+			return err
+		}
 	}
 
 	if err := t.createPlan(ctx); err != nil {
@@ -321,10 +357,25 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited && t.streamConsumer == nil {
 		return fmt.Errorf("empty expression should be used with limit")
 	}
 
+	if applyPolicy {
+		// the row policy may filter by the unreadable fields, so the fields are checked before applying it
+		if err := checkExprFieldsReadable(schema, t.plan.GetQuery().GetPredicates(), t.unreadableFields); err != nil {
+			return err
+		}
+		rowPolicy, err := getRowPolicyExpr(ctx, t.CollectionID)
+		if err != nil {
+			log.Warn("failed to get row policy", zap.Error(err))
+			return err
+		}
+		if err := applyRowPolicy(t.plan, schema, rowPolicy); err != nil {
+			return err
+		}
+	}
+
 	partitionNames := t.request.GetPartitionNames()
 	if t.partitionKeyMode {
 		expr, err := ParseExprFromPlan(t.plan)
@@ -408,13 +459,19 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.String("requestType", "query"))
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
-	err := t.lb.Execute(ctx, CollectionWorkLoad{
+	workload := CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.CollectionID,
 		collectionName: t.collectionName,
 		nq:             1,
 		exec:           t.queryShard,
-	})
+		hedgeable:      true,
+	}
+	if t.streamConsumer != nil {
+		workload.exec = t.queryShardStream
+		workload.hedgeable = false
+	}
+	err := t.lb.Execute(ctx, workload)
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
 		return errors.Wrap(err, "failed to query")
@@ -450,6 +507,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		})
 	}
 
+	t.serviceableTs = typeutil2.MinServiceableTs(toReduceResults)
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
 
@@ -498,11 +556,98 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	}
 
 	log.Debug("get query result")
-	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
+	if !tryCommitShardResult(ctx) {
+		log.Debug("drop query result, the hedged request finished already")
+		return nil
+	}
+	t.resultBuf.Insert(result)
 	return nil
 }
 
+// queryShardStream retrieves the results of the shard by the streaming query and passes the batches to the stream consumer,
+// so that the batches could be dropped once they are consumed.
+func (t *queryTask) queryShardStream(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channelIDs ...string) error {
+	retrieveReq := typeutil.Clone(t.RetrieveRequest)
+	retrieveReq.GetBase().TargetID = nodeID
+	req := &querypb.QueryRequest{
+		Req:         retrieveReq,
+		DmlChannels: channelIDs,
+		Scope:       querypb.DataScope_All,
+	}
+
+	log := log.Ctx(ctx).With(zap.Int64("collection", t.GetCollectionID()),
+		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
+		zap.Int64("nodeID", nodeID),
+		zap.Strings("channels", channelIDs))
+
+	client, err := qn.QueryStream(ctx, req)
+	if err != nil {
+		log.Warn("QueryNode query stream create failed", zap.Error(err))
+		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		return err
+	}
+	for {
+		result, err := client.Recv()
+		if err != nil {
+			if err == io.EOF {
+				log.Debug("query stream finished")
+				return nil
+			}
+			log.Warn("QueryNode query stream receive failed", zap.Error(err))
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+			return err
+		}
+		if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
+			log.Warn("QueryNode is not shardLeader")
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+			return errInvalidShardLeaders
+		}
+		if err := merr.Error(result.GetStatus()); err != nil {
+			log.Warn("QueryNode query stream result error", zap.Error(err))
+			return err
+		}
+		if err := t.streamConsumer.consume(result); err != nil {
+			log.Warn("fail to consume query stream result", zap.Error(err))
+			return err
+		}
+	}
+}
+
+// retrieveByPKs reads the rows identified by ids with a point lookup,
+// the result is a consistent view of the collection at the given timestamp.
+func retrieveByPKs(ctx context.Context, qc types.QueryCoordClient, lb LBPolicy, request *milvuspb.QueryRequest, ids *schemapb.IDs, ts Timestamp) (*milvuspb.QueryResults, error) {
+	request.ConsistencyLevel = commonpb.ConsistencyLevel_Strong
+	request.UseDefaultConsistency = false
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		ids:     ids,
+		qc:      qc,
+		lb:      lb,
+	}
+	qt.SetTs(ts)
+
+	if err := qt.PreExecute(ctx); err != nil {
+		return nil, err
+	}
+	if err := qt.Execute(ctx); err != nil {
+		return nil, err
+	}
+	if err := qt.PostExecute(ctx); err != nil {
+		return nil, err
+	}
+	return qt.result, nil
+}
+
 // IDs2Expr converts ids slices to bool expresion with specified field name
 func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	var idsStr string
@@ -223,6 +223,8 @@ type dmTaskQueue struct {
 
 	statsLock            sync.RWMutex
 	pChanStatisticsInfos map[pChan]*pChanStatInfo
+	// lastMutationTs records the max timestamp of the dml tasks ever enqueued per pchannel
+	lastMutationTs map[pChan]Timestamp
 }
 
 func (queue *dmTaskQueue) Enqueue(t task) error {
@@ -302,6 +304,9 @@ func (queue *dmTaskQueue) commitPChanStats(dmt dmlTask, pChannels []pChan) {
 			}
 			currentStat.tsSet[newStat.minTs] = struct{}{}
 		}
+		if queue.lastMutationTs[cName] < newStat.maxTs {
+			queue.lastMutationTs[cName] = newStat.maxTs
+		}
 	}
 }
 
@@ -340,6 +345,19 @@ func (queue *dmTaskQueue) getPChanStatsInfo() (map[pChan]*pChanStatistics, error
 	return ret, nil
 }
 
+// getLastMutationTs returns the max timestamp of the dml tasks enqueued on the given pchannels.
+func (queue *dmTaskQueue) getLastMutationTs(pChannels []pChan) Timestamp {
+	queue.statsLock.RLock()
+	defer queue.statsLock.RUnlock()
+	var ret Timestamp
+	for _, channel := range pChannels {
+		if ts := queue.lastMutationTs[channel]; ts > ret {
+			ret = ts
+		}
+	}
+	return ret
+}
+
 type dqTaskQueue struct {
 	*baseTaskQueue
 }
@@ -360,6 +378,7 @@ func newDmTaskQueue(tsoAllocatorIns tsoAllocator) *dmTaskQueue {
 	return &dmTaskQueue{
 		baseTaskQueue:        newBaseTaskQueue(tsoAllocatorIns),
 		pChanStatisticsInfos: make(map[pChan]*pChanStatInfo),
+		lastMutationTs:       make(map[pChan]Timestamp),
 	}
 }
 
@@ -297,6 +297,10 @@ func TestDmTaskQueue_TimestampStatistics(t *testing.T) {
 	stats, err = queue.getPChanStatsInfo()
 	assert.NoError(t, err)
 	assert.Zero(t, len(stats))
+
+	// the last mutation timestamp is kept after the task is done
+	assert.Equal(t, unissuedTask.EndTs(), queue.getLastMutationTs(stPChans))
+	assert.Zero(t, queue.getLastMutationTs([]pChan{"not_exist"}))
 }
 
 // test the timestamp statistics
@@ -21,6 +21,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -62,6 +63,8 @@ type searchTask struct {
 
 	offset    int64
 	resultBuf *typeutil.ConcurrentSet[*internalpb.SearchResults]
+	// serviceableTs is the timestamp the result reflects all the mutations before
+	serviceableTs Timestamp
 
 	qc   types.QueryCoordClient
 	node types.ProxyComponent
@@ -236,11 +239,21 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		return errors.New("not support manually specifying the partition names if partition key mode is used")
 	}
 
+	unreadableFields, err := getUnreadableFields(ctx, t.request.GetDbName(), t.schema.GetName())
+	if err != nil {
+		log.Warn("failed to get unreadable fields", zap.Error(err))
+		return err
+	}
+	if err := checkOutputFieldsReadable(t.schema, t.request.GetOutputFields(), unreadableFields); err != nil {
+		return err
+	}
 	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.OutputFields, t.schema, false)
 	if err != nil {
 		log.Warn("translate output fields failed", zap.Error(err))
 		return err
 	}
+	t.request.OutputFields = removeUnreadableFields(t.request.OutputFields, unreadableFields)
+	t.userOutputFields = removeUnreadableFields(t.userOutputFields, unreadableFields)
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
@@ -308,6 +321,19 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
+		// the row policy may filter by the unreadable fields, so the fields are checked before applying it
+		if err := checkExprFieldsReadable(t.schema, plan.GetVectorAnns().GetPredicates(), unreadableFields); err != nil {
+			return err
+		}
+		rowPolicy, err := getRowPolicyExpr(ctx, collID)
+		if err != nil {
+			log.Warn("failed to get row policy", zap.Error(err))
+			return err
+		}
+		if err := applyRowPolicy(plan, t.schema, rowPolicy); err != nil {
+			return err
+		}
+
 		if partitionKeyMode {
 			expr, err := ParseExprFromPlan(plan)
 			if err != nil {
@@ -417,6 +443,7 @@ func (t *searchTask) Execute(ctx context.Context) error {
 		collectionName: t.collectionName,
 		nq:             t.Nq,
 		exec:           t.searchShard,
+		hedgeable:      true,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
@@ -449,6 +476,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		log.Warn("failed to collect search results", zap.Error(err))
 		return err
 	}
+	t.serviceableTs = typeutil2.MinServiceableTs(toReduceResults)
 
 	if len(toReduceResults) >= 1 {
 		MetricType = toReduceResults[0].GetMetricType()
@@ -539,8 +567,12 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 			zap.String("reason", result.GetStatus().GetReason()))
 		return fmt.Errorf("fail to Search, QueryNode ID=%d, reason=%s", nodeID, result.GetStatus().GetReason())
 	}
-	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
+	if !tryCommitShardResult(ctx) {
+		log.Debug("drop search result, the hedged request finished already")
+		return nil
+	}
+	t.resultBuf.Insert(result)
 
 	return nil
 }
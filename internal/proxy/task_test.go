@@ -3495,3 +3495,215 @@ func TestPartitionKey(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAddCollectionFieldTask_PreExecute(t *testing.T) {
+	newTask := func(field *schemapb.FieldSchema) *addCollectionFieldTask {
+		task := &addCollectionFieldTask{
+			AddCollectionFieldRequest: &internalpb.AddCollectionFieldRequest{
+				DbName:         "db",
+				CollectionName: "coll",
+				Field:          field,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionSchema(mock.Anything, "db", "coll").Return(schema, nil).Maybe()
+	globalMetaCache = cache
+
+	task := newTask(nil)
+	assert.Error(t, task.PreExecute(context.Background()))
+	task = newTask(&schemapb.FieldSchema{Name: "$invalid", DataType: schemapb.DataType_Int64})
+	assert.Error(t, task.PreExecute(context.Background()))
+
+	task = newTask(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64})
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, int64(1), task.GetCollectionID())
+	assert.Equal(t, commonpb.MsgType_AlterCollection, task.Type())
+
+	paramtable.Get().Save(Params.ProxyCfg.MaxFieldNum.Key, "2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MaxFieldNum.Key)
+	assert.Error(t, task.PreExecute(context.Background()))
+}
+
+func TestCloneCollectionTask_PreExecute(t *testing.T) {
+	newTask := func(name, newName string) *cloneCollectionTask {
+		task := &cloneCollectionTask{
+			CloneCollectionRequest: &internalpb.CloneCollectionRequest{
+				DbName:            "db",
+				CollectionName:    name,
+				NewCollectionName: newName,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "other").Return(0, merr.WrapErrCollectionNotFound("other")).Maybe()
+	globalMetaCache = cache
+
+	assert.Error(t, newTask("coll", "$invalid").PreExecute(context.Background()))
+	assert.Error(t, newTask("other", "coll_clone").PreExecute(context.Background()))
+
+	task := newTask("coll", "coll_clone")
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_CreateCollection, task.Type())
+}
+
+func TestUndropCollectionTask_PreExecute(t *testing.T) {
+	newTask := func(name string, id int64) *undropCollectionTask {
+		task := &undropCollectionTask{
+			UndropCollectionRequest: &internalpb.UndropCollectionRequest{
+				DbName:         "db",
+				CollectionName: name,
+				CollectionID:   id,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	assert.Error(t, newTask("", 0).PreExecute(context.Background()))
+	assert.Error(t, newTask("$invalid", 0).PreExecute(context.Background()))
+	assert.NoError(t, newTask("", 1).PreExecute(context.Background()))
+
+	task := newTask("coll", 0)
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_CreateCollection, task.Type())
+}
+
+func TestUndropPartitionTask_PreExecute(t *testing.T) {
+	newTask := func(collection, partition string) *undropPartitionTask {
+		task := &undropPartitionTask{
+			UndropPartitionRequest: &internalpb.UndropPartitionRequest{
+				DbName:         "db",
+				CollectionName: collection,
+				PartitionName:  partition,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "other").Return(0, merr.WrapErrCollectionNotFound("other")).Maybe()
+	globalMetaCache = cache
+
+	assert.Error(t, newTask("coll", "$invalid").PreExecute(context.Background()))
+	assert.Error(t, newTask("other", "p1").PreExecute(context.Background()))
+
+	task := newTask("coll", "p1")
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_CreatePartition, task.Type())
+}
+
+func TestCreateSnapshotTask_PreExecute(t *testing.T) {
+	newTask := func(collection, snapshot string) *createSnapshotTask {
+		task := &createSnapshotTask{
+			CreateSnapshotRequest: &internalpb.CreateSnapshotRequest{
+				DbName:         "db",
+				CollectionName: collection,
+				SnapshotName:   snapshot,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "coll").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, "db", "other").Return(0, merr.WrapErrCollectionNotFound("other")).Maybe()
+	globalMetaCache = cache
+
+	assert.Error(t, newTask("coll", "").PreExecute(context.Background()))
+	assert.Error(t, newTask("$invalid", "snap").PreExecute(context.Background()))
+	assert.Error(t, newTask("other", "snap").PreExecute(context.Background()))
+
+	task := newTask("coll", "snap")
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_CreateCollection, task.Type())
+}
+
+func TestDropSnapshotTask_PreExecute(t *testing.T) {
+	task := &dropSnapshotTask{
+		DropSnapshotRequest: &internalpb.DropSnapshotRequest{DbName: "db"},
+	}
+	assert.NoError(t, task.OnEnqueue())
+	assert.Error(t, task.PreExecute(context.Background()))
+
+	task.SnapshotName = "snap"
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_DropCollection, task.Type())
+}
+
+func TestRestoreSnapshotTask_PreExecute(t *testing.T) {
+	newTask := func(snapshot, collection string) *restoreSnapshotTask {
+		task := &restoreSnapshotTask{
+			RestoreSnapshotRequest: &internalpb.RestoreSnapshotRequest{
+				DbName:            "db",
+				SnapshotName:      snapshot,
+				NewCollectionName: collection,
+			},
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	assert.Error(t, newTask("", "coll").PreExecute(context.Background()))
+	assert.Error(t, newTask("snap", "$invalid").PreExecute(context.Background()))
+
+	task := newTask("snap", "coll")
+	assert.NoError(t, task.PreExecute(context.Background()))
+	assert.Equal(t, commonpb.MsgType_CreateCollection, task.Type())
+}
+
+func TestBatchAlterAliasesTask(t *testing.T) {
+	newTask := func(ops ...*internalpb.AliasOperation) *batchAlterAliasesTask {
+		task := &batchAlterAliasesTask{
+			ctx:       context.Background(),
+			Condition: NewTaskCondition(context.Background()),
+			BatchAlterAliasesRequest: &internalpb.BatchAlterAliasesRequest{
+				DbName:     "db",
+				Operations: ops,
+			},
+			rootCoord: NewRootCoordMock(),
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+	alter := &internalpb.AliasOperation{Type: internalpb.AliasOperationType_AliasOperationAlter, Alias: "prod", CollectionName: "green"}
+	create := &internalpb.AliasOperation{Type: internalpb.AliasOperationType_AliasOperationCreate, Alias: "rollback", CollectionName: "blue"}
+	drop := &internalpb.AliasOperation{Type: internalpb.AliasOperationType_AliasOperationDrop, Alias: "old"}
+
+	t.Run("invalid request", func(t *testing.T) {
+		assert.Error(t, newTask().PreExecute(context.Background()))
+		assert.Error(t, newTask(&internalpb.AliasOperation{Alias: "$invalid", CollectionName: "green"}).PreExecute(context.Background()))
+		assert.Error(t, newTask(&internalpb.AliasOperation{Alias: "prod", CollectionName: "$invalid"}).PreExecute(context.Background()))
+	})
+
+	t.Run("only drop", func(t *testing.T) {
+		task := newTask(drop)
+		assert.NoError(t, task.PreExecute(context.Background()))
+		assert.Equal(t, commonpb.MsgType_AlterAlias, task.Type())
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.NoError(t, merr.Error(task.result))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := newTask(alter, create, drop, create)
+		assert.NoError(t, task.PreExecute(context.Background()))
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.NoError(t, merr.Error(task.result))
+		assert.NoError(t, task.PostExecute(context.Background()))
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -62,6 +63,13 @@ type upsertTask struct {
 	schema           *schemapb.CollectionSchema
 	partitionKeyMode bool
 	partitionKeys    *schemapb.FieldData
+
+	// partialUpdate means the request only carries the fields to be updated,
+	// the other fields are read from the existing rows.
+	partialUpdate   bool
+	insertIfMissing bool
+	qc              types.QueryCoordClient
+	lb              LBPolicy
 }
 
 // TraceCtx returns upsertTask context
@@ -136,6 +144,95 @@ func (it *upsertTask) OnEnqueue() error {
 	return nil
 }
 
+// partialUpdatePreExecute completes the fields which are not carried by a partial upsert request
+// with the values of the existing rows, so that the following steps could handle full rows.
+func (it *upsertTask) partialUpdatePreExecute(ctx context.Context) error {
+	log := log.Ctx(ctx).With(zap.String("collectionName", it.req.GetCollectionName()))
+
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(it.schema)
+	if err != nil {
+		return err
+	}
+	primaryFieldData, err := typeutil.GetPrimaryFieldData(it.req.GetFieldsData(), primaryFieldSchema)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("primary key field %s is required by partial update", primaryFieldSchema.GetName())
+	}
+	ids, err := parsePrimaryFieldData2IDs(primaryFieldData)
+	if err != nil {
+		return err
+	}
+
+	// read the rows at the timestamp right before this upsert,
+	// all the mutations prior to this task are visible then.
+	request := &milvuspb.QueryRequest{
+		DbName:         it.req.GetDbName(),
+		CollectionName: it.req.GetCollectionName(),
+		OutputFields:   []string{"*"},
+	}
+	// rows of a partition key collection may be stored in any partition
+	if !it.partitionKeyMode {
+		request.PartitionNames = []string{it.req.GetPartitionName()}
+	}
+	existing, err := retrieveByPKs(ctx, it.qc, it.lb, request, ids, it.BeginTs()-1)
+	if err != nil {
+		log.Warn("failed to retrieve existing rows for partial update", zap.Error(err))
+		return err
+	}
+
+	fieldsData, err := mergePartialUpdateData(it.schema, it.req.GetFieldsData(), existing.GetFieldsData(), it.insertIfMissing)
+	if err != nil {
+		log.Warn("failed to merge partial update data", zap.Error(err))
+		return err
+	}
+	it.req.FieldsData = fieldsData
+	log.Debug("Proxy Upsert partialUpdatePreExecute done")
+	return nil
+}
+
+// checkRowPolicy checks both the rows to write and the existing rows to overwrite satisfy the row policy,
+// so that no one could replace the rows invisible to itself.
+func (it *upsertTask) checkRowPolicy(ctx context.Context) error {
+	rowPolicy, err := getRowPolicyExpr(ctx, it.collectionID)
+	if err != nil || rowPolicy == "" {
+		return err
+	}
+	if err := checkRowPolicyOnRows(it.schema, rowPolicy, it.upsertMsg.InsertMsg.GetFieldsData(), int(it.upsertMsg.InsertMsg.NRows())); err != nil {
+		return err
+	}
+
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(it.schema)
+	if err != nil {
+		return err
+	}
+	request := &milvuspb.QueryRequest{
+		DbName:         it.req.GetDbName(),
+		CollectionName: it.req.GetCollectionName(),
+		Expr:           "not (" + rowPolicy + ")",
+		OutputFields:   []string{primaryFieldSchema.GetName()},
+	}
+	if !it.partitionKeyMode {
+		request.PartitionNames = []string{it.req.GetPartitionName()}
+	}
+	existing, err := retrieveByPKs(ctx, it.qc, it.lb, request, it.result.GetIDs(), it.BeginTs()-1)
+	if err != nil {
+		return err
+	}
+	for _, fieldData := range existing.GetFieldsData() {
+		if fieldData.GetFieldName() != primaryFieldSchema.GetName() {
+			continue
+		}
+		ids, err := parsePrimaryFieldData2IDs(fieldData)
+		if err != nil {
+			return err
+		}
+		if typeutil.GetSizeOfIDs(ids) > 0 {
+			return merr.WrapErrPrivilegeNotPermitted("the existing row of primary key %v doesn't satisfy the row policy of the current user",
+				typeutil.GetPK(ids, 0))
+		}
+	}
+	return nil
+}
+
 func (it *upsertTask) insertPreExecute(ctx context.Context) error {
 	collectionName := it.upsertMsg.InsertMsg.CollectionName
 	if err := validateCollectionName(collectionName); err != nil {
@@ -313,6 +410,13 @@ func (it *upsertTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	if it.partialUpdate {
+		if err := it.partialUpdatePreExecute(ctx); err != nil {
+			log.Warn("Fail to partialUpdatePreExecute", zap.Error(err))
+			return err
+		}
+	}
+
 	it.upsertMsg = &msgstream.UpsertMsg{
 		InsertMsg: &msgstream.InsertMsg{
 			InsertRequest: msgpb.InsertRequest{
@@ -354,6 +458,11 @@ func (it *upsertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := it.checkRowPolicy(ctx); err != nil {
+		log.Warn("Fail to check row policy", zap.Error(err))
+		return err
+	}
+
 	it.result.DeleteCnt = it.upsertMsg.DeleteMsg.NumRows
 	it.result.InsertCnt = int64(it.upsertMsg.InsertMsg.NumRows)
 	if it.result.DeleteCnt != it.result.InsertCnt {
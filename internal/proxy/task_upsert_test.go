@@ -17,17 +17,27 @@ package proxy
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestUpsertTask_CheckAligned(t *testing.T) {
@@ -322,3 +332,148 @@ func TestUpsertTask(t *testing.T) {
 		assert.ElementsMatch(t, channels, ut.pChannels)
 	})
 }
+
+func TestUpsertTask_PartialUpdate(t *testing.T) {
+	var (
+		collectionName = "col_partial_update"
+		collectionID   = UniqueID(1)
+		partitionID    = UniqueID(2)
+		ts             = Timestamp(1000)
+	)
+	schema := &schemapb.CollectionSchema{
+		Name: collectionName,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tag", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Int64},
+		},
+	}
+	newLongFieldData := func(name string, data []int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_Int64,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}},
+			},
+		}
+	}
+	newVarCharFieldData := func(name string, data []string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}},
+			},
+		}
+	}
+	// the only existing row, pk 1
+	existFields := map[int64]*schemapb.FieldData{
+		common.TimeStampField: newLongFieldData("", []int64{0}),
+		100:                   newLongFieldData("", []int64{1}),
+		101:                   newVarCharFieldData("", []string{"a"}),
+		102:                   newLongFieldData("", []int64{10}),
+	}
+
+	newTask := func(t *testing.T, ctx context.Context, fieldsData []*schemapb.FieldData) *upsertTask {
+		cache := NewMockCache(t)
+		cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, collectionName).Return(collectionID, nil)
+		cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, collectionName).Return(schema, nil)
+		cache.EXPECT().GetPartitions(mock.Anything, mock.Anything, collectionName).Return(map[string]UniqueID{"_default": partitionID}, nil)
+		cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, collectionName, collectionID).Return(&collectionBasicInfo{collID: collectionID}, nil)
+		globalMetaCache = cache
+
+		qn := mocks.NewMockQueryNodeClient(t)
+		qn.EXPECT().Query(mock.Anything, mock.Anything).Call.Return(
+			func(ctx context.Context, req *querypb.QueryRequest, opts ...grpc.CallOption) *internalpb.RetrieveResults {
+				// the existing rows are read right before the upsert
+				assert.Equal(t, ts-1, req.GetReq().GetMvccTimestamp())
+				result := &internalpb.RetrieveResults{
+					Status: merr.Success(),
+					Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+				}
+				for _, fieldID := range req.GetReq().GetOutputFieldsId() {
+					result.FieldsData = append(result.FieldsData, existFields[fieldID])
+				}
+				return result
+			}, nil)
+		lb := NewMockLBPolicy(t)
+		lb.EXPECT().Execute(mock.Anything, mock.Anything).Call.Return(func(ctx context.Context, workload CollectionWorkLoad) error {
+			return workload.exec(ctx, 1, qn)
+		})
+		lb.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything).Return()
+
+		partialUpdate, insertIfMissing := GetPartialUpdateFromContext(ctx)
+		return &upsertTask{
+			ctx: ctx,
+			req: &milvuspb.UpsertRequest{
+				CollectionName: collectionName,
+				PartitionName:  "_default",
+				FieldsData:     fieldsData,
+				NumRows:        uint32(len(fieldsData[0].GetScalars().GetLongData().GetData())),
+			},
+			baseMsg:         msgstream.BaseMsg{BeginTimestamp: ts},
+			schema:          schema,
+			partialUpdate:   partialUpdate,
+			insertIfMissing: insertIfMissing,
+			lb:              lb,
+		}
+	}
+	newContext := func(insertIfMissing bool) context.Context {
+		md := metadata.New(map[string]string{
+			strings.ToLower(util.HeaderPartialUpdate):   "true",
+			strings.ToLower(util.HeaderInsertIfMissing): strconv.FormatBool(insertIfMissing),
+		})
+		return metadata.NewIncomingContext(context.Background(), md)
+	}
+
+	t.Run("update existing row", func(t *testing.T) {
+		ctx := newContext(false)
+		task := newTask(t, ctx, []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1}),
+			newLongFieldData("score", []int64{100}),
+		})
+		assert.True(t, task.partialUpdate)
+		assert.NoError(t, task.partialUpdatePreExecute(ctx))
+
+		fieldsData := task.req.GetFieldsData()
+		assert.Equal(t, 3, len(fieldsData))
+		assert.Equal(t, []int64{1}, fieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []string{"a"}, fieldsData[1].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []int64{100}, fieldsData[2].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("missing row without insertIfMissing", func(t *testing.T) {
+		ctx := newContext(false)
+		task := newTask(t, ctx, []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1, 2}),
+			newVarCharFieldData("tag", []string{"aa", "b"}),
+			newLongFieldData("score", []int64{100, 200}),
+		})
+		assert.False(t, task.insertIfMissing)
+		assert.ErrorIs(t, task.partialUpdatePreExecute(ctx), merr.ErrParameterInvalid)
+	})
+
+	t.Run("missing row lacks field", func(t *testing.T) {
+		ctx := newContext(true)
+		task := newTask(t, ctx, []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1, 2}),
+			newLongFieldData("score", []int64{100, 200}),
+		})
+		assert.True(t, task.insertIfMissing)
+		assert.ErrorIs(t, task.partialUpdatePreExecute(ctx), merr.ErrParameterInvalid)
+	})
+
+	t.Run("insert missing row", func(t *testing.T) {
+		ctx := newContext(true)
+		task := newTask(t, ctx, []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1, 2}),
+			newVarCharFieldData("tag", []string{"aa", "b"}),
+			newLongFieldData("score", []int64{100, 200}),
+		})
+		assert.NoError(t, task.partialUpdatePreExecute(ctx))
+
+		fieldsData := task.req.GetFieldsData()
+		assert.Equal(t, []int64{1, 2}, fieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []string{"aa", "b"}, fieldsData[1].GetScalars().GetStringData().GetData())
+	})
+}
@@ -27,12 +27,14 @@ import (
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -760,7 +762,7 @@ func ValidatePassword(password string) error {
 			Params.ProxyCfg.MaxPasswordLength.GetAsInt(),
 			len(password), "invalid password length")
 	}
-	return nil
+	return validatePasswordComplexity(password)
 }
 
 func ReplaceID2Name(oldStr string, id int64, name string) string {
@@ -841,6 +843,25 @@ func ValidateObjectName(entity string) error {
 	return validateName(entity, "role name")
 }
 
+// ValidateFieldObjectName validates the object name of the field level privileges, which is like `collection.field`,
+// the wildcard field represents all the fields of the collection.
+func ValidateFieldObjectName(entity string) error {
+	if util.IsAnyWord(entity) {
+		return nil
+	}
+	collectionName, fieldName, ok := strings.Cut(entity, ".")
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("the object name of the field privilege should be like `collection.field`, current name: %s", entity)
+	}
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	if util.IsAnyWord(fieldName) || fieldName == common.MetaFieldName {
+		return nil
+	}
+	return validateFieldName(fieldName)
+}
+
 func ValidateObjectType(entity string) error {
 	return validateName(entity, "ObjectType")
 }
@@ -894,6 +915,52 @@ func GetCurDBNameFromContextOrDefault(ctx context.Context) string {
 	return dbNameData[0]
 }
 
+// getBoolFromContextHeader parses the bool value carried by the given request header,
+// returns false if the header is absent or malformed.
+func getBoolFromContextHeader(ctx context.Context, header string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md[strings.ToLower(header)]
+	if len(values) < 1 {
+		return false
+	}
+	ret, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false
+	}
+	return ret
+}
+
+// setAsyncDDL asks rootcoord to return as soon as the DDL request is accepted if the client sets the header.
+func setAsyncDDL(ctx context.Context, base *commonpb.MsgBase) {
+	if !getBoolFromContextHeader(ctx, util.HeaderAsyncDDL) {
+		return
+	}
+	if base.Properties == nil {
+		base.Properties = make(map[string]string)
+	}
+	base.Properties[common.DDLAsyncKey] = "true"
+}
+
+// setDDLJobHeader returns the id of the DDL job to the client by the response header,
+// rootcoord tracks the DDL request as a job by the msg id of it.
+// Only creating, dropping and altering collections and partitions are tracked as jobs,
+// the other DDLs don't return the header.
+func setDDLJobHeader(ctx context.Context, jobID UniqueID) {
+	// the context isn't a grpc server context if the request comes from the restful api.
+	if err := grpc.SetHeader(ctx, metadata.Pairs(util.HeaderDDLJobID, strconv.FormatInt(jobID, 10))); err != nil {
+		log.Ctx(ctx).Debug("failed to set ddl job header", zap.Error(err))
+	}
+}
+
+// GetPartialUpdateFromContext returns whether the upsert request only carries the fields to update,
+// and whether rows with nonexistent primary keys are allowed to be inserted.
+func GetPartialUpdateFromContext(ctx context.Context) (partialUpdate bool, insertIfMissing bool) {
+	return getBoolFromContextHeader(ctx, util.HeaderPartialUpdate), getBoolFromContextHeader(ctx, util.HeaderInsertIfMissing)
+}
+
 func NewContextWithMetadata(ctx context.Context, username string, dbName string) context.Context {
 	originValue := fmt.Sprintf("%s%s%s", username, util.CredentialSeperator, username)
 	authKey := strings.ToLower(util.HeaderAuthorize)
@@ -918,16 +985,26 @@ func PasswordVerify(ctx context.Context, username, rawPwd string) bool {
 	return passwordVerify(ctx, username, rawPwd, globalMetaCache)
 }
 
-func VerifyAPIKey(rawToken string) (string, error) {
+// VerifyAPIKey returns the user the api key belongs to, the api keys created by CreateAPIKey are verified first and
+// returned along with the user, then the other api keys are verified by the hook.
+func VerifyAPIKey(ctx context.Context, rawToken string) (string, *internalpb.APIKeyInfo, error) {
+	apiKey, ok, err := verifyNativeAPIKey(ctx, rawToken)
+	if ok {
+		if err != nil {
+			log.Warn("fail to verify apikey", zap.Error(err))
+			return "", nil, err
+		}
+		return apiKey.GetUsername(), apiKey, nil
+	}
 	if hoo == nil {
-		return "", merr.WrapErrServiceInternal("internal: Milvus Proxy is not ready yet. please wait")
+		return "", nil, merr.WrapErrServiceInternal("internal: Milvus Proxy is not ready yet. please wait")
 	}
 	user, err := hoo.VerifyAPIKey(rawToken)
 	if err != nil {
 		log.Warn("fail to verify apikey", zap.String("api_key", rawToken), zap.Error(err))
-		return "", merr.WrapErrParameterInvalidMsg("invalid apikey: [%s]", rawToken)
+		return "", nil, merr.WrapErrParameterInvalidMsg("invalid apikey: [%s]", rawToken)
 	}
-	return user, nil
+	return user, nil, nil
 }
 
 // PasswordVerify verify password
@@ -937,18 +1014,31 @@ func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCach
 	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
 	if err != nil {
 		log.Error("found no credential", zap.String("username", username), zap.Error(err))
+		recordAuthFailure(ctx, username, "no credential found")
+		return false
+	}
+	// the lockout is never cached, rootcoord expires the cached credential once the user is locked out
+	if lockedUntil := credInfo.GetLockedUntil(); lockedUntil > time.Now().Unix() {
+		log.Warn("the user is locked out", zap.String("username", username), zap.Time("lockedUntil", time.Unix(lockedUntil, 0)))
+		recordAuthFailure(ctx, username, "the user is locked out")
 		return false
 	}
 
 	// hit cache
 	sha256Pwd := crypto.SHA256(rawPwd, credInfo.Username)
 	if credInfo.Sha256Password != "" {
-		return sha256Pwd == credInfo.Sha256Password
+		if sha256Pwd != credInfo.Sha256Password {
+			reportLoginFailure(ctx, username, globalMetaCache)
+			return false
+		}
+		reportLoginSuccess(ctx, username, globalMetaCache)
+		return true
 	}
 
 	// miss cache, verify against encrypted password from etcd
 	if err := bcrypt.CompareHashAndPassword([]byte(credInfo.EncryptedPassword), []byte(rawPwd)); err != nil {
 		log.Error("Verify password failed", zap.Error(err))
+		reportLoginFailure(ctx, username, globalMetaCache)
 		return false
 	}
 
@@ -956,6 +1046,7 @@ func passwordVerify(ctx context.Context, username, rawPwd string, globalMetaCach
 	credInfo.Sha256Password = sha256Pwd
 	log.Debug("get credential miss cache, update cache with", zap.Any("credential", credInfo))
 	globalMetaCache.UpdateCredential(credInfo)
+	reportLoginSuccess(ctx, username, globalMetaCache)
 	return true
 }
 
@@ -1505,6 +1596,108 @@ func checkDynamicFieldData(schema *schemapb.CollectionSchema, insertMsg *msgstre
 	return nil
 }
 
+// mergePartialUpdateData builds full rows for a partial upsert request. The fields carried by
+// the request override the values of the existing rows, other fields keep the existing values.
+// Rows whose primary keys do not exist are rejected unless insertIfMissing is set, in that case
+// all the fields except the dynamic field must be provided by the request.
+func mergePartialUpdateData(schema *schemapb.CollectionSchema, updateData []*schemapb.FieldData, existData []*schemapb.FieldData, insertIfMissing bool) ([]*schemapb.FieldData, error) {
+	primaryFieldSchema, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	schemaFieldNames := typeutil.NewSet[string]()
+	for _, field := range schema.GetFields() {
+		schemaFieldNames.Insert(field.GetName())
+	}
+	updateFields := make(map[string]*schemapb.FieldData)
+	for _, data := range updateData {
+		fieldName := data.GetFieldName()
+		if data.GetIsDynamic() {
+			fieldName = common.MetaFieldName
+		}
+		if !schemaFieldNames.Contain(fieldName) {
+			return nil, merr.WrapErrParameterInvalidMsg("field %s not exist in collection schema", fieldName)
+		}
+		if _, ok := updateFields[fieldName]; ok {
+			return nil, merr.WrapErrParameterInvalidMsg("duplicated field %s found", fieldName)
+		}
+		updateFields[fieldName] = data
+	}
+
+	primaryFieldData, ok := updateFields[primaryFieldSchema.GetName()]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("primary key field %s is required by partial update", primaryFieldSchema.GetName())
+	}
+	updatePKs, err := parsePrimaryFieldData2IDs(primaryFieldData)
+	if err != nil {
+		return nil, err
+	}
+
+	existFields := make(map[string]*schemapb.FieldData)
+	for _, data := range existData {
+		existFields[data.GetFieldName()] = data
+	}
+	// primary key -> offset of the existing row
+	existOffsets := make(map[interface{}]int64)
+	if existPKData, ok := existFields[primaryFieldSchema.GetName()]; ok {
+		existPKs, err := parsePrimaryFieldData2IDs(existPKData)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < typeutil.GetSizeOfIDs(existPKs); i++ {
+			existOffsets[typeutil.GetPK(existPKs, int64(i))] = int64(i)
+		}
+	}
+
+	numRows := typeutil.GetSizeOfIDs(updatePKs)
+	if numRows == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no row to be partially updated")
+	}
+	offsets := make([]int64, numRows)
+	for i := 0; i < numRows; i++ {
+		pk := typeutil.GetPK(updatePKs, int64(i))
+		offset, ok := existOffsets[pk]
+		if ok {
+			offsets[i] = offset
+			continue
+		}
+		if !insertIfMissing {
+			return nil, merr.WrapErrParameterInvalidMsg("primary key %v not exist, cannot be partially updated", pk)
+		}
+		for _, field := range schema.GetFields() {
+			if _, ok := updateFields[field.GetName()]; !ok && !field.GetIsDynamic() {
+				return nil, merr.WrapErrParameterInvalidMsg("field %s is required to insert the row with nonexistent primary key %v", field.GetName(), pk)
+			}
+		}
+		offsets[i] = -1
+	}
+
+	result := make([]*schemapb.FieldData, len(schema.GetFields()))
+	for idx, field := range schema.GetFields() {
+		if data, ok := updateFields[field.GetName()]; ok {
+			result[idx] = data
+		} else {
+			dst := result[idx : idx+1]
+			for _, offset := range offsets {
+				if offset < 0 {
+					// only the dynamic field could be absent for the inserted rows
+					typeutil.AppendFieldData(dst, []*schemapb.FieldData{autoGenDynamicFieldData([][]byte{[]byte("{}")})}, 0)
+					continue
+				}
+				existField, ok := existFields[field.GetName()]
+				if !ok {
+					return nil, merr.WrapErrParameterInvalidMsg("field %s not found in the existing rows", field.GetName())
+				}
+				typeutil.AppendFieldData(dst, []*schemapb.FieldData{existField}, offset)
+			}
+		}
+		result[idx].FieldName = field.GetName()
+		result[idx].IsDynamic = field.GetIsDynamic()
+	}
+	return result, nil
+}
+
 func SendReplicateMessagePack(ctx context.Context, replicateMsgStream msgstream.MsgStream, request interface{ GetBase() *commonpb.MsgBase }) {
 	if replicateMsgStream == nil || request == nil {
 		log.Warn("replicate msg stream or request is nil", zap.Any("request", request))
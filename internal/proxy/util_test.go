@@ -814,6 +814,15 @@ func TestValidateName(t *testing.T) {
 	assert.Nil(t, ValidateObjectName("*"))
 }
 
+func TestValidateFieldObjectName(t *testing.T) {
+	for _, name := range []string{"*", "col1.field1", "col1.*", "col1.$meta"} {
+		assert.NoError(t, ValidateFieldObjectName(name), name)
+	}
+	for _, name := range []string{"", "col1", "col1.", ".field1", "1col.field1", "col1.field 1"} {
+		assert.Error(t, ValidateFieldObjectName(name), name)
+	}
+}
+
 func TestIsDefaultRole(t *testing.T) {
 	assert.Equal(t, true, IsDefaultRole(util.RoleAdmin))
 	assert.Equal(t, true, IsDefaultRole(util.RolePublic))
@@ -877,6 +886,48 @@ func TestGetCurDBNameFromContext(t *testing.T) {
 	assert.Equal(t, dbNameValue, dbName)
 }
 
+func TestGetPartialUpdateFromContext(t *testing.T) {
+	partialUpdate, insertIfMissing := GetPartialUpdateFromContext(context.Background())
+	assert.False(t, partialUpdate)
+	assert.False(t, insertIfMissing)
+
+	md := metadata.New(map[string]string{
+		strings.ToLower(util.HeaderPartialUpdate): "true",
+	})
+	partialUpdate, insertIfMissing = GetPartialUpdateFromContext(metadata.NewIncomingContext(context.Background(), md))
+	assert.True(t, partialUpdate)
+	assert.False(t, insertIfMissing)
+
+	md = metadata.New(map[string]string{
+		strings.ToLower(util.HeaderPartialUpdate):   "true",
+		strings.ToLower(util.HeaderInsertIfMissing): "true",
+	})
+	partialUpdate, insertIfMissing = GetPartialUpdateFromContext(metadata.NewIncomingContext(context.Background(), md))
+	assert.True(t, partialUpdate)
+	assert.True(t, insertIfMissing)
+
+	md = metadata.New(map[string]string{
+		strings.ToLower(util.HeaderPartialUpdate): "not a bool",
+	})
+	partialUpdate, _ = GetPartialUpdateFromContext(metadata.NewIncomingContext(context.Background(), md))
+	assert.False(t, partialUpdate)
+}
+
+func TestSetAsyncDDL(t *testing.T) {
+	base := &commonpb.MsgBase{}
+	setAsyncDDL(context.Background(), base)
+	assert.False(t, common.IsAsyncDDL(base))
+
+	md := metadata.New(map[string]string{
+		strings.ToLower(util.HeaderAsyncDDL): "true",
+	})
+	setAsyncDDL(metadata.NewIncomingContext(context.Background(), md), base)
+	assert.True(t, common.IsAsyncDDL(base))
+
+	// the context isn't a grpc server context, setting the header mustn't panic.
+	setDDLJobHeader(context.Background(), 100)
+}
+
 func TestGetRole(t *testing.T) {
 	globalMetaCache = nil
 	_, err := GetRole("foo")
@@ -2089,3 +2140,102 @@ func TestSendReplicateMessagePack(t *testing.T) {
 		SendReplicateMessagePack(ctx, mockStream, &milvuspb.ReleasePartitionsRequest{})
 	})
 }
+
+func Test_MergePartialUpdateData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:               "test_partial_update",
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "tag", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	newLongFieldData := func(name string, data []int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_Int64,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}},
+			},
+		}
+	}
+	newVarCharFieldData := func(name string, data []string) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}},
+			},
+		}
+	}
+	newJSONFieldData := func(name string, data [][]byte) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type:      schemapb.DataType_JSON,
+			FieldName: name,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: data}}},
+			},
+		}
+	}
+	existData := []*schemapb.FieldData{
+		newLongFieldData("pk", []int64{1, 2}),
+		newVarCharFieldData("tag", []string{"a", "b"}),
+		newLongFieldData("score", []int64{10, 20}),
+		newJSONFieldData(common.MetaFieldName, [][]byte{[]byte(`{"x":1}`), []byte(`{"x":2}`)}),
+	}
+
+	t.Run("update existing rows", func(t *testing.T) {
+		updateData := []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{2, 1}),
+			newLongFieldData("score", []int64{200, 100}),
+		}
+		merged, err := mergePartialUpdateData(schema, updateData, existData, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(merged))
+		assert.Equal(t, []int64{2, 1}, merged[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []string{"b", "a"}, merged[1].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []int64{200, 100}, merged[2].GetScalars().GetLongData().GetData())
+		assert.Equal(t, [][]byte{[]byte(`{"x":2}`), []byte(`{"x":1}`)}, merged[3].GetScalars().GetJsonData().GetData())
+		assert.True(t, merged[3].GetIsDynamic())
+	})
+
+	t.Run("missing primary key", func(t *testing.T) {
+		updateData := []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1, 3}),
+			newLongFieldData("score", []int64{100, 300}),
+		}
+		_, err := mergePartialUpdateData(schema, updateData, existData, false)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// fields are still missing for the new row
+		_, err = mergePartialUpdateData(schema, updateData, existData, true)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("insert if missing", func(t *testing.T) {
+		updateData := []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1, 3}),
+			newVarCharFieldData("tag", []string{"aa", "c"}),
+			newLongFieldData("score", []int64{100, 300}),
+		}
+		merged, err := mergePartialUpdateData(schema, updateData, existData, true)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"aa", "c"}, merged[1].GetScalars().GetStringData().GetData())
+		assert.Equal(t, [][]byte{[]byte(`{"x":1}`), []byte(`{}`)}, merged[3].GetScalars().GetJsonData().GetData())
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		_, err := mergePartialUpdateData(schema, []*schemapb.FieldData{
+			newLongFieldData("score", []int64{100}),
+		}, existData, false)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = mergePartialUpdateData(schema, []*schemapb.FieldData{
+			newLongFieldData("pk", []int64{1}),
+			newLongFieldData("not_exist", []int64{100}),
+		}, existData, false)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
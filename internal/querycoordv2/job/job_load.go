@@ -80,10 +80,8 @@ func (job *LoadCollectionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
-	if req.GetReplicaNumber() <= 0 {
-		log.Info("request doesn't indicate the number of replicas, set it to 1",
-			zap.Int32("replicaNumber", req.GetReplicaNumber()))
-		req.ReplicaNumber = 1
+	if err := applyDatabaseLoadDefaults(job.ctx, job.broker, req.GetCollectionID(), &req.ReplicaNumber, &req.ResourceGroups); err != nil {
+		return err
 	}
 
 	collection := job.meta.GetCollection(req.GetCollectionID())
@@ -259,10 +257,8 @@ func (job *LoadPartitionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
-	if req.GetReplicaNumber() <= 0 {
-		log.Info("request doesn't indicate the number of replicas, set it to 1",
-			zap.Int32("replicaNumber", req.GetReplicaNumber()))
-		req.ReplicaNumber = 1
+	if err := applyDatabaseLoadDefaults(job.ctx, job.broker, req.GetCollectionID(), &req.ReplicaNumber, &req.ResourceGroups); err != nil {
+		return err
 	}
 
 	collection := job.meta.GetCollection(req.GetCollectionID())
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type SyncNewCreatedPartitionJob struct {
@@ -98,3 +99,57 @@ func (job *SyncNewCreatedPartitionJob) Execute() error {
 
 	return nil
 }
+
+// UpdateSchemaJob pushes the schema with the fields added to the QueryNodes of the loaded collection,
+// the segments loaded by them, including the growing ones, fill the added fields with their default values.
+type UpdateSchemaJob struct {
+	*BaseJob
+	req     *querypb.UpdateSchemaRequest
+	meta    *meta.Meta
+	cluster session.Cluster
+}
+
+func NewUpdateSchemaJob(
+	ctx context.Context,
+	req *querypb.UpdateSchemaRequest,
+	meta *meta.Meta,
+	cluster session.Cluster,
+) *UpdateSchemaJob {
+	return &UpdateSchemaJob{
+		BaseJob: NewBaseJob(ctx, req.Base.GetMsgID(), req.GetCollectionID()),
+		req:     req,
+		meta:    meta,
+		cluster: cluster,
+	}
+}
+
+func (job *UpdateSchemaJob) PreExecute() error {
+	return nil
+}
+
+func (job *UpdateSchemaJob) Execute() error {
+	req := job.req
+	log := log.Ctx(job.ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	// the collection loaded later gets the schema from rootcoord
+	if collection := job.meta.GetCollection(req.GetCollectionID()); collection == nil {
+		return nil
+	}
+
+	for _, replica := range job.meta.ReplicaManager.GetByCollection(req.GetCollectionID()) {
+		for _, node := range replica.GetNodes() {
+			status, err := job.cluster.UpdateSchema(job.ctx, node, req)
+			if err != nil {
+				log.Warn("failed to update schema", zap.Int64("node", node), zap.Error(err))
+				return err
+			}
+			if !merr.Ok(status) {
+				log.Warn("failed to update schema", zap.Int64("node", node), zap.Error(merr.Error(status)))
+				return merr.Error(status)
+			}
+		}
+	}
+	return nil
+}
@@ -26,21 +26,27 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 const (
@@ -384,6 +390,93 @@ func (suite *JobSuite) TestLoadCollectionWithReplicas() {
 	}
 }
 
+func (suite *JobSuite) TestLoadWithDatabaseDefaults() {
+	ctx := context.Background()
+
+	suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "DescribeCollection"
+	})
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).
+		Return(&milvuspb.DescribeCollectionResponse{DbName: "db1"}, nil)
+	suite.broker.EXPECT().DescribeDatabase(mock.Anything, "db1").
+		Return(&internalpb.DescribeDatabaseResponse{
+			DbName:     "db1",
+			Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "5"}},
+		}, nil)
+	defer func() {
+		suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "DescribeCollection" && call.Method != "DescribeDatabase"
+		})
+		suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(nil, nil)
+	}()
+
+	for _, collection := range suite.collections {
+		// the replica number of database is used, there are not enough nodes for 5 replicas
+		var job Job
+		if suite.loadTypes[collection] == querypb.LoadType_LoadCollection {
+			req := &querypb.LoadCollectionRequest{
+				CollectionID: collection,
+			}
+			job = NewLoadCollectionJob(
+				ctx,
+				req,
+				suite.dist,
+				suite.meta,
+				suite.broker,
+				suite.cluster,
+				suite.targetMgr,
+				suite.targetObserver,
+				suite.nodeMgr,
+			)
+		} else {
+			req := &querypb.LoadPartitionsRequest{
+				CollectionID: collection,
+				PartitionIDs: suite.partitions[collection],
+			}
+			job = NewLoadPartitionJob(
+				ctx,
+				req,
+				suite.dist,
+				suite.meta,
+				suite.broker,
+				suite.cluster,
+				suite.targetMgr,
+				suite.targetObserver,
+				suite.nodeMgr,
+			)
+		}
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.ErrorContains(err, meta.ErrNodeNotEnough.Error())
+	}
+
+	// the replica number of request overrides the one of database
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 1,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.NoError(err)
+		suite.EqualValues(1, suite.meta.GetReplicaNumber(collection))
+	}
+}
+
 func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 	ctx := context.Background()
 
@@ -1399,6 +1492,48 @@ func (suite *JobSuite) TestSyncNewCreatedPartition() {
 	suite.NoError(err)
 }
 
+func (suite *JobSuite) TestUpdateSchema() {
+	suite.loadAll()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, Name: "pk"}, {FieldID: 101, Name: "added"}},
+	}
+
+	// test update the schema on the nodes of the loaded collection
+	replicaNodes := suite.meta.ReplicaManager.GetByCollection(suite.collections[0])[0].GetNodes()
+	nodes := typeutil.NewConcurrentSet[int64]()
+	suite.cluster.EXPECT().UpdateSchema(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, node int64, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+			suite.Equal(schema, req.GetSchema())
+			nodes.Insert(node)
+			return merr.Success(), nil
+		}).Times(len(replicaNodes))
+	job := NewUpdateSchemaJob(context.Background(), &querypb.UpdateSchemaRequest{
+		CollectionID: suite.collections[0],
+		Schema:       schema,
+	}, suite.meta, suite.cluster)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+	suite.ElementsMatch(replicaNodes, nodes.Collect())
+
+	// test collection not loaded
+	job = NewUpdateSchemaJob(context.Background(), &querypb.UpdateSchemaRequest{
+		CollectionID: int64(888),
+		Schema:       schema,
+	}, suite.meta, suite.cluster)
+	suite.scheduler.Add(job)
+	suite.NoError(job.Wait())
+
+	// test update the schema failed
+	suite.cluster.EXPECT().UpdateSchema(mock.Anything, mock.Anything, mock.Anything).
+		Return(merr.Status(merr.WrapErrServiceInternal("mocked")), nil).Once()
+	job = NewUpdateSchemaJob(context.Background(), &querypb.UpdateSchemaRequest{
+		CollectionID: suite.collections[0],
+		Schema:       schema,
+	}, suite.meta, suite.cluster)
+	suite.scheduler.Add(job)
+	suite.Error(job.Wait())
+}
+
 func (suite *JobSuite) loadAll() {
 	ctx := context.Background()
 	for _, collection := range suite.collections {
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -62,6 +63,61 @@ func waitCollectionReleased(dist *meta.DistributionManager, checkerController *c
 	}
 }
 
+// getDatabaseLoadDefaults returns the default replica number and resource groups of the loads set by the properties
+// of the database of the collection, zero and nil if they are not set.
+func getDatabaseLoadDefaults(ctx context.Context, broker meta.Broker, collection int64) (int32, []string, error) {
+	collectionInfo, err := broker.DescribeCollection(ctx, collection)
+	if err != nil {
+		return 0, nil, err
+	}
+	if collectionInfo.GetDbName() == "" {
+		return 0, nil, nil
+	}
+	db, err := broker.DescribeDatabase(ctx, collectionInfo.GetDbName())
+	if err != nil {
+		return 0, nil, err
+	}
+	replicaNumber, err := common.GetDatabaseReplicaNumber(db.GetProperties()...)
+	if err != nil {
+		return 0, nil, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	resourceGroups, err := common.GetDatabaseResourceGroups(db.GetProperties()...)
+	if err != nil {
+		return 0, nil, merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	return replicaNumber, resourceGroups, nil
+}
+
+// applyDatabaseLoadDefaults fills the replica number and resource groups not indicated by the load request with the
+// defaults of the database, the replica number falls back to 1.
+func applyDatabaseLoadDefaults(ctx context.Context, broker meta.Broker, collection int64, replicaNumber *int32, resourceGroups *[]string) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collection))
+	if *replicaNumber <= 0 || len(*resourceGroups) == 0 {
+		dbReplicaNumber, dbResourceGroups, err := getDatabaseLoadDefaults(ctx, broker, collection)
+		if err != nil {
+			log.Warn("failed to get the load defaults of database", zap.Error(err))
+			return err
+		}
+		if *replicaNumber <= 0 && dbReplicaNumber > 0 {
+			log.Info("request doesn't indicate the number of replicas, use the default of database",
+				zap.Int32("replicaNumber", dbReplicaNumber))
+			*replicaNumber = dbReplicaNumber
+		}
+		if len(*resourceGroups) == 0 && len(dbResourceGroups) > 0 {
+			log.Info("request doesn't indicate the resource groups, use the default of database",
+				zap.Strings("resourceGroups", dbResourceGroups))
+			*resourceGroups = dbResourceGroups
+		}
+	}
+
+	if *replicaNumber <= 0 {
+		log.Info("request doesn't indicate the number of replicas, set it to 1",
+			zap.Int32("replicaNumber", *replicaNumber))
+		*replicaNumber = 1
+	}
+	return nil
+}
+
 func loadPartitions(ctx context.Context,
 	meta *meta.Meta,
 	cluster session.Cluster,
@@ -31,7 +31,9 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -98,6 +100,49 @@ func (s *CoordinatorBrokerRootCoordSuite) TestGetCollectionSchema() {
 	})
 }
 
+func (s *CoordinatorBrokerRootCoordSuite) TestDescribeDatabase() {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.Run("normal case", func() {
+		s.rootcoord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).
+			Return(&internalpb.DescribeDatabaseResponse{
+				Status: merr.Success(),
+				DbName: "db1",
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.DatabaseReplicaNumberKey, Value: "2"},
+				},
+			}, nil)
+
+		resp, err := s.broker.DescribeDatabase(ctx, "db1")
+		s.NoError(err)
+		s.Equal("db1", resp.GetDbName())
+		s.Len(resp.GetProperties(), 1)
+		s.resetMock()
+	})
+
+	s.Run("rootcoord_return_error", func() {
+		s.rootcoord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).
+			Return(nil, errors.New("mock error"))
+
+		_, err := s.broker.DescribeDatabase(ctx, "db1")
+		s.Error(err)
+		s.resetMock()
+	})
+
+	s.Run("return_failure_status", func() {
+		s.rootcoord.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).
+			Return(&internalpb.DescribeDatabaseResponse{
+				Status: merr.Status(merr.WrapErrDatabaseNotFound("db1")),
+			}, nil)
+
+		_, err := s.broker.DescribeDatabase(ctx, "db1")
+		s.ErrorIs(err, merr.ErrDatabaseNotFound)
+		s.resetMock()
+	})
+}
+
 func (s *CoordinatorBrokerRootCoordSuite) TestGetPartitions() {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -546,6 +546,31 @@ func (s *Server) SyncNewCreatedPartition(ctx context.Context, req *querypb.SyncN
 	return merr.Success(), nil
 }
 
+// UpdateSchema pushes the schema with the fields added to the QueryNodes if the collection is loaded.
+func (s *Server) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("received update schema request", zap.Int("fieldNum", len(req.GetSchema().GetFields())))
+
+	failedMsg := "failed to update schema"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	updateJob := job.NewUpdateSchemaJob(ctx, req, s.meta, s.cluster)
+	s.jobScheduler.Add(updateJob)
+	err := updateJob.Wait()
+	if err != nil {
+		log.Warn(failedMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
 // refreshCollection must be called after loading a collection. It looks for new segments that are not loaded yet and
 // tries to load them up. It returns when all segments of the given collection are loaded, or when error happens.
 // Note that a collection's loading progress always stays at 100% after a successful load and will not get updated
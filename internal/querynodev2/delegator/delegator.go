@@ -30,7 +30,9 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator/deletebuffer"
@@ -50,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // ShardDelegator is the interface definition.
@@ -208,6 +211,8 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		log.Warn("delegator search failed to wait tsafe", zap.Error(err))
 		return nil, err
 	}
+	// the data visible to this search is consumed up to the tsafe at least
+	serviceableTs := sd.latestTsafe.Load()
 	metrics.QueryNodeSQLatencyWaitTSafe.WithLabelValues(
 		fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel).
 		Observe(float64(waitTr.ElapseSpan().Milliseconds()))
@@ -252,6 +257,9 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		log.Warn("Delegator search failed", zap.Error(err))
 		return nil, err
 	}
+	for _, result := range results {
+		result.ServiceableTs = serviceableTs
+	}
 
 	log.Debug("Delegator search done")
 
@@ -351,6 +359,11 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		log.Warn("delegator query failed to wait tsafe", zap.Error(err))
 		return nil, err
 	}
+	// the data visible to this query is consumed up to the tsafe, and bounded by the mvcc timestamp
+	serviceableTs := sd.latestTsafe.Load()
+	if mvccTs := req.GetReq().GetMvccTimestamp(); mvccTs > 0 && mvccTs < serviceableTs {
+		serviceableTs = mvccTs
+	}
 	metrics.QueryNodeSQLatencyWaitTSafe.WithLabelValues(
 		fmt.Sprint(paramtable.GetNodeID()), metrics.QueryLabel).
 		Observe(float64(waitTr.ElapseSpan().Milliseconds()))
@@ -368,6 +381,7 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
 	}
+	sealed, growing = sd.prunePointQuerySegments(req.GetReq().GetSerializedExprPlan(), sealed, growing)
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
 	log.Debug("query segments...",
@@ -387,12 +401,81 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 		log.Warn("Delegator query failed", zap.Error(err))
 		return nil, err
 	}
+	for _, result := range results {
+		result.ServiceableTs = serviceableTs
+	}
 
 	log.Debug("Delegator Query done")
 
 	return results, nil
 }
 
+// prunePointQuerySegments skips the segments which cannot contain any of the primary keys
+// if the query filters by primary keys only, the segments without registered bloom filters are kept.
+func (sd *shardDelegator) prunePointQuerySegments(serializedPlan []byte, sealed []SnapshotItem, growing []SegmentEntry) ([]SnapshotItem, []SegmentEntry) {
+	pks := getPrimaryKeysFromPlan(serializedPlan)
+	if len(pks) == 0 {
+		return sealed, growing
+	}
+
+	candidates := typeutil.NewUniqueSet()
+	for _, pk := range pks {
+		segmentIDs, err := sd.pkOracle.Get(pk)
+		if err != nil {
+			return sealed, growing
+		}
+		candidates.Insert(segmentIDs...)
+	}
+
+	keep := func(entry SegmentEntry, state commonpb.SegmentState, workerID int64) bool {
+		if candidates.Contain(entry.SegmentID) {
+			return true
+		}
+		return !sd.pkOracle.Exists(pkoracle.NewCandidateKey(entry.SegmentID, entry.PartitionID, state), workerID)
+	}
+	// sealed items are shared with the distribution snapshot, build new ones instead of modifying them
+	sealed = lo.Map(sealed, func(item SnapshotItem, _ int) SnapshotItem {
+		return SnapshotItem{
+			NodeID: item.NodeID,
+			Segments: lo.Filter(item.Segments, func(entry SegmentEntry, _ int) bool {
+				return keep(entry, commonpb.SegmentState_Sealed, item.NodeID)
+			}),
+		}
+	})
+	growing = lo.Filter(growing, func(entry SegmentEntry, _ int) bool {
+		return keep(entry, commonpb.SegmentState_Growing, paramtable.GetNodeID())
+	})
+	return sealed, growing
+}
+
+// getPrimaryKeysFromPlan returns the primary keys if the predicate of the retrieve plan is a primary key term expression.
+func getPrimaryKeysFromPlan(serializedPlan []byte) []storage.PrimaryKey {
+	if len(serializedPlan) == 0 {
+		return nil
+	}
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil
+	}
+	termExpr := plan.GetQuery().GetPredicates().GetTermExpr()
+	if termExpr == nil || !termExpr.GetColumnInfo().GetIsPrimaryKey() || len(termExpr.GetColumnInfo().GetNestedPath()) > 0 {
+		return nil
+	}
+
+	pks := make([]storage.PrimaryKey, 0, len(termExpr.GetValues()))
+	for _, value := range termExpr.GetValues() {
+		switch termExpr.GetColumnInfo().GetDataType() {
+		case schemapb.DataType_Int64:
+			pks = append(pks, storage.NewInt64PrimaryKey(value.GetInt64Val()))
+		case schemapb.DataType_VarChar:
+			pks = append(pks, storage.NewVarCharPrimaryKey(value.GetStringVal()))
+		default:
+			return nil
+		}
+	}
+	return pks
+}
+
 // GetStatistics returns statistics aggregated by delegator.
 func (sd *shardDelegator) GetStatistics(ctx context.Context, req *querypb.GetStatisticsRequest) ([]*internalpb.GetStatisticsResponse, error) {
 	log := sd.getLogger(ctx)
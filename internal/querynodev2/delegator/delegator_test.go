@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -34,11 +35,14 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
+	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tsafe"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -1180,3 +1184,109 @@ func TestDelegatorTSafeListenerClosed(t *testing.T) {
 	assert.Equal(t, sd.Serviceable(), false)
 	assert.Equal(t, sd.Stopped(), true)
 }
+
+func TestGetPrimaryKeysFromPlan(t *testing.T) {
+	marshal := func(expr *planpb.Expr) []byte {
+		bs, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: expr}},
+		})
+		require.NoError(t, err)
+		return bs
+	}
+	termExpr := func(dataType schemapb.DataType, isPK bool, values ...*planpb.GenericValue) *planpb.Expr {
+		return &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100, DataType: dataType, IsPrimaryKey: isPK},
+			Values:     values,
+		}}}
+	}
+
+	assert.Empty(t, getPrimaryKeysFromPlan(nil))
+	assert.Empty(t, getPrimaryKeysFromPlan([]byte("invalid")))
+	assert.Empty(t, getPrimaryKeysFromPlan(marshal(nil)))
+	assert.Empty(t, getPrimaryKeysFromPlan(marshal(termExpr(schemapb.DataType_Int64, false,
+		&planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}}))))
+
+	pks := getPrimaryKeysFromPlan(marshal(termExpr(schemapb.DataType_Int64, true,
+		&planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 1}},
+		&planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: 2}})))
+	assert.Equal(t, []storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)}, pks)
+
+	pks = getPrimaryKeysFromPlan(marshal(termExpr(schemapb.DataType_VarChar, true,
+		&planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: "a"}})))
+	assert.Equal(t, []storage.PrimaryKey{storage.NewVarCharPrimaryKey("a")}, pks)
+}
+
+func TestPrunePointQuerySegments(t *testing.T) {
+	paramtable.Init()
+	nodeID := paramtable.GetNodeID()
+
+	plan := func(pks ...int64) []byte {
+		values := lo.Map(pks, func(pk int64, _ int) *planpb.GenericValue {
+			return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}}
+		})
+		bs, err := proto.Marshal(&planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: &planpb.Expr{
+				Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+					ColumnInfo: &planpb.ColumnInfo{FieldId: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+					Values:     values,
+				}},
+			}}},
+		})
+		require.NoError(t, err)
+		return bs
+	}
+
+	// segment 1 (sealed on worker 1) holds pk 1, segment 2 (sealed on worker 2) holds pk 2,
+	// segment 3 (growing) holds pk 3, segment 4 (sealed on worker 1) has no bloom filter registered
+	oracle := pkoracle.NewPkOracle()
+	register := func(segmentID int64, state commonpb.SegmentState, workerID int64, pks ...int64) {
+		bfs := pkoracle.NewBloomFilterSet(segmentID, 10, state)
+		bfs.UpdateBloomFilter(lo.Map(pks, func(pk int64, _ int) storage.PrimaryKey { return storage.NewInt64PrimaryKey(pk) }))
+		require.NoError(t, oracle.Register(bfs, workerID))
+	}
+	register(1, commonpb.SegmentState_Sealed, 1, 1)
+	register(2, commonpb.SegmentState_Sealed, 2, 2)
+	register(3, commonpb.SegmentState_Growing, nodeID, 3)
+	sd := &shardDelegator{pkOracle: oracle}
+
+	newSealed := func() []SnapshotItem {
+		return []SnapshotItem{
+			{NodeID: 1, Segments: []SegmentEntry{{SegmentID: 1, PartitionID: 10}, {SegmentID: 4, PartitionID: 10}}},
+			{NodeID: 2, Segments: []SegmentEntry{{SegmentID: 2, PartitionID: 10}}},
+		}
+	}
+	growing := []SegmentEntry{{SegmentID: 3, PartitionID: 10}}
+	segmentIDs := func(sealed []SnapshotItem, growing []SegmentEntry) ([]int64, []int64) {
+		sealedIDs := make([]int64, 0)
+		for _, item := range sealed {
+			for _, entry := range item.Segments {
+				sealedIDs = append(sealedIDs, entry.SegmentID)
+			}
+		}
+		return sealedIDs, lo.Map(growing, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })
+	}
+
+	cases := []struct {
+		name          string
+		plan          []byte
+		expectSealed  []int64
+		expectGrowing []int64
+	}{
+		{"no plan", nil, []int64{1, 4, 2}, []int64{3}},
+		{"pk in sealed segment", plan(1), []int64{1, 4}, []int64{}},
+		{"pk in growing segment", plan(3), []int64{4}, []int64{3}},
+		{"pks in several segments", plan(1, 2, 3), []int64{1, 4, 2}, []int64{3}},
+		{"pk not exist", plan(100), []int64{4}, []int64{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			sealed := newSealed()
+			prunedSealed, prunedGrowing := sd.prunePointQuerySegments(c.plan, sealed, growing)
+			sealedIDs, growingIDs := segmentIDs(prunedSealed, prunedGrowing)
+			assert.ElementsMatch(t, c.expectSealed, sealedIDs)
+			assert.ElementsMatch(t, c.expectGrowing, growingIDs)
+			// the snapshot items shared with the distribution are left untouched
+			assert.Equal(t, newSealed(), sealed)
+		})
+	}
+}
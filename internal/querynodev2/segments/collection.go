@@ -73,7 +73,7 @@ func (m *collectionManager) PutOrRef(collectionID int64, schema *schemapb.Collec
 
 	if collection, ok := m.collections[collectionID]; ok {
 		// the schema may be changed even the collection is loaded
-		collection.schema.Store(schema)
+		collection.UpdateSchema(schema)
 		collection.Ref(1)
 		return
 	}
@@ -137,6 +137,39 @@ func (c *Collection) Schema() *schemapb.CollectionSchema {
 	return c.schema.Load()
 }
 
+// UpdateSchema replaces the schema of the collection, the segments created after
+// see the fields added to the collection, the loaded ones need to add them.
+func (c *Collection) UpdateSchema(schema *schemapb.CollectionSchema) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	old := c.schema.Load()
+	// ignore the stale schema, the fields are only appended
+	if schema == nil || len(schema.GetFields()) < len(old.GetFields()) {
+		return
+	}
+	if len(schema.GetFields()) == len(old.GetFields()) {
+		c.schema.Store(schema)
+		return
+	}
+
+	schemaBlob, err := proto.Marshal(schema)
+	if err != nil {
+		log.Warn("marshal schema failed", zap.Int64("collectionID", c.id), zap.Error(err))
+		return
+	}
+	if c.collectionPtr == nil {
+		return
+	}
+	status := C.UpdateSchema(c.collectionPtr, unsafe.Pointer(&schemaBlob[0]), (C.int64_t)(len(schemaBlob)))
+	if err := HandleCStatus(&status, "UpdateSchema failed"); err != nil {
+		log.Warn("failed to update the schema of collection", zap.Int64("collectionID", c.id), zap.Error(err))
+		return
+	}
+	c.schema.Store(schema)
+	log.Info("schema of collection updated", zap.Int64("collectionID", c.id), zap.Int("fieldNum", len(schema.GetFields())))
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) GetPartitions() []int64 {
 	return c.partitions.Collect()
@@ -106,6 +106,7 @@ func retrieveOnSegmentsWithStream(ctx context.Context, segments []Segment, segTy
 				Status:     merr.Success(),
 				Ids:        result.GetIds(),
 				FieldsData: result.GetFieldsData(),
+				SegmentID:  segment.ID(),
 			}); err != nil {
 				errs[i] = err
 			}
@@ -720,6 +720,58 @@ func (s *LocalSegment) LoadFieldData(fieldID int64, rowCount int64, field *datap
 	return nil
 }
 
+// AddFields adds the fields appended to the collection after the segment written,
+// the rows of the segment get the default values of the fields.
+func (s *LocalSegment) AddFields(collection *Collection, fields []*schemapb.FieldSchema) error {
+	// blocks the inserts and reads until all the fields added
+	s.ptrLock.Lock()
+	defer s.ptrLock.Unlock()
+
+	if s.ptr == nil {
+		return merr.WrapErrSegmentNotLoaded(s.segmentID, "segment released")
+	}
+
+	collection.mu.RLock()
+	defer collection.mu.RUnlock()
+	if collection.collectionPtr == nil {
+		return merr.WrapErrCollectionNotLoaded(collection.ID())
+	}
+
+	for _, field := range fields {
+		defaultValue := field.GetDefaultValue()
+		if defaultValue == nil {
+			defaultValue = &schemapb.ValueField{}
+		}
+		defaultValueBlob, err := proto.Marshal(defaultValue)
+		if err != nil {
+			return err
+		}
+		var blobPtr unsafe.Pointer
+		if len(defaultValueBlob) > 0 {
+			blobPtr = unsafe.Pointer(&defaultValueBlob[0])
+		}
+
+		var status C.CStatus
+		GetLoadPool().Submit(func() (any, error) {
+			status = C.LoadFieldDefaultData(s.ptr, collection.collectionPtr, C.int64_t(field.GetFieldID()),
+				blobPtr, C.int64_t(len(defaultValueBlob)))
+			return nil, nil
+		}).Await()
+		if err := HandleCStatus(&status, "LoadFieldDefaultData failed"); err != nil {
+			return err
+		}
+	}
+	s.memSize.Store(-1)
+
+	log.Debug("add fields to segment done",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("segmentID", s.ID()),
+		zap.String("segmentType", s.Type().String()),
+		zap.Int("fieldNum", len(fields)),
+	)
+	return nil
+}
+
 func (s *LocalSegment) AddFieldDataInfo(rowCount int64, fields []*datapb.FieldBinlog) error {
 	s.ptrLock.RLock()
 	defer s.ptrLock.RUnlock()
@@ -573,6 +573,9 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo.GetNumOfRows()); err != nil {
 			return err
 		}
+		if err := loader.loadDefaultFields(ctx, collection, segment, loadInfo); err != nil {
+			return err
+		}
 		if err := segment.AddFieldDataInfo(loadInfo.GetNumOfRows(), loadInfo.GetBinlogPaths()); err != nil {
 			return err
 		}
@@ -653,6 +656,30 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 	return nil
 }
 
+// loadDefaultFields fills the fields added to the collection after the segment written,
+// whose binlogs don't exist, with their default values.
+func (loader *segmentLoader) loadDefaultFields(ctx context.Context, collection *Collection, segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) error {
+	if loadInfo.GetNumOfRows() == 0 {
+		return nil
+	}
+	binlogFields := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		binlogFields.Insert(fieldBinlog.GetFieldID())
+	}
+
+	fields := lo.Filter(collection.Schema().GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID && !binlogFields.Contain(field.GetFieldID())
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+	log.Ctx(ctx).Info("load default data for the fields added after the segment written",
+		zap.Int64("collection", segment.collectionID),
+		zap.Int64("segment", segment.segmentID),
+		zap.Int64s("fieldIDs", lo.Map(fields, func(field *schemapb.FieldSchema, _ int) int64 { return field.GetFieldID() })))
+	return segment.AddFields(collection, fields)
+}
+
 func (loader *segmentLoader) loadFieldsIndex(ctx context.Context,
 	schema *schemapb.CollectionSchema,
 	segment *LocalSegment,
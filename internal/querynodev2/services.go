@@ -43,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -835,6 +836,7 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		failRet.Status = merr.Status(err)
 		return failRet, nil
 	}
+	result.ServiceableTs = typeutil2.MinServiceableTs(toReduceResults)
 	reduceLatency := tr.RecordSpan()
 	metrics.QueryNodeReduceLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel, metrics.ReduceShards).
 		Observe(float64(reduceLatency.Milliseconds()))
@@ -1000,6 +1002,7 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 			Status: merr.Status(err),
 		}, nil
 	}
+	ret.ServiceableTs = typeutil2.MinServiceableTs(toMergeResults)
 	reduceLatency := tr.RecordSpan()
 	metrics.QueryNodeReduceLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.QueryLabel, metrics.ReduceShards).
 		Observe(float64(reduceLatency.Milliseconds()))
@@ -1458,3 +1461,63 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 
 	return merr.Success(), nil
 }
+
+// UpdateSchema updates the schema of the loaded collection,
+// the loaded segments, including the growing ones, fill the added fields with their default values.
+func (node *QueryNode) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("fieldNum", len(req.GetSchema().GetFields())),
+	)
+
+	// check node healthy
+	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
+		return merr.Status(err), nil
+	}
+	defer node.lifetime.Done()
+
+	// check target matches
+	if err := merr.CheckTargetID(req.GetBase()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log.Info("received update schema request")
+	if err := node.updateSchema(req.GetCollectionID(), req.GetSchema()); err != nil {
+		log.Warn("failed to update schema", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// updateSchema replaces the schema of the collection if it's loaded,
+// and adds the fields appended to it to the loaded segments.
+func (node *QueryNode) updateSchema(collectionID int64, schema *schemapb.CollectionSchema) error {
+	collection := node.manager.Collection.Get(collectionID)
+	if collection == nil {
+		return nil
+	}
+	collection.UpdateSchema(schema)
+
+	// the segments skip the fields they have, so the retried request
+	// adds the fields to the segments missed by the failed one
+	fields := lo.Filter(collection.Schema().GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return field.GetFieldID() >= common.StartOfUserFieldID && field.GetDefaultValue() != nil
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+	loaded := node.manager.Segment.GetBy(func(segment segments.Segment) bool {
+		return segment.Collection() == collectionID
+	})
+	for _, segment := range loaded {
+		localSegment, ok := segment.(*segments.LocalSegment)
+		if !ok {
+			// the l0 segments only hold the deletions
+			continue
+		}
+		if err := localSegment.AddFields(collection, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
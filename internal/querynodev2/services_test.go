@@ -2037,6 +2037,50 @@ func (suite *ServiceSuite) TestDelete_Failed() {
 	suite.Equal(commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
 }
 
+func (suite *ServiceSuite) TestUpdateSchema() {
+	ctx := context.Background()
+	// prepare
+	suite.TestWatchDmChannelsInt64()
+	suite.TestLoadSegments_Int64()
+
+	schema := typeutil.Clone(suite.node.manager.Collection.Get(suite.collectionID).Schema())
+	maxFieldID := lo.MaxBy(schema.GetFields(), func(a, b *schemapb.FieldSchema) bool {
+		return a.GetFieldID() > b.GetFieldID()
+	}).GetFieldID()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      maxFieldID + 1,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	})
+	req := &querypb.UpdateSchemaRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:    rand.Int63(),
+			TargetID: suite.node.session.ServerID,
+		},
+		CollectionID: suite.collectionID,
+		Schema:       schema,
+	}
+	status, err := suite.node.UpdateSchema(ctx, req)
+	suite.NoError(merr.CheckRPCCall(status, err))
+	suite.Len(suite.node.manager.Collection.Get(suite.collectionID).Schema().GetFields(), len(schema.GetFields()))
+
+	// the retried request is idempotent
+	status, err = suite.node.UpdateSchema(ctx, req)
+	suite.NoError(merr.CheckRPCCall(status, err))
+
+	// collection not loaded
+	req.CollectionID = -1
+	status, err = suite.node.UpdateSchema(ctx, req)
+	suite.NoError(merr.CheckRPCCall(status, err))
+
+	// target not match
+	req.Base.TargetID = -1
+	status, err = suite.node.UpdateSchema(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_NodeIDNotMatch, status.GetErrorCode())
+}
+
 func (suite *ServiceSuite) TestLoadPartition() {
 	ctx := context.Background()
 	req := &querypb.LoadPartitionsRequest{
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	parameterutil "github.com/milvus-io/milvus/pkg/util/parameterutil.go"
+)
+
+// addCollectionFieldTask appends a scalar field to the schema of the collection. The existing segments aren't
+// rewritten, the rows inserted before read the default value of the field.
+type addCollectionFieldTask struct {
+	baseTask
+	Req *internalpb.AddCollectionFieldRequest
+}
+
+func (a *addCollectionFieldTask) GetLocks() []ddlLock {
+	return newCollectionLocks(a.Req.GetDbName(), a.Req.GetCollectionName(), true)
+}
+
+func (a *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if a.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("add collection field failed, collection name is empty")
+	}
+	if a.Req.GetField() == nil {
+		return merr.WrapErrParameterInvalidMsg("add collection field failed, field schema is empty")
+	}
+	return nil
+}
+
+func (a *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := a.core.meta.GetCollectionByName(ctx, a.Req.GetDbName(), a.Req.GetCollectionName(), a.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding collection field",
+			zap.String("collectionName", a.Req.GetCollectionName()), zap.Uint64("ts", a.ts))
+		return err
+	}
+
+	field, err := a.prepareField(oldColl)
+	if err != nil {
+		return err
+	}
+	newColl := oldColl.Clone()
+	newColl.Fields = append(newColl.Fields, field)
+	newColl.SchemaVersion++
+
+	ts := a.GetTs()
+	redoTask := newBaseRedoTask(a.core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: a.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	// datacoord seals the growing segments with the schema before the proxies insert the new field
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: a.core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         a.Req.GetDbName(),
+			CollectionName: oldColl.Name,
+			CollectionID:   oldColl.CollectionID,
+			Properties:     newColl.Properties,
+		},
+		core: a.core,
+	})
+
+	// the loaded segments read the default value of the field before the proxies search it
+	redoTask.AddSyncStep(&updateSchemaStep{
+		baseStep:     baseStep{core: a.core},
+		collectionID: oldColl.CollectionID,
+		schema: &schemapb.CollectionSchema{
+			Name:               newColl.Name,
+			Description:        newColl.Description,
+			AutoID:             newColl.AutoID,
+			Fields:             model.MarshalFieldModels(newColl.Fields),
+			EnableDynamicField: newColl.EnableDynamicField,
+		},
+	})
+
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: a.core},
+		dbName:          a.Req.GetDbName(),
+		collectionNames: []string{oldColl.Name},
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+	})
+
+	return redoTask.Execute(ctx)
+}
+
+// prepareField validates the field to add and assigns the next field id to it.
+func (a *addCollectionFieldTask) prepareField(coll *model.Collection) (*model.Field, error) {
+	fieldSchema := a.Req.GetField()
+	if fieldSchema.GetName() == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("the name of the field to add is empty")
+	}
+	if funcutil.SliceContain([]string{RowIDFieldName, TimeStampFieldName, MetaFieldName}, fieldSchema.GetName()) {
+		return nil, merr.WrapErrParameterInvalidMsg("can't add the system field %s", fieldSchema.GetName())
+	}
+	maxFieldID := int64(StartOfUserFieldID - 1)
+	for _, field := range coll.Fields {
+		if field.Name == fieldSchema.GetName() {
+			return nil, merr.WrapErrParameterInvalidMsg("field %s already exists in collection %s", field.Name, coll.Name)
+		}
+		if field.FieldID > maxFieldID {
+			maxFieldID = field.FieldID
+		}
+	}
+	if fieldSchema.GetIsPrimaryKey() || fieldSchema.GetAutoID() || fieldSchema.GetIsPartitionKey() || fieldSchema.GetIsDynamic() {
+		return nil, merr.WrapErrParameterInvalidMsg("the added field can't be a primary key, partition key or dynamic field")
+	}
+
+	switch fieldSchema.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+	case schemapb.DataType_VarChar:
+		if _, err := parameterutil.GetMaxLength(fieldSchema); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("only the scalar fields which support default value could be added, got %s",
+			fieldSchema.GetDataType().String())
+	}
+
+	// the rows inserted before have no value of the field, they read the default value instead
+	if fieldSchema.GetDefaultValue() == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("the added field %s must have a default value", fieldSchema.GetName())
+	}
+	if err := checkDefaultValue(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{fieldSchema}}); err != nil {
+		return nil, err
+	}
+
+	return &model.Field{
+		FieldID:      maxFieldID + 1,
+		Name:         fieldSchema.GetName(),
+		Description:  fieldSchema.GetDescription(),
+		DataType:     fieldSchema.GetDataType(),
+		TypeParams:   fieldSchema.GetTypeParams(),
+		IndexParams:  fieldSchema.GetIndexParams(),
+		DefaultValue: fieldSchema.GetDefaultValue(),
+		State:        schemapb.FieldState_FieldCreated,
+	}, nil
+}
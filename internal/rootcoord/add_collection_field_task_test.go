@@ -0,0 +1,261 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	t.Run("empty collection name", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{Field: &schemapb.FieldSchema{Name: "f"}}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty field", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{CollectionName: "cn"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{
+			CollectionName: "cn",
+			Field:          &schemapb.FieldSchema{Name: "f"},
+		}}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_addCollectionFieldTask_prepareField(t *testing.T) {
+	coll := &model.Collection{
+		Name: "cn",
+		Fields: []*model.Field{
+			{FieldID: RowIDField, Name: RowIDFieldName},
+			{FieldID: TimeStampField, Name: TimeStampFieldName},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	newTask := func(field *schemapb.FieldSchema) *addCollectionFieldTask {
+		return &addCollectionFieldTask{Req: &internalpb.AddCollectionFieldRequest{CollectionName: "cn", Field: field}}
+	}
+
+	invalidFields := []*schemapb.FieldSchema{
+		{Name: "", DataType: schemapb.DataType_Int64},
+		{Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+		{Name: "pk", DataType: schemapb.DataType_Int64},
+		{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{Name: "f", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+		{Name: "f", DataType: schemapb.DataType_FloatVector},
+		{Name: "f", DataType: schemapb.DataType_JSON},
+		{Name: "f", DataType: schemapb.DataType_VarChar},
+		{Name: "f", DataType: schemapb.DataType_Int32},
+		{
+			Name:         "f",
+			DataType:     schemapb.DataType_Int32,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+		},
+	}
+	for _, field := range invalidFields {
+		_, err := newTask(field).prepareField(coll)
+		assert.Error(t, err, field.String())
+	}
+
+	field, err := newTask(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 3}},
+	}).prepareField(coll)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(102), field.FieldID)
+	assert.Equal(t, int32(3), field.DefaultValue.GetIntData())
+
+	field, err = newTask(&schemapb.FieldSchema{
+		Name:         "f",
+		DataType:     schemapb.DataType_VarChar,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}},
+	}).prepareField(coll)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", field.DefaultValue.GetStringData())
+	assert.Equal(t, schemapb.FieldState_FieldCreated, field.State)
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+		}
+	}
+	req := &internalpb.AddCollectionFieldRequest{
+		CollectionName: "cn",
+		Field: &schemapb.FieldSchema{
+			Name:         "f",
+			DataType:     schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+		},
+	}
+
+	t.Run("failed to get collection", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      req,
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &internalpb.AddCollectionFieldRequest{
+				CollectionName: "cn",
+				Field:          &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("alter step failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(errors.New("err"))
+
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      req,
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("update schema step failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(nil)
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		broker.UpdateSchemaFunc = func(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+			return errors.New("err")
+		}
+
+		core := newTestCore(withMeta(meta), withBroker(broker))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      req,
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(newColl(), nil)
+		var altered *model.Collection
+		meta.On("AlterCollection",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Run(func(args mock.Arguments) {
+			altered = args.Get(2).(*model.Collection)
+		}).Return(nil)
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			return nil
+		}
+		var updated *schemapb.CollectionSchema
+		broker.UpdateSchemaFunc = func(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+			updated = schema
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      req,
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, altered.Fields, 2)
+		assert.Len(t, updated.GetFields(), 2)
+		assert.Equal(t, int64(7), updated.GetFields()[1].GetDefaultValue().GetLongData())
+		assert.Equal(t, int64(101), altered.Fields[1].FieldID)
+		assert.Equal(t, int32(1), altered.SchemaVersion)
+	})
+}
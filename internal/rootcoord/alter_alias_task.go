@@ -28,6 +28,13 @@ type alterAliasTask struct {
 	Req *milvuspb.AlterAliasRequest
 }
 
+func (t *alterAliasTask) GetLocks() []ddlLock {
+	return []ddlLock{
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetAlias(), true),
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), false),
+	}
+}
+
 func (t *alterAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterAlias); err != nil {
 		return err
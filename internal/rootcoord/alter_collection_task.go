@@ -34,6 +34,10 @@ type alterCollectionTask struct {
 	Req *milvuspb.AlterCollectionRequest
 }
 
+func (a *alterCollectionTask) GetLocks() []ddlLock {
+	return newCollectionLocks(a.Req.GetDbName(), a.Req.GetCollectionName(), true)
+}
+
 func (a *alterCollectionTask) Prepare(ctx context.Context) error {
 	if a.Req.GetCollectionName() == "" {
 		return fmt.Errorf("alter collection failed, collection name does not exists")
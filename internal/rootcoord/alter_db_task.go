@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type alterDatabaseTask struct {
+	baseTask
+	Req *internalpb.AlterDatabaseRequest
+}
+
+func (t *alterDatabaseTask) GetLocks() []ddlLock {
+	locks := []ddlLock{newDatabaseLock(t.Req.GetDbName(), true)}
+	if t.Req.GetNewDbName() != "" {
+		locks = append(locks, newDatabaseLock(t.Req.GetNewDbName(), true))
+	}
+	return locks
+}
+
+func (t *alterDatabaseTask) Prepare(ctx context.Context) error {
+	if t.Req.GetDbName() == "" {
+		return merr.WrapErrParameterInvalidMsg("database name is empty")
+	}
+	if t.Req.GetNewDbName() == "" && len(t.Req.GetProperties()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("nothing to alter for database %s", t.Req.GetDbName())
+	}
+	if err := common.ValidateDatabaseProperties(t.Req.GetProperties()...); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+	return nil
+}
+
+func (t *alterDatabaseTask) Execute(ctx context.Context) error {
+	if len(t.Req.GetProperties()) > 0 {
+		oldDB, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), t.GetTs())
+		if err != nil {
+			return err
+		}
+		newDB := oldDB.Clone()
+		updateDatabaseProperties(newDB, t.Req.GetProperties())
+		if err := t.core.meta.AlterDatabase(ctx, oldDB, newDB, t.GetTs()); err != nil {
+			return err
+		}
+	}
+	if t.Req.GetNewDbName() == "" {
+		return nil
+	}
+
+	if err := t.core.meta.RenameDatabase(ctx, t.Req.GetDbName(), t.Req.GetNewDbName(), t.GetTs()); err != nil {
+		return err
+	}
+	// the collections cached by the old database name are invalid now.
+	if err := t.core.ExpireDatabaseMetaCache(ctx, t.Req.GetDbName(), t.GetTs()); err != nil {
+		return err
+	}
+	// the grants on the objects of the database are remapped to the new name.
+	if err := t.core.refreshPolicyCache(ctx); err != nil {
+		return err
+	}
+	return t.expireAPIKeyCache(ctx)
+}
+
+// expireAPIKeyCache expires the api keys scoped to the renamed database, which are cached along with the
+// credential of the users.
+func (t *alterDatabaseTask) expireAPIKeyCache(ctx context.Context) error {
+	keys, err := t.core.meta.ListAPIKeys("", "")
+	if err != nil {
+		return err
+	}
+	users := typeutil.NewSet[string]()
+	for _, key := range keys {
+		if key.GetScope().GetDbName() == t.Req.GetNewDbName() {
+			users.Insert(key.GetUsername())
+		}
+	}
+	for _, user := range users.Collect() {
+		if err := t.core.ExpireCredCache(ctx, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateDatabaseProperties merges the updated properties into the database, the ones with empty values are removed.
+func updateDatabaseProperties(db *model.Database, updatedProps []*commonpb.KeyValuePair) {
+	props := make(map[string]string)
+	for _, prop := range db.Properties {
+		props[prop.Key] = prop.Value
+	}
+
+	for _, prop := range updatedProps {
+		if prop.Value == "" {
+			delete(props, prop.Key)
+			continue
+		}
+		props[prop.Key] = prop.Value
+	}
+
+	propKV := make([]*commonpb.KeyValuePair, 0, len(props))
+	for key, value := range props {
+		propKV = append(propKV, &commonpb.KeyValuePair{
+			Key:   key,
+			Value: value,
+		})
+	}
+
+	db.Properties = propKV
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_alterDatabaseTask_Prepare(t *testing.T) {
+	task := &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{DbName: "db1"}}
+	assert.Error(t, task.Prepare(context.Background()))
+
+	task = &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"}}
+	assert.NoError(t, task.Prepare(context.Background()))
+	assert.Equal(t, []ddlLock{newDatabaseLock("db1", true), newDatabaseLock("db2", true)}, task.GetLocks())
+
+	task = &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{
+		DbName:     "db1",
+		Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}},
+	}}
+	assert.NoError(t, task.Prepare(context.Background()))
+	assert.Equal(t, []ddlLock{newDatabaseLock("db1", true)}, task.GetLocks())
+
+	task.Req.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "-1"}}
+	assert.ErrorIs(t, task.Prepare(context.Background()), merr.ErrParameterInvalid)
+}
+
+func Test_alterDatabaseTask_Execute(t *testing.T) {
+	propertiesReq := func() *internalpb.AlterDatabaseRequest {
+		return &internalpb.AlterDatabaseRequest{
+			DbName: "db1",
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.DatabaseReplicaNumberKey, Value: "2"},
+				{Key: common.DatabaseResourceGroupsKey, Value: ""},
+			},
+		}
+	}
+
+	t.Run("database not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(nil, merr.WrapErrDatabaseNotFound("db1"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      propertiesReq(),
+		}
+		assert.ErrorIs(t, task.Execute(context.Background()), merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("failed to alter properties", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).
+			Return(model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated), nil)
+		meta.EXPECT().AlterDatabase(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      propertiesReq(),
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("alter properties", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		db := model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated)
+		db.Properties = []*commonpb.KeyValuePair{
+			{Key: common.DatabaseResourceGroupsKey, Value: "rg1"},
+			{Key: common.DatabaseMaxCollectionsKey, Value: "10"},
+		}
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(db, nil)
+		meta.EXPECT().AlterDatabase(mock.Anything, db, mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) {
+				assert.Equal(t, "db1", newDB.Name)
+				assert.Equal(t, map[string]string{
+					common.DatabaseReplicaNumberKey:  "2",
+					common.DatabaseMaxCollectionsKey: "10",
+				}, common.CloneKeyValuePairs(newDB.Properties).ToMap())
+			}).Return(nil)
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      propertiesReq(),
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		// the cached database isn't modified.
+		assert.Len(t, db.Properties, 2)
+	})
+
+	t.Run("failed to rename", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RenameDatabase(mock.Anything, "db1", "db2", mock.Anything).Return(errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RenameDatabase(mock.Anything, "db1", "db2", mock.Anything).Return(nil)
+		core := newTestCore(withMeta(meta), withInvalidProxyManager())
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to list api keys", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RenameDatabase(mock.Anything, "db1", "db2", mock.Anything).Return(nil)
+		meta.EXPECT().ListAPIKeys("", "").Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		p := newMockProxy()
+		p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+			return merr.Success(), nil
+		}
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			return merr.Success(), nil
+		}
+		core.proxyClientManager.proxyClient[TestProxyID] = p
+
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RenameDatabase(mock.Anything, "db1", "db2", mock.Anything).Return(nil)
+		meta.EXPECT().ListAPIKeys("", "").Return([]*internalpb.APIKeyInfo{
+			{Id: "key1", Username: "user1", Scope: &internalpb.APIKeyScope{DbName: "db2"}},
+			{Id: "key2", Username: "user1", Scope: &internalpb.APIKeyScope{DbName: "db2", CollectionName: "coll"}},
+			{Id: "key3", Username: "user2", Scope: &internalpb.APIKeyScope{DbName: "db3"}},
+			{Id: "key4", Username: "user3"},
+		}, nil)
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		p := newMockProxy()
+		p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+			assert.Equal(t, commonpb.MsgType_DropDatabase, request.GetBase().GetMsgType())
+			assert.Equal(t, "db1", request.GetDbName())
+			assert.Empty(t, request.GetCollectionName())
+			return merr.Success(), nil
+		}
+		refreshed := false
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			assert.Equal(t, int32(typeutil.CacheRefresh), request.GetOpType())
+			refreshed = true
+			return merr.Success(), nil
+		}
+		expired := make([]string, 0)
+		p.InvalidateCredentialCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+			expired = append(expired, request.GetUsername())
+			return merr.Success(), nil
+		}
+		core.proxyClientManager.proxyClient[TestProxyID] = p
+
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.True(t, refreshed)
+		// only the users of the api keys scoped to the renamed database are expired.
+		assert.Equal(t, []string{"user1"}, expired)
+	})
+}
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// maxAliasOperations limits the operations of a batch, so that they fit into a single etcd transaction.
+const maxAliasOperations = 16
+
+type batchAlterAliasesTask struct {
+	baseTask
+	Req *internalpb.BatchAlterAliasesRequest
+}
+
+func (t *batchAlterAliasesTask) GetLocks() []ddlLock {
+	locks := make([]ddlLock, 0, 2*len(t.Req.GetOperations()))
+	for _, op := range t.Req.GetOperations() {
+		locks = append(locks, newCollectionLock(t.Req.GetDbName(), op.GetAlias(), true))
+		if op.GetType() != internalpb.AliasOperationType_AliasOperationDrop {
+			locks = append(locks, newCollectionLock(t.Req.GetDbName(), op.GetCollectionName(), false))
+		}
+	}
+	return locks
+}
+
+func (t *batchAlterAliasesTask) Prepare(ctx context.Context) error {
+	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_AlterAlias); err != nil {
+		return err
+	}
+	ops := t.Req.GetOperations()
+	if len(ops) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no alias operation")
+	}
+	if len(ops) > maxAliasOperations {
+		return merr.WrapErrParameterInvalidRange(1, maxAliasOperations, len(ops), "too many alias operations")
+	}
+	for _, op := range ops {
+		if op.GetAlias() == "" {
+			return merr.WrapErrParameterInvalidMsg("alias name is empty")
+		}
+		if op.GetType() != internalpb.AliasOperationType_AliasOperationDrop && op.GetCollectionName() == "" {
+			return merr.WrapErrParameterInvalidMsg("collection name of alias %s is empty", op.GetAlias())
+		}
+	}
+	return nil
+}
+
+// checkLoaded checks the collections the aliases switch to are fully loaded, it runs with the collections locked,
+// so that they can't be released before the aliases are altered.
+func (t *batchAlterAliasesTask) checkLoaded(ctx context.Context) error {
+	collectionIDs := make([]UniqueID, 0, len(t.Req.GetOperations()))
+	collectionNames := make(map[UniqueID]string)
+	for _, op := range t.Req.GetOperations() {
+		if op.GetType() == internalpb.AliasOperationType_AliasOperationDrop {
+			continue
+		}
+		coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), op.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			return err
+		}
+		if _, ok := collectionNames[coll.CollectionID]; !ok {
+			collectionIDs = append(collectionIDs, coll.CollectionID)
+			collectionNames[coll.CollectionID] = op.GetCollectionName()
+		}
+	}
+	if len(collectionIDs) == 0 {
+		return nil
+	}
+	percentages, err := t.core.broker.ShowLoadedCollections(ctx, collectionIDs)
+	if err != nil {
+		return err
+	}
+	for _, collID := range collectionIDs {
+		if percentages[collID] < 100 {
+			return merr.WrapErrCollectionNotFullyLoaded(collectionNames[collID], "the alias can't switch to it")
+		}
+	}
+	return nil
+}
+
+func (t *batchAlterAliasesTask) Execute(ctx context.Context) error {
+	if err := t.checkLoaded(ctx); err != nil {
+		return err
+	}
+	aliases := make([]string, 0, len(t.Req.GetOperations()))
+	for _, op := range t.Req.GetOperations() {
+		aliases = append(aliases, op.GetAlias())
+	}
+	if err := t.core.ExpireAliasesMetaCache(ctx, t.Req.GetDbName(), aliases, t.GetTs()); err != nil {
+		return err
+	}
+	return t.core.meta.AlterAliases(ctx, t.Req.GetDbName(), t.Req.GetOperations(), t.GetTs())
+}
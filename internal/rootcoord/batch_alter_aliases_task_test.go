@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_batchAlterAliasesTask_GetLocks(t *testing.T) {
+	task := &batchAlterAliasesTask{Req: &internalpb.BatchAlterAliasesRequest{
+		DbName: "db",
+		Operations: []*internalpb.AliasOperation{
+			{Type: internalpb.AliasOperationType_AliasOperationAlter, Alias: "prod", CollectionName: "green"},
+			{Type: internalpb.AliasOperationType_AliasOperationDrop, Alias: "old"},
+		},
+	}}
+	assert.Equal(t, []ddlLock{
+		newCollectionLock("db", "prod", true),
+		newCollectionLock("db", "green", false),
+		newCollectionLock("db", "old", true),
+	}, task.GetLocks())
+}
+
+func Test_batchAlterAliasesTask_Prepare(t *testing.T) {
+	newTask := func(msgType commonpb.MsgType, ops ...*internalpb.AliasOperation) *batchAlterAliasesTask {
+		return &batchAlterAliasesTask{Req: &internalpb.BatchAlterAliasesRequest{
+			Base:       &commonpb.MsgBase{MsgType: msgType},
+			Operations: ops,
+		}}
+	}
+
+	t.Run("invalid msg type", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_DropCollection, &internalpb.AliasOperation{Alias: "alias", CollectionName: "coll"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		assert.Error(t, newTask(commonpb.MsgType_AlterAlias).Prepare(context.Background()))
+
+		ops := make([]*internalpb.AliasOperation, maxAliasOperations+1)
+		for i := range ops {
+			ops[i] = &internalpb.AliasOperation{Alias: "alias", CollectionName: "coll"}
+		}
+		assert.Error(t, newTask(commonpb.MsgType_AlterAlias, ops...).Prepare(context.Background()))
+
+		task := newTask(commonpb.MsgType_AlterAlias, &internalpb.AliasOperation{CollectionName: "coll"})
+		assert.Error(t, task.Prepare(context.Background()))
+
+		task = newTask(commonpb.MsgType_AlterAlias, &internalpb.AliasOperation{Type: internalpb.AliasOperationType_AliasOperationAlter, Alias: "alias"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := newTask(commonpb.MsgType_AlterAlias,
+			&internalpb.AliasOperation{Alias: "alias", CollectionName: "coll"},
+			&internalpb.AliasOperation{Type: internalpb.AliasOperationType_AliasOperationDrop, Alias: "old"},
+		)
+		assert.NoError(t, task.Prepare(context.Background()))
+	})
+}
+
+func Test_batchAlterAliasesTask_Execute(t *testing.T) {
+	req := &internalpb.BatchAlterAliasesRequest{
+		Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterAlias},
+		DbName: "db",
+		Operations: []*internalpb.AliasOperation{
+			{Type: internalpb.AliasOperationType_AliasOperationAlter, Alias: "prod", CollectionName: "green"},
+			{Type: internalpb.AliasOperationType_AliasOperationCreate, Alias: "rollback", CollectionName: "blue"},
+			{Type: internalpb.AliasOperationType_AliasOperationCreate, Alias: "latest", CollectionName: "green"},
+			{Type: internalpb.AliasOperationType_AliasOperationDrop, Alias: "old"},
+		},
+	}
+	newMeta := func(t *testing.T) *mockrootcoord.IMetaTable {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "green", mock.Anything).Return(&model.Collection{CollectionID: 1}, nil).Maybe()
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "blue", mock.Anything).Return(&model.Collection{CollectionID: 2}, nil).Maybe()
+		return meta
+	}
+	newBroker := func(percentages map[UniqueID]int64, err error) *mockBroker {
+		broker := newMockBroker()
+		broker.ShowLoadedCollectionsFunc = func(ctx context.Context, collectionIDs []UniqueID) (map[UniqueID]int64, error) {
+			assert.Equal(t, []UniqueID{1, 2}, collectionIDs)
+			return percentages, err
+		}
+		return broker
+	}
+
+	t.Run("collection not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "green", mock.Anything).Return(nil, merr.WrapErrCollectionNotFound("green"))
+		core := newTestCore(withMeta(meta))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.ErrorIs(t, task.Execute(context.Background()), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("not loaded", func(t *testing.T) {
+		core := newTestCore(withMeta(newMeta(t)), withBroker(newBroker(nil, merr.WrapErrCollectionNotLoaded(int64(1)))))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.ErrorIs(t, task.Execute(context.Background()), merr.ErrCollectionNotLoaded)
+	})
+
+	t.Run("not fully loaded", func(t *testing.T) {
+		core := newTestCore(withMeta(newMeta(t)), withBroker(newBroker(map[UniqueID]int64{1: 100, 2: 50}, nil)))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.ErrorIs(t, task.Execute(context.Background()), merr.ErrCollectionNotFullyLoaded)
+	})
+
+	t.Run("only drop", func(t *testing.T) {
+		dropReq := &internalpb.BatchAlterAliasesRequest{
+			Base:       &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterAlias},
+			Operations: []*internalpb.AliasOperation{{Type: internalpb.AliasOperationType_AliasOperationDrop, Alias: "old"}},
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().AlterAliases(mock.Anything, mock.Anything, dropReq.GetOperations(), mock.Anything).Return(nil)
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(newMockBroker()))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: dropReq}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		core := newTestCore(withInvalidProxyManager(), withMeta(newMeta(t)), withBroker(newBroker(map[UniqueID]int64{1: 100, 2: 100}, nil)))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to alter aliases", func(t *testing.T) {
+		meta := newMeta(t)
+		meta.EXPECT().AlterAliases(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("mock"))
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(newBroker(map[UniqueID]int64{1: 100, 2: 100}, nil)))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMeta(t)
+		meta.EXPECT().AlterAliases(mock.Anything, "db", req.GetOperations(), mock.Anything).Return(nil)
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(newBroker(map[UniqueID]int64{1: 100, 2: 100}, nil)))
+		task := &batchAlterAliasesTask{baseTask: newBaseTask(context.Background(), core), Req: req}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
@@ -50,7 +50,10 @@ type Broker interface {
 	ReleaseCollection(ctx context.Context, collectionID UniqueID) error
 	ReleasePartitions(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	SyncNewCreatedPartition(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error
+	UpdateSchema(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	GetQuerySegmentInfo(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
+	// ShowLoadedCollections returns the in memory percentages of the collections, fails if any of them is not loaded.
+	ShowLoadedCollections(ctx context.Context, collectionIDs []UniqueID) (map[UniqueID]int64, error)
 
 	WatchChannels(ctx context.Context, info *watchInfo) error
 	UnwatchChannels(ctx context.Context, info *watchInfo) error
@@ -65,6 +68,10 @@ type Broker interface {
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) error
+	DropCollectionSegments(ctx context.Context, collectionID UniqueID) error
+	CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) error
+	DropSnapshot(ctx context.Context, snapshotID UniqueID) error
 }
 
 type ServerBroker struct {
@@ -138,6 +145,26 @@ func (b *ServerBroker) SyncNewCreatedPartition(ctx context.Context, collectionID
 	return nil
 }
 
+func (b *ServerBroker) UpdateSchema(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	log := log.Ctx(ctx).With(zap.Int64("collection", collectionID), zap.Int("fieldNum", len(schema.GetFields())))
+	log.Info("begin to update schema of loaded collection")
+	resp, err := b.s.queryCoord.UpdateSchema(ctx, &querypb.UpdateSchemaRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection)),
+		CollectionID: collectionID,
+		Schema:       schema,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("update schema failed, reason: %s", resp.GetReason())
+	}
+
+	log.Info("update schema done")
+	return nil
+}
+
 func (b *ServerBroker) GetQuerySegmentInfo(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error) {
 	resp, err := b.s.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
 		Base: commonpbutil.NewMsgBase(
@@ -150,6 +177,26 @@ func (b *ServerBroker) GetQuerySegmentInfo(ctx context.Context, collectionID int
 	return resp, err
 }
 
+func (b *ServerBroker) ShowLoadedCollections(ctx context.Context, collectionIDs []UniqueID) (map[UniqueID]int64, error) {
+	resp, err := b.s.queryCoord.ShowCollections(ctx, &querypb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		CollectionIDs: collectionIDs,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	percentages := make(map[UniqueID]int64, len(resp.GetCollectionIDs()))
+	for i, collID := range resp.GetCollectionIDs() {
+		if i < len(resp.GetInMemoryPercentages()) {
+			percentages[collID] = resp.GetInMemoryPercentages()[i]
+		}
+	}
+	return percentages, nil
+}
+
 func toKeyDataPairs(m map[string][]byte) []*commonpb.KeyDataPair {
 	ret := make([]*commonpb.KeyDataPair, 0, len(m))
 	for k, data := range m {
@@ -273,10 +320,11 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	dcReq := &datapb.AlterCollectionRequest{
 		CollectionID: req.GetCollectionID(),
 		Schema: &schemapb.CollectionSchema{
-			Name:        colMeta.Name,
-			Description: colMeta.Description,
-			AutoID:      colMeta.AutoID,
-			Fields:      model.MarshalFieldModels(colMeta.Fields),
+			Name:               colMeta.Name,
+			Description:        colMeta.Description,
+			AutoID:             colMeta.AutoID,
+			Fields:             model.MarshalFieldModels(colMeta.Fields),
+			EnableDynamicField: colMeta.EnableDynamicField,
 		},
 		PartitionIDs:   partitionIDs,
 		StartPositions: colMeta.StartPositions,
@@ -295,6 +343,86 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	return nil
 }
 
+func (b *ServerBroker) CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("sourceCollectionID", req.GetSourceCollectionID()),
+		zap.Int64("collectionID", req.GetCollectionID()), zap.Uint64("ts", req.GetTimestamp()))
+	log.Info("cloning the segments of collection")
+
+	resp, err := b.s.dataCoord.CloneCollection(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to clone the segments of collection", zap.Error(err))
+		return err
+	}
+	log.Info("done to clone the segments of collection")
+	return nil
+}
+
+// DropCollectionSegments marks all the segments of the collection dropped, it drops the segments cloned to
+// the collection which failed to be created.
+func (b *ServerBroker) DropCollectionSegments(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	log.Info("dropping the segments of collection")
+
+	resp, err := b.s.dataCoord.GetFlushedSegments(ctx, &datapb.GetFlushedSegmentsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		CollectionID: collectionID,
+		PartitionID:  allPartition,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to get the segments of collection", zap.Error(err))
+		return err
+	}
+	if len(resp.GetSegments()) == 0 {
+		return nil
+	}
+	status, err := b.s.dataCoord.MarkSegmentsDropped(ctx, &datapb.MarkSegmentsDroppedRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		SegmentIds: resp.GetSegments(),
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("failed to drop the segments of collection", zap.Error(err))
+		return err
+	}
+	log.Info("done to drop the segments of collection", zap.Int("segments", len(resp.GetSegments())))
+	return nil
+}
+
+func (b *ServerBroker) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", req.GetSnapshotID()),
+		zap.Int64("collectionID", req.GetCollectionID()), zap.Uint64("ts", req.GetTimestamp()))
+	log.Info("creating the snapshot of collection")
+
+	resp, err := b.s.dataCoord.CreateSnapshot(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to create the snapshot of collection", zap.Error(err))
+		return err
+	}
+	log.Info("done to create the snapshot of collection")
+	return nil
+}
+
+func (b *ServerBroker) DropSnapshot(ctx context.Context, snapshotID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("snapshotID", snapshotID))
+	log.Info("dropping the snapshot of collection")
+
+	resp, err := b.s.dataCoord.DropSnapshot(ctx, &datapb.DropSnapshotRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		SnapshotID: snapshotID,
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to drop the snapshot of collection", zap.Error(err))
+		return err
+	}
+	log.Info("done to drop the snapshot of collection")
+	return nil
+}
+
 func (b *ServerBroker) DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
 	return b.s.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: colID,
@@ -208,6 +208,30 @@ func TestServerBroker_DropCollectionIndex(t *testing.T) {
 	})
 }
 
+func TestServerBroker_ShowLoadedCollections(t *testing.T) {
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidQueryCoord())
+		b := newServerBroker(c)
+		_, err := b.ShowLoadedCollections(context.Background(), []UniqueID{1, 2})
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedQueryCoord())
+		b := newServerBroker(c)
+		_, err := b.ShowLoadedCollections(context.Background(), []UniqueID{1, 2})
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidQueryCoord())
+		b := newServerBroker(c)
+		percentages, err := b.ShowLoadedCollections(context.Background(), []UniqueID{1, 2})
+		assert.NoError(t, err)
+		assert.Equal(t, map[UniqueID]int64{1: 100, 2: 50}, percentages)
+	})
+}
+
 func TestServerBroker_GetSegmentIndexState(t *testing.T) {
 	t.Run("failed to execute", func(t *testing.T) {
 		c := newTestCore(withInvalidDataCoord())
@@ -0,0 +1,364 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// cloneCollectionTask creates a collection with the schema and partitions of the source collection. The segments
+// of the new collection reference the flushed binlogs and index files of the source instead of copying them.
+type cloneCollectionTask struct {
+	createCollectionTask
+	cloneReq *internalpb.CloneCollectionRequest
+	source   *model.Collection
+	// the row policies and the field grants of the source, which are rewritten to the new collection,
+	// the new collection would expose the rows and the fields hidden by them otherwise
+	rowPolicies []*internalpb.RowPolicy
+	fieldGrants []*milvuspb.GrantEntity
+	// the segments are cloned from the snapshot instead of the source collection if it's set
+	snapshotID UniqueID
+}
+
+// snapshotTs returns the timestamp at which the source collection is cloned.
+func (t *cloneCollectionTask) snapshotTs() Timestamp {
+	if t.cloneReq.GetTimestamp() != 0 {
+		return t.cloneReq.GetTimestamp()
+	}
+	return t.GetTs()
+}
+
+func (t *cloneCollectionTask) GetLocks() []ddlLock {
+	return []ddlLock{
+		newCollectionLock(t.cloneReq.GetDbName(), t.cloneReq.GetCollectionName(), false),
+		newCollectionLock(t.cloneReq.GetDbName(), t.cloneReq.GetNewCollectionName(), true),
+	}
+}
+
+func (t *cloneCollectionTask) Prepare(ctx context.Context) error {
+	if t.cloneReq.GetCollectionName() == "" || t.cloneReq.GetNewCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("clone collection failed, collection name is empty")
+	}
+	if t.cloneReq.GetTimestamp() > t.GetTs() {
+		return merr.WrapErrParameterInvalidMsg("clone collection failed, the timestamp %d is in the future", t.cloneReq.GetTimestamp())
+	}
+
+	var err error
+	t.source, err = t.core.meta.GetCollectionByName(ctx, t.cloneReq.GetDbName(), t.cloneReq.GetCollectionName(), t.snapshotTs())
+	if err != nil {
+		return err
+	}
+	if err := t.prepareClone(ctx); err != nil {
+		return err
+	}
+	rowPolicies, fieldGrants, err := listCollectionPolicies(t.core, t.cloneReq.GetDbName(), t.source)
+	if err != nil {
+		return err
+	}
+	t.inheritPolicies(rowPolicies, fieldGrants)
+	return nil
+}
+
+// listCollectionPolicies lists the row policies and the field grants on the collection.
+func listCollectionPolicies(core *Core, dbName string, coll *model.Collection) ([]*model.SnapshotRowPolicy, []*model.SnapshotFieldGrant, error) {
+	rowPolicies, err := core.meta.ListRowPolicies("", coll.CollectionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	policies := make([]*model.SnapshotRowPolicy, 0, len(rowPolicies))
+	for _, policy := range rowPolicies {
+		policies = append(policies, &model.SnapshotRowPolicy{RoleName: policy.GetRoleName(), Expr: policy.GetExpr()})
+	}
+
+	policyInfos, err := core.meta.ListPolicy(util.DefaultTenant)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	prefix := funcutil.PolicyForResource(dbName, util.FieldObjectType, coll.Name+".")
+	grants := make([]*model.SnapshotFieldGrant, 0)
+	for _, policyInfo := range policyInfos {
+		policy := struct{ V0, V1, V2 string }{}
+		if err := json.Unmarshal([]byte(policyInfo), &policy); err != nil {
+			continue
+		}
+		if fieldName, ok := strings.CutPrefix(policy.V1, prefix); ok {
+			grants = append(grants, &model.SnapshotFieldGrant{RoleName: policy.V0, FieldName: fieldName, Privilege: policy.V2})
+		}
+	}
+	return policies, grants, nil
+}
+
+// inheritPolicies rewrites the row policies and the field grants of the source to the new collection.
+func (t *cloneCollectionTask) inheritPolicies(rowPolicies []*model.SnapshotRowPolicy, fieldGrants []*model.SnapshotFieldGrant) {
+	dbName := t.cloneReq.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	t.rowPolicies = make([]*internalpb.RowPolicy, 0, len(rowPolicies))
+	for _, policy := range rowPolicies {
+		t.rowPolicies = append(t.rowPolicies, &internalpb.RowPolicy{
+			RoleName:       policy.RoleName,
+			DbName:         dbName,
+			CollectionName: t.schema.GetName(),
+			CollectionID:   t.collID,
+			Expr:           policy.Expr,
+		})
+	}
+	t.fieldGrants = make([]*milvuspb.GrantEntity, 0, len(fieldGrants))
+	for _, grant := range fieldGrants {
+		t.fieldGrants = append(t.fieldGrants, &milvuspb.GrantEntity{
+			Role:       &milvuspb.RoleEntity{Name: grant.RoleName},
+			Object:     &milvuspb.ObjectEntity{Name: util.FieldObjectType},
+			ObjectName: t.schema.GetName() + "." + grant.FieldName,
+			DbName:     dbName,
+			Grantor: &milvuspb.GrantorEntity{
+				User:      &milvuspb.UserEntity{Name: util.UserRoot},
+				Privilege: &milvuspb.PrivilegeEntity{Name: grant.Privilege},
+			},
+		})
+	}
+}
+
+// prepareClone prepares the new collection with the schema, partitions and shards of the source.
+func (t *cloneCollectionTask) prepareClone(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.cloneReq.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.dbID = db.ID
+
+	if _, err := t.core.meta.GetCollectionByName(ctx, t.cloneReq.GetDbName(), t.cloneReq.GetNewCollectionName(), typeutil.MaxTimestamp); err == nil {
+		return merr.WrapErrParameterInvalidMsg("clone collection failed, collection %s already exists", t.cloneReq.GetNewCollectionName())
+	}
+
+	t.Req = &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           t.cloneReq.GetDbName(),
+		CollectionName:   t.cloneReq.GetNewCollectionName(),
+		ShardsNum:        t.source.ShardsNum,
+		ConsistencyLevel: t.source.ConsistencyLevel,
+		Properties:       t.source.Properties,
+	}
+	t.schema = &schemapb.CollectionSchema{
+		Name:               t.cloneReq.GetNewCollectionName(),
+		Description:        t.source.Description,
+		AutoID:             t.source.AutoID,
+		Fields:             model.MarshalFieldModels(t.source.Fields),
+		EnableDynamicField: t.source.EnableDynamicField,
+	}
+	if err := t.validate(); err != nil {
+		return err
+	}
+
+	if err := t.assignCollectionID(); err != nil {
+		return err
+	}
+
+	if err := t.assignPartitionIDs(); err != nil {
+		return err
+	}
+
+	return t.assignChannels()
+}
+
+// assignPartitionIDs allocates the ids of the partitions with the same names as the source.
+func (t *cloneCollectionTask) assignPartitionIDs() error {
+	t.partitionNames = make([]string, 0, len(t.source.Partitions))
+	for _, partition := range t.source.Partitions {
+		if partition.Available() {
+			t.partitionNames = append(t.partitionNames, partition.PartitionName)
+		}
+	}
+
+	t.partIDs = make([]UniqueID, len(t.partitionNames))
+	start, end, err := t.core.idAllocator.Alloc(uint32(len(t.partitionNames)))
+	if err != nil {
+		return err
+	}
+	for i := start; i < end; i++ {
+		t.partIDs[i-start] = i
+	}
+	return nil
+}
+
+// genCloneDataRequest maps the partitions and the channels of the source to the new collection.
+func (t *cloneCollectionTask) genCloneDataRequest(startPositions []*commonpb.KeyDataPair, ts Timestamp) *datapb.CloneCollectionRequest {
+	partitionIDs := make(map[int64]int64, len(t.partIDs))
+	for _, partition := range t.source.Partitions {
+		for i, name := range t.partitionNames {
+			if partition.PartitionName == name {
+				partitionIDs[partition.PartitionID] = t.partIDs[i]
+			}
+		}
+	}
+	channels := make(map[string]string, len(t.channels.virtualChannels))
+	for i, channel := range t.source.VirtualChannelNames {
+		if i < len(t.channels.virtualChannels) {
+			channels[channel] = t.channels.virtualChannels[i]
+		}
+	}
+	return &datapb.CloneCollectionRequest{
+		SourceCollectionID: t.source.CollectionID,
+		CollectionID:       t.collID,
+		SnapshotID:         t.snapshotID,
+		PartitionIDs:       partitionIDs,
+		Channels:           channels,
+		Timestamp:          t.snapshotTs(),
+		StartPositions:     startPositions,
+		CreateTimestamp:    ts,
+	}
+}
+
+func (t *cloneCollectionTask) Execute(ctx context.Context) error {
+	collID := t.collID
+	ts := t.GetTs()
+
+	startPositions, err := t.addChannelsAndGetStartPositions(ctx, ts)
+	if err != nil {
+		t.core.chanTimeTick.removeDmlChannels(t.channels.physicalChannels...)
+		return err
+	}
+
+	partitions := make([]*model.Partition, len(t.partIDs))
+	for i, partID := range t.partIDs {
+		partitions[i] = &model.Partition{
+			PartitionID:               partID,
+			PartitionName:             t.partitionNames[i],
+			PartitionCreatedTimestamp: ts,
+			CollectionID:              collID,
+			State:                     pb.PartitionState_PartitionCreated,
+		}
+	}
+
+	collInfo := model.Collection{
+		CollectionID:         collID,
+		DBID:                 t.dbID,
+		Name:                 t.schema.Name,
+		Description:          t.schema.Description,
+		AutoID:               t.schema.AutoID,
+		Fields:               model.UnmarshalFieldModels(t.schema.Fields),
+		VirtualChannelNames:  t.channels.virtualChannels,
+		PhysicalChannelNames: t.channels.physicalChannels,
+		ShardsNum:            t.Req.ShardsNum,
+		ConsistencyLevel:     t.Req.ConsistencyLevel,
+		StartPositions:       toKeyDataPairs(startPositions),
+		CreateTime:           ts,
+		State:                pb.CollectionState_CollectionCreating,
+		Partitions:           partitions,
+		Properties:           t.Req.Properties,
+		EnableDynamicField:   t.schema.EnableDynamicField,
+		SchemaVersion:        t.source.SchemaVersion,
+	}
+
+	log.Ctx(ctx).Info("clone collection",
+		zap.String("source", t.source.Name), zap.Int64("sourceID", t.source.CollectionID),
+		zap.String("collection", collInfo.Name), zap.Int64("collectionID", collID),
+		zap.Uint64("snapshotTs", t.snapshotTs()), zap.Uint64("ts", ts))
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{t.Req.GetCollectionName()},
+		collectionID:    InvalidCollectionID,
+		ts:              ts,
+	}, &nullStep{})
+	undoTask.AddStep(&nullStep{}, &removeDmlChannelsStep{
+		baseStep:  baseStep{core: t.core},
+		pChannels: t.channels.physicalChannels,
+	})
+	undoTask.AddStep(&addCollectionMetaStep{
+		baseStep: baseStep{core: t.core},
+		coll:     &collInfo,
+	}, &deleteCollectionMetaStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		ts:           ts,
+	})
+	undoTask.AddStep(&nullStep{}, &unwatchChannelsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		channels:     t.channels,
+		isSkip:       !Params.CommonCfg.TTMsgEnabled.GetAsBool(),
+	})
+	// the segments and the indexes cloned partially are dropped as well if cloning them fails.
+	undoTask.AddStep(&nullStep{}, &dropClonedCollectionDataStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+	})
+	// the cloned segments must be there before the channels are watched, so that the datanodes and
+	// the querynodes take them as the flushed segments of the channels.
+	undoTask.AddStep(&cloneCollectionDataStep{
+		baseStep: baseStep{core: t.core},
+		req:      t.genCloneDataRequest(toKeyDataPairs(startPositions), ts),
+	}, &nullStep{})
+	undoTask.AddStep(&watchChannelsStep{
+		baseStep: baseStep{core: t.core},
+		info: &watchInfo{
+			ts:             ts,
+			collectionID:   collID,
+			vChannels:      t.channels.virtualChannels,
+			startPositions: toKeyDataPairs(startPositions),
+			schema: &schemapb.CollectionSchema{
+				Name:        collInfo.Name,
+				Description: collInfo.Description,
+				AutoID:      collInfo.AutoID,
+				Fields:      model.MarshalFieldModels(collInfo.Fields),
+			},
+		},
+	}, &nullStep{})
+	// the policies are granted before the collection is visible, the row policies are removed along with the
+	// collection meta, while the field grants are keyed by the collection name and have to be revoked.
+	undoTask.AddStep(&nullStep{}, &revokeClonedFieldGrantsStep{
+		baseStep:    baseStep{core: t.core},
+		fieldGrants: t.fieldGrants,
+	})
+	undoTask.AddStep(&grantClonedPoliciesStep{
+		baseStep:    baseStep{core: t.core},
+		rowPolicies: t.rowPolicies,
+		fieldGrants: t.fieldGrants,
+	}, &nullStep{})
+	undoTask.AddStep(&changeCollectionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: collID,
+		state:        pb.CollectionState_CollectionCreated,
+		ts:           ts,
+	}, &nullStep{})
+
+	return undoTask.Execute(ctx)
+}
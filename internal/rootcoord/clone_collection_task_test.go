@@ -0,0 +1,283 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newTestCloneSource() *model.Collection {
+	return &model.Collection{
+		CollectionID: 1,
+		DBID:         util.DefaultDBID,
+		Name:         "src",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+		Partitions: []*model.Partition{
+			{PartitionID: 10, PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 11, PartitionName: "p1", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 12, PartitionName: "p2", State: pb.PartitionState_PartitionDropping},
+		},
+		VirtualChannelNames: []string{"dml_0_1v0", "dml_1_1v1"},
+		ShardsNum:           2,
+		EnableDynamicField:  true,
+	}
+}
+
+func Test_cloneCollectionTask_Prepare(t *testing.T) {
+	paramtable.Init()
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.On("GetDatabaseByName",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(model.NewDefaultDatabase(), nil).Maybe()
+	meta.On("ListAllAvailCollections",
+		mock.Anything,
+	).Return(map[int64][]int64{
+		util.DefaultDBID: {1},
+	}, nil).Maybe()
+	meta.On("GetCollectionByName",
+		mock.Anything,
+		mock.Anything,
+		"src",
+		mock.Anything,
+	).Return(newTestCloneSource(), nil).Maybe()
+	meta.On("GetCollectionByName",
+		mock.Anything,
+		mock.Anything,
+		"other",
+		mock.Anything,
+	).Return(nil, errors.New("not found")).Maybe()
+	meta.On("ListRowPolicies",
+		"",
+		int64(1),
+	).Return([]*internalpb.RowPolicy{
+		{RoleName: "role1", DbName: util.DefaultDBName, CollectionName: "src", CollectionID: 1, Expr: "pk > 10"},
+	}, nil).Maybe()
+	meta.On("ListPolicy",
+		util.DefaultTenant,
+	).Return([]string{
+		funcutil.PolicyForPrivilege("role1", util.FieldObjectType, "src.vec", util.PrivilegeReadField, util.DefaultDBName),
+		funcutil.PolicyForPrivilege("role1", util.FieldObjectType, "src2.vec", util.PrivilegeReadField, util.DefaultDBName),
+		funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), "src", commonpb.ObjectPrivilege_PrivilegeQuery.String(), util.DefaultDBName),
+	}, nil).Maybe()
+
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNum.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNum.Key)
+
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNumPerDB.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNumPerDB.Key)
+
+	newTask := func(core *Core, req *internalpb.CloneCollectionRequest) *cloneCollectionTask {
+		task := &cloneCollectionTask{
+			createCollectionTask: createCollectionTask{baseTask: newBaseTask(context.Background(), core)},
+			cloneReq:             req,
+		}
+		task.SetTs(100)
+		return task
+	}
+
+	t.Run("empty name", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "src"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("timestamp in the future", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "src", NewCollectionName: "other", Timestamp: 200})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("collection exists", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "other", NewCollectionName: "src"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("source not found", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "other", NewCollectionName: "other"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
+
+		task := newTask(core, &internalpb.CloneCollectionRequest{CollectionName: "src", NewCollectionName: "other", Timestamp: 50})
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Equal(t, Timestamp(50), task.snapshotTs())
+		assert.Equal(t, "other", task.schema.GetName())
+		assert.Len(t, task.schema.GetFields(), 2)
+		assert.True(t, task.schema.GetEnableDynamicField())
+		assert.Equal(t, []string{"_default", "p1"}, task.partitionNames)
+		assert.Len(t, task.partIDs, 2)
+		assert.Len(t, task.channels.virtualChannels, 2)
+
+		// the policies of the source are rewritten to the new collection
+		assert.Len(t, task.rowPolicies, 1)
+		assert.Equal(t, task.collID, task.rowPolicies[0].GetCollectionID())
+		assert.Equal(t, "other", task.rowPolicies[0].GetCollectionName())
+		assert.Equal(t, "pk > 10", task.rowPolicies[0].GetExpr())
+		assert.Len(t, task.fieldGrants, 1)
+		assert.Equal(t, "role1", task.fieldGrants[0].GetRole().GetName())
+		assert.Equal(t, "other.vec", task.fieldGrants[0].GetObjectName())
+		assert.Equal(t, util.PrivilegeReadField, task.fieldGrants[0].GetGrantor().GetPrivilege().GetName())
+	})
+}
+
+func Test_grantClonedPoliciesStep(t *testing.T) {
+	rowPolicies := []*internalpb.RowPolicy{{RoleName: "role1", CollectionName: "other", CollectionID: 2, Expr: "pk > 10"}}
+	fieldGrants := []*milvuspb.GrantEntity{{
+		Role:       &milvuspb.RoleEntity{Name: "role1"},
+		Object:     &milvuspb.ObjectEntity{Name: util.FieldObjectType},
+		ObjectName: "other.vec",
+		DbName:     util.DefaultDBName,
+		Grantor: &milvuspb.GrantorEntity{
+			User:      &milvuspb.UserEntity{Name: util.UserRoot},
+			Privilege: &milvuspb.PrivilegeEntity{Name: util.PrivilegeReadField},
+		},
+	}}
+
+	t.Run("add row policy failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().AddRowPolicy(mock.Anything).Return(errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		step := &grantClonedPoliciesStep{baseStep: baseStep{core: core}, rowPolicies: rowPolicies, fieldGrants: fieldGrants}
+		_, err := step.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("grant and revoke", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().AddRowPolicy(rowPolicies[0]).Return(nil)
+		meta.EXPECT().OperatePrivilege(util.DefaultTenant, fieldGrants[0], milvuspb.OperatePrivilegeType_Grant).Return(nil)
+		// the revoked grant is skipped
+		meta.EXPECT().OperatePrivilege(util.DefaultTenant, fieldGrants[0], milvuspb.OperatePrivilegeType_Revoke).
+			Return(common.NewIgnorableError(errors.New("not existed")))
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		p := newMockProxy()
+		var opTypes []int32
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			assert.Equal(t, funcutil.PolicyForPrivilege("role1", util.FieldObjectType, "other.vec", util.PrivilegeReadField, util.DefaultDBName),
+				request.GetOpKey())
+			opTypes = append(opTypes, request.GetOpType())
+			return merr.Success(), nil
+		}
+		core.proxyClientManager.proxyClient[TestProxyID] = p
+
+		step := &grantClonedPoliciesStep{baseStep: baseStep{core: core}, rowPolicies: rowPolicies, fieldGrants: fieldGrants}
+		_, err := step.Execute(context.Background())
+		assert.NoError(t, err)
+
+		undo := &revokeClonedFieldGrantsStep{baseStep: baseStep{core: core}, fieldGrants: fieldGrants}
+		_, err = undo.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int32{int32(typeutil.CacheGrantPrivilege), int32(typeutil.CacheRevokePrivilege)}, opTypes)
+	})
+
+	t.Run("refresh cache failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().OperatePrivilege(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		core := newTestCore(withMeta(meta), withValidProxyManager())
+		p := newMockProxy()
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			return nil, errors.New("mock")
+		}
+		core.proxyClientManager.proxyClient[TestProxyID] = p
+		step := &revokeClonedFieldGrantsStep{baseStep: baseStep{core: core}, fieldGrants: fieldGrants}
+		_, err := step.Execute(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func Test_dropClonedCollectionDataStep(t *testing.T) {
+	broker := newMockBroker()
+	broker.DropCollectionSegmentsFunc = func(ctx context.Context, collectionID UniqueID) error {
+		return errors.New("mock")
+	}
+	core := newTestCore(withBroker(broker))
+	step := &dropClonedCollectionDataStep{baseStep: baseStep{core: core}, collectionID: 2}
+	_, err := step.Execute(context.Background())
+	assert.Error(t, err)
+
+	var dropped []UniqueID
+	broker.DropCollectionSegmentsFunc = func(ctx context.Context, collectionID UniqueID) error {
+		dropped = append(dropped, collectionID)
+		return nil
+	}
+	broker.DropCollectionIndexFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
+		dropped = append(dropped, collID)
+		return nil
+	}
+	core = newTestCore(withBroker(broker))
+	step = &dropClonedCollectionDataStep{baseStep: baseStep{core: core}, collectionID: 2}
+	_, err = step.Execute(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []UniqueID{2, 2}, dropped)
+}
+
+func Test_cloneCollectionTask_genCloneDataRequest(t *testing.T) {
+	task := &cloneCollectionTask{
+		createCollectionTask: createCollectionTask{
+			collID:         2,
+			partIDs:        []UniqueID{20, 21},
+			partitionNames: []string{"_default", "p1"},
+			channels: collectionChannels{
+				virtualChannels:  []string{"dml_0_2v0", "dml_1_2v1"},
+				physicalChannels: []string{"dml_0", "dml_1"},
+			},
+		},
+		cloneReq: &internalpb.CloneCollectionRequest{Timestamp: 50},
+		source:   newTestCloneSource(),
+	}
+
+	req := task.genCloneDataRequest(nil, 100)
+	assert.Equal(t, int64(1), req.GetSourceCollectionID())
+	assert.Equal(t, int64(2), req.GetCollectionID())
+	assert.Equal(t, map[int64]int64{10: 20, 11: 21}, req.GetPartitionIDs())
+	assert.Equal(t, map[string]string{"dml_0_1v0": "dml_0_2v0", "dml_1_1v1": "dml_1_2v1"}, req.GetChannels())
+	assert.Equal(t, uint64(50), req.GetTimestamp())
+	assert.Equal(t, uint64(100), req.GetCreateTimestamp())
+}
@@ -28,6 +28,13 @@ type createAliasTask struct {
 	Req *milvuspb.CreateAliasRequest
 }
 
+func (t *createAliasTask) GetLocks() []ddlLock {
+	return []ddlLock{
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetAlias(), true),
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), false),
+	}
+}
+
 func (t *createAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_CreateAlias); err != nil {
 		return err
@@ -54,6 +54,7 @@ type createCollectionTask struct {
 	partIDs        []UniqueID
 	channels       collectionChannels
 	dbID           UniqueID
+	dbProperties   []*commonpb.KeyValuePair
 	partitionNames []string
 }
 
@@ -86,7 +87,7 @@ func (t *createCollectionTask) validate() error {
 		return merr.WrapErrDatabaseNotFound(t.Req.GetDbName(), "failed to create collection")
 	}
 
-	maxColNumPerDB := Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt()
+	maxColNumPerDB := getDatabaseMaxCollections(t.dbProperties)
 	if len(collIDs) >= maxColNumPerDB {
 		log.Warn("unable to create collection because the number of collection has reached the limit in DB", zap.Int("maxCollectionNumPerDB", maxColNumPerDB))
 		return merr.WrapErrCollectionNumLimitExceeded(maxColNumPerDB, "max number of collection has reached the limit in DB")
@@ -321,17 +322,40 @@ func (t *createCollectionTask) assignChannels() error {
 	return nil
 }
 
+func (t *createCollectionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), true)}
+}
+
+// assignConsistencyLevel inherits the default consistency level of the database, the level can't be told from
+// Strong which is the zero value, so only the collections requesting Strong inherit it.
+func (t *createCollectionTask) assignConsistencyLevel() {
+	if t.Req.GetConsistencyLevel() != commonpb.ConsistencyLevel_Strong {
+		return
+	}
+	level, ok, err := common.GetDatabaseConsistencyLevel(t.dbProperties...)
+	if err != nil {
+		log.Warn("invalid consistency level of database, ignore it", zap.String("dbName", t.Req.GetDbName()), zap.Error(err))
+		return
+	}
+	if ok {
+		t.Req.ConsistencyLevel = level
+	}
+}
+
 func (t *createCollectionTask) Prepare(ctx context.Context) error {
 	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
 	if err != nil {
 		return err
 	}
 	t.dbID = db.ID
+	t.dbProperties = db.Properties
 
 	if err := t.validate(); err != nil {
 		return err
 	}
 
+	t.assignConsistencyLevel()
+
 	if err := t.prepareSchema(); err != nil {
 		return err
 	}
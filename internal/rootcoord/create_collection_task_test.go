@@ -183,6 +183,26 @@ func Test_createCollectionTask_validate(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("collection num exceeds the limit of db properties", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.On("ListAllAvailCollections",
+			mock.Anything,
+		).Return(map[int64][]int64{
+			1: {1, 2},
+		}, nil)
+		core := newTestCore(withMeta(meta))
+		task := createCollectionTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req: &milvuspb.CreateCollectionRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+			},
+			dbID:         1,
+			dbProperties: []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "2"}},
+		}
+		err := task.validate()
+		assert.ErrorIs(t, err, merr.ErrCollectionNumLimitExceeded)
+	})
+
 	t.Run("normal case", func(t *testing.T) {
 		meta := mockrootcoord.NewIMetaTable(t)
 		meta.On("ListAllAvailCollections",
@@ -609,6 +629,31 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 	})
 }
 
+func Test_createCollectionTask_assignConsistencyLevel(t *testing.T) {
+	dbProperties := []*commonpb.KeyValuePair{{Key: common.DatabaseConsistencyLevelKey, Value: "Bounded"}}
+
+	task := &createCollectionTask{
+		Req:          &milvuspb.CreateCollectionRequest{ConsistencyLevel: commonpb.ConsistencyLevel_Strong},
+		dbProperties: dbProperties,
+	}
+	task.assignConsistencyLevel()
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, task.Req.GetConsistencyLevel())
+
+	task = &createCollectionTask{
+		Req:          &milvuspb.CreateCollectionRequest{ConsistencyLevel: commonpb.ConsistencyLevel_Session},
+		dbProperties: dbProperties,
+	}
+	task.assignConsistencyLevel()
+	assert.Equal(t, commonpb.ConsistencyLevel_Session, task.Req.GetConsistencyLevel())
+
+	task = &createCollectionTask{
+		Req:          &milvuspb.CreateCollectionRequest{ConsistencyLevel: commonpb.ConsistencyLevel_Strong},
+		dbProperties: []*commonpb.KeyValuePair{{Key: common.DatabaseConsistencyLevelKey, Value: "invalid"}},
+	}
+	task.assignConsistencyLevel()
+	assert.Equal(t, commonpb.ConsistencyLevel_Strong, task.Req.GetConsistencyLevel())
+}
+
 func Test_createCollectionTask_Execute(t *testing.T) {
 	t.Run("add same collection with different parameters", func(t *testing.T) {
 		defer cleanTestEnv()
@@ -31,6 +31,10 @@ type createDatabaseTask struct {
 	dbID UniqueID
 }
 
+func (t *createDatabaseTask) GetLocks() []ddlLock {
+	return []ddlLock{newDatabaseLock(t.Req.GetDbName(), true)}
+}
+
 func (t *createDatabaseTask) Prepare(ctx context.Context) error {
 	dbs, err := t.core.meta.ListDatabases(ctx, t.GetTs())
 	if err != nil {
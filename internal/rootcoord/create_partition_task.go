@@ -35,6 +35,10 @@ type createPartitionTask struct {
 	collMeta *model.Collection
 }
 
+func (t *createPartitionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), true)}
+}
+
 func (t *createPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_CreatePartition); err != nil {
 		return err
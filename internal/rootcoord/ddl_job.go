@@ -0,0 +1,394 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const interruptedDDLJobReason = "rootcoord restarted before the job finished"
+
+type ddlJobCtxKey struct{}
+
+// withDDLJob attaches the job to the context of the task, the steps executed with the context report the
+// progress to the job.
+func withDDLJob(ctx context.Context, job *ddlJob) context.Context {
+	return context.WithValue(ctx, ddlJobCtxKey{}, job)
+}
+
+// getDDLJob returns the job of the task, nil if the task isn't tracked as a DDL job.
+func getDDLJob(ctx context.Context) *ddlJob {
+	job, _ := ctx.Value(ddlJobCtxKey{}).(*ddlJob)
+	return job
+}
+
+// ddlJob tracks the progress of a DDL task, including the step stacks executed in the background by the step
+// executor. The job finishes once the task returns and all of its step stacks are done.
+// All methods are no-op on a nil job, so the tasks not tracked as jobs needn't check it.
+type ddlJob struct {
+	manager *ddlJobManager
+
+	mu       sync.Mutex
+	info     *model.DDLJob
+	executed bool // the task has returned.
+	stacks   int  // the step stacks of the job not done yet.
+}
+
+func (j *ddlJob) start() {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.info.State = internalpb.DDLJobState_DDLJobRunning
+	j.info.StartTime = time.Now().UnixMilli()
+	j.save()
+}
+
+func (j *ddlJob) setStep(desc string) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	// the steps may be retried by the step executor, only persist the changes.
+	if j.info.CurrentStep == desc {
+		return
+	}
+	j.info.CurrentStep = desc
+	j.save()
+}
+
+// addStepStack registers the step stack to execute in the background, the job is undoing if the stack rolls back
+// the failed task.
+func (j *ddlJob) addStepStack(undo bool) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.stacks++
+	if undo {
+		j.info.State = internalpb.DDLJobState_DDLJobUndoing
+		j.save()
+	}
+}
+
+// stepStackDone is called once the step stack is done, err is the error which makes the stack give up.
+func (j *ddlJob) stepStackDone(err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.stacks--
+	if err != nil {
+		j.info.FailReason = err.Error()
+		// the rollback isn't done either.
+		j.info.State = internalpb.DDLJobState_DDLJobFailed
+	}
+	j.tryFinish()
+}
+
+// taskDone is called once the task returns, the step stacks of it may be still executing.
+func (j *ddlJob) taskDone(err error) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.executed = true
+	if err != nil {
+		j.info.FailReason = err.Error()
+		if j.info.State != internalpb.DDLJobState_DDLJobUndoing {
+			j.info.State = internalpb.DDLJobState_DDLJobFailed
+		}
+	}
+	j.tryFinish()
+}
+
+func (j *ddlJob) tryFinish() {
+	if !j.executed || j.stacks > 0 {
+		return
+	}
+	switch j.info.State {
+	case internalpb.DDLJobState_DDLJobUndoing:
+		j.info.State = internalpb.DDLJobState_DDLJobRolledBack
+	case internalpb.DDLJobState_DDLJobRunning:
+		j.info.State = internalpb.DDLJobState_DDLJobCompleted
+	}
+	j.info.CurrentStep = ""
+	j.info.EndTime = time.Now().UnixMilli()
+	j.save()
+}
+
+func (j *ddlJob) save() {
+	// the progress is informative, failing to persist it doesn't fail the task.
+	if err := j.manager.meta.SaveDDLJob(j.manager.ctx, j.info.Clone()); err != nil {
+		log.Warn("failed to save ddl job", zap.Int64("jobID", j.info.ID), zap.Error(err))
+	}
+}
+
+func (j *ddlJob) snapshot() *model.DDLJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.info.Clone()
+}
+
+// ddlJobManager keeps the DDL jobs, the finished jobs are removed after the retention.
+type ddlJobManager struct {
+	ctx  context.Context
+	meta IMetaTable
+
+	mu   sync.RWMutex
+	jobs map[UniqueID]*ddlJob
+}
+
+func newDDLJobManager(ctx context.Context, meta IMetaTable) *ddlJobManager {
+	return &ddlJobManager{
+		ctx:  ctx,
+		meta: meta,
+		jobs: make(map[UniqueID]*ddlJob),
+	}
+}
+
+// load recovers the jobs from the meta. The tasks of the unfinished jobs aren't recovered, their outcome is decided
+// by the meta instead.
+func (m *ddlJobManager) load() error {
+	jobs, err := m.meta.ListDDLJobs(m.ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, info := range jobs {
+		job := &ddlJob{manager: m, info: info, executed: true}
+		if !info.Finished() {
+			info.State, info.FailReason = m.recoverState(info)
+			info.CurrentStep = ""
+			info.EndTime = time.Now().UnixMilli()
+			job.save()
+		}
+		m.jobs[info.ID] = job
+	}
+	log.Info("ddl jobs loaded", zap.Int("num", len(jobs)))
+	return nil
+}
+
+// recoverState decides the state of the job interrupted by the restart. Once rootcoord restarts, the collections
+// and partitions being created are removed, and the ones being dropped are dropped again, so whether the job took
+// effect is told by whether the collection or the partition is available.
+func (m *ddlJobManager) recoverState(info *model.DDLJob) (internalpb.DDLJobState, string) {
+	coll, err := m.meta.GetCollectionByName(m.ctx, info.DBName, info.CollectionName, typeutil.MaxTimestamp)
+	if err != nil && !errors.Is(err, merr.ErrCollectionNotFound) {
+		log.Warn("failed to recover the state of ddl job", zap.Int64("jobID", info.ID), zap.Error(err))
+		return internalpb.DDLJobState_DDLJobFailed, interruptedDDLJobReason
+	}
+	collExists := err == nil
+	partExists := collExists && lo.ContainsBy(coll.Partitions, func(partition *model.Partition) bool {
+		return partition.PartitionName == info.PartitionName
+	})
+
+	switch info.Type {
+	case "CreateCollection":
+		if collExists {
+			return internalpb.DDLJobState_DDLJobCompleted, ""
+		}
+		return internalpb.DDLJobState_DDLJobRolledBack, interruptedDDLJobReason
+	case "DropCollection":
+		if !collExists {
+			return internalpb.DDLJobState_DDLJobCompleted, ""
+		}
+	case "CreatePartition":
+		if partExists {
+			return internalpb.DDLJobState_DDLJobCompleted, ""
+		}
+		return internalpb.DDLJobState_DDLJobRolledBack, interruptedDDLJobReason
+	case "DropPartition":
+		if !partExists {
+			return internalpb.DDLJobState_DDLJobCompleted, ""
+		}
+	}
+	// the job didn't take effect, or the meta can't tell it, e.g. altering the collection.
+	return internalpb.DDLJobState_DDLJobFailed, interruptedDDLJobReason
+}
+
+func (m *ddlJobManager) newJob(jobID UniqueID, jobType string, dbName string, collectionName string, partitionName string) *ddlJob {
+	job := &ddlJob{
+		manager: m,
+		info: &model.DDLJob{
+			ID:             jobID,
+			Type:           jobType,
+			DBName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			State:          internalpb.DDLJobState_DDLJobPending,
+			CreateTime:     time.Now().UnixMilli(),
+		},
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[jobID] = job
+	return job
+}
+
+func (m *ddlJobManager) removeJob(jobID UniqueID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.jobs, jobID)
+}
+
+// cleanupLoop removes the expired jobs periodically.
+func (m *ddlJobManager) cleanupLoop(wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.DDLJobCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			log.Info("rootcoord's ddl job cleanup loop quit!")
+			return
+		case <-ticker.C:
+			m.removeExpiredJobs()
+		}
+	}
+}
+
+// removeExpiredJobs removes the jobs finished longer than the retention, the meta is removed without holding
+// the lock, so the requests aren't blocked by it.
+func (m *ddlJobManager) removeExpiredJobs() {
+	retention := Params.RootCoordCfg.DDLJobRetention.GetAsDuration(time.Second)
+	now := time.Now()
+	m.mu.RLock()
+	expired := make([]UniqueID, 0)
+	for id, job := range m.jobs {
+		info := job.snapshot()
+		if info.Finished() && now.Sub(time.UnixMilli(info.EndTime)) >= retention {
+			expired = append(expired, id)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, id := range expired {
+		if err := m.meta.DropDDLJob(m.ctx, id); err != nil {
+			log.Warn("failed to remove expired ddl job", zap.Int64("jobID", id), zap.Error(err))
+			continue
+		}
+		m.removeJob(id)
+	}
+}
+
+func (m *ddlJobManager) getJob(jobID UniqueID) (*model.DDLJob, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("ddl job %d not found", jobID)
+	}
+	return job.snapshot(), nil
+}
+
+// listJobs lists the jobs of the database in the order of creation, only the jobs of the collection are listed
+// if collectionName isn't empty.
+func (m *ddlJobManager) listJobs(dbName string, collectionName string, unfinishedOnly bool) []*model.DDLJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*model.DDLJob, 0)
+	for _, job := range m.jobs {
+		info := job.snapshot()
+		if info.DBName != dbName || (collectionName != "" && info.CollectionName != collectionName) {
+			continue
+		}
+		if unfinishedOnly && info.Finished() {
+			continue
+		}
+		ret = append(ret, info)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ID < ret[j].ID })
+	return ret
+}
+
+// ddlJobRequest is the request of the DDL tracked as a job.
+type ddlJobRequest interface {
+	GetBase() *commonpb.MsgBase
+	GetDbName() string
+	GetCollectionName() string
+}
+
+// ddlJobPartitionRequest is the request of the DDL on a partition.
+type ddlJobPartitionRequest interface {
+	GetPartitionName() string
+}
+
+// addDDLJob enqueues the task and tracks it as a DDL job, the id of the job is the msg id of the request, so the
+// requests without msg id aren't tracked. If the request asks for asynchronous execution, the task is detached from
+// the context of the request.
+// Only the DDLs creating, dropping and altering collections and partitions are tracked, the proxy returns the id of
+// the job to the client for them only.
+func (c *Core) addDDLJob(ctx context.Context, jobType string, req ddlJobRequest, t task) error {
+	jobID := req.GetBase().GetMsgID()
+	if jobID <= 0 {
+		return c.scheduler.AddTask(t)
+	}
+
+	if common.IsAsyncDDL(req.GetBase()) {
+		// the task outlives the request, keep the trace only.
+		ctx = trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+	}
+	dbName := req.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	partitionName := ""
+	if partitionReq, ok := req.(ddlJobPartitionRequest); ok {
+		partitionName = partitionReq.GetPartitionName()
+	}
+	job := c.ddlJobs.newJob(jobID, jobType, dbName, req.GetCollectionName(), partitionName)
+	t.SetCtx(withDDLJob(ctx, job))
+	if err := c.scheduler.AddTask(t); err != nil {
+		c.ddlJobs.removeJob(jobID)
+		return err
+	}
+	return nil
+}
+
+// waitDDLJob waits for the task to finish, it returns at once if the request asks for asynchronous execution.
+func (c *Core) waitDDLJob(req ddlJobRequest, t task) error {
+	if req.GetBase().GetMsgID() > 0 && common.IsAsyncDDL(req.GetBase()) {
+		return nil
+	}
+	return t.WaitToFinish()
+}
@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func newTestDDLJobManager(t *testing.T) (*ddlJobManager, *mockrootcoord.IMetaTable) {
+	paramtable.Init()
+	meta := mockrootcoord.NewIMetaTable(t)
+	return newDDLJobManager(context.Background(), meta), meta
+}
+
+func Test_ddlJob(t *testing.T) {
+	t.Run("nil job", func(t *testing.T) {
+		var job *ddlJob
+		job.start()
+		job.setStep("step")
+		job.addStepStack(true)
+		job.stepStackDone(nil)
+		job.taskDone(nil)
+		assert.Nil(t, getDDLJob(context.Background()))
+	})
+
+	t.Run("completed", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		// start, step, completed.
+		meta.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil).Times(3)
+		job := manager.newJob(1, "CreateCollection", util.DefaultDBName, "coll", "")
+		assert.Same(t, job, getDDLJob(withDDLJob(context.Background(), job)))
+
+		job.start()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobRunning, job.snapshot().State)
+		job.setStep("step")
+		job.setStep("step")
+		assert.Equal(t, "step", job.snapshot().CurrentStep)
+		job.taskDone(nil)
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, info.State)
+		assert.Empty(t, info.CurrentStep)
+		assert.NotZero(t, info.EndTime)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		meta.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(errors.New("mock"))
+		job := manager.newJob(1, "CreateCollection", util.DefaultDBName, "coll", "")
+		job.start()
+		job.taskDone(errors.New("mock"))
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobFailed, info.State)
+		assert.Equal(t, "mock", info.FailReason)
+	})
+
+	t.Run("rolled back", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		meta.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil)
+		job := manager.newJob(1, "CreateCollection", util.DefaultDBName, "coll", "")
+		job.start()
+		job.addStepStack(true)
+		job.taskDone(errors.New("mock"))
+		// the undo steps are still executing.
+		assert.Equal(t, internalpb.DDLJobState_DDLJobUndoing, job.snapshot().State)
+		job.stepStackDone(nil)
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobRolledBack, info.State)
+		assert.Equal(t, "mock", info.FailReason)
+	})
+
+	t.Run("background steps failed", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		meta.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil)
+		job := manager.newJob(1, "DropCollection", util.DefaultDBName, "coll", "")
+		job.start()
+		job.addStepStack(false)
+		job.taskDone(nil)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobRunning, job.snapshot().State)
+		job.stepStackDone(errors.New("mock"))
+		info := job.snapshot()
+		assert.Equal(t, internalpb.DDLJobState_DDLJobFailed, info.State)
+		assert.Equal(t, "mock", info.FailReason)
+	})
+}
+
+func Test_ddlJobManager_load(t *testing.T) {
+	t.Run("failed to list", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		meta.EXPECT().ListDDLJobs(mock.Anything).Return(nil, errors.New("mock"))
+		assert.Error(t, manager.load())
+	})
+
+	t.Run("interrupted jobs", func(t *testing.T) {
+		manager, meta := newTestDDLJobManager(t)
+		meta.EXPECT().ListDDLJobs(mock.Anything).Return([]*model.DDLJob{
+			{ID: 1, Type: "CreateCollection", DBName: util.DefaultDBName, CollectionName: "coll", State: internalpb.DDLJobState_DDLJobRunning, CurrentStep: "step"},
+			{ID: 2, DBName: util.DefaultDBName, State: internalpb.DDLJobState_DDLJobCompleted, EndTime: 100},
+		}, nil)
+		meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "coll", typeutil.MaxTimestamp).Return(&model.Collection{Name: "coll"}, nil)
+		meta.EXPECT().SaveDDLJob(mock.Anything, mock.Anything).Return(nil).Once()
+		assert.NoError(t, manager.load())
+
+		info, err := manager.getJob(1)
+		assert.NoError(t, err)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, info.State)
+		assert.Empty(t, info.FailReason)
+		assert.Empty(t, info.CurrentStep)
+		assert.NotZero(t, info.EndTime)
+
+		info, err = manager.getJob(2)
+		assert.NoError(t, err)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, info.State)
+
+		_, err = manager.getJob(3)
+		assert.Error(t, err)
+	})
+}
+
+func Test_ddlJobManager_recoverState(t *testing.T) {
+	coll := &model.Collection{
+		Name:       "coll",
+		Partitions: []*model.Partition{{PartitionName: "_default"}, {PartitionName: "part"}},
+	}
+	notFound := merr.WrapErrCollectionNotFoundWithDB(util.DefaultDBName, "coll")
+
+	tests := []struct {
+		name      string
+		jobType   string
+		partition string
+		coll      *model.Collection
+		err       error
+		state     internalpb.DDLJobState
+	}{
+		{"collection created", "CreateCollection", "", coll, nil, internalpb.DDLJobState_DDLJobCompleted},
+		{"collection not created", "CreateCollection", "", nil, notFound, internalpb.DDLJobState_DDLJobRolledBack},
+		{"collection dropped", "DropCollection", "", nil, notFound, internalpb.DDLJobState_DDLJobCompleted},
+		{"collection not dropped", "DropCollection", "", coll, nil, internalpb.DDLJobState_DDLJobFailed},
+		{"partition created", "CreatePartition", "part", coll, nil, internalpb.DDLJobState_DDLJobCompleted},
+		{"partition not created", "CreatePartition", "part2", coll, nil, internalpb.DDLJobState_DDLJobRolledBack},
+		{"collection of partition dropped", "DropPartition", "part", nil, notFound, internalpb.DDLJobState_DDLJobCompleted},
+		{"partition dropped", "DropPartition", "part2", coll, nil, internalpb.DDLJobState_DDLJobCompleted},
+		{"partition not dropped", "DropPartition", "part", coll, nil, internalpb.DDLJobState_DDLJobFailed},
+		{"collection altered", "AlterCollection", "", coll, nil, internalpb.DDLJobState_DDLJobFailed},
+		{"failed to get collection", "CreateCollection", "", nil, errors.New("mock"), internalpb.DDLJobState_DDLJobFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, meta := newTestDDLJobManager(t)
+			meta.EXPECT().GetCollectionByName(mock.Anything, util.DefaultDBName, "coll", typeutil.MaxTimestamp).Return(tt.coll, tt.err)
+			state, reason := manager.recoverState(&model.DDLJob{
+				ID:             1,
+				Type:           tt.jobType,
+				DBName:         util.DefaultDBName,
+				CollectionName: "coll",
+				PartitionName:  tt.partition,
+			})
+			assert.Equal(t, tt.state, state)
+			if state == internalpb.DDLJobState_DDLJobCompleted {
+				assert.Empty(t, reason)
+			} else {
+				assert.Equal(t, interruptedDDLJobReason, reason)
+			}
+		})
+	}
+}
+
+func Test_ddlJobManager_listJobs(t *testing.T) {
+	manager, _ := newTestDDLJobManager(t)
+	manager.newJob(3, "CreateCollection", "db", "coll1", "")
+	manager.newJob(1, "CreateCollection", "db", "coll2", "")
+	manager.newJob(2, "CreateCollection", "db2", "coll1", "")
+	manager.jobs[4] = &ddlJob{manager: manager, executed: true, info: &model.DDLJob{
+		ID: 4, DBName: "db", CollectionName: "coll1", State: internalpb.DDLJobState_DDLJobCompleted,
+	}}
+
+	jobs := manager.listJobs("db", "", false)
+	assert.Len(t, jobs, 3)
+	assert.Equal(t, int64(1), jobs[0].ID)
+	assert.Equal(t, int64(3), jobs[1].ID)
+	assert.Equal(t, int64(4), jobs[2].ID)
+
+	jobs = manager.listJobs("db", "coll1", false)
+	assert.Len(t, jobs, 2)
+
+	jobs = manager.listJobs("db", "coll1", true)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, int64(3), jobs[0].ID)
+}
+
+func Test_ddlJobManager_removeExpiredJobs(t *testing.T) {
+	manager, meta := newTestDDLJobManager(t)
+	paramtable.Get().Save(Params.RootCoordCfg.DDLJobRetention.Key, "0")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.DDLJobRetention.Key)
+
+	manager.jobs[1] = &ddlJob{manager: manager, executed: true, info: &model.DDLJob{
+		ID: 1, State: internalpb.DDLJobState_DDLJobCompleted, EndTime: 100,
+	}}
+	manager.jobs[2] = &ddlJob{manager: manager, executed: true, info: &model.DDLJob{
+		ID: 2, State: internalpb.DDLJobState_DDLJobFailed, EndTime: 100,
+	}}
+	meta.EXPECT().DropDDLJob(mock.Anything, int64(1)).Return(nil).Once()
+	meta.EXPECT().DropDDLJob(mock.Anything, int64(2)).Return(errors.New("mock")).Once()
+
+	manager.newJob(3, "CreateCollection", util.DefaultDBName, "coll", "")
+	manager.removeExpiredJobs()
+	_, err := manager.getJob(1)
+	assert.Error(t, err)
+	// failed to remove, retry next time.
+	_, err = manager.getJob(2)
+	assert.NoError(t, err)
+	_, err = manager.getJob(3)
+	assert.NoError(t, err)
+}
+
+func TestCore_addDDLJob(t *testing.T) {
+	t.Run("no msg id", func(t *testing.T) {
+		c := newTestCore(withValidScheduler())
+		req := &milvuspb.CreateCollectionRequest{CollectionName: "coll"}
+		task := &createCollectionTask{baseTask: newBaseTask(context.Background(), c), Req: req}
+		assert.NoError(t, c.addDDLJob(context.Background(), "CreateCollection", req, task))
+		assert.Nil(t, getDDLJob(task.GetCtx()))
+		assert.NoError(t, c.waitDDLJob(req, task))
+		assert.Empty(t, c.ddlJobs.listJobs(util.DefaultDBName, "", false))
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withInvalidScheduler())
+		req := &milvuspb.CreateCollectionRequest{Base: &commonpb.MsgBase{MsgID: 100}, CollectionName: "coll"}
+		task := &createCollectionTask{baseTask: newBaseTask(context.Background(), c), Req: req}
+		assert.Error(t, c.addDDLJob(context.Background(), "CreateCollection", req, task))
+		_, err := c.ddlJobs.getJob(100)
+		assert.Error(t, err)
+	})
+
+	t.Run("async", func(t *testing.T) {
+		c := newTestCore(withScheduler(newMockScheduler()))
+		req := &milvuspb.CreateCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgID:      100,
+				Properties: map[string]string{common.DDLAsyncKey: "true"},
+			},
+			CollectionName: "coll",
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		task := &createCollectionTask{baseTask: newBaseTask(ctx, c), Req: req}
+		assert.NoError(t, c.addDDLJob(ctx, "CreateCollection", req, task))
+		// the task isn't done, the request returns at once.
+		assert.NoError(t, c.waitDDLJob(req, task))
+		// the task outlives the request.
+		cancel()
+		assert.NoError(t, task.GetCtx().Err())
+		assert.NotNil(t, getDDLJob(task.GetCtx()))
+
+		info, err := c.ddlJobs.getJob(100)
+		assert.NoError(t, err)
+		assert.Equal(t, "CreateCollection", info.Type)
+		assert.Equal(t, util.DefaultDBName, info.DBName)
+		assert.Equal(t, "coll", info.CollectionName)
+		assert.Equal(t, internalpb.DDLJobState_DDLJobPending, info.State)
+	})
+
+	t.Run("partition", func(t *testing.T) {
+		c := newTestCore(withScheduler(newMockScheduler()))
+		req := &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{MsgID: 100},
+			CollectionName: "coll",
+			PartitionName:  "part",
+		}
+		task := &createPartitionTask{baseTask: newBaseTask(context.Background(), c), Req: req}
+		assert.NoError(t, c.addDDLJob(context.Background(), "CreatePartition", req, task))
+		info, err := c.ddlJobs.getJob(100)
+		assert.NoError(t, err)
+		assert.Equal(t, "part", info.PartitionName)
+	})
+}
@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+type ddlLockLevel int
+
+const (
+	ddlLockCluster ddlLockLevel = iota
+	ddlLockDatabase
+	ddlLockCollection
+	ddlLockSnapshot
+)
+
+// ddlLockKey identifies the object locked by a ddl task, the collections share the key space with the aliases.
+type ddlLockKey struct {
+	level  ddlLockLevel
+	dbName string
+	name   string
+}
+
+func (k ddlLockKey) less(other ddlLockKey) bool {
+	if k.level != other.level {
+		return k.level < other.level
+	}
+	if k.dbName != other.dbName {
+		return k.dbName < other.dbName
+	}
+	return k.name < other.name
+}
+
+// ddlLock is the lock on an object required by a ddl task, the tasks holding the shared locks of the same object
+// are executed concurrently.
+type ddlLock struct {
+	key       ddlLockKey
+	exclusive bool
+}
+
+func newClusterLock(exclusive bool) ddlLock {
+	return ddlLock{key: ddlLockKey{level: ddlLockCluster}, exclusive: exclusive}
+}
+
+func newDatabaseLock(dbName string, exclusive bool) ddlLock {
+	return ddlLock{key: ddlLockKey{level: ddlLockDatabase, dbName: normalizeDBName(dbName)}, exclusive: exclusive}
+}
+
+func newCollectionLock(dbName string, collectionName string, exclusive bool) ddlLock {
+	return ddlLock{
+		key:       ddlLockKey{level: ddlLockCollection, dbName: normalizeDBName(dbName), name: collectionName},
+		exclusive: exclusive,
+	}
+}
+
+// newCollectionLocks locks the collection by name, the collection requested by id only is protected by locking the
+// database instead.
+func newCollectionLocks(dbName string, collectionName string, exclusive bool) []ddlLock {
+	if collectionName == "" {
+		return []ddlLock{newDatabaseLock(dbName, exclusive)}
+	}
+	return []ddlLock{newCollectionLock(dbName, collectionName, exclusive)}
+}
+
+func newSnapshotLock(dbName string, snapshotName string, exclusive bool) ddlLock {
+	return ddlLock{
+		key:       ddlLockKey{level: ddlLockSnapshot, dbName: normalizeDBName(dbName), name: snapshotName},
+		exclusive: exclusive,
+	}
+}
+
+func normalizeDBName(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
+
+// normalizeDDLLocks completes the shared locks of the parents of the objects, merges the locks of the same object
+// and sorts them by level and name, so that all tasks take the locks in the same order.
+func normalizeDDLLocks(locks []ddlLock) []ddlLock {
+	if len(locks) == 0 {
+		return nil
+	}
+	merged := make(map[ddlLockKey]bool)
+	add := func(l ddlLock) {
+		merged[l.key] = merged[l.key] || l.exclusive
+	}
+	add(newClusterLock(false))
+	for _, l := range locks {
+		if l.key.level > ddlLockDatabase {
+			add(newDatabaseLock(l.key.dbName, false))
+		}
+		add(l)
+	}
+
+	ret := make([]ddlLock, 0, len(merged))
+	for key, exclusive := range merged {
+		ret = append(ret, ddlLock{key: key, exclusive: exclusive})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].key.less(ret[j].key) })
+	return ret
+}
+
+// equalDDLLocks returns whether the normalized locks are the same.
+func equalDDLLocks(a []ddlLock, b []ddlLock) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ddlLockRequest is the locks requested by a task, it's ready once all the locks are granted.
+type ddlLockRequest struct {
+	locks   []ddlLock
+	pending int
+	ready   chan struct{}
+}
+
+func (r *ddlLockRequest) Ready() <-chan struct{} {
+	return r.ready
+}
+
+type ddlLockEntry struct {
+	req       *ddlLockRequest
+	exclusive bool
+	granted   bool
+}
+
+// ddlLockManager grants the locks of every object in the order of the requests, a shared lock is granted if no
+// exclusive lock is requested before it, and an exclusive lock is granted if no lock is requested before it.
+// The locks of a request are queued atomically, so a request only waits for the requests before it and the
+// tasks of the same object are executed in the order of their timestamps, there is no deadlock.
+type ddlLockManager struct {
+	mu     sync.Mutex
+	queues map[ddlLockKey][]*ddlLockEntry
+}
+
+func newDDLLockManager() *ddlLockManager {
+	return &ddlLockManager{
+		queues: make(map[ddlLockKey][]*ddlLockEntry),
+	}
+}
+
+func (m *ddlLockManager) Acquire(locks []ddlLock) *ddlLockRequest {
+	locks = normalizeDDLLocks(locks)
+	req := &ddlLockRequest{
+		locks:   locks,
+		pending: len(locks),
+		ready:   make(chan struct{}),
+	}
+	if len(locks) == 0 {
+		close(req.ready)
+		return req
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range locks {
+		m.queues[l.key] = append(m.queues[l.key], &ddlLockEntry{req: req, exclusive: l.exclusive})
+		m.grant(l.key)
+	}
+	return req
+}
+
+func (m *ddlLockManager) Release(req *ddlLockRequest) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, l := range req.locks {
+		queue := m.queues[l.key]
+		for i, entry := range queue {
+			if entry.req == req {
+				queue = append(queue[:i], queue[i+1:]...)
+				break
+			}
+		}
+		if len(queue) == 0 {
+			delete(m.queues, l.key)
+			continue
+		}
+		m.queues[l.key] = queue
+		m.grant(l.key)
+	}
+}
+
+func (m *ddlLockManager) grant(key ddlLockKey) {
+	for i, entry := range m.queues[key] {
+		if entry.exclusive && i > 0 {
+			return
+		}
+		if !entry.granted {
+			entry.granted = true
+			entry.req.pending--
+			if entry.req.pending == 0 {
+				close(entry.req.ready)
+			}
+		}
+		if entry.exclusive {
+			return
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+func isReady(req *ddlLockRequest) bool {
+	select {
+	case <-req.Ready():
+		return true
+	default:
+		return false
+	}
+}
+
+func Test_normalizeDDLLocks(t *testing.T) {
+	assert.Empty(t, normalizeDDLLocks(nil))
+
+	locks := normalizeDDLLocks([]ddlLock{
+		newCollectionLock("db2", "coll", true),
+		newCollectionLock("", "coll", false),
+		newCollectionLock("db2", "coll", false),
+		newDatabaseLock("db1", true),
+	})
+	assert.Equal(t, []ddlLock{
+		newClusterLock(false),
+		newDatabaseLock("db1", true),
+		newDatabaseLock("db2", false),
+		newDatabaseLock(util.DefaultDBName, false),
+		newCollectionLock("db2", "coll", true),
+		newCollectionLock(util.DefaultDBName, "coll", false),
+	}, locks)
+
+	assert.Equal(t, []ddlLock{newDatabaseLock("db", true)}, newCollectionLocks("db", "", true))
+	assert.Equal(t, []ddlLock{newCollectionLock("db", "coll", false)}, newCollectionLocks("db", "coll", false))
+}
+
+func Test_ddlLockManager(t *testing.T) {
+	t.Run("no lock", func(t *testing.T) {
+		m := newDDLLockManager()
+		req := m.Acquire(nil)
+		assert.True(t, isReady(req))
+		m.Release(req)
+		assert.Empty(t, m.queues)
+	})
+
+	t.Run("independent objects", func(t *testing.T) {
+		m := newDDLLockManager()
+		req1 := m.Acquire([]ddlLock{newCollectionLock("db", "coll1", true)})
+		req2 := m.Acquire([]ddlLock{newCollectionLock("db", "coll2", true)})
+		req3 := m.Acquire([]ddlLock{newCollectionLock("db2", "coll1", true)})
+		assert.True(t, isReady(req1))
+		assert.True(t, isReady(req2))
+		assert.True(t, isReady(req3))
+		m.Release(req1)
+		m.Release(req2)
+		m.Release(req3)
+		assert.Empty(t, m.queues)
+	})
+
+	t.Run("shared and exclusive", func(t *testing.T) {
+		m := newDDLLockManager()
+		read1 := m.Acquire([]ddlLock{newCollectionLock("db", "coll", false)})
+		read2 := m.Acquire([]ddlLock{newCollectionLock("db", "coll", false)})
+		write := m.Acquire([]ddlLock{newCollectionLock("db", "coll", true)})
+		// the later shared lock waits for the exclusive lock requested before it.
+		read3 := m.Acquire([]ddlLock{newCollectionLock("db", "coll", false)})
+		assert.True(t, isReady(read1))
+		assert.True(t, isReady(read2))
+		assert.False(t, isReady(write))
+		assert.False(t, isReady(read3))
+
+		m.Release(read1)
+		assert.False(t, isReady(write))
+		m.Release(read2)
+		assert.True(t, isReady(write))
+		assert.False(t, isReady(read3))
+		m.Release(write)
+		assert.True(t, isReady(read3))
+		m.Release(read3)
+		assert.Empty(t, m.queues)
+	})
+
+	t.Run("database lock", func(t *testing.T) {
+		m := newDDLLockManager()
+		coll := m.Acquire([]ddlLock{newCollectionLock("db", "coll", true)})
+		dropDB := m.Acquire([]ddlLock{newDatabaseLock("db", true)})
+		otherDB := m.Acquire([]ddlLock{newCollectionLock("db2", "coll", true)})
+		assert.True(t, isReady(coll))
+		assert.False(t, isReady(dropDB))
+		assert.True(t, isReady(otherDB))
+		m.Release(coll)
+		assert.True(t, isReady(dropDB))
+		m.Release(dropDB)
+		m.Release(otherDB)
+		assert.Empty(t, m.queues)
+	})
+
+	t.Run("cross objects", func(t *testing.T) {
+		m := newDDLLockManager()
+		// rename coll1 to coll2 and coll2 to coll1 across databases.
+		req1 := m.Acquire([]ddlLock{newCollectionLock("db1", "coll1", true), newCollectionLock("db2", "coll2", true)})
+		req2 := m.Acquire([]ddlLock{newCollectionLock("db2", "coll2", true), newCollectionLock("db1", "coll1", true)})
+		assert.True(t, isReady(req1))
+		assert.False(t, isReady(req2))
+		m.Release(req1)
+		assert.True(t, isReady(req2))
+		m.Release(req2)
+		assert.Empty(t, m.queues)
+	})
+}
+
+func Test_ddlLockManager_deadlock_free(t *testing.T) {
+	m := newDDLLockManager()
+	randomLocks := func() []ddlLock {
+		n := rand.Intn(3) + 1
+		locks := make([]ddlLock, 0, n)
+		for i := 0; i < n; i++ {
+			dbName := fmt.Sprintf("db%d", rand.Intn(2))
+			exclusive := rand.Intn(2) == 0
+			switch rand.Intn(4) {
+			case 0:
+				locks = append(locks, newDatabaseLock(dbName, exclusive))
+			case 1:
+				locks = append(locks, newSnapshotLock(dbName, fmt.Sprintf("snap%d", rand.Intn(2)), exclusive))
+			default:
+				locks = append(locks, newCollectionLock(dbName, fmt.Sprintf("coll%d", rand.Intn(3)), exclusive))
+			}
+		}
+		return locks
+	}
+
+	// the holders of every object, negative if it's held exclusively.
+	var mu sync.Mutex
+	holders := make(map[ddlLockKey]int)
+	hold := func(req *ddlLockRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, l := range req.locks {
+			if l.exclusive {
+				assert.Zero(t, holders[l.key])
+				holders[l.key] = -1
+			} else {
+				assert.GreaterOrEqual(t, holders[l.key], 0)
+				holders[l.key]++
+			}
+		}
+	}
+	unhold := func(req *ddlLockRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, l := range req.locks {
+			if l.exclusive {
+				holders[l.key] = 0
+			} else {
+				holders[l.key]--
+			}
+		}
+	}
+
+	n := 500
+	finished := atomic.NewInt32(0)
+	wg := sync.WaitGroup{}
+	for i := 0; i < n; i++ {
+		req := m.Acquire(randomLocks())
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-req.Ready()
+			hold(req)
+			time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+			unhold(req)
+			m.Release(req)
+			finished.Inc()
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Minute):
+		assert.FailNow(t, "deadlock", "%d of %d requests finished", finished.Load(), n)
+	}
+	assert.Empty(t, m.queues)
+}
+
+func Test_taskLocks(t *testing.T) {
+	rename := &renameCollectionTask{Req: &milvuspb.RenameCollectionRequest{OldName: "old", NewName: "new"}}
+	assert.Equal(t, []ddlLock{
+		newCollectionLock(util.DefaultDBName, "old", true),
+		newCollectionLock(util.DefaultDBName, "new", true),
+	}, rename.GetLocks())
+
+	rename = &renameCollectionTask{Req: &milvuspb.RenameCollectionRequest{DbName: "db1", OldName: "old", NewDBName: "db2", NewName: "new"}}
+	assert.Equal(t, []ddlLock{
+		newCollectionLock("db1", "old", true),
+		newCollectionLock("db2", "new", true),
+	}, rename.GetLocks())
+
+	alterAlias := &alterAliasTask{Req: &milvuspb.AlterAliasRequest{Alias: "alias", CollectionName: "coll"}}
+	assert.Equal(t, []ddlLock{
+		newCollectionLock(util.DefaultDBName, "alias", true),
+		newCollectionLock(util.DefaultDBName, "coll", false),
+	}, alterAlias.GetLocks())
+
+	describe := &describeCollectionTask{Req: &milvuspb.DescribeCollectionRequest{CollectionID: 100}}
+	assert.Equal(t, []ddlLock{newDatabaseLock(util.DefaultDBName, false)}, describe.GetLocks())
+
+	purge := &purgeRecycleBinTask{dbName: "db1", collectionName: "coll"}
+	assert.Equal(t, []ddlLock{newCollectionLock("db1", "coll", true)}, purge.GetLocks())
+}
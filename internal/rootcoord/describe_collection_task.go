@@ -19,8 +19,12 @@ package rootcoord
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // describeCollectionTask describe collection request task
@@ -31,6 +35,10 @@ type describeCollectionTask struct {
 	allowUnavailable bool
 }
 
+func (t *describeCollectionTask) GetLocks() []ddlLock {
+	return newCollectionLocks(t.Req.GetDbName(), t.Req.GetCollectionName(), false)
+}
+
 func (t *describeCollectionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.Base.MsgType, commonpb.MsgType_DescribeCollection); err != nil {
 		return err
@@ -47,5 +55,15 @@ func (t *describeCollectionTask) Execute(ctx context.Context) (err error) {
 	aliases := t.core.meta.ListAliasesByID(coll.CollectionID)
 	t.Rsp = convertModelToDesc(coll, aliases)
 	t.Rsp.DbName = t.Req.GetDbName()
+	if t.Rsp.DbName == "" {
+		// the collection requested by id only, the database is resolved for the callers applying its properties.
+		db, err := t.core.meta.GetDatabaseByID(ctx, coll.DBID, typeutil.MaxTimestamp)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to get database of collection",
+				zap.Int64("collectionID", coll.CollectionID), zap.Int64("dbID", coll.DBID), zap.Error(err))
+		} else {
+			t.Rsp.DbName = db.Name
+		}
+	}
 	return nil
 }
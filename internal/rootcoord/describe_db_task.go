@@ -0,0 +1,53 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type describeDatabaseTask struct {
+	baseTask
+	Req  *internalpb.DescribeDatabaseRequest
+	Resp *internalpb.DescribeDatabaseResponse
+}
+
+func (t *describeDatabaseTask) GetLocks() []ddlLock {
+	return []ddlLock{newDatabaseLock(t.Req.GetDbName(), false)}
+}
+
+func (t *describeDatabaseTask) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (t *describeDatabaseTask) Execute(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), t.GetTs())
+	if err != nil {
+		t.Resp.Status = merr.Status(err)
+		return err
+	}
+
+	t.Resp.Status = merr.Success()
+	t.Resp.DbName = db.Name
+	t.Resp.DbID = db.ID
+	t.Resp.CreatedTimestamp = db.CreatedTime
+	t.Resp.Properties = db.Properties
+	return nil
+}
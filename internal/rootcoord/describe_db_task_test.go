@@ -0,0 +1,69 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_describeDatabaseTask(t *testing.T) {
+	t.Run("database not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(nil, merr.WrapErrDatabaseNotFound("db1"))
+		core := newTestCore(withMeta(meta))
+		task := &describeDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.DescribeDatabaseRequest{DbName: "db1"},
+			Resp:     &internalpb.DescribeDatabaseResponse{},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+		assert.ErrorIs(t, merr.Error(task.Resp.GetStatus()), merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		db := model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated)
+		db.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(db, nil)
+		core := newTestCore(withMeta(meta))
+		task := &describeDatabaseTask{
+			baseTask: newBaseTask(context.TODO(), core),
+			Req:      &internalpb.DescribeDatabaseRequest{DbName: "db1"},
+			Resp:     &internalpb.DescribeDatabaseResponse{},
+		}
+		assert.Equal(t, []ddlLock{newDatabaseLock("db1", false)}, task.GetLocks())
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.True(t, merr.Ok(task.Resp.GetStatus()))
+		assert.Equal(t, "db1", task.Resp.GetDbName())
+		assert.Equal(t, int64(2), task.Resp.GetDbID())
+		assert.Equal(t, db.CreatedTime, task.Resp.GetCreatedTimestamp())
+		assert.Equal(t, db.Properties, task.Resp.GetProperties())
+	})
+}
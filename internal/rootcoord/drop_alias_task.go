@@ -28,6 +28,10 @@ type dropAliasTask struct {
 	Req *milvuspb.DropAliasRequest
 }
 
+func (t *dropAliasTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetAlias(), true)}
+}
+
 func (t *dropAliasTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropAlias); err != nil {
 		return err
@@ -19,12 +19,14 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -46,6 +48,10 @@ func (t *dropCollectionTask) validate() error {
 	return nil
 }
 
+func (t *dropCollectionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), true)}
+}
+
 func (t *dropCollectionTask) Prepare(ctx context.Context) error {
 	return t.validate()
 }
@@ -70,6 +76,11 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 	aliases := t.core.meta.ListAliasesByID(collMeta.CollectionID)
 
 	ts := t.GetTs()
+	state := pb.CollectionState_CollectionDropping
+	recycle := Params.RootCoordCfg.RecycleBinRetention.GetAsDuration(time.Second) > 0
+	if recycle {
+		state = pb.CollectionState_CollectionRecycled
+	}
 
 	redoTask := newBaseRedoTask(t.core.stepExecutor)
 
@@ -84,7 +95,7 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 	redoTask.AddSyncStep(&changeCollectionStateStep{
 		baseStep:     baseStep{core: t.core},
 		collectionID: collMeta.CollectionID,
-		state:        pb.CollectionState_CollectionDropping,
+		state:        state,
 		ts:           ts,
 	})
 
@@ -92,29 +103,38 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		baseStep:     baseStep{core: t.core},
 		collectionID: collMeta.CollectionID,
 	})
+	if recycle {
+		// the indexes, data and channels are kept for undrop, they're removed once the retention expires.
+		return redoTask.Execute(ctx)
+	}
+	addGcCollectionSteps(t.core, redoTask, collMeta, ts, t.Req.GetBase().GetReplicateInfo().GetIsReplicate())
+
+	return redoTask.Execute(ctx)
+}
+
+// addGcCollectionSteps adds the steps removing the indexes, data, channels and meta of the dropped collection.
+func addGcCollectionSteps(core *Core, redoTask *baseRedoTask, collMeta *model.Collection, ts Timestamp, isSkip bool) {
 	redoTask.AddAsyncStep(&dropIndexStep{
-		baseStep: baseStep{core: t.core},
+		baseStep: baseStep{core: core},
 		collID:   collMeta.CollectionID,
 		partIDs:  nil,
 	})
 	redoTask.AddAsyncStep(&deleteCollectionDataStep{
-		baseStep: baseStep{core: t.core},
+		baseStep: baseStep{core: core},
 		coll:     collMeta,
-		isSkip:   t.Req.GetBase().GetReplicateInfo().GetIsReplicate(),
+		isSkip:   isSkip,
 	})
 	redoTask.AddAsyncStep(&removeDmlChannelsStep{
-		baseStep:  baseStep{core: t.core},
+		baseStep:  baseStep{core: core},
 		pChannels: collMeta.PhysicalChannelNames,
 	})
-	redoTask.AddAsyncStep(newConfirmGCStep(t.core, collMeta.CollectionID, allPartition))
+	redoTask.AddAsyncStep(newConfirmGCStep(core, collMeta.CollectionID, allPartition))
 	redoTask.AddAsyncStep(&deleteCollectionMetaStep{
-		baseStep:     baseStep{core: t.core},
+		baseStep:     baseStep{core: core},
 		collectionID: collMeta.CollectionID,
 		// This ts is less than the ts when we notify data nodes to drop collection, but it's OK since we have already
 		// marked this collection as deleted. If we want to make this ts greater than the notification's ts, we should
 		// wrap a step who will have these three children and connect them with ts.
 		ts: ts,
 	})
-
-	return redoTask.Execute(ctx)
 }
@@ -28,9 +28,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func Test_dropCollectionTask_Prepare(t *testing.T) {
@@ -280,4 +282,37 @@ func Test_dropCollectionTask_Execute(t *testing.T) {
 		<-removeCollectionMetaChan
 		assert.True(t, removeCollectionMetaCalled)
 	})
+
+	t.Run("move to recycle bin", func(t *testing.T) {
+		paramtable.Get().Save(Params.RootCoordCfg.RecycleBinRetention.Key, "3600")
+		defer paramtable.Get().Reset(Params.RootCoordCfg.RecycleBinRetention.Key)
+
+		collectionName := funcutil.GenRandomStr()
+		coll := &model.Collection{Name: collectionName, CollectionID: 1}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, collectionName, mock.Anything).Return(coll.Clone(), nil)
+		meta.EXPECT().ListAliasesByID(int64(1)).Return([]string{})
+		meta.EXPECT().ChangeCollectionState(mock.Anything, int64(1), pb.CollectionState_CollectionRecycled, mock.Anything).Return(nil).Once()
+
+		broker := newMockBroker()
+		releaseCollectionChan := make(chan struct{}, 1)
+		broker.ReleaseCollectionFunc = func(ctx context.Context, collectionID UniqueID) error {
+			releaseCollectionChan <- struct{}{}
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &dropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.DropCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+				CollectionName: collectionName,
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		// the collection is released, but neither the data nor the meta is removed.
+		<-releaseCollectionChan
+	})
 }
@@ -27,6 +27,10 @@ type dropDatabaseTask struct {
 	Req *milvuspb.DropDatabaseRequest
 }
 
+func (t *dropDatabaseTask) GetLocks() []ddlLock {
+	return []ddlLock{newDatabaseLock(t.Req.GetDbName(), true)}
+}
+
 func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
 	return nil
 }
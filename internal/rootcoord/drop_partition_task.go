@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -36,6 +37,10 @@ type dropPartitionTask struct {
 	collMeta *model.Collection
 }
 
+func (t *dropPartitionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), true)}
+}
+
 func (t *dropPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_DropPartition); err != nil {
 		return err
@@ -66,6 +71,12 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 		return nil
 	}
 
+	state := pb.PartitionState_PartitionDropping
+	recycle := Params.RootCoordCfg.RecycleBinRetention.GetAsDuration(time.Second) > 0
+	if recycle {
+		state = pb.PartitionState_PartitionRecycled
+	}
+
 	redoTask := newBaseRedoTask(t.core.stepExecutor)
 
 	redoTask.AddSyncStep(&expireCacheStep{
@@ -79,31 +90,40 @@ func (t *dropPartitionTask) Execute(ctx context.Context) error {
 		baseStep:     baseStep{core: t.core},
 		collectionID: t.collMeta.CollectionID,
 		partitionID:  partID,
-		state:        pb.PartitionState_PartitionDropping,
+		state:        state,
 		ts:           t.GetTs(),
 	})
+	if recycle {
+		// the data is kept for undrop, it's removed once the retention expires.
+		return redoTask.Execute(ctx)
+	}
+
+	addGcPartitionSteps(t.core, redoTask, t.collMeta, &model.Partition{
+		PartitionID:   partID,
+		PartitionName: t.Req.GetPartitionName(),
+		CollectionID:  t.collMeta.CollectionID,
+	}, t.GetTs(), t.Req.GetBase().GetReplicateInfo().GetIsReplicate())
+
+	return redoTask.Execute(ctx)
+}
 
+// addGcPartitionSteps adds the steps removing the data and meta of the dropped partition.
+func addGcPartitionSteps(core *Core, redoTask *baseRedoTask, collMeta *model.Collection, partition *model.Partition, ts Timestamp, isSkip bool) {
 	redoTask.AddAsyncStep(&deletePartitionDataStep{
-		baseStep: baseStep{core: t.core},
-		pchans:   t.collMeta.PhysicalChannelNames,
-		partition: &model.Partition{
-			PartitionID:   partID,
-			PartitionName: t.Req.GetPartitionName(),
-			CollectionID:  t.collMeta.CollectionID,
-		},
-		isSkip: t.Req.GetBase().GetReplicateInfo().GetIsReplicate(),
+		baseStep:  baseStep{core: core},
+		pchans:    collMeta.PhysicalChannelNames,
+		partition: partition,
+		isSkip:    isSkip,
 	})
-	redoTask.AddAsyncStep(newConfirmGCStep(t.core, t.collMeta.CollectionID, partID))
+	redoTask.AddAsyncStep(newConfirmGCStep(core, collMeta.CollectionID, partition.PartitionID))
 	redoTask.AddAsyncStep(&removePartitionMetaStep{
-		baseStep:     baseStep{core: t.core},
-		dbID:         t.collMeta.DBID,
-		collectionID: t.collMeta.CollectionID,
-		partitionID:  partID,
+		baseStep:     baseStep{core: core},
+		dbID:         collMeta.DBID,
+		collectionID: collMeta.CollectionID,
+		partitionID:  partition.PartitionID,
 		// This ts is less than the ts when we notify data nodes to drop partition, but it's OK since we have already
 		// marked this partition as deleted. If we want to make this ts greater than the notification's ts, we should
 		// wrap a step who will have these children and connect them with ts.
-		ts: t.GetTs(),
+		ts: ts,
 	})
-
-	return redoTask.Execute(ctx)
 }
@@ -27,8 +27,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func Test_dropPartitionTask_Prepare(t *testing.T) {
@@ -218,4 +220,30 @@ func Test_dropPartitionTask_Execute(t *testing.T) {
 		<-deletePartitionChan
 		assert.True(t, deletePartitionCalled)
 	})
+
+	t.Run("move to recycle bin", func(t *testing.T) {
+		paramtable.Get().Save(Params.RootCoordCfg.RecycleBinRetention.Key, "3600")
+		defer paramtable.Get().Reset(Params.RootCoordCfg.RecycleBinRetention.Key)
+
+		collectionName := funcutil.GenRandomStr()
+		partitionName := funcutil.GenRandomStr()
+		coll := &model.Collection{Name: collectionName, Partitions: []*model.Partition{{PartitionName: partitionName}}}
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ChangePartitionState(mock.Anything, mock.Anything, mock.Anything,
+			pb.PartitionState_PartitionRecycled, mock.Anything).Return(nil).Once()
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &dropPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.DropPartitionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropPartition},
+				CollectionName: collectionName,
+				PartitionName:  partitionName,
+			},
+			collMeta: coll.Clone(),
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
 }
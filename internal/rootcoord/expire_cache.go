@@ -84,3 +84,43 @@ func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []s
 	}
 	return nil
 }
+
+// ExpireDatabaseMetaCache invalidates the meta cache of all the collections in the database, which is required
+// once the database is renamed.
+func (c *Core) ExpireDatabaseMetaCache(ctx context.Context, dbName string, ts typeutil.Timestamp) error {
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DropDatabase),
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(c.session.ServerID),
+		),
+		DbName: dbName,
+	}
+	return c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+}
+
+// ExpireAliasesMetaCache invalidates the meta cache of the aliases with a single request, so that the proxies switch
+// all of them at once. The first alias is also set as the collection name for the proxies of the older versions.
+func (c *Core) ExpireAliasesMetaCache(ctx context.Context, dbName string, aliases []string, ts typeutil.Timestamp) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+	req := proxypb.InvalidateCollMetaCacheRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_AlterAlias),
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(c.session.ServerID),
+		),
+		DbName:          dbName,
+		CollectionName:  aliases[0],
+		CollectionNames: aliases,
+	}
+	return c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
+}
+
+// refreshPolicyCache reloads the rbac policies in proxies, which is required once the grants are remapped.
+func (c *Core) refreshPolicyCache(ctx context.Context) error {
+	return c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+		OpType: int32(typeutil.CacheRefresh),
+	})
+}
@@ -17,12 +17,15 @@
 package rootcoord
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_expireCacheConfig_apply(t *testing.T) {
@@ -35,3 +38,25 @@ func Test_expireCacheConfig_apply(t *testing.T) {
 	c.apply(req)
 	assert.Equal(t, commonpb.MsgType_DropCollection, req.GetBase().GetMsgType())
 }
+
+func TestCore_ExpireAliasesMetaCache(t *testing.T) {
+	c := newTestCore()
+	c.proxyClientManager = &proxyClientManager{proxyClient: make(map[UniqueID]types.ProxyClient)}
+	p := newMockProxy()
+	var reqs []*proxypb.InvalidateCollMetaCacheRequest
+	p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+		reqs = append(reqs, request)
+		return merr.Success(), nil
+	}
+	c.proxyClientManager.proxyClient[TestProxyID] = p
+
+	assert.NoError(t, c.ExpireAliasesMetaCache(context.Background(), "db", nil, 100))
+	assert.Empty(t, reqs)
+
+	assert.NoError(t, c.ExpireAliasesMetaCache(context.Background(), "db", []string{"alias1", "alias2"}, 100))
+	assert.Len(t, reqs, 1)
+	assert.Equal(t, commonpb.MsgType_AlterAlias, reqs[0].GetBase().GetMsgType())
+	assert.Equal(t, "db", reqs[0].GetDbName())
+	assert.Equal(t, "alias1", reqs[0].GetCollectionName())
+	assert.Equal(t, []string{"alias1", "alias2"}, reqs[0].GetCollectionNames())
+}
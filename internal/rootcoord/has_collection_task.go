@@ -31,6 +31,10 @@ type hasCollectionTask struct {
 	Rsp *milvuspb.BoolResponse
 }
 
+func (t *hasCollectionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), false)}
+}
+
 func (t *hasCollectionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.Base.MsgType, commonpb.MsgType_HasCollection); err != nil {
 		return err
@@ -32,6 +32,10 @@ type hasPartitionTask struct {
 	Rsp *milvuspb.BoolResponse
 }
 
+func (t *hasPartitionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), false)}
+}
+
 func (t *hasPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.Base.MsgType, commonpb.MsgType_HasPartition); err != nil {
 		return err
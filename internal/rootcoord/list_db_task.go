@@ -29,6 +29,10 @@ type listDatabaseTask struct {
 	Resp *milvuspb.ListDatabasesResponse
 }
 
+func (t *listDatabaseTask) GetLocks() []ddlLock {
+	return []ddlLock{newClusterLock(false)}
+}
+
 func (t *listDatabaseTask) Prepare(ctx context.Context) error {
 	return nil
 }
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"sync"
+	"time"
+)
+
+type loginFailures struct {
+	count       int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// loginLockout counts the failed logins reported by all the proxies, the user is locked out for a while
+// once the consecutive failures reach the limit. The counters are kept in memory only, so they're reset
+// when rootcoord restarts. The zero value is ready to use.
+type loginLockout struct {
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+}
+
+// recordFailure counts the failed login of the user, returns the time until which the user is locked out,
+// which is zero if the user isn't locked out, and whether the user is locked out by this failure.
+func (l *loginLockout) recordFailure(username string, now time.Time, maxAttempts int, duration time.Duration) (time.Time, bool) {
+	if maxAttempts <= 0 {
+		return time.Time{}, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.failures == nil {
+		l.failures = make(map[string]*loginFailures)
+	}
+	record, ok := l.failures[username]
+	if !ok || now.Sub(record.lastFailure) > duration {
+		record = &loginFailures{}
+		l.failures[username] = record
+	}
+	if now.Before(record.lockedUntil) {
+		return record.lockedUntil, false
+	}
+	record.count++
+	record.lastFailure = now
+	if record.count < maxAttempts {
+		return time.Time{}, false
+	}
+	record.count = 0
+	record.lockedUntil = now.Add(duration)
+	return record.lockedUntil, true
+}
+
+// lockedUntil returns the time until which the user is locked out, it's zero if the user isn't locked out.
+func (l *loginLockout) lockedUntil(username string, now time.Time) time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	record, ok := l.failures[username]
+	if !ok || !now.Before(record.lockedUntil) {
+		return time.Time{}
+	}
+	return record.lockedUntil
+}
+
+// recordSuccess clears the failures of the user after the successful login, the lockout is kept if any, as the
+// login may be verified by the proxy before the lockout reaches it.
+func (l *loginLockout) recordSuccess(username string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if record, ok := l.failures[username]; ok && !now.Before(record.lockedUntil) {
+		delete(l.failures, username)
+	}
+}
+
+// reset clears the failures of the user, like when the password is updated or the user is dropped.
+func (l *loginLockout) reset(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, username)
+}
@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_loginLockout(t *testing.T) {
+	var l loginLockout
+	now := time.Now()
+	duration := time.Minute
+
+	t.Run("disabled", func(t *testing.T) {
+		for i := 0; i < 10; i++ {
+			lockedUntil, locked := l.recordFailure("user1", now, 0, duration)
+			assert.True(t, lockedUntil.IsZero())
+			assert.False(t, locked)
+		}
+		assert.True(t, l.lockedUntil("user1", now).IsZero())
+	})
+
+	t.Run("lock out", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, locked := l.recordFailure("user1", now, 3, duration)
+			assert.False(t, locked)
+		}
+		lockedUntil, locked := l.recordFailure("user1", now, 3, duration)
+		assert.True(t, locked)
+		assert.Equal(t, now.Add(duration), lockedUntil)
+		assert.Equal(t, lockedUntil, l.lockedUntil("user1", now.Add(time.Second)))
+		assert.True(t, l.lockedUntil("user2", now).IsZero())
+
+		// the failures while locked out don't extend the lockout
+		lockedUntil, locked = l.recordFailure("user1", now.Add(time.Second), 3, duration)
+		assert.False(t, locked)
+		assert.Equal(t, now.Add(duration), lockedUntil)
+
+		// the lockout expires
+		assert.True(t, l.lockedUntil("user1", now.Add(duration)).IsZero())
+		_, locked = l.recordFailure("user1", now.Add(2*duration), 3, duration)
+		assert.False(t, locked)
+	})
+
+	t.Run("stale failures", func(t *testing.T) {
+		l.reset("user1")
+		for i := 0; i < 2; i++ {
+			_, locked := l.recordFailure("user1", now, 3, duration)
+			assert.False(t, locked)
+		}
+		_, locked := l.recordFailure("user1", now.Add(2*duration), 3, duration)
+		assert.False(t, locked)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		_, locked := l.recordFailure("user3", now, 1, duration)
+		assert.True(t, locked)
+		l.reset("user3")
+		assert.True(t, l.lockedUntil("user3", now).IsZero())
+	})
+
+	t.Run("success", func(t *testing.T) {
+		// the successful login clears the failures
+		_, locked := l.recordFailure("user4", now, 2, duration)
+		assert.False(t, locked)
+		l.recordSuccess("user4", now)
+		_, locked = l.recordFailure("user4", now, 2, duration)
+		assert.False(t, locked)
+
+		// but not the lockout
+		_, locked = l.recordFailure("user4", now, 2, duration)
+		assert.True(t, locked)
+		l.recordSuccess("user4", now)
+		assert.Equal(t, now.Add(duration), l.lockedUntil("user4", now))
+	})
+}
@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -48,10 +49,13 @@ type IMetaTable interface {
 	GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error)
 	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
 	DropDatabase(ctx context.Context, dbName string, ts typeutil.Timestamp) error
+	RenameDatabase(ctx context.Context, oldName string, newName string, ts typeutil.Timestamp) error
+	AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error
 	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
 
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
+	UndropCollection(ctx context.Context, dbName string, collectionID UniqueID, ts Timestamp) error
 	RemoveCollection(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 	GetCollectionByName(ctx context.Context, dbName string, collectionName string, ts Timestamp) (*model.Collection, error)
 	GetCollectionByID(ctx context.Context, dbName string, collectionID UniqueID, ts Timestamp, allowUnavailable bool) (*model.Collection, error)
@@ -65,8 +69,16 @@ type IMetaTable interface {
 	CreateAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
 	DropAlias(ctx context.Context, dbName string, alias string, ts Timestamp) error
 	AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts Timestamp) error
+	AlterAliases(ctx context.Context, dbName string, ops []*internalpb.AliasOperation, ts Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error
 	RenameCollection(ctx context.Context, dbName string, oldName string, newDBName string, newName string, ts Timestamp) error
+	AddCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error
+	GetCollectionSnapshot(ctx context.Context, dbID int64, name string) (*model.CollectionSnapshot, error)
+	ListCollectionSnapshots(ctx context.Context, dbID int64, collectionID UniqueID) ([]*model.CollectionSnapshot, error)
+	DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID UniqueID) error
+	SaveDDLJob(ctx context.Context, job *model.DDLJob) error
+	ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error)
+	DropDDLJob(ctx context.Context, jobID UniqueID) error
 
 	// TODO: it'll be a big cost if we handle the time travel logic, since we should always list all aliases in catalog.
 	IsAlias(db, name string) bool
@@ -82,6 +94,12 @@ type IMetaTable interface {
 	DeleteCredential(username string) error
 	AlterCredential(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernames() (*milvuspb.ListCredUsersResponse, error)
+	AddAPIKey(info *internalpb.APIKeyInfo) error
+	ListAPIKeys(username string, id string) ([]*internalpb.APIKeyInfo, error)
+	DeleteAPIKey(username string, id string) error
+	AddRowPolicy(policy *internalpb.RowPolicy) error
+	ListRowPolicies(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error)
+	DeleteRowPolicy(roleName string, collectionID int64) error
 
 	// TODO: better to accept ctx.
 	CreateRole(tenant string, entity *milvuspb.RoleEntity) error
@@ -111,6 +129,7 @@ type MetaTable struct {
 
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
+	snapshotLock   sync.RWMutex
 }
 
 func NewMetaTable(ctx context.Context, catalog metastore.RootCoordCatalog, tsoAllocator tso.Allocator) (*MetaTable, error) {
@@ -308,6 +327,63 @@ func (mt *MetaTable) DropDatabase(ctx context.Context, dbName string, ts typeuti
 	return nil
 }
 
+// RenameDatabase renames the database, the collections and aliases stay in it since they refer to the database
+// by id, and the grants on the objects of the database and the api keys scoped to it are remapped to the new name.
+func (mt *MetaTable) RenameDatabase(ctx context.Context, oldName string, newName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if oldName == util.DefaultDBName {
+		return fmt.Errorf("can not rename default database")
+	}
+	oldDB, ok := mt.dbName2Meta[oldName]
+	if !ok {
+		return merr.WrapErrDatabaseNotFound(oldName)
+	}
+	if _, ok := mt.dbName2Meta[newName]; ok {
+		return fmt.Errorf("database already exist: %s", newName)
+	}
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	newDB := oldDB.Clone()
+	newDB.Name = newName
+	if err := mt.catalog.AlterDatabase(ctx, util.DefaultTenant, oldDB, newDB, ts); err != nil {
+		return err
+	}
+
+	mt.names.db2Name2ID[newName] = mt.names.db2Name2ID[oldName]
+	mt.names.dropDb(oldName)
+	mt.aliases.db2Name2ID[newName] = mt.aliases.db2Name2ID[oldName]
+	mt.aliases.dropDb(oldName)
+	delete(mt.dbName2Meta, oldName)
+	mt.dbName2Meta[newName] = newDB
+	log.Ctx(ctx).Info("rename database", zap.String("oldName", oldName), zap.String("newName", newName), zap.Uint64("ts", ts))
+
+	return nil
+}
+
+// AlterDatabase replaces the meta of the database, the name and id of the database can't be altered.
+func (mt *MetaTable) AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if oldDB.Name != newDB.Name || oldDB.ID != newDB.ID {
+		return fmt.Errorf("altering the name or id of database is forbidden, database: %s", oldDB.Name)
+	}
+	if _, ok := mt.dbName2Meta[oldDB.Name]; !ok {
+		return merr.WrapErrDatabaseNotFound(oldDB.Name)
+	}
+	if err := mt.catalog.AlterDatabase(ctx, util.DefaultTenant, oldDB, newDB, ts); err != nil {
+		return err
+	}
+	mt.dbName2Meta[newDB.Name] = newDB
+	log.Ctx(ctx).Info("alter database", zap.String("db", newDB.Name), zap.Any("properties", newDB.Properties), zap.Uint64("ts", ts))
+
+	return nil
+}
+
 func (mt *MetaTable) ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -394,6 +470,9 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 	}
 	clone := coll.Clone()
 	clone.State = state
+	if state == pb.CollectionState_CollectionRecycled {
+		clone.DropTime = ts
+	}
 	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
 	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
 		return err
@@ -405,8 +484,11 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 		metrics.RootCoordNumOfCollections.Inc()
 		metrics.RootCoordNumOfPartitions.WithLabelValues().Add(float64(coll.GetPartitionNum(true)))
 	default:
-		metrics.RootCoordNumOfCollections.Dec()
-		metrics.RootCoordNumOfPartitions.WithLabelValues().Sub(float64(coll.GetPartitionNum(true)))
+		// the recycled collection isn't counted since it's moved to the recycle bin.
+		if coll.State != pb.CollectionState_CollectionRecycled {
+			metrics.RootCoordNumOfCollections.Dec()
+			metrics.RootCoordNumOfPartitions.WithLabelValues().Sub(float64(coll.GetPartitionNum(true)))
+		}
 	}
 
 	log.Ctx(ctx).Info("change collection state", zap.Int64("collection", collectionID),
@@ -415,6 +497,51 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 	return nil
 }
 
+// UndropCollection restores the collection from the recycle bin, it fails if the name of the collection has been
+// taken by another collection or alias.
+func (mt *MetaTable) UndropCollection(ctx context.Context, dbName string, collectionID UniqueID, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	// backward compatibility for rolling  upgrade
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	db, ok := mt.dbName2Meta[dbName]
+	if !ok {
+		return merr.WrapErrDatabaseNotFound(dbName)
+	}
+	coll, ok := mt.collID2Meta[collectionID]
+	if !ok || coll.DBID != db.ID || coll.State != pb.CollectionState_CollectionRecycled {
+		return merr.WrapErrCollectionNotFound(collectionID, "the collection isn't in the recycle bin")
+	}
+	if id, ok := mt.names.get(dbName, coll.Name); ok && id != collectionID {
+		if other, ok := mt.collID2Meta[id]; ok && other.Available() {
+			return merr.WrapErrParameterInvalidMsg("collection name %s is used by another collection", coll.Name)
+		}
+	}
+	if _, ok := mt.aliases.get(dbName, coll.Name); ok {
+		return merr.WrapErrParameterInvalidMsg("collection name %s is used by an alias", coll.Name)
+	}
+
+	clone := coll.Clone()
+	clone.State = pb.CollectionState_CollectionCreated
+	clone.DropTime = 0
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterCollection(ctx1, coll, clone, metastore.MODIFY, ts); err != nil {
+		return err
+	}
+	mt.collID2Meta[collectionID] = clone
+	mt.names.insert(dbName, clone.Name, collectionID)
+
+	metrics.RootCoordNumOfCollections.Inc()
+	metrics.RootCoordNumOfPartitions.WithLabelValues().Add(float64(clone.GetPartitionNum(true)))
+
+	log.Ctx(ctx).Info("undrop collection", zap.String("db", dbName), zap.String("collection", clone.Name),
+		zap.Int64("collectionID", collectionID), zap.Uint64("ts", ts))
+	return nil
+}
+
 func (mt *MetaTable) removeIfNameMatchedInternal(collectionID UniqueID, name string) {
 	mt.names.removeIf(func(db string, collection string, id UniqueID) bool {
 		return collectionID == id
@@ -467,6 +594,7 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	// We cannot delete the name directly, since newly collection with same name may be created.
 	mt.removeAllNamesIfMatchedInternal(collectionID, allNames)
 	mt.removeCollectionByIDInternal(collectionID)
+	mt.removeRowPoliciesOfCollection(collectionID)
 
 	log.Ctx(ctx).Info("remove collection",
 		zap.Int64("dbID", coll.DBID),
@@ -478,6 +606,28 @@ func (mt *MetaTable) RemoveCollection(ctx context.Context, collectionID UniqueID
 	return nil
 }
 
+// removeRowPoliciesOfCollection removes the row policies on the dropped collection, the policies left behind
+// are never applied since the collection id isn't reused, so the failure is only logged.
+func (mt *MetaTable) removeRowPoliciesOfCollection(collectionID UniqueID) {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx)
+	if err != nil {
+		log.Warn("fail to list row policies of the dropped collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return
+	}
+	for _, policy := range policies {
+		if policy.CollectionID != collectionID {
+			continue
+		}
+		if err := mt.catalog.DropRowPolicy(mt.ctx, collectionID, policy.RoleName); err != nil {
+			log.Warn("fail to drop row policy of the dropped collection", zap.Int64("collectionID", collectionID),
+				zap.String("role", policy.RoleName), zap.Error(err))
+		}
+	}
+}
+
 func filterUnavailable(coll *model.Collection) *model.Collection {
 	clone := coll.Clone()
 	// pick available partitions.
@@ -755,21 +905,39 @@ func (mt *MetaTable) RenameCollection(ctx context.Context, dbName string, oldNam
 		return err
 	}
 
-	// unsupported rename collection while the collection has aliases
+	// the aliases are moved to the target db with the collection
 	aliases := mt.listAliasesByID(oldColl.CollectionID)
-	if len(aliases) > 0 && oldColl.DBID != targetDB.ID {
-		return fmt.Errorf("fail to rename db name, must drop all aliases of this collection before rename")
+	if oldColl.DBID != targetDB.ID {
+		for _, alias := range aliases {
+			if _, ok := mt.names.get(newDBName, alias); ok {
+				return fmt.Errorf("alias:%s of the collection conflicts with a collection name in target database:%s", alias, newDBName)
+			}
+			if _, ok := mt.aliases.get(newDBName, alias); ok {
+				return fmt.Errorf("alias:%s of the collection conflicts with an alias in target database:%s", alias, newDBName)
+			}
+		}
 	}
 
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
 	newColl = oldColl.Clone()
 	newColl.Name = newName
 	newColl.DBID = targetDB.ID
-	if err := mt.catalog.AlterCollection(ctx, oldColl, newColl, metastore.MODIFY, ts); err != nil {
+	movedColl := newColl.Clone()
+	movedColl.Aliases = aliases
+	if err := mt.catalog.MoveCollection(ctx, util.DefaultTenant, oldColl, movedColl, dbName, newDBName, ts); err != nil {
 		return err
 	}
 
 	mt.names.insert(newDBName, newName, oldColl.CollectionID)
 	mt.names.remove(dbName, oldName)
+	if oldColl.DBID != targetDB.ID {
+		for _, alias := range aliases {
+			mt.aliases.insert(newDBName, alias, oldColl.CollectionID)
+			mt.aliases.remove(dbName, alias)
+		}
+	}
 
 	mt.collID2Meta[oldColl.CollectionID] = newColl
 
@@ -826,6 +994,11 @@ func (mt *MetaTable) ChangePartitionState(ctx context.Context, collectionID Uniq
 		if part.PartitionID == partitionID {
 			clone := part.Clone()
 			clone.State = state
+			if state == pb.PartitionState_PartitionRecycled {
+				clone.DropTime = ts
+			} else if state == pb.PartitionState_PartitionCreated {
+				clone.DropTime = 0
+			}
 			ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
 			if err := mt.catalog.AlterPartition(ctx1, coll.DBID, part, clone, metastore.MODIFY, ts); err != nil {
 				return err
@@ -837,7 +1010,10 @@ func (mt *MetaTable) ChangePartitionState(ctx context.Context, collectionID Uniq
 				// support Dynamic load/release partitions
 				metrics.RootCoordNumOfPartitions.WithLabelValues().Inc()
 			default:
-				metrics.RootCoordNumOfPartitions.WithLabelValues().Dec()
+				// the recycled partition isn't counted since it's moved to the recycle bin.
+				if part.State != pb.PartitionState_PartitionRecycled {
+					metrics.RootCoordNumOfPartitions.WithLabelValues().Dec()
+				}
 			}
 
 			log.Ctx(ctx).Info("change partition state", zap.Int64("collection", collectionID),
@@ -1048,6 +1224,128 @@ func (mt *MetaTable) AlterAlias(ctx context.Context, dbName string, alias string
 	return nil
 }
 
+// AlterAliases applies the alias operations in order, each operation is checked against the aliases left by the
+// ones before it, and the result is saved in a single transaction.
+func (mt *MetaTable) AlterAliases(ctx context.Context, dbName string, ops []*internalpb.AliasOperation, ts Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+
+	db, err := mt.getDatabaseByNameInternal(ctx, dbName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	// the collection of the alias after the operations, zero if it's dropped.
+	staged := make(map[string]UniqueID)
+	touched := make([]string, 0, len(ops))
+	getAlias := func(alias string) (UniqueID, bool) {
+		if collID, ok := staged[alias]; ok {
+			return collID, collID != 0
+		}
+		return mt.aliases.get(dbName, alias)
+	}
+	setAlias := func(alias string, collID UniqueID) {
+		if _, ok := staged[alias]; !ok {
+			touched = append(touched, alias)
+		}
+		staged[alias] = collID
+	}
+	getCollection := func(collectionName string) (UniqueID, error) {
+		collID, ok := mt.names.get(dbName, collectionName)
+		if !ok {
+			return 0, merr.WrapErrCollectionNotFoundWithDB(dbName, collectionName)
+		}
+		coll, ok := mt.collID2Meta[collID]
+		if !ok || !coll.Available() {
+			return 0, merr.WrapErrCollectionNotFoundWithDB(dbName, collectionName)
+		}
+		return collID, nil
+	}
+
+	for _, op := range ops {
+		alias := op.GetAlias()
+		if op.GetType() != internalpb.AliasOperationType_AliasOperationDrop {
+			if collID, ok := mt.names.get(dbName, alias); ok {
+				coll, ok := mt.collID2Meta[collID]
+				// allow alias with dropping&dropped
+				if ok && coll.State != pb.CollectionState_CollectionDropping && coll.State != pb.CollectionState_CollectionDropped {
+					return merr.WrapErrAliasCollectionNameConflict(dbName, alias)
+				}
+			}
+		}
+
+		switch op.GetType() {
+		case internalpb.AliasOperationType_AliasOperationCreate:
+			collID, err := getCollection(op.GetCollectionName())
+			if err != nil {
+				return err
+			}
+			aliasedCollID, ok := getAlias(alias)
+			if ok && aliasedCollID != collID {
+				msg := fmt.Sprintf("%s is alias to another collection: %d", alias, aliasedCollID)
+				return merr.WrapErrAliasAlreadyExist(dbName, alias, msg)
+			}
+			setAlias(alias, collID)
+		case internalpb.AliasOperationType_AliasOperationAlter:
+			collID, err := getCollection(op.GetCollectionName())
+			if err != nil {
+				return err
+			}
+			if _, ok := getAlias(alias); !ok {
+				return merr.WrapErrAliasNotFound(dbName, alias)
+			}
+			setAlias(alias, collID)
+		case internalpb.AliasOperationType_AliasOperationDrop:
+			if _, ok := getAlias(alias); !ok {
+				return merr.WrapErrAliasNotFound(dbName, alias)
+			}
+			setAlias(alias, 0)
+		default:
+			return merr.WrapErrParameterInvalidMsg("unknown alias operation %s", op.GetType())
+		}
+	}
+
+	aliases := make([]*model.Alias, 0, len(touched))
+	dropped := make([]string, 0)
+	for _, alias := range touched {
+		if collID := staged[alias]; collID != 0 {
+			aliases = append(aliases, &model.Alias{
+				Name:         alias,
+				CollectionID: collID,
+				CreatedTime:  ts,
+				State:        pb.AliasState_AliasCreated,
+				DbID:         db.ID,
+			})
+		} else {
+			dropped = append(dropped, alias)
+		}
+	}
+
+	ctx1 := contextutil.WithTenantID(ctx, Params.CommonCfg.ClusterName.GetValue())
+	if err := mt.catalog.AlterAliases(ctx1, db.ID, aliases, dropped, ts); err != nil {
+		return err
+	}
+
+	for _, alias := range aliases {
+		mt.aliases.insert(dbName, alias.Name, alias.CollectionID)
+	}
+	for _, alias := range dropped {
+		mt.aliases.remove(dbName, alias)
+	}
+
+	log.Ctx(ctx).Info("alter aliases",
+		zap.String("db", dbName),
+		zap.Int("saved", len(aliases)),
+		zap.Int("dropped", len(dropped)),
+		zap.Uint64("ts", ts),
+	)
+
+	return nil
+}
+
 func (mt *MetaTable) IsAlias(db, name string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -1142,6 +1440,87 @@ func (mt *MetaTable) GetPartitionByName(collID UniqueID, partitionName string, t
 		partitionName, coll.Name)
 }
 
+// AddCollectionSnapshot adds the snapshot of the collection, the names of snapshots are unique in the database.
+func (mt *MetaTable) AddCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error {
+	mt.snapshotLock.Lock()
+	defer mt.snapshotLock.Unlock()
+
+	snapshots, err := mt.catalog.ListCollectionSnapshots(mt.ctx)
+	if err != nil {
+		return err
+	}
+	for _, s := range snapshots {
+		if s.ID == snapshot.ID {
+			// idempotency.
+			return nil
+		}
+		if s.DBID == snapshot.DBID && s.Name == snapshot.Name {
+			return merr.WrapErrParameterInvalidMsg("snapshot %s already exists", snapshot.Name)
+		}
+	}
+	return mt.catalog.SaveCollectionSnapshot(mt.ctx, snapshot)
+}
+
+// GetCollectionSnapshot gets the snapshot by name in the database.
+func (mt *MetaTable) GetCollectionSnapshot(ctx context.Context, dbID int64, name string) (*model.CollectionSnapshot, error) {
+	mt.snapshotLock.RLock()
+	defer mt.snapshotLock.RUnlock()
+
+	snapshots, err := mt.catalog.ListCollectionSnapshots(mt.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snapshots {
+		if s.DBID == dbID && s.Name == name {
+			return s, nil
+		}
+	}
+	return nil, merr.WrapErrParameterInvalidMsg("snapshot %s not found", name)
+}
+
+// ListCollectionSnapshots lists the snapshots in the database, only the snapshots of the collection are listed
+// if collectionID isn't zero.
+func (mt *MetaTable) ListCollectionSnapshots(ctx context.Context, dbID int64, collectionID UniqueID) ([]*model.CollectionSnapshot, error) {
+	mt.snapshotLock.RLock()
+	defer mt.snapshotLock.RUnlock()
+
+	snapshots, err := mt.catalog.ListCollectionSnapshots(mt.ctx)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*model.CollectionSnapshot, 0, len(snapshots))
+	for _, s := range snapshots {
+		if s.DBID == dbID && (collectionID == 0 || s.Collection.CollectionID == collectionID) {
+			ret = append(ret, s)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].CreateTime < ret[j].CreateTime })
+	return ret, nil
+}
+
+// DropCollectionSnapshot removes the snapshot from the meta.
+func (mt *MetaTable) DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID UniqueID) error {
+	mt.snapshotLock.Lock()
+	defer mt.snapshotLock.Unlock()
+
+	return mt.catalog.DropCollectionSnapshot(mt.ctx, dbID, snapshotID)
+}
+
+// SaveDDLJob persists the progress of the DDL job.
+func (mt *MetaTable) SaveDDLJob(ctx context.Context, job *model.DDLJob) error {
+	return mt.catalog.SaveDDLJob(mt.ctx, job)
+}
+
+// ListDDLJobs lists all persisted DDL jobs.
+func (mt *MetaTable) ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error) {
+	return mt.catalog.ListDDLJobs(mt.ctx)
+}
+
+// DropDDLJob removes the DDL job from the meta.
+func (mt *MetaTable) DropDDLJob(ctx context.Context, jobID UniqueID) error {
+	return mt.catalog.DropDDLJob(mt.ctx, jobID)
+}
+
 // AddCredential add credential
 func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	if credInfo.Username == "" {
@@ -1165,8 +1544,11 @@ func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	}
 
 	credential := &model.Credential{
-		Username:          credInfo.Username,
-		EncryptedPassword: credInfo.EncryptedPassword,
+		Username:           credInfo.Username,
+		EncryptedPassword:  credInfo.EncryptedPassword,
+		PasswordHistory:    credInfo.PasswordHistory,
+		PasswordUpdateTime: credInfo.PasswordUpdateTime,
+		NeedPasswordChange: credInfo.NeedPasswordChange,
 	}
 	return mt.catalog.CreateCredential(mt.ctx, credential)
 }
@@ -1181,8 +1563,11 @@ func (mt *MetaTable) AlterCredential(credInfo *internalpb.CredentialInfo) error
 	defer mt.permissionLock.Unlock()
 
 	credential := &model.Credential{
-		Username:          credInfo.Username,
-		EncryptedPassword: credInfo.EncryptedPassword,
+		Username:           credInfo.Username,
+		EncryptedPassword:  credInfo.EncryptedPassword,
+		PasswordHistory:    credInfo.PasswordHistory,
+		PasswordUpdateTime: credInfo.PasswordUpdateTime,
+		NeedPasswordChange: credInfo.NeedPasswordChange,
 	}
 	return mt.catalog.AlterCredential(mt.ctx, credential)
 }
@@ -1216,6 +1601,129 @@ func (mt *MetaTable) ListCredentialUsernames() (*milvuspb.ListCredUsersResponse,
 	return &milvuspb.ListCredUsersResponse{Usernames: usernames}, nil
 }
 
+// AddAPIKey add api key for the existing user
+func (mt *MetaTable) AddAPIKey(info *internalpb.APIKeyInfo) error {
+	if info.GetUsername() == "" {
+		return fmt.Errorf("username is empty")
+	}
+	if info.GetId() == "" || info.GetSha256Key() == "" {
+		return fmt.Errorf("api key is empty")
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.GetCredential(mt.ctx, info.GetUsername()); err != nil {
+		return fmt.Errorf("user not found: %s", info.GetUsername())
+	}
+	keys, err := mt.catalog.ListAPIKeys(mt.ctx)
+	if err != nil {
+		return err
+	}
+	num := 0
+	for _, key := range keys {
+		if key.ID == info.GetId() {
+			return fmt.Errorf("api key already exists: %s", info.GetId())
+		}
+		if key.Username == info.GetUsername() {
+			num++
+		}
+	}
+	if num >= Params.ProxyCfg.MaxAPIKeyNumPerUser.GetAsInt() {
+		errMsg := "unable to add api key because the number of api keys of the user has reached the limit"
+		log.Error(errMsg, zap.String("username", info.GetUsername()), zap.Int("max_api_key_num", Params.ProxyCfg.MaxAPIKeyNumPerUser.GetAsInt()))
+		return errors.New(errMsg)
+	}
+
+	return mt.catalog.SaveAPIKey(mt.ctx, model.UnmarshalAPIKeyModel(info))
+}
+
+// ListAPIKeys list the api keys filtered by the username and id, all api keys are listed if both are empty
+func (mt *MetaTable) ListAPIKeys(username string, id string) ([]*internalpb.APIKeyInfo, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	keys, err := mt.catalog.ListAPIKeys(mt.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys err:%w", err)
+	}
+	infos := make([]*internalpb.APIKeyInfo, 0, len(keys))
+	for _, key := range keys {
+		if (username == "" || key.Username == username) && (id == "" || key.ID == id) {
+			infos = append(infos, model.MarshalAPIKeyModel(key))
+		}
+	}
+	return infos, nil
+}
+
+// DeleteAPIKey delete the api key of the user
+func (mt *MetaTable) DeleteAPIKey(username string, id string) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	keys, err := mt.catalog.ListAPIKeys(mt.ctx)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.ID == id && key.Username == username {
+			return mt.catalog.DropAPIKey(mt.ctx, id)
+		}
+	}
+	return fmt.Errorf("api key %s not found for user %s", id, username)
+}
+
+// AddRowPolicy add or overwrite the row policy of the role on the collection
+func (mt *MetaTable) AddRowPolicy(policy *internalpb.RowPolicy) error {
+	if policy.GetRoleName() == "" {
+		return fmt.Errorf("the role name in the row policy is empty")
+	}
+	if policy.GetCollectionID() == 0 {
+		return fmt.Errorf("the collection in the row policy is empty")
+	}
+	if policy.GetExpr() == "" {
+		return fmt.Errorf("the expression in the row policy is empty")
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	return mt.catalog.SaveRowPolicy(mt.ctx, model.UnmarshalRowPolicyModel(policy))
+}
+
+// ListRowPolicies list the row policies filtered by the role and collection, all policies are listed if both are empty
+func (mt *MetaTable) ListRowPolicies(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list row policies err:%w", err)
+	}
+	infos := make([]*internalpb.RowPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if (roleName == "" || policy.RoleName == roleName) && (collectionID == 0 || policy.CollectionID == collectionID) {
+			infos = append(infos, model.MarshalRowPolicyModel(policy))
+		}
+	}
+	return infos, nil
+}
+
+// DeleteRowPolicy delete the row policy of the role on the collection
+func (mt *MetaTable) DeleteRowPolicy(roleName string, collectionID int64) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	policies, err := mt.catalog.ListRowPolicies(mt.ctx)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		if policy.RoleName == roleName && policy.CollectionID == collectionID {
+			return mt.catalog.DropRowPolicy(mt.ctx, collectionID, roleName)
+		}
+	}
+	return fmt.Errorf("row policy of role %s not found for collection %d", roleName, collectionID)
+}
+
 // CreateRole create role
 func (mt *MetaTable) CreateRole(tenant string, entity *milvuspb.RoleEntity) error {
 	if funcutil.IsEmptyString(entity.Name) {
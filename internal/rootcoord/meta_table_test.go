@@ -26,8 +26,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -78,6 +80,128 @@ func TestRbacAddCredential(t *testing.T) {
 	}
 }
 
+func TestRbacAPIKey(t *testing.T) {
+	mt := generateMetaTable(t)
+	err := mt.AddCredential(&internalpb.CredentialInfo{
+		Username: "user1",
+		Tenant:   util.DefaultTenant,
+	})
+	require.NoError(t, err)
+
+	paramtable.Get().Save(Params.ProxyCfg.MaxAPIKeyNumPerUser.Key, "2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MaxAPIKeyNumPerUser.Key)
+	key1 := &internalpb.APIKeyInfo{Id: "key1", Username: "user1", Sha256Key: "xxxx", Scope: &internalpb.APIKeyScope{DbName: "db1"}}
+	key2 := &internalpb.APIKeyInfo{Id: "key2", Username: "user1", Sha256Key: "xxxx", Scope: &internalpb.APIKeyScope{ReadOnly: true}}
+	require.NoError(t, mt.AddAPIKey(key1))
+	require.NoError(t, mt.AddAPIKey(key2))
+
+	t.Run("add invalid api key", func(t *testing.T) {
+		tests := []struct {
+			description string
+			info        *internalpb.APIKeyInfo
+		}{
+			{"empty username", &internalpb.APIKeyInfo{Id: "key3", Sha256Key: "xxxx"}},
+			{"empty key", &internalpb.APIKeyInfo{Username: "user1"}},
+			{"user not found", &internalpb.APIKeyInfo{Id: "key3", Username: "user2", Sha256Key: "xxxx"}},
+			{"key exist", &internalpb.APIKeyInfo{Id: "key1", Username: "user1", Sha256Key: "xxxx"}},
+			{"exceed MaxAPIKeyNumPerUser", &internalpb.APIKeyInfo{Id: "key3", Username: "user1", Sha256Key: "xxxx"}},
+		}
+		for _, test := range tests {
+			assert.Error(t, mt.AddAPIKey(test.info), test.description)
+		}
+	})
+
+	t.Run("list api keys", func(t *testing.T) {
+		keys, err := mt.ListAPIKeys("user1", "")
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"key1", "key2"}, []string{keys[0].GetId(), keys[1].GetId()})
+
+		keys, err = mt.ListAPIKeys("", "key2")
+		assert.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.True(t, keys[0].GetScope().GetReadOnly())
+
+		keys, err = mt.ListAPIKeys("user2", "")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+
+	t.Run("delete api key", func(t *testing.T) {
+		assert.Error(t, mt.DeleteAPIKey("user2", "key1"))
+		assert.NoError(t, mt.DeleteAPIKey("user1", "key1"))
+		assert.Error(t, mt.DeleteAPIKey("user1", "key1"))
+
+		// the api keys are dropped along with the user
+		assert.NoError(t, mt.DeleteCredential("user1"))
+		keys, err := mt.ListAPIKeys("", "")
+		assert.NoError(t, err)
+		assert.Empty(t, keys)
+	})
+}
+
+func TestRbacRowPolicy(t *testing.T) {
+	mt := generateMetaTable(t)
+
+	policy1 := &internalpb.RowPolicy{RoleName: "role1", DbName: "db", CollectionName: "coll1", CollectionID: 100, Expr: "tenant == 'a'"}
+	policy2 := &internalpb.RowPolicy{RoleName: "role2", DbName: "db", CollectionName: "coll1", CollectionID: 100, Expr: "tenant == 'b'"}
+	policy3 := &internalpb.RowPolicy{RoleName: "role1", DbName: "db", CollectionName: "coll2", CollectionID: 200, Expr: "tenant == 'a'"}
+	require.NoError(t, mt.AddRowPolicy(policy1))
+	require.NoError(t, mt.AddRowPolicy(policy2))
+	require.NoError(t, mt.AddRowPolicy(policy3))
+
+	t.Run("add invalid row policy", func(t *testing.T) {
+		tests := []struct {
+			description string
+			policy      *internalpb.RowPolicy
+		}{
+			{"empty role", &internalpb.RowPolicy{CollectionID: 100, Expr: "a > 1"}},
+			{"empty collection", &internalpb.RowPolicy{RoleName: "role1", Expr: "a > 1"}},
+			{"empty expr", &internalpb.RowPolicy{RoleName: "role1", CollectionID: 100}},
+		}
+		for _, test := range tests {
+			assert.Error(t, mt.AddRowPolicy(test.policy), test.description)
+		}
+	})
+
+	t.Run("list row policies", func(t *testing.T) {
+		policies, err := mt.ListRowPolicies("", 0)
+		assert.NoError(t, err)
+		assert.Len(t, policies, 3)
+
+		policies, err = mt.ListRowPolicies("role1", 0)
+		assert.NoError(t, err)
+		assert.Len(t, policies, 2)
+
+		policies, err = mt.ListRowPolicies("", 100)
+		assert.NoError(t, err)
+		assert.Len(t, policies, 2)
+
+		policies, err = mt.ListRowPolicies("role2", 100)
+		assert.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, "tenant == 'b'", policies[0].GetExpr())
+	})
+
+	t.Run("overwrite row policy", func(t *testing.T) {
+		require.NoError(t, mt.AddRowPolicy(&internalpb.RowPolicy{RoleName: "role2", CollectionID: 100, Expr: "tenant == 'c'"}))
+		policies, err := mt.ListRowPolicies("role2", 100)
+		assert.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, "tenant == 'c'", policies[0].GetExpr())
+	})
+
+	t.Run("delete row policy", func(t *testing.T) {
+		assert.Error(t, mt.DeleteRowPolicy("role3", 100))
+		assert.NoError(t, mt.DeleteRowPolicy("role1", 100))
+		assert.Error(t, mt.DeleteRowPolicy("role1", 100))
+
+		policies, err := mt.ListRowPolicies("role1", 0)
+		assert.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, int64(200), policies[0].GetCollectionID())
+	})
+}
+
 func TestRbacCreateRole(t *testing.T) {
 	mt := generateMetaTable(t)
 
@@ -812,6 +936,14 @@ func TestMetaTable_RemoveCollection(t *testing.T) {
 			mock.Anything, // model.Collection
 			mock.AnythingOfType("uint64"),
 		).Return(nil)
+		catalog.EXPECT().ListRowPolicies(mock.Anything).Return([]*model.RowPolicy{
+			{RoleName: "role1", CollectionID: 100, Expr: "a > 1"},
+			{RoleName: "role2", CollectionID: 100, Expr: "a > 2"},
+			{RoleName: "role1", CollectionID: 200, Expr: "a > 1"},
+		}, nil)
+		catalog.EXPECT().DropRowPolicy(mock.Anything, int64(100), "role1").Return(nil)
+		// the failure of dropping the row policies doesn't fail the removal
+		catalog.EXPECT().DropRowPolicy(mock.Anything, int64(100), "role2").Return(errors.New("error mock DropRowPolicy"))
 		meta := &MetaTable{
 			catalog: catalog,
 			names:   newNameDb(),
@@ -1127,6 +1259,79 @@ func TestMetaTable_ChangeCollectionState(t *testing.T) {
 	})
 }
 
+func TestMetaTable_UndropCollection(t *testing.T) {
+	newMeta := func(catalog metastore.RootCoordCatalog) *MetaTable {
+		meta := &MetaTable{
+			catalog: catalog,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {Name: "test", CollectionID: 100, DBID: util.DefaultDBID, State: pb.CollectionState_CollectionRecycled, DropTime: 1000},
+			},
+			names:   newNameDb(),
+			aliases: newNameDb(),
+		}
+		meta.names.insert(util.DefaultDBName, "test", 100)
+		return meta
+	}
+
+	t.Run("not in the recycle bin", func(t *testing.T) {
+		meta := newMeta(nil)
+		err := meta.UndropCollection(context.TODO(), util.DefaultDBName, 101, 2000)
+		assert.Error(t, err)
+	})
+
+	t.Run("name used by another collection", func(t *testing.T) {
+		meta := newMeta(nil)
+		meta.collID2Meta[101] = &model.Collection{Name: "test", CollectionID: 101, DBID: util.DefaultDBID, State: pb.CollectionState_CollectionCreated}
+		meta.names.insert(util.DefaultDBName, "test", 101)
+		err := meta.UndropCollection(context.TODO(), util.DefaultDBName, 100, 2000)
+		assert.Error(t, err)
+	})
+
+	t.Run("name used by an alias", func(t *testing.T) {
+		meta := newMeta(nil)
+		meta.aliases.insert(util.DefaultDBName, "test", 101)
+		err := meta.UndropCollection(context.TODO(), util.DefaultDBName, 100, 2000)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter collection", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.Anything, // *model.Collection
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(errors.New("error mock AlterCollection"))
+		meta := newMeta(catalog)
+		err := meta.UndropCollection(context.TODO(), util.DefaultDBName, 100, 2000)
+		assert.Error(t, err)
+		assert.Equal(t, pb.CollectionState_CollectionRecycled, meta.collID2Meta[100].State)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterCollection",
+			mock.Anything, // context.Context
+			mock.Anything, // *model.Collection
+			mock.Anything, // *model.Collection
+			mock.Anything, // metastore.AlterType
+			mock.AnythingOfType("uint64"),
+		).Return(nil)
+		meta := newMeta(catalog)
+		err := meta.UndropCollection(context.TODO(), util.DefaultDBName, 100, 2000)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.CollectionState_CollectionCreated, meta.collID2Meta[100].State)
+		assert.Equal(t, uint64(0), meta.collID2Meta[100].DropTime)
+		coll, err := meta.GetCollectionByName(context.TODO(), util.DefaultDBName, "test", typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), coll.CollectionID)
+	})
+}
+
 func TestMetaTable_AddPartition(t *testing.T) {
 	t.Run("collection not available", func(t *testing.T) {
 		meta := &MetaTable{}
@@ -1267,7 +1472,9 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 
 	t.Run("alter collection fail", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
-		catalog.On("AlterCollection",
+		catalog.On("MoveCollection",
+			mock.Anything,
+			mock.Anything,
 			mock.Anything,
 			mock.Anything,
 			mock.Anything,
@@ -1300,7 +1507,7 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	t.Run("rename db name fails if aliases exists", func(t *testing.T) {
+	t.Run("aliases conflict in target db", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything,
@@ -1325,14 +1532,72 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		}
 		meta.names.insert(util.DefaultDBName, "old", 1)
 		meta.aliases.insert(util.DefaultDBName, "alias", 1)
+		meta.aliases.insert("db1", "alias", 2)
 
 		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
 		assert.Error(t, err)
+
+		meta.aliases.remove("db1", "alias")
+		meta.names.insert("db1", "alias", 2)
+		err = meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.Error(t, err)
+	})
+
+	t.Run("move collection with aliases", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(nil, merr.WrapErrCollectionNotFound("error"))
+		catalog.EXPECT().MoveCollection(mock.Anything, util.DefaultTenant, mock.Anything, mock.Anything, util.DefaultDBName, "db1", uint64(1000)).
+			Run(func(ctx context.Context, tenant string, oldColl *model.Collection, newColl *model.Collection, oldDBName string, newDBName string, ts uint64) {
+				assert.Equal(t, int64(2), newColl.DBID)
+				assert.Equal(t, "new", newColl.Name)
+				assert.Equal(t, []string{"alias"}, newColl.Aliases)
+			}).Return(nil)
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated),
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				1: {
+					CollectionID: 1,
+					DBID:         util.DefaultDBID,
+					Name:         "old",
+				},
+			},
+		}
+		meta.names.insert(util.DefaultDBName, "old", 1)
+		meta.aliases.insert(util.DefaultDBName, "alias", 1)
+
+		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.NoError(t, err)
+
+		_, ok := meta.names.get(util.DefaultDBName, "old")
+		assert.False(t, ok)
+		id, ok := meta.names.get("db1", "new")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
+		_, ok = meta.aliases.get(util.DefaultDBName, "alias")
+		assert.False(t, ok)
+		id, ok = meta.aliases.get("db1", "alias")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
+		assert.Equal(t, int64(2), meta.collID2Meta[1].DBID)
+		assert.Empty(t, meta.collID2Meta[1].Aliases)
 	})
 
 	t.Run("alter collection ok", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
-		catalog.On("AlterCollection",
+		catalog.On("MoveCollection",
+			mock.Anything,
+			mock.Anything,
 			mock.Anything,
 			mock.Anything,
 			mock.Anything,
@@ -1373,6 +1638,232 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 	})
 }
 
+func TestMetaTable_RenameDatabase(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated),
+				"db2":              model.NewDatabase(3, "db2", pb.DatabaseState_DatabaseCreated),
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+		}
+		meta.names.insert("db1", "coll", 1)
+		meta.aliases.insert("db1", "alias", 1)
+		return meta
+	}
+
+	t.Run("rename default db", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		err := meta.RenameDatabase(context.TODO(), util.DefaultDBName, "db3", 1000)
+		assert.Error(t, err)
+	})
+
+	t.Run("database not found", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		err := meta.RenameDatabase(context.TODO(), "db4", "db3", 1000)
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("new name exists", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		err := meta.RenameDatabase(context.TODO(), "db1", "db2", 1000)
+		assert.Error(t, err)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterDatabase(mock.Anything, util.DefaultTenant, mock.Anything, mock.Anything, uint64(1000)).Return(errors.New("mock"))
+		meta := newMeta(catalog)
+		err := meta.RenameDatabase(context.TODO(), "db1", "db3", 1000)
+		assert.Error(t, err)
+		_, ok := meta.dbName2Meta["db1"]
+		assert.True(t, ok)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterDatabase(mock.Anything, util.DefaultTenant, mock.Anything, mock.Anything, uint64(1000)).
+			Run(func(ctx context.Context, tenant string, oldDB *model.Database, newDB *model.Database, ts uint64) {
+				assert.Equal(t, "db1", oldDB.Name)
+				assert.Equal(t, "db3", newDB.Name)
+				assert.Equal(t, oldDB.ID, newDB.ID)
+			}).Return(nil)
+		meta := newMeta(catalog)
+		err := meta.RenameDatabase(context.TODO(), "db1", "db3", 1000)
+		assert.NoError(t, err)
+
+		_, ok := meta.dbName2Meta["db1"]
+		assert.False(t, ok)
+		assert.Equal(t, int64(2), meta.dbName2Meta["db3"].ID)
+		assert.False(t, meta.names.exist("db1"))
+		id, ok := meta.names.get("db3", "coll")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
+		id, ok = meta.aliases.get("db3", "alias")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
+	})
+}
+
+func TestMetaTable_AlterDatabase(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		return &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated),
+			},
+			catalog: catalog,
+		}
+	}
+
+	t.Run("name changed", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		oldDB := meta.dbName2Meta["db1"]
+		newDB := oldDB.Clone()
+		newDB.Name = "db2"
+		err := meta.AlterDatabase(context.TODO(), oldDB, newDB, 1000)
+		assert.Error(t, err)
+	})
+
+	t.Run("database not found", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		db := model.NewDatabase(3, "db3", pb.DatabaseState_DatabaseCreated)
+		err := meta.AlterDatabase(context.TODO(), db, db.Clone(), 1000)
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterDatabase(mock.Anything, util.DefaultTenant, mock.Anything, mock.Anything, uint64(1000)).Return(errors.New("mock"))
+		meta := newMeta(catalog)
+		oldDB := meta.dbName2Meta["db1"]
+		newDB := oldDB.Clone()
+		newDB.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}}
+		err := meta.AlterDatabase(context.TODO(), oldDB, newDB, 1000)
+		assert.Error(t, err)
+		assert.Same(t, oldDB, meta.dbName2Meta["db1"])
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterDatabase(mock.Anything, util.DefaultTenant, mock.Anything, mock.Anything, uint64(1000)).Return(nil)
+		meta := newMeta(catalog)
+		oldDB := meta.dbName2Meta[util.DefaultDBName]
+		newDB := oldDB.Clone()
+		newDB.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumberKey, Value: "2"}}
+		err := meta.AlterDatabase(context.TODO(), oldDB, newDB, 1000)
+		assert.NoError(t, err)
+		db, err := meta.GetDatabaseByName(context.TODO(), util.DefaultDBName, typeutil.MaxTimestamp)
+		assert.NoError(t, err)
+		assert.Equal(t, newDB.Properties, db.Properties)
+		assert.Empty(t, oldDB.Properties)
+	})
+}
+
+func TestMetaTable_AlterAliases(t *testing.T) {
+	newMeta := func(catalog *mocks.RootCoordCatalog) *MetaTable {
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+			},
+			collID2Meta: map[UniqueID]*model.Collection{
+				1: {CollectionID: 1, Name: "blue", State: pb.CollectionState_CollectionCreated},
+				2: {CollectionID: 2, Name: "green", State: pb.CollectionState_CollectionCreated},
+				3: {CollectionID: 3, Name: "dropping", State: pb.CollectionState_CollectionDropping},
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+		}
+		meta.names.insert(util.DefaultDBName, "blue", 1)
+		meta.names.insert(util.DefaultDBName, "green", 2)
+		meta.names.insert(util.DefaultDBName, "dropping", 3)
+		meta.aliases.insert(util.DefaultDBName, "prod", 1)
+		meta.aliases.insert(util.DefaultDBName, "old", 1)
+		return meta
+	}
+	op := func(typ internalpb.AliasOperationType, alias string, collectionName string) *internalpb.AliasOperation {
+		return &internalpb.AliasOperation{Type: typ, Alias: alias, CollectionName: collectionName}
+	}
+
+	t.Run("database not found", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		err := meta.AlterAliases(context.TODO(), "db", nil, 1000)
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("invalid operations", func(t *testing.T) {
+		meta := newMeta(mocks.NewRootCoordCatalog(t))
+		cases := [][]*internalpb.AliasOperation{
+			{op(internalpb.AliasOperationType_AliasOperationCreate, "green", "blue")},
+			{op(internalpb.AliasOperationType_AliasOperationCreate, "prod", "green")},
+			{op(internalpb.AliasOperationType_AliasOperationCreate, "staging", "dropping")},
+			{op(internalpb.AliasOperationType_AliasOperationAlter, "staging", "green")},
+			{op(internalpb.AliasOperationType_AliasOperationAlter, "prod", "not_exist")},
+			{op(internalpb.AliasOperationType_AliasOperationDrop, "staging", "")},
+			// the alias dropped by the former operation can't be altered.
+			{
+				op(internalpb.AliasOperationType_AliasOperationDrop, "prod", ""),
+				op(internalpb.AliasOperationType_AliasOperationAlter, "prod", "green"),
+			},
+			// the former operations don't take effect if the latter fails.
+			{
+				op(internalpb.AliasOperationType_AliasOperationAlter, "prod", "green"),
+				op(internalpb.AliasOperationType_AliasOperationDrop, "staging", ""),
+			},
+		}
+		for _, ops := range cases {
+			err := meta.AlterAliases(context.TODO(), util.DefaultDBName, ops, 1000)
+			assert.Error(t, err)
+		}
+		id, _ := meta.aliases.get(util.DefaultDBName, "prod")
+		assert.Equal(t, int64(1), id)
+	})
+
+	t.Run("catalog failed", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliases(mock.Anything, mock.Anything, mock.Anything, mock.Anything, uint64(1000)).Return(errors.New("mock"))
+		meta := newMeta(catalog)
+		err := meta.AlterAliases(context.TODO(), util.DefaultDBName, []*internalpb.AliasOperation{
+			op(internalpb.AliasOperationType_AliasOperationAlter, "prod", "green"),
+		}, 1000)
+		assert.Error(t, err)
+		id, _ := meta.aliases.get(util.DefaultDBName, "prod")
+		assert.Equal(t, int64(1), id)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterAliases(mock.Anything, util.DefaultDBID, mock.Anything, mock.Anything, uint64(1000)).
+			Run(func(ctx context.Context, dbID int64, aliases []*model.Alias, droppedAliases []string, ts uint64) {
+				assert.Len(t, aliases, 2)
+				assert.Equal(t, "prod", aliases[0].Name)
+				assert.Equal(t, int64(2), aliases[0].CollectionID)
+				assert.Equal(t, "rollback", aliases[1].Name)
+				assert.Equal(t, int64(1), aliases[1].CollectionID)
+				assert.Equal(t, []string{"old"}, droppedAliases)
+			}).Return(nil)
+		meta := newMeta(catalog)
+		err := meta.AlterAliases(context.TODO(), "", []*internalpb.AliasOperation{
+			op(internalpb.AliasOperationType_AliasOperationAlter, "prod", "green"),
+			op(internalpb.AliasOperationType_AliasOperationCreate, "rollback", "blue"),
+			op(internalpb.AliasOperationType_AliasOperationDrop, "old", ""),
+			// duplicate alias of the same collection is ignored.
+			op(internalpb.AliasOperationType_AliasOperationCreate, "rollback", "blue"),
+		}, 1000)
+		assert.NoError(t, err)
+
+		id, _ := meta.aliases.get(util.DefaultDBName, "prod")
+		assert.Equal(t, int64(2), id)
+		id, _ = meta.aliases.get(util.DefaultDBName, "rollback")
+		assert.Equal(t, int64(1), id)
+		assert.False(t, meta.IsAlias(util.DefaultDBName, "old"))
+	})
+}
+
 func TestMetaTable_ChangePartitionState(t *testing.T) {
 	t.Run("collection not exist", func(t *testing.T) {
 		meta := &MetaTable{}
@@ -1663,3 +2154,84 @@ func TestMetaTable_DropDatabase(t *testing.T) {
 		assert.False(t, mt.aliases.exist("not_commit"))
 	})
 }
+
+func TestMetaTable_CollectionSnapshot(t *testing.T) {
+	mt := generateMetaTable(t)
+	ctx := context.TODO()
+
+	newSnapshot := func(id int64, name string, dbID int64, collectionID int64, ts uint64) *model.CollectionSnapshot {
+		return &model.CollectionSnapshot{
+			ID:         id,
+			Name:       name,
+			DBID:       dbID,
+			Collection: &model.Collection{CollectionID: collectionID, DBID: dbID, Name: "coll"},
+			CreateTime: ts,
+		}
+	}
+	require.NoError(t, mt.AddCollectionSnapshot(ctx, newSnapshot(1, "s1", 1, 100, 30)))
+	require.NoError(t, mt.AddCollectionSnapshot(ctx, newSnapshot(2, "s2", 1, 100, 20)))
+	require.NoError(t, mt.AddCollectionSnapshot(ctx, newSnapshot(3, "s3", 1, 200, 10)))
+	require.NoError(t, mt.AddCollectionSnapshot(ctx, newSnapshot(4, "s1", 2, 300, 10)))
+
+	t.Run("add snapshot", func(t *testing.T) {
+		// idempotency.
+		assert.NoError(t, mt.AddCollectionSnapshot(ctx, newSnapshot(1, "s1", 1, 100, 30)))
+		// duplicate name.
+		assert.Error(t, mt.AddCollectionSnapshot(ctx, newSnapshot(5, "s2", 1, 200, 40)))
+	})
+
+	t.Run("get snapshot", func(t *testing.T) {
+		snapshot, err := mt.GetCollectionSnapshot(ctx, 2, "s1")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), snapshot.ID)
+		assert.Equal(t, int64(300), snapshot.Collection.CollectionID)
+
+		_, err = mt.GetCollectionSnapshot(ctx, 2, "s2")
+		assert.Error(t, err)
+	})
+
+	t.Run("list snapshots", func(t *testing.T) {
+		snapshots, err := mt.ListCollectionSnapshots(ctx, 1, 0)
+		assert.NoError(t, err)
+		require.Len(t, snapshots, 3)
+		assert.Equal(t, "s3", snapshots[0].Name)
+		assert.Equal(t, "s1", snapshots[2].Name)
+
+		snapshots, err = mt.ListCollectionSnapshots(ctx, 1, 100)
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 2)
+	})
+
+	t.Run("drop snapshot", func(t *testing.T) {
+		assert.NoError(t, mt.DropCollectionSnapshot(ctx, 1, 2))
+		_, err := mt.GetCollectionSnapshot(ctx, 1, "s2")
+		assert.Error(t, err)
+		snapshots, err := mt.ListCollectionSnapshots(ctx, 1, 100)
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 1)
+	})
+}
+
+func TestMetaTable_DDLJob(t *testing.T) {
+	mt := generateMetaTable(t)
+	ctx := context.TODO()
+
+	job := &model.DDLJob{ID: 1, Type: "DropCollection", DBName: "db", CollectionName: "coll", State: internalpb.DDLJobState_DDLJobRunning}
+	require.NoError(t, mt.SaveDDLJob(ctx, job))
+	require.NoError(t, mt.SaveDDLJob(ctx, &model.DDLJob{ID: 2, Type: "CreateCollection", State: internalpb.DDLJobState_DDLJobCompleted}))
+
+	job.State = internalpb.DDLJobState_DDLJobCompleted
+	require.NoError(t, mt.SaveDDLJob(ctx, job))
+	jobs, err := mt.ListDDLJobs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	for _, j := range jobs {
+		assert.Equal(t, internalpb.DDLJobState_DDLJobCompleted, j.State)
+	}
+
+	assert.NoError(t, mt.DropDDLJob(ctx, 1))
+	jobs, err = mt.ListDDLJobs(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, int64(2), jobs[0].ID)
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
@@ -82,6 +83,12 @@ type mockMetaTable struct {
 	DeleteCredentialFunc             func(username string) error
 	AlterCredentialFunc              func(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernamesFunc      func() (*milvuspb.ListCredUsersResponse, error)
+	AddAPIKeyFunc                    func(info *internalpb.APIKeyInfo) error
+	ListAPIKeysFunc                  func(username string, id string) ([]*internalpb.APIKeyInfo, error)
+	DeleteAPIKeyFunc                 func(username string, id string) error
+	AddRowPolicyFunc                 func(policy *internalpb.RowPolicy) error
+	ListRowPoliciesFunc              func(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error)
+	DeleteRowPolicyFunc              func(roleName string, collectionID int64) error
 	CreateRoleFunc                   func(tenant string, entity *milvuspb.RoleEntity) error
 	DropRoleFunc                     func(tenant string, roleName string) error
 	OperateUserRoleFunc              func(tenant string, userEntity *milvuspb.UserEntity, roleEntity *milvuspb.RoleEntity, operateType milvuspb.OperateUserRoleType) error
@@ -194,6 +201,30 @@ func (m mockMetaTable) ListCredentialUsernames() (*milvuspb.ListCredUsersRespons
 	return m.ListCredentialUsernamesFunc()
 }
 
+func (m mockMetaTable) AddAPIKey(info *internalpb.APIKeyInfo) error {
+	return m.AddAPIKeyFunc(info)
+}
+
+func (m mockMetaTable) ListAPIKeys(username string, id string) ([]*internalpb.APIKeyInfo, error) {
+	return m.ListAPIKeysFunc(username, id)
+}
+
+func (m mockMetaTable) DeleteAPIKey(username string, id string) error {
+	return m.DeleteAPIKeyFunc(username, id)
+}
+
+func (m mockMetaTable) AddRowPolicy(policy *internalpb.RowPolicy) error {
+	return m.AddRowPolicyFunc(policy)
+}
+
+func (m mockMetaTable) ListRowPolicies(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error) {
+	return m.ListRowPoliciesFunc(roleName, collectionID)
+}
+
+func (m mockMetaTable) DeleteRowPolicy(roleName string, collectionID int64) error {
+	return m.DeleteRowPolicyFunc(roleName, collectionID)
+}
+
 func (m mockMetaTable) CreateRole(tenant string, entity *milvuspb.RoleEntity) error {
 	return m.CreateRoleFunc(tenant, entity)
 }
@@ -361,6 +392,7 @@ type mockProxy struct {
 	types.ProxyClient
 	InvalidateCollectionMetaCacheFunc func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error)
 	InvalidateCredentialCacheFunc     func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+	UpdateCredentialCacheFunc         func(ctx context.Context, request *proxypb.UpdateCredCacheRequest) (*commonpb.Status, error)
 	RefreshPolicyInfoCacheFunc        func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
 	GetComponentStatesFunc            func(ctx context.Context) (*milvuspb.ComponentStates, error)
 }
@@ -373,6 +405,10 @@ func (m mockProxy) InvalidateCredentialCache(ctx context.Context, request *proxy
 	return m.InvalidateCredentialCacheFunc(ctx, request)
 }
 
+func (m mockProxy) UpdateCredentialCache(ctx context.Context, request *proxypb.UpdateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return m.UpdateCredentialCacheFunc(ctx, request)
+}
+
 func (m mockProxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return m.RefreshPolicyInfoCacheFunc(ctx, request)
 }
@@ -403,6 +439,9 @@ func newTestCore(opts ...Opt) *Core {
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.ddlJobs == nil {
+		c.ddlJobs = newDDLJobManager(context.Background(), c.meta)
+	}
 	return c
 }
 
@@ -504,6 +543,24 @@ func withInvalidMeta() Opt {
 	meta.ListCredentialUsernamesFunc = func() (*milvuspb.ListCredUsersResponse, error) {
 		return nil, errors.New("error mock ListCredentialUsernames")
 	}
+	meta.AddAPIKeyFunc = func(info *internalpb.APIKeyInfo) error {
+		return errors.New("error mock AddAPIKey")
+	}
+	meta.ListAPIKeysFunc = func(username string, id string) ([]*internalpb.APIKeyInfo, error) {
+		return nil, errors.New("error mock ListAPIKeys")
+	}
+	meta.DeleteAPIKeyFunc = func(username string, id string) error {
+		return errors.New("error mock DeleteAPIKey")
+	}
+	meta.AddRowPolicyFunc = func(policy *internalpb.RowPolicy) error {
+		return errors.New("error mock AddRowPolicy")
+	}
+	meta.ListRowPoliciesFunc = func(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error) {
+		return nil, errors.New("error mock ListRowPolicies")
+	}
+	meta.DeleteRowPolicyFunc = func(roleName string, collectionID int64) error {
+		return errors.New("error mock DeleteRowPolicy")
+	}
 	meta.CreateRoleFunc = func(tenant string, entity *milvuspb.RoleEntity) error {
 		return errors.New("error mock CreateRole")
 	}
@@ -596,6 +653,10 @@ func withInvalidQueryCoord() Opt {
 		nil, errors.New("error mock GetSegmentInfo"),
 	)
 
+	qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(
+		nil, errors.New("error mock ShowCollections"),
+	)
+
 	return withQueryCoord(qc)
 }
 
@@ -618,6 +679,12 @@ func withFailedQueryCoord() Opt {
 		}, nil,
 	)
 
+	qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(
+		&querypb.ShowCollectionsResponse{
+			Status: merr.Status(err),
+		}, nil,
+	)
+
 	return withQueryCoord(qc)
 }
 
@@ -643,9 +710,20 @@ func withValidQueryCoord() Opt {
 		}, nil,
 	)
 
+	qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(
+		&querypb.ShowCollectionsResponse{
+			Status:              merr.Success(),
+			CollectionIDs:       []int64{1, 2},
+			InMemoryPercentages: []int64{100, 50},
+		}, nil,
+	)
+
 	qc.EXPECT().SyncNewCreatedPartition(mock.Anything, mock.Anything).Return(
 		merr.Success(), nil,
 	)
+	qc.EXPECT().UpdateSchema(mock.Anything, mock.Anything).Return(
+		merr.Success(), nil,
+	)
 
 	return withQueryCoord(qc)
 }
@@ -925,7 +1003,9 @@ type mockBroker struct {
 	ReleaseCollectionFunc       func(ctx context.Context, collectionID UniqueID) error
 	ReleasePartitionsFunc       func(ctx context.Context, collectionID UniqueID, partitionIDs ...UniqueID) error
 	SyncNewCreatedPartitionFunc func(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error
+	UpdateSchemaFunc            func(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	GetQuerySegmentInfoFunc     func(ctx context.Context, collectionID int64, segIDs []int64) (retResp *querypb.GetSegmentInfoResponse, retErr error)
+	ShowLoadedCollectionsFunc   func(ctx context.Context, collectionIDs []UniqueID) (map[UniqueID]int64, error)
 
 	WatchChannelsFunc     func(ctx context.Context, info *watchInfo) error
 	UnwatchChannelsFunc   func(ctx context.Context, info *watchInfo) error
@@ -939,6 +1019,10 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	CloneCollectionFunc            func(ctx context.Context, req *datapb.CloneCollectionRequest) error
+	DropCollectionSegmentsFunc     func(ctx context.Context, collectionID UniqueID) error
+	CreateSnapshotFunc             func(ctx context.Context, req *datapb.CreateSnapshotRequest) error
+	DropSnapshotFunc               func(ctx context.Context, snapshotID UniqueID) error
 
 	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
 }
@@ -967,6 +1051,14 @@ func (b mockBroker) SyncNewCreatedPartition(ctx context.Context, collectionID Un
 	return b.SyncNewCreatedPartitionFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) UpdateSchema(ctx context.Context, collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	return b.UpdateSchemaFunc(ctx, collectionID, schema)
+}
+
+func (b mockBroker) ShowLoadedCollections(ctx context.Context, collectionIDs []UniqueID) (map[UniqueID]int64, error) {
+	return b.ShowLoadedCollectionsFunc(ctx, collectionIDs)
+}
+
 func (b mockBroker) DropCollectionIndex(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
 	return b.DropCollectionIndexFunc(ctx, collID, partIDs)
 }
@@ -983,6 +1075,22 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) CloneCollection(ctx context.Context, req *datapb.CloneCollectionRequest) error {
+	return b.CloneCollectionFunc(ctx, req)
+}
+
+func (b mockBroker) DropCollectionSegments(ctx context.Context, collectionID UniqueID) error {
+	return b.DropCollectionSegmentsFunc(ctx, collectionID)
+}
+
+func (b mockBroker) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) error {
+	return b.CreateSnapshotFunc(ctx, req)
+}
+
+func (b mockBroker) DropSnapshot(ctx context.Context, snapshotID UniqueID) error {
+	return b.DropSnapshotFunc(ctx, snapshotID)
+}
+
 func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID UniqueID) bool {
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
@@ -28,6 +28,48 @@ func (_m *IMetaTable) EXPECT() *IMetaTable_Expecter {
 	return &IMetaTable_Expecter{mock: &_m.Mock}
 }
 
+// AddAPIKey provides a mock function with given fields: info
+func (_m *IMetaTable) AddAPIKey(info *internalpb.APIKeyInfo) error {
+	ret := _m.Called(info)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*internalpb.APIKeyInfo) error); ok {
+		r0 = rf(info)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AddAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAPIKey'
+type IMetaTable_AddAPIKey_Call struct {
+	*mock.Call
+}
+
+// AddAPIKey is a helper method to define mock.On call
+//   - info *internalpb.APIKeyInfo
+func (_e *IMetaTable_Expecter) AddAPIKey(info interface{}) *IMetaTable_AddAPIKey_Call {
+	return &IMetaTable_AddAPIKey_Call{Call: _e.mock.On("AddAPIKey", info)}
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) Run(run func(info *internalpb.APIKeyInfo)) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*internalpb.APIKeyInfo))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) Return(_a0 error) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) RunAndReturn(run func(*internalpb.APIKeyInfo) error) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddCollection provides a mock function with given fields: ctx, coll
 func (_m *IMetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	ret := _m.Called(ctx, coll)
@@ -71,6 +113,49 @@ func (_c *IMetaTable_AddCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AddCollectionSnapshot provides a mock function with given fields: ctx, snapshot
+func (_m *IMetaTable) AddCollectionSnapshot(ctx context.Context, snapshot *model.CollectionSnapshot) error {
+	ret := _m.Called(ctx, snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CollectionSnapshot) error); ok {
+		r0 = rf(ctx, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AddCollectionSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionSnapshot'
+type IMetaTable_AddCollectionSnapshot_Call struct {
+	*mock.Call
+}
+
+// AddCollectionSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot *model.CollectionSnapshot
+func (_e *IMetaTable_Expecter) AddCollectionSnapshot(ctx interface{}, snapshot interface{}) *IMetaTable_AddCollectionSnapshot_Call {
+	return &IMetaTable_AddCollectionSnapshot_Call{Call: _e.mock.On("AddCollectionSnapshot", ctx, snapshot)}
+}
+
+func (_c *IMetaTable_AddCollectionSnapshot_Call) Run(run func(ctx context.Context, snapshot *model.CollectionSnapshot)) *IMetaTable_AddCollectionSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CollectionSnapshot))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AddCollectionSnapshot_Call) Return(_a0 error) *IMetaTable_AddCollectionSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AddCollectionSnapshot_Call) RunAndReturn(run func(context.Context, *model.CollectionSnapshot) error) *IMetaTable_AddCollectionSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddCredential provides a mock function with given fields: credInfo
 func (_m *IMetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	ret := _m.Called(credInfo)
@@ -156,6 +241,48 @@ func (_c *IMetaTable_AddPartition_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// AddRowPolicy provides a mock function with given fields: policy
+func (_m *IMetaTable) AddRowPolicy(policy *internalpb.RowPolicy) error {
+	ret := _m.Called(policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*internalpb.RowPolicy) error); ok {
+		r0 = rf(policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AddRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRowPolicy'
+type IMetaTable_AddRowPolicy_Call struct {
+	*mock.Call
+}
+
+// AddRowPolicy is a helper method to define mock.On call
+//   - policy *internalpb.RowPolicy
+func (_e *IMetaTable_Expecter) AddRowPolicy(policy interface{}) *IMetaTable_AddRowPolicy_Call {
+	return &IMetaTable_AddRowPolicy_Call{Call: _e.mock.On("AddRowPolicy", policy)}
+}
+
+func (_c *IMetaTable_AddRowPolicy_Call) Run(run func(policy *internalpb.RowPolicy)) *IMetaTable_AddRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*internalpb.RowPolicy))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AddRowPolicy_Call) Return(_a0 error) *IMetaTable_AddRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AddRowPolicy_Call) RunAndReturn(run func(*internalpb.RowPolicy) error) *IMetaTable_AddRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterAlias provides a mock function with given fields: ctx, dbName, alias, collectionName, ts
 func (_m *IMetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, alias, collectionName, ts)
@@ -202,6 +329,51 @@ func (_c *IMetaTable_AlterAlias_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// AlterAliases provides a mock function with given fields: ctx, dbName, ops, ts
+func (_m *IMetaTable) AlterAliases(ctx context.Context, dbName string, ops []*internalpb.AliasOperation, ts uint64) error {
+	ret := _m.Called(ctx, dbName, ops, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*internalpb.AliasOperation, uint64) error); ok {
+		r0 = rf(ctx, dbName, ops, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterAliases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAliases'
+type IMetaTable_AlterAliases_Call struct {
+	*mock.Call
+}
+
+// AlterAliases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - ops []*internalpb.AliasOperation
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterAliases(ctx interface{}, dbName interface{}, ops interface{}, ts interface{}) *IMetaTable_AlterAliases_Call {
+	return &IMetaTable_AlterAliases_Call{Call: _e.mock.On("AlterAliases", ctx, dbName, ops, ts)}
+}
+
+func (_c *IMetaTable_AlterAliases_Call) Run(run func(ctx context.Context, dbName string, ops []*internalpb.AliasOperation, ts uint64)) *IMetaTable_AlterAliases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]*internalpb.AliasOperation), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliases_Call) Return(_a0 error) *IMetaTable_AlterAliases_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterAliases_Call) RunAndReturn(run func(context.Context, string, []*internalpb.AliasOperation, uint64) error) *IMetaTable_AlterAliases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterCollection provides a mock function with given fields: ctx, oldColl, newColl, ts
 func (_m *IMetaTable) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
 	ret := _m.Called(ctx, oldColl, newColl, ts)
@@ -289,6 +461,51 @@ func (_c *IMetaTable_AlterCredential_Call) RunAndReturn(run func(*internalpb.Cre
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, oldDB, newDB, ts
+func (_m *IMetaTable) AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, oldDB, newDB, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, oldDB, newDB, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type IMetaTable_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldDB *model.Database
+//   - newDB *model.Database
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterDatabase(ctx interface{}, oldDB interface{}, newDB interface{}, ts interface{}) *IMetaTable_AlterDatabase_Call {
+	return &IMetaTable_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", ctx, oldDB, newDB, ts)}
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Run(run func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64)) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Database), args[2].(*model.Database), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Return(_a0 error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) RunAndReturn(run func(context.Context, *model.Database, *model.Database, uint64) error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangeCollectionState provides a mock function with given fields: ctx, collectionID, state, ts
 func (_m *IMetaTable) ChangeCollectionState(ctx context.Context, collectionID int64, state etcdpb.CollectionState, ts uint64) error {
 	ret := _m.Called(ctx, collectionID, state, ts)
@@ -513,6 +730,49 @@ func (_c *IMetaTable_CreateRole_Call) RunAndReturn(run func(string, *milvuspb.Ro
 	return _c
 }
 
+// DeleteAPIKey provides a mock function with given fields: username, id
+func (_m *IMetaTable) DeleteAPIKey(username string, id string) error {
+	ret := _m.Called(username, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(username, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DeleteAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAPIKey'
+type IMetaTable_DeleteAPIKey_Call struct {
+	*mock.Call
+}
+
+// DeleteAPIKey is a helper method to define mock.On call
+//   - username string
+//   - id string
+func (_e *IMetaTable_Expecter) DeleteAPIKey(username interface{}, id interface{}) *IMetaTable_DeleteAPIKey_Call {
+	return &IMetaTable_DeleteAPIKey_Call{Call: _e.mock.On("DeleteAPIKey", username, id)}
+}
+
+func (_c *IMetaTable_DeleteAPIKey_Call) Run(run func(username string, id string)) *IMetaTable_DeleteAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DeleteAPIKey_Call) Return(_a0 error) *IMetaTable_DeleteAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DeleteAPIKey_Call) RunAndReturn(run func(string, string) error) *IMetaTable_DeleteAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteCredential provides a mock function with given fields: username
 func (_m *IMetaTable) DeleteCredential(username string) error {
 	ret := _m.Called(username)
@@ -555,6 +815,49 @@ func (_c *IMetaTable_DeleteCredential_Call) RunAndReturn(run func(string) error)
 	return _c
 }
 
+// DeleteRowPolicy provides a mock function with given fields: roleName, collectionID
+func (_m *IMetaTable) DeleteRowPolicy(roleName string, collectionID int64) error {
+	ret := _m.Called(roleName, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(roleName, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DeleteRowPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRowPolicy'
+type IMetaTable_DeleteRowPolicy_Call struct {
+	*mock.Call
+}
+
+// DeleteRowPolicy is a helper method to define mock.On call
+//   - roleName string
+//   - collectionID int64
+func (_e *IMetaTable_Expecter) DeleteRowPolicy(roleName interface{}, collectionID interface{}) *IMetaTable_DeleteRowPolicy_Call {
+	return &IMetaTable_DeleteRowPolicy_Call{Call: _e.mock.On("DeleteRowPolicy", roleName, collectionID)}
+}
+
+func (_c *IMetaTable_DeleteRowPolicy_Call) Run(run func(roleName string, collectionID int64)) *IMetaTable_DeleteRowPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DeleteRowPolicy_Call) Return(_a0 error) *IMetaTable_DeleteRowPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DeleteRowPolicy_Call) RunAndReturn(run func(string, int64) error) *IMetaTable_DeleteRowPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbName, alias, ts
 func (_m *IMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, alias, ts)
@@ -600,6 +903,93 @@ func (_c *IMetaTable_DropAlias_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// DropCollectionSnapshot provides a mock function with given fields: ctx, dbID, snapshotID
+func (_m *IMetaTable) DropCollectionSnapshot(ctx context.Context, dbID int64, snapshotID int64) error {
+	ret := _m.Called(ctx, dbID, snapshotID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) error); ok {
+		r0 = rf(ctx, dbID, snapshotID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropCollectionSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionSnapshot'
+type IMetaTable_DropCollectionSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropCollectionSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - snapshotID int64
+func (_e *IMetaTable_Expecter) DropCollectionSnapshot(ctx interface{}, dbID interface{}, snapshotID interface{}) *IMetaTable_DropCollectionSnapshot_Call {
+	return &IMetaTable_DropCollectionSnapshot_Call{Call: _e.mock.On("DropCollectionSnapshot", ctx, dbID, snapshotID)}
+}
+
+func (_c *IMetaTable_DropCollectionSnapshot_Call) Run(run func(ctx context.Context, dbID int64, snapshotID int64)) *IMetaTable_DropCollectionSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropCollectionSnapshot_Call) Return(_a0 error) *IMetaTable_DropCollectionSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropCollectionSnapshot_Call) RunAndReturn(run func(context.Context, int64, int64) error) *IMetaTable_DropCollectionSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropDDLJob provides a mock function with given fields: ctx, jobID
+func (_m *IMetaTable) DropDDLJob(ctx context.Context, jobID int64) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropDDLJob'
+type IMetaTable_DropDDLJob_Call struct {
+	*mock.Call
+}
+
+// DropDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int64
+func (_e *IMetaTable_Expecter) DropDDLJob(ctx interface{}, jobID interface{}) *IMetaTable_DropDDLJob_Call {
+	return &IMetaTable_DropDDLJob_Call{Call: _e.mock.On("DropDDLJob", ctx, jobID)}
+}
+
+func (_c *IMetaTable_DropDDLJob_Call) Run(run func(ctx context.Context, jobID int64)) *IMetaTable_DropDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropDDLJob_Call) Return(_a0 error) *IMetaTable_DropDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropDDLJob_Call) RunAndReturn(run func(context.Context, int64) error) *IMetaTable_DropDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, dbName, ts
 func (_m *IMetaTable) DropDatabase(ctx context.Context, dbName string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, ts)
@@ -794,19 +1184,76 @@ func (_m *IMetaTable) GetCollectionByName(ctx context.Context, dbName string, co
 
 	var r0 *model.Collection
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) (*model.Collection, error)); ok {
-		return rf(ctx, dbName, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) (*model.Collection, error)); ok {
+		return rf(ctx, dbName, collectionName, ts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) *model.Collection); ok {
+		r0 = rf(ctx, dbName, collectionName, ts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Collection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint64) error); ok {
+		r1 = rf(ctx, dbName, collectionName, ts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_GetCollectionByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionByName'
+type IMetaTable_GetCollectionByName_Call struct {
+	*mock.Call
+}
+
+// GetCollectionByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - collectionName string
+//   - ts uint64
+func (_e *IMetaTable_Expecter) GetCollectionByName(ctx interface{}, dbName interface{}, collectionName interface{}, ts interface{}) *IMetaTable_GetCollectionByName_Call {
+	return &IMetaTable_GetCollectionByName_Call{Call: _e.mock.On("GetCollectionByName", ctx, dbName, collectionName, ts)}
+}
+
+func (_c *IMetaTable_GetCollectionByName_Call) Run(run func(ctx context.Context, dbName string, collectionName string, ts uint64)) *IMetaTable_GetCollectionByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_GetCollectionByName_Call) Return(_a0 *model.Collection, _a1 error) *IMetaTable_GetCollectionByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_GetCollectionByName_Call) RunAndReturn(run func(context.Context, string, string, uint64) (*model.Collection, error)) *IMetaTable_GetCollectionByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCollectionSnapshot provides a mock function with given fields: ctx, dbID, name
+func (_m *IMetaTable) GetCollectionSnapshot(ctx context.Context, dbID int64, name string) (*model.CollectionSnapshot, error) {
+	ret := _m.Called(ctx, dbID, name)
+
+	var r0 *model.CollectionSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) (*model.CollectionSnapshot, error)); ok {
+		return rf(ctx, dbID, name)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) *model.Collection); ok {
-		r0 = rf(ctx, dbName, collectionName, ts)
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) *model.CollectionSnapshot); ok {
+		r0 = rf(ctx, dbID, name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Collection)
+			r0 = ret.Get(0).(*model.CollectionSnapshot)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, uint64) error); ok {
-		r1 = rf(ctx, dbName, collectionName, ts)
+	if rf, ok := ret.Get(1).(func(context.Context, int64, string) error); ok {
+		r1 = rf(ctx, dbID, name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -814,33 +1261,32 @@ func (_m *IMetaTable) GetCollectionByName(ctx context.Context, dbName string, co
 	return r0, r1
 }
 
-// IMetaTable_GetCollectionByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionByName'
-type IMetaTable_GetCollectionByName_Call struct {
+// IMetaTable_GetCollectionSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionSnapshot'
+type IMetaTable_GetCollectionSnapshot_Call struct {
 	*mock.Call
 }
 
-// GetCollectionByName is a helper method to define mock.On call
+// GetCollectionSnapshot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - dbName string
-//   - collectionName string
-//   - ts uint64
-func (_e *IMetaTable_Expecter) GetCollectionByName(ctx interface{}, dbName interface{}, collectionName interface{}, ts interface{}) *IMetaTable_GetCollectionByName_Call {
-	return &IMetaTable_GetCollectionByName_Call{Call: _e.mock.On("GetCollectionByName", ctx, dbName, collectionName, ts)}
+//   - dbID int64
+//   - name string
+func (_e *IMetaTable_Expecter) GetCollectionSnapshot(ctx interface{}, dbID interface{}, name interface{}) *IMetaTable_GetCollectionSnapshot_Call {
+	return &IMetaTable_GetCollectionSnapshot_Call{Call: _e.mock.On("GetCollectionSnapshot", ctx, dbID, name)}
 }
 
-func (_c *IMetaTable_GetCollectionByName_Call) Run(run func(ctx context.Context, dbName string, collectionName string, ts uint64)) *IMetaTable_GetCollectionByName_Call {
+func (_c *IMetaTable_GetCollectionSnapshot_Call) Run(run func(ctx context.Context, dbID int64, name string)) *IMetaTable_GetCollectionSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint64))
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *IMetaTable_GetCollectionByName_Call) Return(_a0 *model.Collection, _a1 error) *IMetaTable_GetCollectionByName_Call {
+func (_c *IMetaTable_GetCollectionSnapshot_Call) Return(_a0 *model.CollectionSnapshot, _a1 error) *IMetaTable_GetCollectionSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *IMetaTable_GetCollectionByName_Call) RunAndReturn(run func(context.Context, string, string, uint64) (*model.Collection, error)) *IMetaTable_GetCollectionByName_Call {
+func (_c *IMetaTable_GetCollectionSnapshot_Call) RunAndReturn(run func(context.Context, int64, string) (*model.CollectionSnapshot, error)) *IMetaTable_GetCollectionSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1206,6 +1652,61 @@ func (_c *IMetaTable_IsAlias_Call) RunAndReturn(run func(string, string) bool) *
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: username, id
+func (_m *IMetaTable) ListAPIKeys(username string, id string) ([]*internalpb.APIKeyInfo, error) {
+	ret := _m.Called(username, id)
+
+	var r0 []*internalpb.APIKeyInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]*internalpb.APIKeyInfo, error)); ok {
+		return rf(username, id)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []*internalpb.APIKeyInfo); ok {
+		r0 = rf(username, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.APIKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(username, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type IMetaTable_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - username string
+//   - id string
+func (_e *IMetaTable_Expecter) ListAPIKeys(username interface{}, id interface{}) *IMetaTable_ListAPIKeys_Call {
+	return &IMetaTable_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", username, id)}
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Run(run func(username string, id string)) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Return(_a0 []*internalpb.APIKeyInfo, _a1 error) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) RunAndReturn(run func(string, string) ([]*internalpb.APIKeyInfo, error)) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliasesByID provides a mock function with given fields: collID
 func (_m *IMetaTable) ListAliasesByID(collID int64) []string {
 	ret := _m.Called(collID)
@@ -1337,6 +1838,62 @@ func (_c *IMetaTable_ListCollectionPhysicalChannels_Call) RunAndReturn(run func(
 	return _c
 }
 
+// ListCollectionSnapshots provides a mock function with given fields: ctx, dbID, collectionID
+func (_m *IMetaTable) ListCollectionSnapshots(ctx context.Context, dbID int64, collectionID int64) ([]*model.CollectionSnapshot, error) {
+	ret := _m.Called(ctx, dbID, collectionID)
+
+	var r0 []*model.CollectionSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) ([]*model.CollectionSnapshot, error)); ok {
+		return rf(ctx, dbID, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64) []*model.CollectionSnapshot); ok {
+		r0 = rf(ctx, dbID, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CollectionSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64) error); ok {
+		r1 = rf(ctx, dbID, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListCollectionSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCollectionSnapshots'
+type IMetaTable_ListCollectionSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListCollectionSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbID int64
+//   - collectionID int64
+func (_e *IMetaTable_Expecter) ListCollectionSnapshots(ctx interface{}, dbID interface{}, collectionID interface{}) *IMetaTable_ListCollectionSnapshots_Call {
+	return &IMetaTable_ListCollectionSnapshots_Call{Call: _e.mock.On("ListCollectionSnapshots", ctx, dbID, collectionID)}
+}
+
+func (_c *IMetaTable_ListCollectionSnapshots_Call) Run(run func(ctx context.Context, dbID int64, collectionID int64)) *IMetaTable_ListCollectionSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListCollectionSnapshots_Call) Return(_a0 []*model.CollectionSnapshot, _a1 error) *IMetaTable_ListCollectionSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListCollectionSnapshots_Call) RunAndReturn(run func(context.Context, int64, int64) ([]*model.CollectionSnapshot, error)) *IMetaTable_ListCollectionSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListCollections provides a mock function with given fields: ctx, dbName, ts, onlyAvail
 func (_m *IMetaTable) ListCollections(ctx context.Context, dbName string, ts uint64, onlyAvail bool) ([]*model.Collection, error) {
 	ret := _m.Called(ctx, dbName, ts, onlyAvail)
@@ -1447,6 +2004,60 @@ func (_c *IMetaTable_ListCredentialUsernames_Call) RunAndReturn(run func() (*mil
 	return _c
 }
 
+// ListDDLJobs provides a mock function with given fields: ctx
+func (_m *IMetaTable) ListDDLJobs(ctx context.Context) ([]*model.DDLJob, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.DDLJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.DDLJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.DDLJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DDLJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListDDLJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDDLJobs'
+type IMetaTable_ListDDLJobs_Call struct {
+	*mock.Call
+}
+
+// ListDDLJobs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *IMetaTable_Expecter) ListDDLJobs(ctx interface{}) *IMetaTable_ListDDLJobs_Call {
+	return &IMetaTable_ListDDLJobs_Call{Call: _e.mock.On("ListDDLJobs", ctx)}
+}
+
+func (_c *IMetaTable_ListDDLJobs_Call) Run(run func(ctx context.Context)) *IMetaTable_ListDDLJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListDDLJobs_Call) Return(_a0 []*model.DDLJob, _a1 error) *IMetaTable_ListDDLJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListDDLJobs_Call) RunAndReturn(run func(context.Context) ([]*model.DDLJob, error)) *IMetaTable_ListDDLJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDatabases provides a mock function with given fields: ctx, ts
 func (_m *IMetaTable) ListDatabases(ctx context.Context, ts uint64) ([]*model.Database, error) {
 	ret := _m.Called(ctx, ts)
@@ -1556,6 +2167,61 @@ func (_c *IMetaTable_ListPolicy_Call) RunAndReturn(run func(string) ([]string, e
 	return _c
 }
 
+// ListRowPolicies provides a mock function with given fields: roleName, collectionID
+func (_m *IMetaTable) ListRowPolicies(roleName string, collectionID int64) ([]*internalpb.RowPolicy, error) {
+	ret := _m.Called(roleName, collectionID)
+
+	var r0 []*internalpb.RowPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) ([]*internalpb.RowPolicy, error)); ok {
+		return rf(roleName, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) []*internalpb.RowPolicy); ok {
+		r0 = rf(roleName, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*internalpb.RowPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(roleName, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListRowPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRowPolicies'
+type IMetaTable_ListRowPolicies_Call struct {
+	*mock.Call
+}
+
+// ListRowPolicies is a helper method to define mock.On call
+//   - roleName string
+//   - collectionID int64
+func (_e *IMetaTable_Expecter) ListRowPolicies(roleName interface{}, collectionID interface{}) *IMetaTable_ListRowPolicies_Call {
+	return &IMetaTable_ListRowPolicies_Call{Call: _e.mock.On("ListRowPolicies", roleName, collectionID)}
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) Run(run func(roleName string, collectionID int64)) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) Return(_a0 []*internalpb.RowPolicy, _a1 error) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListRowPolicies_Call) RunAndReturn(run func(string, int64) ([]*internalpb.RowPolicy, error)) *IMetaTable_ListRowPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -1836,6 +2502,94 @@ func (_c *IMetaTable_RenameCollection_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RenameDatabase provides a mock function with given fields: ctx, oldName, newName, ts
+func (_m *IMetaTable) RenameDatabase(ctx context.Context, oldName string, newName string, ts uint64) error {
+	ret := _m.Called(ctx, oldName, newName, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, uint64) error); ok {
+		r0 = rf(ctx, oldName, newName, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_RenameDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameDatabase'
+type IMetaTable_RenameDatabase_Call struct {
+	*mock.Call
+}
+
+// RenameDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldName string
+//   - newName string
+//   - ts uint64
+func (_e *IMetaTable_Expecter) RenameDatabase(ctx interface{}, oldName interface{}, newName interface{}, ts interface{}) *IMetaTable_RenameDatabase_Call {
+	return &IMetaTable_RenameDatabase_Call{Call: _e.mock.On("RenameDatabase", ctx, oldName, newName, ts)}
+}
+
+func (_c *IMetaTable_RenameDatabase_Call) Run(run func(ctx context.Context, oldName string, newName string, ts uint64)) *IMetaTable_RenameDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_RenameDatabase_Call) Return(_a0 error) *IMetaTable_RenameDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_RenameDatabase_Call) RunAndReturn(run func(context.Context, string, string, uint64) error) *IMetaTable_RenameDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDDLJob provides a mock function with given fields: ctx, job
+func (_m *IMetaTable) SaveDDLJob(ctx context.Context, job *model.DDLJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DDLJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_SaveDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDDLJob'
+type IMetaTable_SaveDDLJob_Call struct {
+	*mock.Call
+}
+
+// SaveDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - job *model.DDLJob
+func (_e *IMetaTable_Expecter) SaveDDLJob(ctx interface{}, job interface{}) *IMetaTable_SaveDDLJob_Call {
+	return &IMetaTable_SaveDDLJob_Call{Call: _e.mock.On("SaveDDLJob", ctx, job)}
+}
+
+func (_c *IMetaTable_SaveDDLJob_Call) Run(run func(ctx context.Context, job *model.DDLJob)) *IMetaTable_SaveDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.DDLJob))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_SaveDDLJob_Call) Return(_a0 error) *IMetaTable_SaveDDLJob_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_SaveDDLJob_Call) RunAndReturn(run func(context.Context, *model.DDLJob) error) *IMetaTable_SaveDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: tenant, entity
 func (_m *IMetaTable) SelectGrant(tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error) {
 	ret := _m.Called(tenant, entity)
@@ -2003,6 +2757,51 @@ func (_c *IMetaTable_SelectUser_Call) RunAndReturn(run func(string, *milvuspb.Us
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: ctx, dbName, collectionID, ts
+func (_m *IMetaTable) UndropCollection(ctx context.Context, dbName string, collectionID int64, ts uint64) error {
+	ret := _m.Called(ctx, dbName, collectionID, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, uint64) error); ok {
+		r0 = rf(ctx, dbName, collectionID, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type IMetaTable_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbName string
+//   - collectionID int64
+//   - ts uint64
+func (_e *IMetaTable_Expecter) UndropCollection(ctx interface{}, dbName interface{}, collectionID interface{}, ts interface{}) *IMetaTable_UndropCollection_Call {
+	return &IMetaTable_UndropCollection_Call{Call: _e.mock.On("UndropCollection", ctx, dbName, collectionID, ts)}
+}
+
+func (_c *IMetaTable_UndropCollection_Call) Run(run func(ctx context.Context, dbName string, collectionID int64, ts uint64)) *IMetaTable_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_UndropCollection_Call) Return(_a0 error) *IMetaTable_UndropCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_UndropCollection_Call) RunAndReturn(run func(context.Context, string, int64, uint64) error) *IMetaTable_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewIMetaTable creates a new instance of IMetaTable. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewIMetaTable(t interface {
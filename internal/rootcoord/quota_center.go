@@ -30,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/tso"
@@ -106,6 +107,14 @@ type QuotaCenter struct {
 	quotaStates  map[int64]collectionStates
 	tsoAllocator tso.Allocator
 
+	// rates of tenants, keyed by database id and username
+	databaseRates map[int64]collectionRates
+	userRates     map[string]collectionRates
+	// databases synced from meta, their properties set the rate limits and disk quotas, keyed by database id
+	databases map[int64]*model.Database
+	// collections of the databases with rate limits, keyed by database id
+	databaseCollections map[int64][]int64
+
 	rateAllocateStrategy RateAllocateStrategy
 
 	stopOnce sync.Once
@@ -120,6 +129,10 @@ func NewQuotaCenter(proxies *proxyClientManager, queryCoord types.QueryCoordClie
 		dataCoord:           dataCoord,
 		currentRates:        make(map[int64]map[internalpb.RateType]Limit),
 		quotaStates:         make(map[int64]map[milvuspb.QuotaState]commonpb.ErrorCode),
+		databaseRates:       make(map[int64]collectionRates),
+		userRates:           make(map[string]collectionRates),
+		databases:           make(map[int64]*model.Database),
+		databaseCollections: make(map[int64][]int64),
 		tsoAllocator:        tsoAllocator,
 		meta:                meta,
 		readableCollections: make([]int64, 0),
@@ -265,6 +278,14 @@ func (q *QuotaCenter) syncMetrics() error {
 	if err != nil {
 		return err
 	}
+
+	dbs, err := q.meta.ListDatabases(ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	q.databases = lo.SliceToMap(dbs, func(db *model.Database) (int64, *model.Database) {
+		return db.ID, db
+	})
 	// log.Debug("QuotaCenter sync metrics done",
 	//	zap.Any("dataNodeMetrics", q.dataNodeMetrics),
 	//	zap.Any("queryNodeMetrics", q.queryNodeMetrics),
@@ -484,9 +505,65 @@ func (q *QuotaCenter) calculateWriteRates() error {
 			zap.Float64("factor", factor))
 	}
 
+	q.coolDatabaseWriteRatesOff(collectionFactors)
 	return nil
 }
 
+// coolDatabaseWriteRatesOff scales the dml rates of databases by the least write factor of their collections,
+// the collections denied to write are excluded since they are rejected at collection level already.
+func (q *QuotaCenter) coolDatabaseWriteRatesOff(collectionFactors map[int64]float64) {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	if len(q.databaseRates) == 0 {
+		return
+	}
+
+	databaseFactors := make(map[int64]float64)
+	for dbID, collections := range q.databaseCollections {
+		for _, collection := range collections {
+			factor, ok := collectionFactors[collection]
+			if !ok || factor <= 0 || factor >= 1 {
+				continue
+			}
+			if f, ok := databaseFactors[dbID]; !ok || f > factor {
+				databaseFactors[dbID] = factor
+			}
+		}
+	}
+
+	for dbID, factor := range databaseFactors {
+		for _, rt := range []internalpb.RateType{internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete} {
+			if r, ok := q.databaseRates[dbID][rt]; ok && r != Inf {
+				q.databaseRates[dbID][rt] = r * Limit(factor)
+			}
+		}
+		log.RatedDebug(10, "QuotaCenter cool database write rates off done",
+			zap.Int64("dbID", dbID),
+			zap.Float64("factor", factor))
+	}
+}
+
+// getDatabaseCollections returns the readable or writable collections of the databases with rate limits.
+func (q *QuotaCenter) getDatabaseCollections() map[int64][]int64 {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	ret := make(map[int64][]int64)
+	if len(q.databaseRates) == 0 {
+		return ret
+	}
+	collections := typeutil.NewUniqueSet(q.readableCollections...)
+	collections.Insert(q.writableCollections...)
+	for collection := range collections {
+		collectionInfo, err := q.meta.GetCollectionByID(context.TODO(), "", collection, typeutil.MaxTimestamp, false)
+		if err != nil {
+			log.RatedWarn(10, "failed to get database of collection", zap.Int64("collectionID", collection), zap.Error(err))
+			continue
+		}
+		if _, ok := q.databaseRates[collectionInfo.DBID]; ok {
+			ret[collectionInfo.DBID] = append(ret[collectionInfo.DBID], collection)
+		}
+	}
+	return ret
+}
+
 func (q *QuotaCenter) getTimeTickDelayFactor(ts Timestamp) map[int64]float64 {
 	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
 	if !Params.QuotaConfig.TtProtectionEnabled.GetAsBool() {
@@ -706,6 +783,10 @@ func (q *QuotaCenter) resetAllCurrentRates() {
 		q.resetCurrentRate(internalpb.RateType_DQLSearch, collection)
 		q.resetCurrentRate(internalpb.RateType_DQLQuery, collection)
 	}
+
+	q.databaseRates = getDatabaseRateLimits(lo.Values(q.databases))
+	q.databaseCollections = q.getDatabaseCollections()
+	q.userRates = getTenantRateLimitConfig(Params.QuotaConfig.UserRateLimits.GetAsJSONMap())
 }
 
 // resetCurrentRates resets all current rates to configured rates.
@@ -781,6 +862,7 @@ func (q *QuotaCenter) checkDiskQuota() {
 			collections.Insert(collection)
 		}
 	}
+	collections.Insert(q.checkDatabaseDiskQuota()...)
 	if collections.Len() > 0 {
 		q.forceDenyWriting(commonpb.ErrorCode_DiskQuotaExhausted, collections.Collect()...)
 	}
@@ -794,6 +876,47 @@ func (q *QuotaCenter) checkDiskQuota() {
 	q.totalBinlogSize = total
 }
 
+// checkDatabaseDiskQuota returns the collections of the databases whose disk usage exceeds their disk quota.
+func (q *QuotaCenter) checkDatabaseDiskQuota() []int64 {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	dbDiskQuotas := make(map[int64]float64)
+	for dbID, db := range q.databases {
+		if quota, ok := getDatabaseDiskQuota(db.Properties); ok {
+			dbDiskQuotas[dbID] = quota
+		}
+	}
+	if len(dbDiskQuotas) == 0 {
+		return nil
+	}
+
+	dbUsages := make(map[int64]int64)
+	dbCollections := make(map[int64][]int64)
+	for collection, binlogSize := range q.dataCoordMetrics.CollectionBinlogSize {
+		collectionInfo, err := q.meta.GetCollectionByID(context.TODO(), "", collection, typeutil.MaxTimestamp, false)
+		if err != nil {
+			log.RatedWarn(10, "failed to get database of collection", zap.Int64("collectionID", collection), zap.Error(err))
+			continue
+		}
+		if _, ok := dbDiskQuotas[collectionInfo.DBID]; !ok {
+			continue
+		}
+		dbUsages[collectionInfo.DBID] += binlogSize
+		dbCollections[collectionInfo.DBID] = append(dbCollections[collectionInfo.DBID], collection)
+	}
+
+	ret := make([]int64, 0)
+	for dbID, usage := range dbUsages {
+		if float64(usage) >= dbDiskQuotas[dbID] {
+			log.RatedWarn(10, "database disk quota exceeded",
+				zap.String("dbName", q.databases[dbID].Name),
+				zap.Int64("db disk usage", usage),
+				zap.Float64("db disk quota", dbDiskQuotas[dbID]))
+			ret = append(ret, dbCollections[dbID]...)
+		}
+	}
+	return ret
+}
+
 // setRates notifies Proxies to set rates for different rate types.
 func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
@@ -827,6 +950,37 @@ func (q *QuotaCenter) setRates() error {
 		}
 	}
 
+	// tenant rates are always allocated averagely
+	toTenantRates := func(currentRates collectionRates, proxyNum int) []*internalpb.Rate {
+		rates := make([]*internalpb.Rate, 0, len(currentRates))
+		for rt, r := range currentRates {
+			if r == Inf {
+				rates = append(rates, &internalpb.Rate{Rt: rt, R: float64(r)})
+			} else {
+				rates = append(rates, &internalpb.Rate{Rt: rt, R: float64(r) / float64(proxyNum)})
+			}
+		}
+		return rates
+	}
+	databaseRates := make([]*proxypb.TenantRate, 0, len(q.databaseRates))
+	userRates := make([]*proxypb.TenantRate, 0, len(q.userRates))
+	if proxyNum := q.proxies.GetProxyCount(); proxyNum > 0 {
+		for dbID, rates := range q.databaseRates {
+			databaseRates = append(databaseRates, &proxypb.TenantRate{
+				Id:          dbID,
+				Name:        q.databases[dbID].Name,
+				Rates:       toTenantRates(rates, proxyNum),
+				Collections: q.databaseCollections[dbID],
+			})
+		}
+		for username, rates := range q.userRates {
+			userRates = append(userRates, &proxypb.TenantRate{
+				Name:  username,
+				Rates: toTenantRates(rates, proxyNum),
+			})
+		}
+	}
+
 	collectionRates := make([]*proxypb.CollectionRate, 0)
 	for collection, rates := range q.currentRates {
 		collectionRates = append(collectionRates, toCollectionRate(collection, rates))
@@ -837,7 +991,9 @@ func (q *QuotaCenter) setRates() error {
 			commonpbutil.WithMsgID(int64(timestamp)),
 			commonpbutil.WithTimeStamp(timestamp),
 		),
-		Rates: collectionRates,
+		Rates:         collectionRates,
+		DatabaseRates: databaseRates,
+		UserRates:     userRates,
 	}
 	return q.proxies.SetRates(ctx, req)
 }
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -540,6 +542,42 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, colQuotaBackup)
 	})
 
+	t.Run("test database properties", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(1), mock.Anything, mock.Anything).Return(&model.Collection{DBID: 10}, nil).Maybe()
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(2), mock.Anything, mock.Anything).Return(&model.Collection{DBID: 10}, nil).Maybe()
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(3), mock.Anything, mock.Anything).Return(&model.Collection{DBID: 20}, nil).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, qc, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.databases = map[int64]*model.Database{
+			10: {ID: 10, Name: "db1", Properties: []*commonpb.KeyValuePair{
+				{Key: common.DatabaseInsertRateMaxKey, Value: "4"},
+				{Key: common.DatabaseDiskQuotaKey, Value: "40"},
+			}},
+			20: {ID: 20, Name: "db2"},
+		}
+
+		// the rates of databases are set by their properties, keyed by database id
+		quotaCenter.resetAllCurrentRates()
+		assert.Equal(t, Limit(4*1024*1024), quotaCenter.databaseRates[10][internalpb.RateType_DMLInsert])
+		assert.Len(t, quotaCenter.databaseRates[10], 1)
+		assert.Empty(t, quotaCenter.databaseRates[20])
+
+		// the collections of the database exceeding its disk quota are denied to write
+		quotaCenter.writableCollections = []int64{1, 2, 3}
+		quotaCenter.resetAllCurrentRates()
+		assert.ElementsMatch(t, []int64{1, 2}, quotaCenter.databaseCollections[10])
+		assert.Empty(t, quotaCenter.databaseCollections[20])
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{
+			TotalBinlogSize:      100 * 1024 * 1024,
+			CollectionBinlogSize: map[int64]int64{1: 20 * 1024 * 1024, 2: 30 * 1024 * 1024, 3: 50 * 1024 * 1024},
+		}
+		quotaCenter.checkDiskQuota()
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[1][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[2][internalpb.RateType_DMLInsert])
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[3][internalpb.RateType_DMLInsert])
+	})
+
 	t.Run("test setRates", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoordClient(t)
 		p1 := mocks.NewMockProxyClient(t)
@@ -561,6 +599,59 @@ func TestQuotaCenter(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test tenant rates", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		p1 := mocks.NewMockProxyClient(t)
+		p2 := mocks.NewMockProxyClient(t)
+		var req *proxypb.SetRatesRequest
+		p1.EXPECT().SetRates(mock.Anything, mock.Anything).Run(func(ctx context.Context, r *proxypb.SetRatesRequest, opts ...grpc.CallOption) {
+			req = r
+		}).Return(nil, nil)
+		p2.EXPECT().SetRates(mock.Anything, mock.Anything).Return(nil, nil)
+		pcm := &proxyClientManager{proxyClient: map[int64]types.ProxyClient{
+			1: p1,
+			2: p2,
+		}}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(1), mock.Anything, mock.Anything).Return(&model.Collection{DBID: 10}, nil).Maybe()
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(2), mock.Anything, mock.Anything).Return(&model.Collection{DBID: 20}, nil).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, qc, &dataCoordMockForQuota{}, core.tsoAllocator, meta)
+		quotaCenter.databases = map[int64]*model.Database{
+			10: {ID: 10, Name: "db1", Properties: []*commonpb.KeyValuePair{
+				{Key: common.DatabaseInsertRateMaxKey, Value: "8"},
+				{Key: common.DatabaseSearchRateMaxKey, Value: "100"},
+			}},
+			20: {ID: 20, Name: "db2"},
+		}
+		quotaCenter.writableCollections = []int64{1, 2}
+
+		paramtable.Get().Save(Params.QuotaConfig.UserRateLimits.Key, `{"user1.queryRate.max.qps": 10}`)
+		defer paramtable.Get().Reset(Params.QuotaConfig.UserRateLimits.Key)
+		quotaCenter.resetAllCurrentRates()
+		assert.Equal(t, Limit(8*1024*1024), quotaCenter.databaseRates[10][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(10), quotaCenter.userRates["user1"][internalpb.RateType_DQLQuery])
+
+		// the database is cooled off by the least factor of its collections, the denied collections are excluded
+		quotaCenter.coolDatabaseWriteRatesOff(map[int64]float64{1: 0.5, 2: 0.1, 3: 0})
+		assert.Equal(t, Limit(4*1024*1024), quotaCenter.databaseRates[10][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(100), quotaCenter.databaseRates[10][internalpb.RateType_DQLSearch])
+
+		// the rates are allocated to proxies averagely
+		err = quotaCenter.setRates()
+		assert.NoError(t, err)
+		assert.Len(t, req.GetDatabaseRates(), 1)
+		assert.Equal(t, int64(10), req.GetDatabaseRates()[0].GetId())
+		assert.Equal(t, "db1", req.GetDatabaseRates()[0].GetName())
+		assert.Equal(t, []int64{1}, req.GetDatabaseRates()[0].GetCollections())
+		assert.Len(t, req.GetDatabaseRates()[0].GetRates(), 2)
+		assert.ElementsMatch(t, []float64{2 * 1024 * 1024, 50}, lo.Map(req.GetDatabaseRates()[0].GetRates(), func(r *internalpb.Rate, _ int) float64 {
+			return r.GetR()
+		}))
+		assert.Len(t, req.GetUserRates(), 1)
+		assert.Equal(t, "user1", req.GetUserRates()[0].GetName())
+		assert.Equal(t, float64(5), req.GetUserRates()[0].GetRates()[0].GetR())
+	})
+
 	t.Run("test recordMetrics", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoordClient(t)
 		meta := mockrootcoord.NewIMetaTable(t)
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// recycleBinExpired returns whether the collection or partition dropped at the timestamp should be removed
+// from the recycle bin.
+func recycleBinExpired(dropTime Timestamp) bool {
+	retention := Params.RootCoordCfg.RecycleBinRetention.GetAsDuration(time.Second)
+	return time.Since(tsoutil.PhysicalTime(dropTime)) >= retention
+}
+
+// recycleBinExpiredIn returns whether the collection or any partition of it should be removed from the recycle bin.
+func recycleBinExpiredIn(coll *model.Collection) bool {
+	if coll.State == pb.CollectionState_CollectionRecycled {
+		return recycleBinExpired(coll.DropTime)
+	}
+	if !coll.Available() {
+		return false
+	}
+	for _, partition := range coll.Partitions {
+		if partition.State == pb.PartitionState_PartitionRecycled && recycleBinExpired(partition.DropTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// purgeRecycleBinTask removes the collection or the partitions of it whose retention in the recycle bin expires,
+// the indexes, data and channels of them are garbage collected as if they're dropped just now.
+type purgeRecycleBinTask struct {
+	baseTask
+	dbName         string
+	collectionName string
+	collectionID   UniqueID
+}
+
+func (t *purgeRecycleBinTask) GetLocks() []ddlLock {
+	// undropping the collection or the partitions of it takes the same lock.
+	return []ddlLock{newCollectionLock(t.dbName, t.collectionName, true)}
+}
+
+func (t *purgeRecycleBinTask) Execute(ctx context.Context) error {
+	// the collection may be undropped or removed before the lock is acquired.
+	coll, err := t.core.meta.GetCollectionByID(ctx, t.dbName, t.collectionID, typeutil.MaxTimestamp, true)
+	if err != nil {
+		return err
+	}
+	ts := t.GetTs()
+	if coll.State == pb.CollectionState_CollectionRecycled {
+		if !recycleBinExpired(coll.DropTime) {
+			return nil
+		}
+		log.Info("remove the expired collection from the recycle bin", zap.String("db", t.dbName),
+			zap.String("collection", coll.Name), zap.Int64("collectionID", coll.CollectionID),
+			zap.Time("dropTime", tsoutil.PhysicalTime(coll.DropTime)))
+		redoTask := newBaseRedoTask(t.core.stepExecutor)
+		redoTask.AddSyncStep(&changeCollectionStateStep{
+			baseStep:     baseStep{core: t.core},
+			collectionID: coll.CollectionID,
+			state:        pb.CollectionState_CollectionDropping,
+			ts:           ts,
+		})
+		addGcCollectionSteps(t.core, redoTask, coll, ts, false)
+		return redoTask.Execute(ctx)
+	}
+	if !coll.Available() {
+		return nil
+	}
+	for _, partition := range coll.Partitions {
+		if partition.State != pb.PartitionState_PartitionRecycled || !recycleBinExpired(partition.DropTime) {
+			continue
+		}
+		log.Info("remove the expired partition from the recycle bin", zap.String("db", t.dbName),
+			zap.String("collection", coll.Name), zap.String("partition", partition.PartitionName),
+			zap.Int64("partitionID", partition.PartitionID),
+			zap.Time("dropTime", tsoutil.PhysicalTime(partition.DropTime)))
+		redoTask := newBaseRedoTask(t.core.stepExecutor)
+		redoTask.AddSyncStep(&changePartitionStateStep{
+			baseStep:     baseStep{core: t.core},
+			collectionID: coll.CollectionID,
+			partitionID:  partition.PartitionID,
+			state:        pb.PartitionState_PartitionDropping,
+			ts:           ts,
+		})
+		addGcPartitionSteps(t.core, redoTask, coll, partition, ts, false)
+		if err := redoTask.Execute(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeRecycleBin schedules a purgeRecycleBinTask for every collection having expired objects in the recycle bin,
+// the tasks lock the collections only, so that the ddl of the other collections isn't blocked.
+func (c *Core) purgeRecycleBin() {
+	dbs, err := c.meta.ListDatabases(c.ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		log.Warn("failed to list databases to purge recycle bin", zap.Error(err))
+		return
+	}
+	for _, db := range dbs {
+		colls, err := c.meta.ListCollections(c.ctx, db.Name, typeutil.MaxTimestamp, false)
+		if err != nil {
+			log.Warn("failed to list collections to purge recycle bin", zap.String("db", db.Name), zap.Error(err))
+			continue
+		}
+		for _, coll := range colls {
+			if !recycleBinExpiredIn(coll) {
+				continue
+			}
+			t := &purgeRecycleBinTask{
+				baseTask:       newBaseTask(c.ctx, c),
+				dbName:         db.Name,
+				collectionName: coll.Name,
+				collectionID:   coll.CollectionID,
+			}
+			if err := c.scheduler.AddTask(t); err != nil {
+				log.Warn("failed to enqueue the task to purge recycle bin", zap.Error(err))
+				return
+			}
+			if err := t.WaitToFinish(); err != nil {
+				log.Warn("failed to purge recycle bin", zap.String("db", db.Name),
+					zap.String("collection", coll.Name), zap.Int64("collectionID", coll.CollectionID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// recycleBinLoop removes the expired collections and partitions from the recycle bin periodically,
+// they're removed by the ddl tasks so that it never runs concurrently with undrop.
+func (c *Core) recycleBinLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.RootCoordCfg.RecycleBinCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Info("rootcoord's recycle bin loop quit!")
+			return
+		case <-ticker.C:
+			c.purgeRecycleBin()
+		}
+	}
+}
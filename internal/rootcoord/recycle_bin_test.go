@@ -0,0 +1,262 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_recycleBinExpired(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.RootCoordCfg.RecycleBinRetention.Key, "3600")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.RecycleBinRetention.Key)
+
+	assert.False(t, recycleBinExpired(tsoutil.ComposeTSByTime(time.Now(), 0)))
+	assert.True(t, recycleBinExpired(tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)))
+}
+
+func Test_undropCollectionTask(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		task := &undropCollectionTask{Req: &internalpb.UndropCollectionRequest{}}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("not in the recycle bin", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return([]*model.Collection{
+			{CollectionID: 1, Name: "test", State: pb.CollectionState_CollectionCreated},
+		}, nil)
+		core := newTestCore(withMeta(meta))
+		task := &undropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropCollectionRequest{DbName: "db", CollectionName: "test"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return([]*model.Collection{
+			{CollectionID: 1, Name: "test", State: pb.CollectionState_CollectionRecycled, DropTime: 100},
+		}, nil)
+		meta.EXPECT().ListAliasesByID(int64(1)).Return(nil)
+		core := newTestCore(withInvalidProxyManager(), withMeta(meta))
+		task := &undropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropCollectionRequest{DbName: "db", CollectionName: "test"},
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("undrop the latest dropped one", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return([]*model.Collection{
+			{CollectionID: 1, Name: "test", State: pb.CollectionState_CollectionRecycled, DropTime: 100},
+			{CollectionID: 2, Name: "test", State: pb.CollectionState_CollectionRecycled, DropTime: 200},
+			{CollectionID: 3, Name: "other", State: pb.CollectionState_CollectionRecycled, DropTime: 300},
+		}, nil)
+		meta.EXPECT().ListAliasesByID(int64(2)).Return([]string{"alias"})
+		meta.EXPECT().UndropCollection(mock.Anything, "db", int64(2), mock.Anything).Return(nil).Once()
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &undropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropCollectionRequest{DbName: "db", CollectionName: "test"},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+
+	t.Run("undrop by id", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return([]*model.Collection{
+			{CollectionID: 1, Name: "test", State: pb.CollectionState_CollectionRecycled, DropTime: 100},
+			{CollectionID: 2, Name: "test", State: pb.CollectionState_CollectionRecycled, DropTime: 200},
+		}, nil)
+		meta.EXPECT().ListAliasesByID(int64(1)).Return(nil)
+		meta.EXPECT().UndropCollection(mock.Anything, "db", int64(1), mock.Anything).Return(nil).Once()
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &undropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropCollectionRequest{DbName: "db", CollectionID: 1},
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
+
+func Test_undropPartitionTask(t *testing.T) {
+	paramtable.Init()
+
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "test",
+			Partitions: []*model.Partition{
+				{PartitionID: 10, PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+				{PartitionID: 11, PartitionName: "p1", State: pb.PartitionState_PartitionRecycled, DropTime: 100},
+				{PartitionID: 12, PartitionName: "p1", State: pb.PartitionState_PartitionRecycled, DropTime: 200},
+				{PartitionID: 13, PartitionName: "p2", State: pb.PartitionState_PartitionDropping},
+			},
+		}
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		task := &undropPartitionTask{Req: &internalpb.UndropPartitionRequest{CollectionName: "test"}}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("prepare", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "test", typeutil.MaxTimestamp).Return(&model.Collection{CollectionID: 1}, nil)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", int64(1), typeutil.MaxTimestamp, true).Return(newColl(), nil)
+		core := newTestCore(withMeta(meta))
+		task := &undropPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropPartitionRequest{DbName: "db", CollectionName: "test", PartitionName: "p1"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Len(t, task.collMeta.Partitions, 4)
+	})
+
+	t.Run("pick recycled partition", func(t *testing.T) {
+		task := &undropPartitionTask{
+			Req:      &internalpb.UndropPartitionRequest{PartitionName: "p1"},
+			collMeta: newColl(),
+		}
+		partition, err := task.pickRecycledPartition()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), partition.PartitionID)
+
+		task.Req.PartitionName = "p2"
+		_, err = task.pickRecycledPartition()
+		assert.Error(t, err)
+
+		task.Req.PartitionName = "_default"
+		_, err = task.pickRecycledPartition()
+		assert.Error(t, err)
+
+		paramtable.Get().Save(Params.RootCoordCfg.MaxPartitionNum.Key, "1")
+		defer paramtable.Get().Reset(Params.RootCoordCfg.MaxPartitionNum.Key)
+		task.Req.PartitionName = "p1"
+		_, err = task.pickRecycledPartition()
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ChangePartitionState(mock.Anything, int64(1), int64(12), pb.PartitionState_PartitionCreated, mock.Anything).Return(nil).Once()
+		broker := newMockBroker()
+		broker.SyncNewCreatedPartitionFunc = func(ctx context.Context, collectionID UniqueID, partitionID UniqueID) error {
+			return nil
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &undropPartitionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.UndropPartitionRequest{CollectionName: "test", PartitionName: "p1"},
+			collMeta: newColl(),
+		}
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
+
+func Test_purgeRecycleBinTask_Execute(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(Params.RootCoordCfg.RecycleBinRetention.Key, "3600")
+	defer paramtable.Get().Reset(Params.RootCoordCfg.RecycleBinRetention.Key)
+
+	confirmGCInterval = time.Millisecond
+	defer restoreConfirmGCInterval()
+
+	expired := tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)
+	fresh := tsoutil.ComposeTSByTime(time.Now(), 0)
+
+	expiredColl := &model.Collection{CollectionID: 1, Name: "expired", State: pb.CollectionState_CollectionRecycled, DropTime: expired}
+	freshColl := &model.Collection{CollectionID: 2, Name: "fresh", State: pb.CollectionState_CollectionRecycled, DropTime: fresh}
+	availableColl := &model.Collection{
+		CollectionID: 3, Name: "available", State: pb.CollectionState_CollectionCreated,
+		Partitions: []*model.Partition{
+			{PartitionID: 30, PartitionName: "_default", State: pb.PartitionState_PartitionCreated},
+			{PartitionID: 31, PartitionName: "expired", State: pb.PartitionState_PartitionRecycled, DropTime: expired},
+			{PartitionID: 32, PartitionName: "fresh", State: pb.PartitionState_PartitionRecycled, DropTime: fresh},
+		},
+	}
+	assert.True(t, recycleBinExpiredIn(expiredColl))
+	assert.False(t, recycleBinExpiredIn(freshColl))
+	assert.True(t, recycleBinExpiredIn(availableColl))
+
+	meta := mockrootcoord.NewIMetaTable(t)
+	for _, coll := range []*model.Collection{expiredColl, freshColl, availableColl} {
+		meta.EXPECT().GetCollectionByID(mock.Anything, "db", coll.CollectionID, typeutil.MaxTimestamp, true).Return(coll, nil)
+	}
+	meta.EXPECT().ChangeCollectionState(mock.Anything, int64(1), pb.CollectionState_CollectionDropping, mock.Anything).Return(nil).Once()
+	meta.EXPECT().ChangePartitionState(mock.Anything, int64(3), int64(31), pb.PartitionState_PartitionDropping, mock.Anything).Return(nil).Once()
+	removeCollectionChan := make(chan struct{}, 1)
+	meta.EXPECT().RemoveCollection(mock.Anything, int64(1), mock.Anything).RunAndReturn(func(ctx context.Context, collID UniqueID, ts Timestamp) error {
+		removeCollectionChan <- struct{}{}
+		return nil
+	}).Once()
+	removePartitionChan := make(chan struct{}, 1)
+	meta.EXPECT().RemovePartition(mock.Anything, mock.Anything, int64(3), int64(31), mock.Anything).RunAndReturn(func(ctx context.Context, dbID int64, collectionID int64, partitionID int64, ts uint64) error {
+		removePartitionChan <- struct{}{}
+		return nil
+	}).Once()
+
+	broker := newMockBroker()
+	broker.DropCollectionIndexFunc = func(ctx context.Context, collID UniqueID, partIDs []UniqueID) error {
+		return nil
+	}
+	broker.GCConfirmFunc = func(ctx context.Context, collectionID, partitionID UniqueID) bool {
+		return true
+	}
+	gc := newMockGarbageCollector()
+	gc.GcCollectionDataFunc = func(ctx context.Context, coll *model.Collection) (Timestamp, error) {
+		return 0, nil
+	}
+	gc.GcPartitionDataFunc = func(ctx context.Context, pChannels []string, partition *model.Partition) (Timestamp, error) {
+		return 0, nil
+	}
+
+	defer cleanTestEnv()
+	ticker := newRocksMqTtSynchronizer()
+	core := newTestCore(withMeta(meta), withBroker(broker), withGarbageCollector(gc), withTtSynchronizer(ticker))
+	newTask := func(coll *model.Collection) *purgeRecycleBinTask {
+		return &purgeRecycleBinTask{
+			baseTask:       newBaseTask(context.Background(), core),
+			dbName:         "db",
+			collectionName: coll.Name,
+			collectionID:   coll.CollectionID,
+		}
+	}
+
+	assert.NoError(t, newTask(expiredColl).Execute(context.Background()))
+	<-removeCollectionChan
+	assert.NoError(t, newTask(freshColl).Execute(context.Background()))
+	assert.NoError(t, newTask(availableColl).Execute(context.Background()))
+	<-removePartitionChan
+}
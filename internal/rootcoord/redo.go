@@ -46,25 +46,29 @@ func (b *baseRedoTask) AddAsyncStep(step nestedStep) {
 	b.asyncTodoStep = append(b.asyncTodoStep, step)
 }
 
-func (b *baseRedoTask) redoAsyncSteps() {
+func (b *baseRedoTask) redoAsyncSteps(job *ddlJob) {
 	l := len(b.asyncTodoStep)
 	steps := make([]nestedStep, 0, l)
 	for i := l - 1; i >= 0; i-- {
 		steps = append(steps, b.asyncTodoStep[i])
 	}
 	b.asyncTodoStep = nil // make baseRedoTask can be collected.
-	b.stepExecutor.AddSteps(&stepStack{steps: steps})
+	b.stepExecutor.AddSteps(&stepStack{steps: steps, job: job})
 }
 
 func (b *baseRedoTask) Execute(ctx context.Context) error {
+	job := getDDLJob(ctx)
 	for i := 0; i < len(b.syncTodoStep); i++ {
 		todo := b.syncTodoStep[i]
+		job.setStep(todo.Desc())
 		// no children step in sync steps.
 		if _, err := todo.Execute(ctx); err != nil {
 			log.Error("failed to execute step", zap.Error(err), zap.String("desc", todo.Desc()))
 			return err
 		}
 	}
-	go b.redoAsyncSteps()
+	// register the stack before the task returns, so the job won't finish before the async steps.
+	job.addStepStack(false)
+	go b.redoAsyncSteps(job)
 	return nil
 }
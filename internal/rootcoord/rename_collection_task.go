@@ -19,8 +19,13 @@ package rootcoord
 import (
 	"context"
 
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type renameCollectionTask struct {
@@ -28,10 +33,38 @@ type renameCollectionTask struct {
 	Req *milvuspb.RenameCollectionRequest
 }
 
+func (t *renameCollectionTask) GetLocks() []ddlLock {
+	newDBName := t.Req.GetNewDBName()
+	if newDBName == "" {
+		newDBName = t.Req.GetDbName()
+	}
+	return []ddlLock{
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetOldName(), true),
+		newCollectionLock(newDBName, t.Req.GetNewName(), true),
+	}
+}
+
 func (t *renameCollectionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.GetBase().GetMsgType(), commonpb.MsgType_RenameCollection); err != nil {
 		return err
 	}
+	newDBName := t.Req.GetNewDBName()
+	if newDBName == "" || normalizeDBName(newDBName) == normalizeDBName(t.Req.GetDbName()) {
+		return nil
+	}
+
+	// the collection moved to another database is counted by the target database.
+	targetDB, err := t.core.meta.GetDatabaseByName(ctx, newDBName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	collIDs := t.core.meta.ListAllAvailCollections(ctx)[targetDB.ID]
+	maxColNumPerDB := getDatabaseMaxCollections(targetDB.Properties)
+	if len(collIDs) >= maxColNumPerDB {
+		log.Warn("unable to move collection because the number of collection has reached the limit in DB",
+			zap.String("dbName", newDBName), zap.Int("maxCollectionNumPerDB", maxColNumPerDB))
+		return merr.WrapErrCollectionNumLimitExceeded(maxColNumPerDB, "max number of collection has reached the limit in DB")
+	}
 	return nil
 }
 
@@ -39,5 +72,22 @@ func (t *renameCollectionTask) Execute(ctx context.Context) error {
 	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), []string{t.Req.GetOldName()}, InvalidCollectionID, t.GetTs()); err != nil {
 		return err
 	}
-	return t.core.meta.RenameCollection(ctx, t.Req.GetDbName(), t.Req.GetOldName(), t.Req.GetNewDBName(), t.Req.GetNewName(), t.GetTs())
+	if err := t.core.meta.RenameCollection(ctx, t.Req.GetDbName(), t.Req.GetOldName(), t.Req.GetNewDBName(), t.Req.GetNewName(), t.GetTs()); err != nil {
+		return err
+	}
+
+	newDBName := t.Req.GetNewDBName()
+	if newDBName == "" {
+		newDBName = t.Req.GetDbName()
+	}
+	coll, err := t.core.meta.GetCollectionByName(ctx, newDBName, t.Req.GetNewName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	// the aliases are moved with the collection, expire them in all databases by the collection id.
+	if err := t.core.ExpireMetaCache(ctx, newDBName, nil, coll.CollectionID, t.GetTs()); err != nil {
+		return err
+	}
+	// the grants on the collection are remapped to the new name.
+	return t.core.refreshPolicyCache(ctx)
 }
@@ -22,9 +22,17 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 func Test_renameCollectionTask_Prepare(t *testing.T) {
@@ -51,6 +59,43 @@ func Test_renameCollectionTask_Prepare(t *testing.T) {
 		err := task.Prepare(context.Background())
 		assert.NoError(t, err)
 	})
+
+	t.Run("target db not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", typeutil.MaxTimestamp).Return(nil, merr.WrapErrDatabaseNotFound("db2"))
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base:      &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+				DbName:    "db1",
+				NewDBName: "db2",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("target db exceeds collection limit", func(t *testing.T) {
+		paramtable.Init()
+		paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNumPerDB.Key, "1")
+		defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNumPerDB.Key)
+
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", typeutil.MaxTimestamp).Return(model.NewDatabase(2, "db2", pb.DatabaseState_DatabaseCreated), nil)
+		meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{1: {100}, 2: {200}})
+		core := newTestCore(withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base:      &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+				DbName:    "db1",
+				NewDBName: "db2",
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionNumLimitExceeded)
+	})
 }
 
 func Test_renameCollectionTask_Execute(t *testing.T) {
@@ -86,4 +131,42 @@ func Test_renameCollectionTask_Execute(t *testing.T) {
 		err := task.Execute(context.Background())
 		assert.Error(t, err)
 	})
+
+	t.Run("move collection", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RenameCollection(mock.Anything, "db1", "old", "db2", "new", mock.Anything).Return(nil)
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db2", "new", typeutil.MaxTimestamp).Return(&model.Collection{CollectionID: 100}, nil)
+
+		var expired []*proxypb.InvalidateCollMetaCacheRequest
+		refreshed := false
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		p := newMockProxy()
+		p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
+			expired = append(expired, request)
+			return merr.Success(), nil
+		}
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			assert.Equal(t, int32(typeutil.CacheRefresh), request.GetOpType())
+			refreshed = true
+			return merr.Success(), nil
+		}
+		core.proxyClientManager.proxyClient[TestProxyID] = p
+
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base:      &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+				DbName:    "db1",
+				OldName:   "old",
+				NewDBName: "db2",
+				NewName:   "new",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, expired, 2)
+		assert.Equal(t, "old", expired[0].GetCollectionName())
+		assert.Equal(t, int64(100), expired[1].GetCollectionID())
+		assert.True(t, refreshed)
+	})
 }
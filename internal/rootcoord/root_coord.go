@@ -21,12 +21,12 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/samber/lo"
 	"github.com/tikv/client-go/v2/txnkv"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/atomic"
@@ -54,6 +54,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	tsoutil2 "github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -98,6 +99,7 @@ type Core struct {
 	ddlTsLockManager DdlTsLockManager
 	garbageCollector GarbageCollector
 	stepExecutor     StepExecutor
+	ddlJobs          *ddlJobManager
 
 	metaKVCreator metaKVCreator
 
@@ -128,6 +130,8 @@ type Core struct {
 
 	enableActiveStandBy bool
 	activateFunc        func() error
+
+	loginLockout loginLockout
 }
 
 // --------------------- function --------------------------
@@ -466,7 +470,12 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
-	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
+	c.ddlJobs = newDDLJobManager(c.ctx, c.meta)
+	if err := c.ddlJobs.load(); err != nil {
+		return err
+	}
+
+	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator, c.meta)
 
 	c.factory.Init(Params)
 	chanMap := c.meta.ListCollectionPhysicalChannels()
@@ -691,13 +700,15 @@ func (c *Core) startInternal() error {
 }
 
 func (c *Core) startServerLoop() {
-	c.wg.Add(6)
+	c.wg.Add(8)
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
 	go c.importManager.cleanupLoop(&c.wg)
 	go c.importManager.sendOutTasksLoop(&c.wg)
 	go c.importManager.flipTaskStateLoop(&c.wg)
+	go c.recycleBinLoop()
+	go c.ddlJobs.cleanupLoop(&c.wg)
 }
 
 // Start starts RootCoord.
@@ -936,6 +947,82 @@ func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequ
 	return t.Resp, nil
 }
 
+// AlterDatabase alters the database, like renaming it or setting its properties.
+func (c *Core) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "AlterDatabase"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.String("newDBName", in.GetNewDbName()),
+		zap.Any("properties", in.GetProperties()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+	log.Info("received request to alter database")
+
+	t := &alterDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to alter database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to alter database", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Info("done to alter database", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// DescribeDatabase returns the meta of the database, including its properties.
+func (c *Core) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	method := "DescribeDatabase"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+	log.Debug("received request to describe database")
+
+	t := &describeDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+		Resp:     &internalpb.DescribeDatabaseResponse{},
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to describe database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to describe database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Debug("done to describe database")
+	return t.Resp, nil
+}
+
 // CreateCollection create collection
 func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -955,7 +1042,7 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 		Req:      in,
 	}
 
-	if err := c.scheduler.AddTask(t); err != nil {
+	if err := c.addDDLJob(ctx, "CreateCollection", in, t); err != nil {
 		log.Ctx(ctx).Info("failed to enqueue request to create collection",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -965,7 +1052,7 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 		return merr.Status(err), nil
 	}
 
-	if err := t.WaitToFinish(); err != nil {
+	if err := c.waitDDLJob(in, t); err != nil {
 		log.Ctx(ctx).Info("failed to create collection",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -1006,7 +1093,7 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 		Req:      in,
 	}
 
-	if err := c.scheduler.AddTask(t); err != nil {
+	if err := c.addDDLJob(ctx, "DropCollection", in, t); err != nil {
 		log.Ctx(ctx).Info("failed to enqueue request to drop collection", zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
 			zap.String("name", in.GetCollectionName()))
@@ -1015,7 +1102,7 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 		return merr.Status(err), nil
 	}
 
-	if err := t.WaitToFinish(); err != nil {
+	if err := c.waitDDLJob(in, t); err != nil {
 		log.Ctx(ctx).Info("failed to drop collection", zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
 			zap.String("name", in.GetCollectionName()),
@@ -1234,58 +1321,558 @@ func (c *Core) ShowCollections(ctx context.Context, in *milvuspb.ShowCollections
 
 	log.Info("done to show collections", zap.Int("num of collections", len(t.Rsp.GetCollectionNames()))) // maybe very large, print number instead.
 
-	return t.Rsp, nil
+	return t.Rsp, nil
+}
+
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AlterCollection")
+
+	log.Ctx(ctx).Info("received request to alter collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()))
+
+	t := &alterCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.addDDLJob(ctx, "AlterCollection", in, t); err != nil {
+		log.Warn("failed to enqueue request to alter collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := c.waitDDLJob(in, t); err != nil {
+		log.Warn("failed to alter collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("AlterCollection").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to alter collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// AddCollectionField appends a field to the schema of an existing collection
+func (c *Core) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+
+	log.Ctx(ctx).Info("received request to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("field", in.GetField().GetName()))
+
+	t := &addCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.addDDLJob(ctx, "AddCollectionField", in, t); err != nil {
+		log.Warn("failed to enqueue request to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := c.waitDDLJob(in, t); err != nil {
+		log.Warn("failed to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("AddCollectionField").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("field", in.GetField().GetName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// CloneCollection creates a collection which shares the flushed data and the indexes of the source collection
+func (c *Core) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CloneCollection")
+
+	log.Ctx(ctx).Info("received request to clone collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("newName", in.GetNewCollectionName()),
+		zap.Uint64("snapshotTs", in.GetTimestamp()))
+
+	t := &cloneCollectionTask{
+		createCollectionTask: createCollectionTask{
+			baseTask: newBaseTask(ctx, c),
+		},
+		cloneReq: in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to clone collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to clone collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CloneCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CloneCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("CloneCollection").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to clone collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("newName", in.GetNewCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// ListDroppedCollections lists the collections in the recycle bin of the database
+func (c *Core) ListDroppedCollections(ctx context.Context, in *internalpb.ListDroppedCollectionsRequest) (*internalpb.ListDroppedCollectionsResponse, error) {
+	method := "ListDroppedCollections"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("db", in.GetDbName()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListDroppedCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	colls, err := c.meta.ListCollections(ctx, in.GetDbName(), typeutil.MaxTimestamp, false)
+	if err != nil {
+		ctxLog.Warn("ListDroppedCollections list collections failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.ListDroppedCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	retention := Params.RootCoordCfg.RecycleBinRetention.GetAsDuration(time.Second)
+	infos := make([]*internalpb.DroppedCollectionInfo, 0)
+	for _, coll := range colls {
+		if coll.State != pb.CollectionState_CollectionRecycled {
+			continue
+		}
+		infos = append(infos, &internalpb.DroppedCollectionInfo{
+			CollectionName:     coll.Name,
+			CollectionID:       coll.CollectionID,
+			DropTimestamp:      coll.DropTime,
+			ExpireUtcTimestamp: uint64(tsoutil.PhysicalTime(coll.DropTime).Add(retention).UnixMilli()),
+		})
+	}
+	ctxLog.Debug("ListDroppedCollections success", zap.Int("num", len(infos)))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListDroppedCollectionsResponse{
+		Status:      merr.Success(),
+		Collections: infos,
+	}, nil
+}
+
+// UndropCollection restores the collection from the recycle bin
+func (c *Core) UndropCollection(ctx context.Context, in *internalpb.UndropCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UndropCollection")
+
+	log.Ctx(ctx).Info("received request to undrop collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Int64("collectionID", in.GetCollectionID()))
+
+	t := &undropCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to undrop collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to undrop collection",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("UndropCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("UndropCollection").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to undrop collection",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// UndropPartition restores the partition from the recycle bin
+func (c *Core) UndropPartition(ctx context.Context, in *internalpb.UndropPartitionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropPartition", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UndropPartition")
+
+	log.Ctx(ctx).Info("received request to undrop partition",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection", in.GetCollectionName()),
+		zap.String("partition", in.GetPartitionName()))
+
+	t := &undropPartitionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to undrop partition",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("collection", in.GetCollectionName()),
+			zap.String("partition", in.GetPartitionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropPartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to undrop partition",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("collection", in.GetCollectionName()),
+			zap.String("partition", in.GetPartitionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropPartition", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropPartition", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("UndropPartition").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("UndropPartition").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to undrop partition",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection", in.GetCollectionName()),
+		zap.String("partition", in.GetPartitionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// CreateSnapshot creates a named snapshot of the collection
+func (c *Core) CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateSnapshot", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("CreateSnapshot")
+
+	log.Ctx(ctx).Info("received request to create snapshot",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection", in.GetCollectionName()),
+		zap.String("snapshot", in.GetSnapshotName()))
+
+	t := &createSnapshotTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to create snapshot",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("snapshot", in.GetSnapshotName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to create snapshot",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("snapshot", in.GetSnapshotName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("CreateSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateSnapshot", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("CreateSnapshot").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("CreateSnapshot").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to create snapshot",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("snapshot", in.GetSnapshotName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// DropSnapshot drops the snapshot
+func (c *Core) DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropSnapshot", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("DropSnapshot")
+
+	log.Ctx(ctx).Info("received request to drop snapshot",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("snapshot", in.GetSnapshotName()))
+
+	t := &dropSnapshotTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to drop snapshot",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("snapshot", in.GetSnapshotName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to drop snapshot",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("snapshot", in.GetSnapshotName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("DropSnapshot", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("DropSnapshot", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("DropSnapshot").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("DropSnapshot").Observe(float64(t.queueDur.Milliseconds()))
+
+	log.Info("done to drop snapshot",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("snapshot", in.GetSnapshotName()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// ListSnapshots lists the snapshots in the database, only the snapshots of the collection are listed if it's specified
+func (c *Core) ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest) (*internalpb.ListSnapshotsResponse, error) {
+	method := "ListSnapshots"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("db", in.GetDbName()),
+		zap.String("collection", in.GetCollectionName()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+
+	db, err := c.meta.GetDatabaseByName(ctx, in.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		ctxLog.Warn("ListSnapshots get database failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+	collectionID := UniqueID(0)
+	if in.GetCollectionName() != "" {
+		coll, err := c.meta.GetCollectionByName(ctx, in.GetDbName(), in.GetCollectionName(), typeutil.MaxTimestamp)
+		if err != nil {
+			ctxLog.Warn("ListSnapshots get collection failed", zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+		}
+		collectionID = coll.CollectionID
+	}
+	snapshots, err := c.meta.ListCollectionSnapshots(ctx, db.ID, collectionID)
+	if err != nil {
+		ctxLog.Warn("ListSnapshots failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.ListSnapshotsResponse{Status: merr.Status(err)}, nil
+	}
+	infos := make([]*internalpb.SnapshotInfo, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		infos = append(infos, &internalpb.SnapshotInfo{
+			Name:            snapshot.Name,
+			Description:     snapshot.Description,
+			CollectionName:  snapshot.Collection.Name,
+			CollectionID:    snapshot.Collection.CollectionID,
+			CreateTimestamp: snapshot.CreateTime,
+		})
+	}
+	ctxLog.Debug("ListSnapshots success", zap.Int("num", len(infos)))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: infos,
+	}, nil
 }
 
-func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+// RestoreSnapshot creates a new collection from the snapshot without copying the data
+func (c *Core) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
 
-	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
-	tr := timerecord.NewTimeRecorder("AlterCollection")
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("RestoreSnapshot")
 
-	log.Ctx(ctx).Info("received request to alter collection",
+	log.Ctx(ctx).Info("received request to restore snapshot",
 		zap.String("role", typeutil.RootCoordRole),
-		zap.String("name", in.GetCollectionName()))
+		zap.String("snapshot", in.GetSnapshotName()),
+		zap.String("newName", in.GetNewCollectionName()))
 
-	t := &alterCollectionTask{
-		baseTask: newBaseTask(ctx, c),
-		Req:      in,
+	t := &restoreSnapshotTask{
+		cloneCollectionTask: cloneCollectionTask{
+			createCollectionTask: createCollectionTask{
+				baseTask: newBaseTask(ctx, c),
+			},
+		},
+		restoreReq: in,
 	}
 
 	if err := c.scheduler.AddTask(t); err != nil {
-		log.Warn("failed to enqueue request to alter collection",
+		log.Warn("failed to enqueue request to restore snapshot",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
-			zap.String("name", in.GetCollectionName()))
+			zap.String("snapshot", in.GetSnapshotName()))
 
-		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.FailLabel).Inc()
 		return merr.Status(err), nil
 	}
 
 	if err := t.WaitToFinish(); err != nil {
-		log.Warn("failed to alter collection",
+		log.Warn("failed to restore snapshot",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
-			zap.String("name", in.GetCollectionName()),
+			zap.String("snapshot", in.GetSnapshotName()),
 			zap.Uint64("ts", t.GetTs()))
 
-		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.FailLabel).Inc()
+		metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.FailLabel).Inc()
 		return merr.Status(err), nil
 	}
 
-	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.SuccessLabel).Inc()
-	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
-	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("AlterCollection").Observe(float64(t.queueDur.Milliseconds()))
+	metrics.RootCoordDDLReqCounter.WithLabelValues("RestoreSnapshot", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("RestoreSnapshot").Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues("RestoreSnapshot").Observe(float64(t.queueDur.Milliseconds()))
 
-	log.Info("done to alter collection",
+	log.Info("done to restore snapshot",
 		zap.String("role", typeutil.RootCoordRole),
-		zap.String("name", in.GetCollectionName()),
+		zap.String("snapshot", in.GetSnapshotName()),
 		zap.Uint64("ts", t.GetTs()))
 	return merr.Success(), nil
 }
 
+// GetDDLJob gets the progress of the DDL job.
+func (c *Core) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest) (*internalpb.GetDDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	job, err := c.ddlJobs.getJob(in.GetJobID())
+	if err != nil {
+		log.Ctx(ctx).Warn("GetDDLJob failed", zap.Int64("jobID", in.GetJobID()), zap.Error(err))
+		return &internalpb.GetDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	return &internalpb.GetDDLJobResponse{
+		Status: merr.Success(),
+		Job:    model.MarshalDDLJobModel(job),
+	}, nil
+}
+
+// ListDDLJobs lists the DDL jobs of the database, or of the collection if it's specified.
+func (c *Core) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest) (*internalpb.ListDDLJobsResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListDDLJobsResponse{Status: merr.Status(err)}, nil
+	}
+
+	dbName := in.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	jobs := c.ddlJobs.listJobs(dbName, in.GetCollectionName(), in.GetUnfinishedOnly())
+	infos := make([]*internalpb.DDLJobInfo, 0, len(jobs))
+	for _, job := range jobs {
+		infos = append(infos, model.MarshalDDLJobModel(job))
+	}
+	return &internalpb.ListDDLJobsResponse{
+		Status: merr.Success(),
+		Jobs:   infos,
+	}, nil
+}
+
 // CreatePartition create partition
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -1305,7 +1892,7 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 		Req:      in,
 	}
 
-	if err := c.scheduler.AddTask(t); err != nil {
+	if err := c.addDDLJob(ctx, "CreatePartition", in, t); err != nil {
 		log.Ctx(ctx).Info("failed to enqueue request to create partition",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -1316,7 +1903,7 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 		return merr.Status(err), nil
 	}
 
-	if err := t.WaitToFinish(); err != nil {
+	if err := c.waitDDLJob(in, t); err != nil {
 		log.Ctx(ctx).Info("failed to create partition",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -1359,7 +1946,7 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 		Req:      in,
 	}
 
-	if err := c.scheduler.AddTask(t); err != nil {
+	if err := c.addDDLJob(ctx, "DropPartition", in, t); err != nil {
 		log.Ctx(ctx).Info("failed to enqueue request to drop partition",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -1369,7 +1956,7 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 		metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.FailLabel).Inc()
 		return merr.Status(err), nil
 	}
-	if err := t.WaitToFinish(); err != nil {
+	if err := c.waitDDLJob(in, t); err != nil {
 		log.Ctx(ctx).Info("failed to drop partition",
 			zap.String("role", typeutil.RootCoordRole),
 			zap.Error(err),
@@ -1830,6 +2417,45 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 	return merr.Success(), nil
 }
 
+// BatchAlterAliases creates, alters and drops the aliases of the database atomically.
+func (c *Core) BatchAlterAliases(ctx context.Context, in *internalpb.BatchAlterAliasesRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "BatchAlterAliases"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Int("operations", len(in.GetOperations())),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+	log.Info("received request to batch alter aliases")
+
+	t := &batchAlterAliasesTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to batch alter aliases", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to batch alter aliases", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	metrics.RootCoordDDLReqLatencyInQueue.WithLabelValues(method).Observe(float64(t.queueDur.Milliseconds()))
+	log.Info("done to batch alter aliases", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
 // Import imports large files (json, numpy, etc.) on MinIO/S3 storage into Milvus storage.
 func (c *Core) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -2044,8 +2670,10 @@ func (c *Core) UpdateCredCache(ctx context.Context, credInfo *internalpb.Credent
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithSourceID(c.session.ServerID),
 		),
-		Username: credInfo.Username,
-		Password: credInfo.Sha256Password,
+		Username:           credInfo.Username,
+		Password:           credInfo.Sha256Password,
+		PasswordUpdateTime: credInfo.PasswordUpdateTime,
+		NeedPasswordChange: credInfo.NeedPasswordChange,
 	}
 	return c.proxyClientManager.UpdateCredentialCache(ctx, &req)
 }
@@ -2064,6 +2692,7 @@ func (c *Core) CreateCredential(ctx context.Context, credInfo *internalpb.Creden
 		return merr.Status(err), nil
 	}
 
+	credInfo.PasswordUpdateTime = time.Now().Unix()
 	// insert to db
 	err := c.meta.AddCredential(credInfo)
 	if err != nil {
@@ -2109,11 +2738,18 @@ func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialR
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
 	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return &rootcoordpb.GetCredentialResponse{
-		Status:   merr.Success(),
-		Username: credInfo.Username,
-		Password: credInfo.EncryptedPassword,
-	}, nil
+	resp := &rootcoordpb.GetCredentialResponse{
+		Status:             merr.Success(),
+		Username:           credInfo.Username,
+		Password:           credInfo.EncryptedPassword,
+		PasswordHistory:    credInfo.PasswordHistory,
+		PasswordUpdateTime: credInfo.PasswordUpdateTime,
+		NeedPasswordChange: credInfo.NeedPasswordChange,
+	}
+	if lockedUntil := c.loginLockout.lockedUntil(in.Username, time.Now()); !lockedUntil.IsZero() {
+		resp.LockedUntil = lockedUntil.Unix()
+	}
+	return resp, nil
 }
 
 // UpdateCredential update password for a user
@@ -2126,13 +2762,28 @@ func (c *Core) UpdateCredential(ctx context.Context, credInfo *internalpb.Creden
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	origin, err := c.meta.GetCredential(credInfo.Username)
+	if err != nil {
+		ctxLog.Warn("UpdateCredential query credential failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.StatusWithErrorCode(err, commonpb.ErrorCode_UpdateCredentialFailure), nil
+	}
+	// the current password is pushed to the history, which keeps the latest passwords of the history size besides the new one
+	if historySize := Params.CommonCfg.PasswordHistorySize.GetAsInt() - 1; historySize > 0 {
+		credInfo.PasswordHistory = append([]string{origin.GetEncryptedPassword()}, origin.GetPasswordHistory()...)
+		if len(credInfo.PasswordHistory) > historySize {
+			credInfo.PasswordHistory = credInfo.PasswordHistory[:historySize]
+		}
+	}
+	credInfo.PasswordUpdateTime = time.Now().Unix()
 	// update data on storage
-	err := c.meta.AlterCredential(credInfo)
+	err = c.meta.AlterCredential(credInfo)
 	if err != nil {
 		ctxLog.Warn("UpdateCredential save credential failed", zap.Error(err))
 		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
 		return merr.StatusWithErrorCode(err, commonpb.ErrorCode_UpdateCredentialFailure), nil
 	}
+	c.loginLockout.reset(credInfo.Username)
 	// update proxy's local cache
 	err = c.UpdateCredCache(ctx, credInfo)
 	if err != nil {
@@ -2169,8 +2820,10 @@ func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredenti
 		err := c.meta.DeleteCredential(in.Username)
 		if err != nil {
 			ctxLog.Warn("delete credential meta data failed", zap.Error(err))
+			return nil, err
 		}
-		return nil, err
+		c.loginLockout.reset(in.Username)
+		return nil, nil
 	}))
 	redoTask.AddAsyncStep(NewSimpleStep("delete credential cache", func(ctx context.Context) ([]nestedStep, error) {
 		err := c.ExpireCredCache(ctx, in.Username)
@@ -2235,6 +2888,235 @@ func (c *Core) ListCredUsers(ctx context.Context, in *milvuspb.ListCredUsersRequ
 	}, nil
 }
 
+// CreateAPIKey saves the api key created by proxy for the user
+func (c *Core) CreateAPIKey(ctx context.Context, in *internalpb.APIKeyInfo) (*commonpb.Status, error) {
+	method := "CreateAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.String("id", in.GetId()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	err := c.meta.AddAPIKey(in)
+	if err != nil {
+		ctxLog.Warn("CreateAPIKey save api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	ctxLog.Debug("CreateAPIKey success")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ListAPIKeys list the api keys filtered by the username and id, the keys are encrypted by sha256
+func (c *Core) ListAPIKeys(ctx context.Context, in *internalpb.ListAPIKeysRequest) (*internalpb.ListAPIKeysResponse, error) {
+	method := "ListAPIKeys"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.String("id", in.GetId()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+
+	keys, err := c.meta.ListAPIKeys(in.GetUsername(), in.GetId())
+	if err != nil {
+		ctxLog.Warn("ListAPIKeys query api keys failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	ctxLog.Debug("ListAPIKeys success", zap.Int("num", len(keys)))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListAPIKeysResponse{
+		Status:  merr.Success(),
+		ApiKeys: keys,
+	}, nil
+}
+
+// RevokeAPIKey delete the api key of the user and expire the cache of proxies
+func (c *Core) RevokeAPIKey(ctx context.Context, in *internalpb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	method := "RevokeAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()), zap.String("id", in.GetId()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	err := c.meta.DeleteAPIKey(in.GetUsername(), in.GetId())
+	if err != nil {
+		ctxLog.Warn("RevokeAPIKey delete api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	// the api keys are cached along with the credential of the user
+	err = c.ExpireCredCache(ctx, in.GetUsername())
+	if err != nil {
+		ctxLog.Warn("RevokeAPIKey expire cache failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	ctxLog.Debug("RevokeAPIKey success")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ReportLoginFailure counts the failed login reported by proxy, the credential cache of proxies is expired
+// once the user is locked out, so that proxies get the lockout along with the credential. The successful login
+// after the failures is reported too, which clears the failures. Root isn't locked out unless it's configured.
+func (c *Core) ReportLoginFailure(ctx context.Context, in *internalpb.ReportLoginFailureRequest) (*internalpb.ReportLoginFailureResponse, error) {
+	method := "ReportLoginFailure"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("username", in.GetUsername()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ReportLoginFailureResponse{Status: merr.Status(err)}, nil
+	}
+
+	if in.GetSucceeded() {
+		c.loginLockout.recordSuccess(in.GetUsername(), time.Now())
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+		return &internalpb.ReportLoginFailureResponse{Status: merr.Success()}, nil
+	}
+	if in.GetUsername() == util.UserRoot && Params.CommonCfg.LoginLockoutExemptRoot.GetAsBool() {
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+		return &internalpb.ReportLoginFailureResponse{Status: merr.Success()}, nil
+	}
+	lockedUntil, locked := c.loginLockout.recordFailure(in.GetUsername(), time.Now(),
+		Params.CommonCfg.LoginMaxFailedAttempts.GetAsInt(),
+		Params.CommonCfg.LoginLockoutDuration.GetAsDuration(time.Second))
+	if locked {
+		ctxLog.Warn("the user is locked out due to too many failed logins", zap.Time("lockedUntil", lockedUntil))
+		eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+			fmt.Sprintf("user %s is locked out until %s due to too many failed logins", in.GetUsername(), lockedUntil.Format(time.RFC3339))))
+		if err := c.ExpireCredCache(ctx, in.GetUsername()); err != nil {
+			ctxLog.Warn("ReportLoginFailure expire credential cache failed", zap.Error(err))
+		}
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	resp := &internalpb.ReportLoginFailureResponse{Status: merr.Success()}
+	if !lockedUntil.IsZero() {
+		resp.LockedUntil = lockedUntil.Unix()
+	}
+	return resp, nil
+}
+
+// GrantRowPolicy saves the row policy validated by proxy and expires the collection cache of proxies
+func (c *Core) GrantRowPolicy(ctx context.Context, in *internalpb.GrantRowPolicyRequest) (*commonpb.Status, error) {
+	method := "GrantRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	policy := in.GetPolicy()
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("role_name", policy.GetRoleName()),
+		zap.String("db", policy.GetDbName()), zap.Int64("collectionID", policy.GetCollectionID()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := c.isValidRole(&milvuspb.RoleEntity{Name: policy.GetRoleName()}); err != nil {
+		ctxLog.Warn("", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	if _, err := c.meta.GetCollectionByID(ctx, policy.GetDbName(), policy.GetCollectionID(), typeutil.MaxTimestamp, false); err != nil {
+		ctxLog.Warn("GrantRowPolicy collection not found", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := c.operateRowPolicy(ctx, policy.GetDbName(), policy.GetCollectionID(), func() error {
+		return c.meta.AddRowPolicy(policy)
+	}); err != nil {
+		ctxLog.Warn("GrantRowPolicy save row policy failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	ctxLog.Debug("GrantRowPolicy success")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// RevokeRowPolicy deletes the row policy of the role on the collection and expires the collection cache of proxies
+func (c *Core) RevokeRowPolicy(ctx context.Context, in *internalpb.RevokeRowPolicyRequest) (*commonpb.Status, error) {
+	method := "RevokeRowPolicy"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("role_name", in.GetRoleName()),
+		zap.String("db", in.GetDbName()), zap.Int64("collectionID", in.GetCollectionID()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := c.operateRowPolicy(ctx, in.GetDbName(), in.GetCollectionID(), func() error {
+		return c.meta.DeleteRowPolicy(in.GetRoleName(), in.GetCollectionID())
+	}); err != nil {
+		ctxLog.Warn("RevokeRowPolicy delete row policy failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+	ctxLog.Debug("RevokeRowPolicy success")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// operateRowPolicy operates the row policy meta and expires the collection cache of proxies,
+// which carries the row policies of the collection.
+func (c *Core) operateRowPolicy(ctx context.Context, dbName string, collectionID UniqueID, operate func() error) error {
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("operate row policy meta data", func(ctx context.Context) ([]nestedStep, error) {
+		return nil, operate()
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("expire row policy cache", func(ctx context.Context) ([]nestedStep, error) {
+		return nil, c.ExpireMetaCache(ctx, dbName, nil, collectionID, 0)
+	}))
+	return redoTask.Execute(ctx)
+}
+
+// ListRowPolicies list the row policies filtered by the role and collection
+func (c *Core) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest) (*internalpb.ListRowPoliciesResponse, error) {
+	method := "ListRowPolicies"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("role_name", in.GetRoleName()),
+		zap.Int64("collectionID", in.GetCollectionID()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+
+	policies, err := c.meta.ListRowPolicies(in.GetRoleName(), in.GetCollectionID())
+	if err != nil {
+		ctxLog.Warn("ListRowPolicies query row policies failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.ListRowPoliciesResponse{Status: merr.Status(err)}, nil
+	}
+	ctxLog.Debug("ListRowPolicies success", zap.Int("num", len(policies)))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.ListRowPoliciesResponse{
+		Status:   merr.Success(),
+		Policies: policies,
+	}, nil
+}
+
 // CreateRole create role
 // - check the node health
 // - check if the role is existed
@@ -2271,6 +3153,7 @@ func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (
 // - check the node health
 // - check if the role name is existed
 // - check if the role has some grant info
+// - check if the role has some row policies
 // - get all role mapping of this role
 // - drop these role mappings
 // - drop the role by the meta api
@@ -2298,6 +3181,13 @@ func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*com
 		ctxLog.Warn(errMsg, zap.Error(err))
 		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
 	}
+	// the row policies would be inherited by the role created with the same name later
+	rowPolicies, err := c.meta.ListRowPolicies(in.RoleName, 0)
+	if err != nil || len(rowPolicies) != 0 {
+		errMsg := "fail to drop the role that it has row policies. Use REVOKE ROW POLICY API to revoke row policies"
+		ctxLog.Warn(errMsg, zap.Error(err))
+		return merr.StatusWithErrorCode(errors.New(errMsg), commonpb.ErrorCode_DropRoleFailure), nil
+	}
 	redoTask := newBaseRedoTask(c.stepExecutor)
 	redoTask.AddSyncStep(NewSimpleStep("drop role meta data", func(ctx context.Context) ([]nestedStep, error) {
 		err := c.meta.DropRole(util.DefaultTenant, in.RoleName)
@@ -2515,8 +3405,66 @@ func (c *Core) isValidObject(entity *milvuspb.ObjectEntity) error {
 	if entity == nil {
 		return errors.New("the object entity is nil")
 	}
-	if _, ok := commonpb.ObjectType_value[entity.Name]; !ok {
-		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", entity.Name, lo.Keys(commonpb.ObjectType_value))
+	if !util.IsObjectType(entity.Name) {
+		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", entity.Name, util.ObjectTypes())
+	}
+	return nil
+}
+
+// isValidFieldObject checks the object name of the field level privilege is like `collection.field`,
+// the field must exist and mustn't be the primary key, which is always returned.
+func (c *Core) isValidFieldObject(ctx context.Context, dbName string, objectName string) error {
+	if util.IsAnyWord(objectName) {
+		return nil
+	}
+	collectionName, fieldName, ok := strings.Cut(objectName, ".")
+	if !ok || collectionName == "" || fieldName == "" {
+		return fmt.Errorf("the object name of the field privilege should be like `collection.field`, current name: %s", objectName)
+	}
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	coll, err := c.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	if util.IsAnyWord(fieldName) {
+		return nil
+	}
+	for _, field := range coll.Fields {
+		if field.Name == fieldName {
+			if field.IsPrimaryKey {
+				return fmt.Errorf("the primary key field[%s] can't be restricted", fieldName)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("not found the field[%s] in the collection[%s]", fieldName, collectionName)
+}
+
+// operateFieldGrants grants or revokes the field level privileges and refreshes the policy cache of proxies,
+// the grants existed or revoked already are skipped, so it could be retried.
+func (c *Core) operateFieldGrants(ctx context.Context, grants []*milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
+	if len(grants) == 0 {
+		return nil
+	}
+	opType := int32(typeutil.CacheGrantPrivilege)
+	if operateType == milvuspb.OperatePrivilegeType_Revoke {
+		opType = int32(typeutil.CacheRevokePrivilege)
+	}
+	for _, grant := range grants {
+		if err := c.meta.OperatePrivilege(util.DefaultTenant, grant, operateType); err != nil && !common.IsIgnorableError(err) {
+			log.Ctx(ctx).Warn("fail to operate the field privilege", zap.Any("grant", grant), zap.Error(err))
+			return err
+		}
+		if err := c.proxyClientManager.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{
+			OpType: opType,
+			OpKey: funcutil.PolicyForPrivilege(grant.GetRole().GetName(), grant.GetObject().GetName(), grant.GetObjectName(),
+				grant.GetGrantor().GetPrivilege().GetName(), grant.GetDbName()),
+		}); err != nil {
+			log.Ctx(ctx).Warn("fail to refresh policy info cache", zap.Any("grant", grant), zap.Error(err))
+			return err
+		}
 	}
 	return nil
 }
@@ -2546,7 +3494,7 @@ func (c *Core) isValidGrantor(entity *milvuspb.GrantorEntity, object string) err
 	}
 	privileges, ok := util.ObjectPrivileges[object]
 	if !ok {
-		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, lo.Keys(commonpb.ObjectType_value))
+		return fmt.Errorf("not found the object type[name: %s], supported the object types: %v", object, util.ObjectTypes())
 	}
 	for _, privilege := range privileges {
 		if privilege == entity.Privilege.Name {
@@ -2596,6 +3544,13 @@ func (c *Core) OperatePrivilege(ctx context.Context, in *milvuspb.OperatePrivile
 		return merr.StatusWithErrorCode(err, commonpb.ErrorCode_OperatePrivilegeFailure), nil
 	}
 
+	if in.Entity.Object.Name == util.FieldObjectType && in.Type == milvuspb.OperatePrivilegeType_Grant {
+		if err := c.isValidFieldObject(ctx, in.Entity.DbName, in.Entity.ObjectName); err != nil {
+			ctxLog.Warn("", zap.Error(err))
+			return merr.StatusWithErrorCode(err, commonpb.ErrorCode_OperatePrivilegeFailure), nil
+		}
+	}
+
 	ctxLog.Debug("before PrivilegeNameForMetastore", zap.String("privilege", in.Entity.Grantor.Privilege.Name))
 	if !util.IsAnyWord(in.Entity.Grantor.Privilege.Name) {
 		in.Entity.Grantor.Privilege.Name = util.PrivilegeNameForMetastore(in.Entity.Grantor.Privilege.Name)
@@ -43,17 +43,20 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tikv"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -147,6 +150,84 @@ func TestRootCoord_DropDatabase(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AlterDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{DbName: "db1", NewDbName: "db2"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DescribeDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
+
 func TestRootCoord_ListDatabases(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -422,6 +503,45 @@ func TestRootCoord_DropAlias(t *testing.T) {
 	})
 }
 
+func TestRootCoord_BatchAlterAliases(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.BatchAlterAliases(ctx, &internalpb.BatchAlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.BatchAlterAliases(ctx, &internalpb.BatchAlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.BatchAlterAliases(ctx, &internalpb.BatchAlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.BatchAlterAliases(ctx, &internalpb.BatchAlterAliasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_AlterAlias(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
@@ -756,105 +876,429 @@ func TestRootCoord_AllocID(t *testing.T) {
 	})
 }
 
-func TestRootCoord_UpdateChannelTimeTick(t *testing.T) {
+func TestRootCoord_UpdateChannelTimeTick(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("invalid msg type", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection}})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("invalid msg", func(t *testing.T) {
+		defer cleanTestEnv()
+
+		ticker := newRocksMqTtSynchronizer()
+
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(),
+			withTtSynchronizer(ticker))
+
+		// the length of channel names & timestamps mismatch.
+		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_TimeTick,
+			},
+			ChannelNames: []string{funcutil.GenRandomStr()},
+			Timestamps:   []uint64{},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+
+		source := int64(20220824)
+		ts := Timestamp(100)
+		defaultTs := Timestamp(101)
+
+		ticker := newRocksMqTtSynchronizer()
+		ticker.addSession(&sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: source}})
+
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(),
+			withTtSynchronizer(ticker))
+
+		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{
+			Base: &commonpb.MsgBase{
+				SourceID: source,
+				MsgType:  commonpb.MsgType_TimeTick,
+			},
+			ChannelNames:     []string{funcutil.GenRandomStr()},
+			Timestamps:       []uint64{ts},
+			DefaultTimestamp: defaultTs,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_InvalidateCollectionMetaCache(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to invalidate cache", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(),
+			withInvalidProxyManager())
+		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode(),
+			withValidProxyManager())
+		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_RenameCollection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_AddCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &internalpb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_CloneCollection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CloneCollection(ctx, &internalpb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.CloneCollection(ctx, &internalpb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.CloneCollection(ctx, &internalpb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.CloneCollection(ctx, &internalpb.CloneCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_UndropCollection(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.UndropCollection(ctx, &internalpb.UndropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropCollection(ctx, &internalpb.UndropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropCollection(ctx, &internalpb.UndropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropCollection(ctx, &internalpb.UndropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_UndropPartition(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.UndropPartition(ctx, &internalpb.UndropPartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropPartition(ctx, &internalpb.UndropPartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropPartition(ctx, &internalpb.UndropPartitionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.UndropPartition(ctx, &internalpb.UndropPartitionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_ListDroppedCollections(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListDroppedCollections(ctx, &internalpb.ListDroppedCollectionsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("list collections failed", func(t *testing.T) {
+		ctx := context.Background()
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return(nil, errors.New("mock")).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListDroppedCollections(ctx, &internalpb.ListDroppedCollectionsRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		paramtable.Get().Save(Params.RootCoordCfg.RecycleBinRetention.Key, "3600")
+		defer paramtable.Get().Reset(Params.RootCoordCfg.RecycleBinRetention.Key)
+
+		ctx := context.Background()
+		dropTime := tsoutil.ComposeTSByTime(time.Now(), 0)
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListCollections(mock.Anything, "db", typeutil.MaxTimestamp, false).Return([]*model.Collection{
+			{CollectionID: 1, Name: "c1", State: etcdpb.CollectionState_CollectionCreated},
+			{CollectionID: 2, Name: "c2", State: etcdpb.CollectionState_CollectionRecycled, DropTime: dropTime},
+			{CollectionID: 3, Name: "c3", State: etcdpb.CollectionState_CollectionDropping},
+		}, nil).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListDroppedCollections(ctx, &internalpb.ListDroppedCollectionsRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetCollections(), 1)
+		assert.Equal(t, int64(2), resp.GetCollections()[0].GetCollectionID())
+		assert.Equal(t, dropTime, resp.GetCollections()[0].GetDropTimestamp())
+		assert.Equal(t, uint64(tsoutil.PhysicalTime(dropTime).Add(time.Hour).UnixMilli()), resp.GetCollections()[0].GetExpireUtcTimestamp())
+	})
+}
+
+func TestRootCoord_CreateSnapshot(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withAbnormalCode())
-		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{})
+		resp, err := c.CreateSnapshot(ctx, &internalpb.CreateSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
-	t.Run("invalid msg type", func(t *testing.T) {
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
 		ctx := context.Background()
-		c := newTestCore(withHealthyCode())
-		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection}})
+		resp, err := c.CreateSnapshot(ctx, &internalpb.CreateSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
-	t.Run("invalid msg", func(t *testing.T) {
-		defer cleanTestEnv()
-
-		ticker := newRocksMqTtSynchronizer()
-
-		ctx := context.Background()
+	t.Run("execute task failed", func(t *testing.T) {
 		c := newTestCore(withHealthyCode(),
-			withTtSynchronizer(ticker))
+			withTaskFailScheduler())
 
-		// the length of channel names & timestamps mismatch.
-		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{
-			Base: &commonpb.MsgBase{
-				MsgType: commonpb.MsgType_TimeTick,
-			},
-			ChannelNames: []string{funcutil.GenRandomStr()},
-			Timestamps:   []uint64{},
-		})
+		ctx := context.Background()
+		resp, err := c.CreateSnapshot(ctx, &internalpb.CreateSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
-	t.Run("normal case", func(t *testing.T) {
-		defer cleanTestEnv()
-
-		source := int64(20220824)
-		ts := Timestamp(100)
-		defaultTs := Timestamp(101)
-
-		ticker := newRocksMqTtSynchronizer()
-		ticker.addSession(&sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: source}})
-
-		ctx := context.Background()
+	t.Run("run ok", func(t *testing.T) {
 		c := newTestCore(withHealthyCode(),
-			withTtSynchronizer(ticker))
+			withValidScheduler())
 
-		resp, err := c.UpdateChannelTimeTick(ctx, &internalpb.ChannelTimeTickMsg{
-			Base: &commonpb.MsgBase{
-				SourceID: source,
-				MsgType:  commonpb.MsgType_TimeTick,
-			},
-			ChannelNames:     []string{funcutil.GenRandomStr()},
-			Timestamps:       []uint64{ts},
-			DefaultTimestamp: defaultTs,
-		})
+		ctx := context.Background()
+		resp, err := c.CreateSnapshot(ctx, &internalpb.CreateSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 }
 
-func TestRootCoord_InvalidateCollectionMetaCache(t *testing.T) {
+func TestRootCoord_DropSnapshot(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withAbnormalCode())
-		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+		resp, err := c.DropSnapshot(ctx, &internalpb.DropSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
-	t.Run("failed to invalidate cache", func(t *testing.T) {
-		ctx := context.Background()
+	t.Run("add task failed", func(t *testing.T) {
 		c := newTestCore(withHealthyCode(),
-			withInvalidProxyManager())
-		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropSnapshot(ctx, &internalpb.DropSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 
-	t.Run("normal case", func(t *testing.T) {
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
 		ctx := context.Background()
+		resp, err := c.DropSnapshot(ctx, &internalpb.DropSnapshotRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
 		c := newTestCore(withHealthyCode(),
-			withValidProxyManager())
-		resp, err := c.InvalidateCollectionMetaCache(ctx, &proxypb.InvalidateCollMetaCacheRequest{})
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropSnapshot(ctx, &internalpb.DropSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 }
 
-func TestRootCoord_RenameCollection(t *testing.T) {
+func TestRootCoord_RestoreSnapshot(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
 		c := newTestCore(withAbnormalCode())
-		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		resp, err := c.RestoreSnapshot(ctx, &internalpb.RestoreSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
@@ -864,7 +1308,7 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 			withInvalidScheduler())
 
 		ctx := context.Background()
-		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		resp, err := c.RestoreSnapshot(ctx, &internalpb.RestoreSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
@@ -874,7 +1318,7 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 			withTaskFailScheduler())
 
 		ctx := context.Background()
-		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		resp, err := c.RestoreSnapshot(ctx, &internalpb.RestoreSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
@@ -884,12 +1328,130 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 			withValidScheduler())
 
 		ctx := context.Background()
-		resp, err := c.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		resp, err := c.RestoreSnapshot(ctx, &internalpb.RestoreSnapshotRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
 	})
 }
 
+func TestRootCoord_ListSnapshots(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("database not found", func(t *testing.T) {
+		ctx := context.Background()
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", typeutil.MaxTimestamp).Return(nil, errors.New("mock")).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		ctx := context.Background()
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", typeutil.MaxTimestamp).Return(model.NewDatabase(1, "db", etcdpb.DatabaseState_DatabaseCreated), nil).Once()
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "coll", typeutil.MaxTimestamp).Return(nil, errors.New("mock")).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{DbName: "db", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("list snapshots failed", func(t *testing.T) {
+		ctx := context.Background()
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", typeutil.MaxTimestamp).Return(model.NewDatabase(1, "db", etcdpb.DatabaseState_DatabaseCreated), nil).Once()
+		meta.EXPECT().ListCollectionSnapshots(mock.Anything, int64(1), int64(0)).Return(nil, errors.New("mock")).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{DbName: "db"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", typeutil.MaxTimestamp).Return(model.NewDatabase(1, "db", etcdpb.DatabaseState_DatabaseCreated), nil).Once()
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db", "coll", typeutil.MaxTimestamp).Return(&model.Collection{CollectionID: 100, Name: "coll"}, nil).Once()
+		meta.EXPECT().ListCollectionSnapshots(mock.Anything, int64(1), int64(100)).Return([]*model.CollectionSnapshot{
+			{ID: 10, Name: "s1", DBID: 1, Collection: &model.Collection{CollectionID: 100, Name: "coll"}, CreateTime: 1000},
+		}, nil).Once()
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		resp, err := c.ListSnapshots(ctx, &internalpb.ListSnapshotsRequest{DbName: "db", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetSnapshots(), 1)
+		assert.Equal(t, "s1", resp.GetSnapshots()[0].GetName())
+		assert.Equal(t, "coll", resp.GetSnapshots()[0].GetCollectionName())
+		assert.Equal(t, uint64(1000), resp.GetSnapshots()[0].GetCreateTimestamp())
+	})
+}
+
+func TestRootCoord_GetDDLJob(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		resp, err := c.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		c.ddlJobs.newJob(100, "CreateCollection", util.DefaultDBName, "coll", "")
+		resp, err := c.GetDDLJob(ctx, &internalpb.GetDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, int64(100), resp.GetJob().GetJobID())
+		assert.Equal(t, "coll", resp.GetJob().GetCollectionName())
+		assert.Equal(t, internalpb.DDLJobState_DDLJobPending, resp.GetJob().GetState())
+	})
+}
+
+func TestRootCoord_ListDDLJobs(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withHealthyCode())
+		c.ddlJobs.newJob(100, "CreateCollection", util.DefaultDBName, "coll", "")
+		c.ddlJobs.newJob(101, "CreateCollection", util.DefaultDBName, "coll2", "")
+		c.ddlJobs.newJob(102, "CreateCollection", "db", "coll", "")
+		resp, err := c.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetJobs(), 2)
+
+		resp, err = c.ListDDLJobs(ctx, &internalpb.ListDDLJobsRequest{DbName: "db", CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Len(t, resp.GetJobs(), 1)
+		assert.Equal(t, int64(102), resp.GetJobs()[0].GetJobID())
+	})
+}
+
 func TestRootCoord_ShowConfigurations(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
@@ -1584,6 +2146,48 @@ func TestCore_Rbac(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
 	}
 
+	{
+		resp, err := c.CreateAPIKey(ctx, &internalpb.APIKeyInfo{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.ListAPIKeys(ctx, &internalpb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	}
+
+	{
+		resp, err := c.RevokeAPIKey(ctx, &internalpb.RevokeAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	}
+
+	{
+		resp, err := c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.RevokeRowPolicy(ctx, &internalpb.RevokeRowPolicyRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	}
+
+	{
+		resp, err := c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	}
+
 	{
 		resp, err := c.CreateRole(ctx, &milvuspb.CreateRoleRequest{})
 		assert.NoError(t, err)
@@ -1633,6 +2237,78 @@ func TestCore_Rbac(t *testing.T) {
 	}
 }
 
+func TestCore_RowPolicy(t *testing.T) {
+	ctx := context.Background()
+	policy := &internalpb.RowPolicy{RoleName: "r1", DbName: "default", CollectionID: 1, Expr: "a > 1"}
+
+	t.Run("grant", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta), withStepExecutor(newMockStepExecutor()))
+
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, errors.New("mock")).Once()
+		resp, err := c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, nil)
+		meta.EXPECT().GetCollectionByID(mock.Anything, "default", int64(1), typeutil.MaxTimestamp, false).
+			Return(nil, merr.WrapErrCollectionNotFound(1)).Once()
+		resp, err = c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrCollectionNotFound)
+
+		meta.EXPECT().GetCollectionByID(mock.Anything, "default", int64(1), typeutil.MaxTimestamp, false).
+			Return(&model.Collection{CollectionID: 1}, nil)
+		meta.EXPECT().AddRowPolicy(policy).Return(errors.New("mock")).Once()
+		resp, err = c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		meta.EXPECT().AddRowPolicy(policy).Return(nil).Once()
+		resp, err = c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{Policy: policy})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta), withStepExecutor(newMockStepExecutor()))
+
+		meta.EXPECT().DeleteRowPolicy("r1", int64(1)).Return(merr.WrapErrParameterInvalidMsg("not found")).Once()
+		resp, err := c.RevokeRowPolicy(ctx, &internalpb.RevokeRowPolicyRequest{RoleName: "r1", DbName: "default", CollectionID: 1})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		meta.EXPECT().DeleteRowPolicy("r1", int64(1)).Return(nil).Once()
+		resp, err = c.RevokeRowPolicy(ctx, &internalpb.RevokeRowPolicyRequest{RoleName: "r1", DbName: "default", CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		meta.EXPECT().ListRowPolicies("", int64(1)).Return([]*internalpb.RowPolicy{policy}, nil).Once()
+		resp, err := c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{CollectionID: 1})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetPolicies(), 1)
+	})
+
+	t.Run("drop role with row policies", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, nil).Once()
+		meta.EXPECT().SelectGrant(util.DefaultTenant, mock.Anything).Return(nil, nil).Once()
+		meta.EXPECT().ListRowPolicies("r1", int64(0)).Return([]*internalpb.RowPolicy{policy}, nil).Once()
+		resp, err := c.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: "r1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_DropRoleFailure, resp.GetErrorCode())
+	})
+}
+
 func TestCore_sendMinDdlTsAsTt(t *testing.T) {
 	ticker := newRocksMqTtSynchronizer()
 	ddlManager := newMockDdlTsLockManager()
@@ -1878,6 +2554,102 @@ func TestRootCoord_CheckHealth(t *testing.T) {
 	})
 }
 
+func TestRootCoord_PasswordPolicy(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	t.Run("password history", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.PasswordHistorySize.Key, "3")
+		defer paramtable.Get().Reset(Params.CommonCfg.PasswordHistorySize.Key)
+
+		stored := &internalpb.CredentialInfo{Username: "foo", EncryptedPassword: "pwd2", PasswordHistory: []string{"pwd1", "pwd0"}}
+		meta := newMockMetaTable()
+		meta.GetCredentialFunc = func(username string) (*internalpb.CredentialInfo, error) {
+			return stored, nil
+		}
+		meta.AlterCredentialFunc = func(credInfo *internalpb.CredentialInfo) error {
+			stored = credInfo
+			return nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.proxyClientManager = &proxyClientManager{proxyClient: make(map[UniqueID]types.ProxyClient)}
+
+		resp, err := c.UpdateCredential(ctx, &internalpb.CredentialInfo{Username: "foo", EncryptedPassword: "pwd3"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, "pwd3", stored.EncryptedPassword)
+		assert.Equal(t, []string{"pwd2", "pwd1"}, stored.PasswordHistory)
+		assert.NotZero(t, stored.PasswordUpdateTime)
+	})
+
+	t.Run("login lockout", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.LoginMaxFailedAttempts.Key, "2")
+		defer paramtable.Get().Reset(Params.CommonCfg.LoginMaxFailedAttempts.Key)
+
+		meta := newMockMetaTable()
+		meta.GetCredentialFunc = func(username string) (*internalpb.CredentialInfo, error) {
+			return &internalpb.CredentialInfo{Username: username, EncryptedPassword: "pwd"}, nil
+		}
+		meta.AlterCredentialFunc = func(credInfo *internalpb.CredentialInfo) error {
+			return nil
+		}
+		expired := false
+		p := newMockProxy()
+		p.InvalidateCredentialCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+			expired = request.GetUsername() == "foo"
+			return merr.Success(), nil
+		}
+		p.UpdateCredentialCacheFunc = func(ctx context.Context, request *proxypb.UpdateCredCacheRequest) (*commonpb.Status, error) {
+			return merr.Success(), nil
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		c.proxyClientManager = &proxyClientManager{proxyClient: map[UniqueID]types.ProxyClient{TestProxyID: p}}
+
+		resp, err := c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Zero(t, resp.GetLockedUntil())
+		assert.False(t, expired)
+
+		resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.NotZero(t, resp.GetLockedUntil())
+		assert.True(t, expired)
+
+		credResp, err := c.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(credResp, err))
+		assert.Equal(t, resp.GetLockedUntil(), credResp.GetLockedUntil())
+
+		// the lockout is cleared once the password is updated
+		status, err := c.UpdateCredential(ctx, &internalpb.CredentialInfo{Username: "foo", EncryptedPassword: "pwd1"})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+		credResp, err = c.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(credResp, err))
+		assert.Zero(t, credResp.GetLockedUntil())
+
+		// the successful login clears the failures
+		resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: "foo", Succeeded: true})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: "foo"})
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Zero(t, resp.GetLockedUntil())
+
+		// root isn't locked out unless it's configured
+		for i := 0; i < 2; i++ {
+			resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: util.UserRoot})
+			assert.NoError(t, merr.CheckRPCCall(resp, err))
+			assert.Zero(t, resp.GetLockedUntil())
+		}
+		paramtable.Get().Save(Params.CommonCfg.LoginLockoutExemptRoot.Key, "false")
+		defer paramtable.Get().Reset(Params.CommonCfg.LoginLockoutExemptRoot.Key)
+		for i := 0; i < 2; i++ {
+			resp, err = c.ReportLoginFailure(ctx, &internalpb.ReportLoginFailureRequest{Username: util.UserRoot})
+			assert.NoError(t, merr.CheckRPCCall(resp, err))
+		}
+		assert.NotZero(t, resp.GetLockedUntil())
+	})
+}
+
 func TestRootCoord_RBACError(t *testing.T) {
 	ctx := context.Background()
 	c := newTestCore(withHealthyCode(), withInvalidMeta())
@@ -1906,6 +2678,33 @@ func TestRootCoord_RBACError(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	})
+	t.Run("create api key failed", func(t *testing.T) {
+		resp, err := c.CreateAPIKey(ctx, &internalpb.APIKeyInfo{Id: "foo", Username: "foo", Sha256Key: "bar"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+	t.Run("list api keys failed", func(t *testing.T) {
+		resp, err := c.ListAPIKeys(ctx, &internalpb.ListAPIKeysRequest{Username: "foo"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+	t.Run("revoke api key failed", func(t *testing.T) {
+		resp, err := c.RevokeAPIKey(ctx, &internalpb.RevokeAPIKeyRequest{Username: "foo", Id: "foo"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+	t.Run("grant row policy failed", func(t *testing.T) {
+		resp, err := c.GrantRowPolicy(ctx, &internalpb.GrantRowPolicyRequest{
+			Policy: &internalpb.RowPolicy{RoleName: "foo", CollectionID: 1, Expr: "a > 1"},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+	t.Run("list row policies failed", func(t *testing.T) {
+		resp, err := c.ListRowPolicies(ctx, &internalpb.ListRowPoliciesRequest{RoleName: "foo"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
 	t.Run("create role failed", func(t *testing.T) {
 		resp, err := c.CreateRole(ctx, &milvuspb.CreateRoleRequest{Entity: &milvuspb.RoleEntity{Name: "foo"}})
 		assert.NoError(t, err)
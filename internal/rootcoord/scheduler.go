@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type IScheduler interface {
@@ -36,6 +37,9 @@ type IScheduler interface {
 	GetMinDdlTs() Timestamp
 }
 
+// scheduler assigns the ts to the ddl tasks in order and executes the tasks of independent objects concurrently,
+// the tasks of the same object are still executed in the order of ts. The min ddl ts, along with the one of
+// ddlTsLockManager, bounds the time tick of rootcoord.
 type scheduler struct {
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -44,14 +48,23 @@ type scheduler struct {
 	idAllocator  allocator.Interface
 	tsoAllocator tso.Allocator
 
-	taskChan chan task
+	taskChan    chan task
+	lockManager *ddlLockManager
+	// meta resolves the aliases of the collections locked by the tasks.
+	meta IMetaTable
 
 	lock sync.Mutex
 
+	// the ts of the unfinished tasks in the order of ts, minDdlTs is the ts of the last task before which all tasks
+	// are finished, as the tasks of different objects may finish out of order.
+	tsLock     sync.Mutex
+	pendingTs  []Timestamp
+	finishedTs map[Timestamp]int
+
 	minDdlTs atomic.Uint64
 }
 
-func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAllocator tso.Allocator) *scheduler {
+func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAllocator tso.Allocator, meta IMetaTable) *scheduler {
 	ctx1, cancel := context.WithCancel(ctx)
 	// TODO
 	n := 1024 * 10
@@ -61,6 +74,9 @@ func newScheduler(ctx context.Context, idAllocator allocator.Interface, tsoAlloc
 		idAllocator:  idAllocator,
 		tsoAllocator: tsoAllocator,
 		taskChan:     make(chan task, n),
+		lockManager:  newDDLLockManager(),
+		meta:         meta,
+		finishedTs:   make(map[Timestamp]int),
 		minDdlTs:     *atomic.NewUint64(0),
 	}
 }
@@ -79,13 +95,17 @@ func (s *scheduler) Stop() {
 }
 
 func (s *scheduler) execute(task task) {
-	defer s.setMinDdlTs(task.GetTs()) // we should update ts, whatever task succeeds or not.
+	defer s.finishTs(task.GetTs()) // we should update ts, whatever task succeeds or not.
 	task.SetInQueueDuration()
+	job := getDDLJob(task.GetCtx())
+	job.start()
 	if err := task.Prepare(task.GetCtx()); err != nil {
+		job.taskDone(err)
 		task.NotifyDone(err)
 		return
 	}
 	err := task.Execute(task.GetCtx())
+	job.taskDone(err)
 	task.NotifyDone(err)
 }
 
@@ -96,11 +116,59 @@ func (s *scheduler) taskLoop() {
 		case <-s.ctx.Done():
 			return
 		case task := <-s.taskChan:
-			s.execute(task)
+			// the locks are requested in the order of ts, the task executes once the tasks before it on the
+			// same objects finish, and the tasks on the other objects needn't wait.
+			req := s.lockManager.Acquire(s.resolveAliasLocks(task.GetLocks()))
+			s.wg.Add(1)
+			go s.executeWithLock(task, req)
 		}
 	}
 }
 
+func (s *scheduler) executeWithLock(task task, req *ddlLockRequest) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.ctx.Done():
+			s.lockManager.Release(req)
+			return
+		case <-req.Ready():
+		}
+		// the alias may be altered by the tasks before it, the aliases can't change any more once the locks of
+		// them are granted, so the locks of the collections referred by them are requested again if they changed.
+		locks := s.resolveAliasLocks(task.GetLocks())
+		if equalDDLLocks(normalizeDDLLocks(locks), req.locks) {
+			break
+		}
+		s.lockManager.Release(req)
+		req = s.lockManager.Acquire(locks)
+	}
+	defer s.lockManager.Release(req)
+	s.execute(task)
+}
+
+// resolveAliasLocks adds the locks of the collections referred by the aliases in the locks, so that the tasks
+// requesting a collection by its alias and by its name exclude each other.
+func (s *scheduler) resolveAliasLocks(locks []ddlLock) []ddlLock {
+	if s.meta == nil {
+		return locks
+	}
+	ret := make([]ddlLock, 0, len(locks))
+	for _, l := range locks {
+		ret = append(ret, l)
+		if l.key.level != ddlLockCollection || !s.meta.IsAlias(l.key.dbName, l.key.name) {
+			continue
+		}
+		coll, err := s.meta.GetCollectionByName(s.ctx, l.key.dbName, l.key.name, typeutil.MaxTimestamp)
+		if err != nil {
+			// the alias is dropped meanwhile, the task fails by itself then.
+			continue
+		}
+		ret = append(ret, newCollectionLock(l.key.dbName, coll.Name, l.exclusive))
+	}
+	return ret
+}
+
 // syncTsLoop send a base task into queue periodically, the base task will gain the latest ts which is bigger than
 // everyone in the queue. The scheduler will update the ts after the task is finished.
 func (s *scheduler) syncTsLoop() {
@@ -157,6 +225,7 @@ func (s *scheduler) AddTask(task task) error {
 	if err := s.setTs(task); err != nil {
 		return err
 	}
+	s.addPendingTs(task.GetTs())
 	s.enqueue(task)
 	return nil
 }
@@ -168,3 +237,27 @@ func (s *scheduler) GetMinDdlTs() Timestamp {
 func (s *scheduler) setMinDdlTs(ts Timestamp) {
 	s.minDdlTs.Store(ts)
 }
+
+func (s *scheduler) addPendingTs(ts Timestamp) {
+	s.tsLock.Lock()
+	defer s.tsLock.Unlock()
+	s.pendingTs = append(s.pendingTs, ts)
+}
+
+func (s *scheduler) finishTs(ts Timestamp) {
+	s.tsLock.Lock()
+	defer s.tsLock.Unlock()
+	s.finishedTs[ts]++
+	for len(s.pendingTs) > 0 {
+		first := s.pendingTs[0]
+		if s.finishedTs[first] == 0 {
+			return
+		}
+		s.finishedTs[first]--
+		if s.finishedTs[first] == 0 {
+			delete(s.finishedTs, first)
+		}
+		s.pendingTs = s.pendingTs[1:]
+		s.setMinDdlTs(first)
+	}
+}
@@ -25,8 +25,11 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"go.uber.org/atomic"
 
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -80,7 +83,7 @@ func Test_scheduler_Start_Stop(t *testing.T) {
 	idAlloc := newMockIDAllocator()
 	tsoAlloc := newMockTsoAllocator()
 	ctx := context.Background()
-	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 	s.Start()
 	s.Stop()
 }
@@ -92,7 +95,7 @@ func Test_scheduler_failed_to_set_id(t *testing.T) {
 		return 0, errors.New("error mock AllocOne")
 	}
 	ctx := context.Background()
-	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 	s.Start()
 	time.Sleep(time.Second)
 	defer s.Stop()
@@ -111,7 +114,7 @@ func Test_scheduler_failed_to_set_ts(t *testing.T) {
 		return 0, errors.New("error mock GenerateTSO")
 	}
 	ctx := context.Background()
-	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 	s.Start()
 	time.Sleep(time.Second)
 	defer s.Stop()
@@ -130,7 +133,7 @@ func Test_scheduler_enqueue_normal_case(t *testing.T) {
 		return 101, nil
 	}
 	ctx := context.Background()
-	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 	s.Start()
 	defer s.Stop()
 	task := newMockNormalTask()
@@ -150,7 +153,7 @@ func Test_scheduler_bg(t *testing.T) {
 		return 101, nil
 	}
 	ctx := context.Background()
-	s := newScheduler(ctx, idAlloc, tsoAlloc)
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 	s.Start()
 
 	n := 10
@@ -197,7 +200,7 @@ func Test_scheduler_updateDdlMinTsLoop(t *testing.T) {
 			return got, nil
 		}
 		ctx := context.Background()
-		s := newScheduler(ctx, idAlloc, tsoAlloc)
+		s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 		paramtable.Init()
 		paramtable.Get().Save(Params.ProxyCfg.TimeTickInterval.Key, "1")
 		s.Start()
@@ -232,7 +235,7 @@ func Test_scheduler_updateDdlMinTsLoop(t *testing.T) {
 			return 0, fmt.Errorf("error mock GenerateTSO")
 		}
 		ctx := context.Background()
-		s := newScheduler(ctx, idAlloc, tsoAlloc)
+		s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
 		paramtable.Init()
 		paramtable.Get().Save(Params.ProxyCfg.TimeTickInterval.Key, "1")
 		s.Start()
@@ -242,3 +245,183 @@ func Test_scheduler_updateDdlMinTsLoop(t *testing.T) {
 		s.Stop()
 	})
 }
+
+type mockLockTask struct {
+	baseTask
+	locks       []ddlLock
+	executeFunc func() error
+}
+
+func newMockLockTask(executeFunc func() error, locks ...ddlLock) *mockLockTask {
+	task := &mockLockTask{
+		baseTask:    newBaseTask(context.Background(), nil),
+		locks:       locks,
+		executeFunc: executeFunc,
+	}
+	task.SetCtx(context.Background())
+	return task
+}
+
+func (m *mockLockTask) GetLocks() []ddlLock {
+	return m.locks
+}
+
+func (m *mockLockTask) Execute(context.Context) error {
+	return m.executeFunc()
+}
+
+func Test_scheduler_concurrent_tasks(t *testing.T) {
+	idAlloc := newMockIDAllocator()
+	tsoAlloc := newMockTsoAllocator()
+	tso := atomic.NewUint64(100)
+	idAlloc.AllocOneF = func() (UniqueID, error) {
+		return 100, nil
+	}
+	tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+		return tso.Inc(), nil
+	}
+	ctx := context.Background()
+	s := newScheduler(ctx, idAlloc, tsoAlloc, nil)
+	s.Start()
+	defer s.Stop()
+
+	block := make(chan struct{})
+	slow := newMockLockTask(func() error {
+		<-block
+		return nil
+	}, newCollectionLock("db1", "coll", true))
+	executed := atomic.NewBool(false)
+	sameColl := newMockLockTask(func() error {
+		executed.Store(true)
+		return nil
+	}, newCollectionLock("db1", "coll", false))
+	otherDB := newMockLockTask(func() error {
+		return nil
+	}, newCollectionLock("db2", "coll", true))
+
+	assert.NoError(t, s.AddTask(slow))
+	assert.NoError(t, s.AddTask(sameColl))
+	assert.NoError(t, s.AddTask(otherDB))
+
+	// the task of the other database isn't blocked by the slow task.
+	assert.NoError(t, otherDB.WaitToFinish())
+	assert.False(t, executed.Load())
+	// the ddl ts doesn't pass the unfinished task.
+	assert.Less(t, s.GetMinDdlTs(), slow.GetTs())
+
+	close(block)
+	assert.NoError(t, slow.WaitToFinish())
+	assert.NoError(t, sameColl.WaitToFinish())
+	assert.True(t, executed.Load())
+	assert.Eventually(t, func() bool {
+		return s.GetMinDdlTs() >= otherDB.GetTs()
+	}, time.Second, time.Millisecond)
+}
+
+func Test_scheduler_alias_tasks(t *testing.T) {
+	newAliasScheduler := func(t *testing.T, target *atomic.String) *scheduler {
+		idAlloc := newMockIDAllocator()
+		tsoAlloc := newMockTsoAllocator()
+		tso := atomic.NewUint64(100)
+		idAlloc.AllocOneF = func() (UniqueID, error) {
+			return 100, nil
+		}
+		tsoAlloc.GenerateTSOF = func(count uint32) (uint64, error) {
+			return tso.Inc(), nil
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().IsAlias(mock.Anything, mock.Anything).RunAndReturn(func(dbName string, name string) bool {
+			return name == "alias"
+		}).Maybe()
+		meta.EXPECT().GetCollectionByName(mock.Anything, "db1", "alias", mock.Anything).RunAndReturn(
+			func(ctx context.Context, dbName string, name string, ts uint64) (*model.Collection, error) {
+				return &model.Collection{Name: target.Load()}, nil
+			}).Maybe()
+		return newScheduler(context.Background(), idAlloc, tsoAlloc, meta)
+	}
+
+	t.Run("alias and name", func(t *testing.T) {
+		s := newAliasScheduler(t, atomic.NewString("coll"))
+		s.Start()
+		defer s.Stop()
+
+		block := make(chan struct{})
+		byAlias := newMockLockTask(func() error {
+			<-block
+			return nil
+		}, newCollectionLock("db1", "alias", true))
+		executed := atomic.NewBool(false)
+		byName := newMockLockTask(func() error {
+			executed.Store(true)
+			return nil
+		}, newCollectionLock("db1", "coll", true))
+		other := newMockLockTask(func() error {
+			return nil
+		}, newCollectionLock("db1", "other", true))
+
+		assert.NoError(t, s.AddTask(byAlias))
+		assert.NoError(t, s.AddTask(byName))
+		assert.NoError(t, s.AddTask(other))
+
+		// the task of the collection waits for the task of its alias.
+		assert.NoError(t, other.WaitToFinish())
+		assert.False(t, executed.Load())
+
+		close(block)
+		assert.NoError(t, byAlias.WaitToFinish())
+		assert.NoError(t, byName.WaitToFinish())
+		assert.True(t, executed.Load())
+	})
+
+	t.Run("alias altered", func(t *testing.T) {
+		target := atomic.NewString("coll1")
+		s := newAliasScheduler(t, target)
+		s.Start()
+		defer s.Stop()
+
+		block := make(chan struct{})
+		slow := newMockLockTask(func() error {
+			<-block
+			return nil
+		}, newCollectionLock("db1", "coll2", true))
+		alter := newMockLockTask(func() error {
+			target.Store("coll2")
+			return nil
+		}, newCollectionLock("db1", "alias", true))
+		executed := atomic.NewBool(false)
+		byAlias := newMockLockTask(func() error {
+			executed.Store(true)
+			return nil
+		}, newCollectionLock("db1", "alias", false))
+
+		assert.NoError(t, s.AddTask(slow))
+		assert.NoError(t, s.AddTask(alter))
+		assert.NoError(t, s.AddTask(byAlias))
+
+		// the task requested the alias of coll1, it waits for coll2 once the alias is altered.
+		assert.NoError(t, alter.WaitToFinish())
+		time.Sleep(10 * time.Millisecond)
+		assert.False(t, executed.Load())
+
+		close(block)
+		assert.NoError(t, slow.WaitToFinish())
+		assert.NoError(t, byAlias.WaitToFinish())
+		assert.True(t, executed.Load())
+	})
+}
+
+func Test_scheduler_finishTs(t *testing.T) {
+	s := newScheduler(context.Background(), newMockIDAllocator(), newMockTsoAllocator(), nil)
+	s.addPendingTs(101)
+	s.addPendingTs(102)
+	s.addPendingTs(103)
+
+	s.finishTs(102)
+	assert.Zero(t, s.GetMinDdlTs())
+	s.finishTs(101)
+	assert.Equal(t, Timestamp(102), s.GetMinDdlTs())
+	s.finishTs(103)
+	assert.Equal(t, Timestamp(103), s.GetMinDdlTs())
+	assert.Empty(t, s.pendingTs)
+	assert.Empty(t, s.finishedTs)
+}
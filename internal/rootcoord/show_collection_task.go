@@ -33,6 +33,10 @@ type showCollectionTask struct {
 	Rsp *milvuspb.ShowCollectionsResponse
 }
 
+func (t *showCollectionTask) GetLocks() []ddlLock {
+	return []ddlLock{newDatabaseLock(t.Req.GetDbName(), false)}
+}
+
 func (t *showCollectionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.Base.MsgType, commonpb.MsgType_ShowCollections); err != nil {
 		return err
@@ -35,6 +35,10 @@ type showPartitionTask struct {
 	allowUnavailable bool
 }
 
+func (t *showPartitionTask) GetLocks() []ddlLock {
+	return newCollectionLocks(t.Req.GetDbName(), t.Req.GetCollectionName(), false)
+}
+
 func (t *showPartitionTask) Prepare(ctx context.Context) error {
 	if err := CheckMsgType(t.Req.Base.MsgType, commonpb.MsgType_ShowPartitions); err != nil {
 		return err
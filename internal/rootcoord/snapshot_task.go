@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// createSnapshotTask records the schema and partitions of the collection in rootcoord, and the flushed segments
+// and indexes of it in datacoord. The data of the snapshot isn't copied, the binlogs and index files it refers to
+// are kept until the snapshot is dropped.
+type createSnapshotTask struct {
+	baseTask
+	Req      *internalpb.CreateSnapshotRequest
+	dbID     UniqueID
+	collMeta *model.Collection
+}
+
+func (t *createSnapshotTask) GetLocks() []ddlLock {
+	return []ddlLock{
+		newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), false),
+		newSnapshotLock(t.Req.GetDbName(), t.Req.GetSnapshotName(), true),
+	}
+}
+
+func (t *createSnapshotTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" || t.Req.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("create snapshot failed, collection name or snapshot name is empty")
+	}
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.dbID = db.ID
+	if _, err := t.core.meta.GetCollectionSnapshot(ctx, t.dbID, t.Req.GetSnapshotName()); err == nil {
+		return merr.WrapErrParameterInvalidMsg("create snapshot failed, snapshot %s already exists", t.Req.GetSnapshotName())
+	}
+	t.collMeta, err = t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	return err
+}
+
+func (t *createSnapshotTask) Execute(ctx context.Context) error {
+	snapshotID, err := t.core.idAllocator.AllocOne()
+	if err != nil {
+		return err
+	}
+	ts := t.GetTs()
+
+	coll := t.collMeta.Clone()
+	partitions := make([]*model.Partition, 0, len(coll.Partitions))
+	for _, partition := range coll.Partitions {
+		if partition.Available() {
+			partitions = append(partitions, partition)
+		}
+	}
+	coll.Partitions = partitions
+	rowPolicies, fieldGrants, err := listCollectionPolicies(t.core, t.Req.GetDbName(), t.collMeta)
+	if err != nil {
+		return err
+	}
+	snapshot := &model.CollectionSnapshot{
+		ID:          snapshotID,
+		Name:        t.Req.GetSnapshotName(),
+		Description: t.Req.GetDescription(),
+		DBID:        t.dbID,
+		Collection:  coll,
+		CreateTime:  ts,
+		RowPolicies: rowPolicies,
+		FieldGrants: fieldGrants,
+	}
+
+	log.Ctx(ctx).Info("create snapshot", zap.String("snapshot", snapshot.Name), zap.Int64("snapshotID", snapshotID),
+		zap.String("collection", coll.Name), zap.Int64("collectionID", coll.CollectionID), zap.Uint64("ts", ts))
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&createSnapshotDataStep{
+		baseStep: baseStep{core: t.core},
+		req: &datapb.CreateSnapshotRequest{
+			SnapshotID:   snapshotID,
+			CollectionID: coll.CollectionID,
+			Timestamp:    ts,
+		},
+	}, &dropSnapshotDataStep{
+		baseStep:   baseStep{core: t.core},
+		snapshotID: snapshotID,
+	})
+	undoTask.AddStep(&addSnapshotMetaStep{
+		baseStep: baseStep{core: t.core},
+		snapshot: snapshot,
+	}, &nullStep{})
+
+	return undoTask.Execute(ctx)
+}
+
+// dropSnapshotTask removes the snapshot, the binlogs and index files only referred by it are garbage collected
+// by datacoord later.
+type dropSnapshotTask struct {
+	baseTask
+	Req      *internalpb.DropSnapshotRequest
+	snapshot *model.CollectionSnapshot
+}
+
+func (t *dropSnapshotTask) GetLocks() []ddlLock {
+	return []ddlLock{newSnapshotLock(t.Req.GetDbName(), t.Req.GetSnapshotName(), true)}
+}
+
+func (t *dropSnapshotTask) Prepare(ctx context.Context) error {
+	if t.Req.GetSnapshotName() == "" {
+		return merr.WrapErrParameterInvalidMsg("drop snapshot failed, snapshot name is empty")
+	}
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	t.snapshot, err = t.core.meta.GetCollectionSnapshot(ctx, db.ID, t.Req.GetSnapshotName())
+	return err
+}
+
+func (t *dropSnapshotTask) Execute(ctx context.Context) error {
+	log.Ctx(ctx).Info("drop snapshot", zap.String("snapshot", t.snapshot.Name), zap.Int64("snapshotID", t.snapshot.ID))
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&deleteSnapshotMetaStep{
+		baseStep:   baseStep{core: t.core},
+		dbID:       t.snapshot.DBID,
+		snapshotID: t.snapshot.ID,
+	})
+	redoTask.AddAsyncStep(&dropSnapshotDataStep{
+		baseStep:   baseStep{core: t.core},
+		snapshotID: t.snapshot.ID,
+	})
+
+	return redoTask.Execute(ctx)
+}
+
+// restoreSnapshotTask creates a new collection from the snapshot, the segments of it refer to the binlogs and
+// index files recorded by the snapshot instead of copying them.
+type restoreSnapshotTask struct {
+	cloneCollectionTask
+	restoreReq *internalpb.RestoreSnapshotRequest
+}
+
+func (t *restoreSnapshotTask) GetLocks() []ddlLock {
+	return []ddlLock{
+		newSnapshotLock(t.restoreReq.GetDbName(), t.restoreReq.GetSnapshotName(), false),
+		newCollectionLock(t.restoreReq.GetDbName(), t.restoreReq.GetNewCollectionName(), true),
+	}
+}
+
+func (t *restoreSnapshotTask) Prepare(ctx context.Context) error {
+	if t.restoreReq.GetSnapshotName() == "" || t.restoreReq.GetNewCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("restore snapshot failed, snapshot name or collection name is empty")
+	}
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.restoreReq.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	snapshot, err := t.core.meta.GetCollectionSnapshot(ctx, db.ID, t.restoreReq.GetSnapshotName())
+	if err != nil {
+		return err
+	}
+
+	t.snapshotID = snapshot.ID
+	t.source = snapshot.Collection.Clone()
+	t.cloneReq = &internalpb.CloneCollectionRequest{
+		Base:              t.restoreReq.GetBase(),
+		DbName:            t.restoreReq.GetDbName(),
+		CollectionName:    snapshot.Collection.Name,
+		NewCollectionName: t.restoreReq.GetNewCollectionName(),
+		Timestamp:         snapshot.CreateTime,
+	}
+	if err := t.prepareClone(ctx); err != nil {
+		return err
+	}
+	// the policies on the collection when the snapshot is created are carried, rather than the current ones
+	t.inheritPolicies(snapshot.RowPolicies, snapshot.FieldGrants)
+	return nil
+}
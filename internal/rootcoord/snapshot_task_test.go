@@ -0,0 +1,353 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newTestCollectionSnapshot() *model.CollectionSnapshot {
+	return &model.CollectionSnapshot{
+		ID:         1000,
+		Name:       "snap",
+		DBID:       util.DefaultDBID,
+		Collection: newTestCloneSource(),
+		CreateTime: 50,
+		RowPolicies: []*model.SnapshotRowPolicy{
+			{RoleName: "role1", Expr: "pk > 10"},
+		},
+		FieldGrants: []*model.SnapshotFieldGrant{
+			{RoleName: "role1", FieldName: "vec", Privilege: util.PrivilegeReadField},
+		},
+	}
+}
+
+// expectSourcePolicies mocks the row policies and the field grants on the source collection.
+func expectSourcePolicies(meta *mockrootcoord.IMetaTable) {
+	meta.EXPECT().ListRowPolicies("", int64(1)).Return([]*internalpb.RowPolicy{
+		{RoleName: "role1", DbName: util.DefaultDBName, CollectionName: "src", CollectionID: 1, Expr: "pk > 10"},
+	}, nil)
+	meta.EXPECT().ListPolicy(util.DefaultTenant).Return([]string{
+		funcutil.PolicyForPrivilege("role1", util.FieldObjectType, "src.vec", util.PrivilegeReadField, util.DefaultDBName),
+		funcutil.PolicyForPrivilege("role1", util.FieldObjectType, "src2.vec", util.PrivilegeReadField, util.DefaultDBName),
+	}, nil)
+}
+
+func Test_createSnapshotTask_Prepare(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore()),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src"},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("snapshot exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(newTestCollectionSnapshot(), nil)
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(nil, errors.New("not found"))
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "src", mock.Anything).Return(nil, errors.New("not found"))
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(nil, errors.New("not found"))
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "src", mock.Anything).Return(newTestCloneSource(), nil)
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Equal(t, util.DefaultDBID, task.dbID)
+		assert.Equal(t, int64(1), task.collMeta.CollectionID)
+	})
+}
+
+func Test_createSnapshotTask_Execute(t *testing.T) {
+	t.Run("failed to allocate id", func(t *testing.T) {
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withInvalidIDAllocator())),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+			collMeta: newTestCloneSource(),
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to list policies", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListRowPolicies("", int64(1)).Return(nil, errors.New("mock"))
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withValidIDAllocator(), withMeta(meta))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+			collMeta: newTestCloneSource(),
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to create snapshot in datacoord", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		expectSourcePolicies(meta)
+		broker := newMockBroker()
+		broker.CreateSnapshotFunc = func(ctx context.Context, req *datapb.CreateSnapshotRequest) error {
+			return errors.New("mock")
+		}
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withValidIDAllocator(), withBroker(broker), withMeta(meta), withStepExecutor(newMockStepExecutor()))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+			collMeta: newTestCloneSource(),
+		}
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("failed to add meta", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		expectSourcePolicies(meta)
+		meta.EXPECT().AddCollectionSnapshot(mock.Anything, mock.Anything).Return(errors.New("mock"))
+		broker := newMockBroker()
+		broker.CreateSnapshotFunc = func(ctx context.Context, req *datapb.CreateSnapshotRequest) error {
+			return nil
+		}
+		undo := make(chan *stepStack, 1)
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			undo <- s
+		}
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withValidIDAllocator(), withBroker(broker), withMeta(meta), withStepExecutor(executor))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap"},
+			collMeta: newTestCloneSource(),
+		}
+		assert.Error(t, task.Execute(context.Background()))
+		s := <-undo
+		assert.Len(t, s.steps, 1)
+		_, ok := s.steps[0].(*dropSnapshotDataStep)
+		assert.True(t, ok)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		var saved *model.CollectionSnapshot
+		meta := mockrootcoord.NewIMetaTable(t)
+		expectSourcePolicies(meta)
+		meta.EXPECT().AddCollectionSnapshot(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, snapshot *model.CollectionSnapshot) error {
+				saved = snapshot
+				return nil
+			})
+		broker := newMockBroker()
+		var req *datapb.CreateSnapshotRequest
+		broker.CreateSnapshotFunc = func(ctx context.Context, r *datapb.CreateSnapshotRequest) error {
+			req = r
+			return nil
+		}
+		task := &createSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withValidIDAllocator(), withBroker(broker), withMeta(meta))),
+			Req:      &internalpb.CreateSnapshotRequest{CollectionName: "src", SnapshotName: "snap", Description: "desc"},
+			dbID:     util.DefaultDBID,
+			collMeta: newTestCloneSource(),
+		}
+		task.SetTs(100)
+		assert.NoError(t, task.Execute(context.Background()))
+		assert.Equal(t, int64(1), req.GetCollectionID())
+		assert.Equal(t, uint64(100), req.GetTimestamp())
+		assert.Equal(t, req.GetSnapshotID(), saved.ID)
+		assert.Equal(t, "snap", saved.Name)
+		assert.Equal(t, "desc", saved.Description)
+		assert.Equal(t, uint64(100), saved.CreateTime)
+		assert.Len(t, saved.Collection.Partitions, 2)
+		assert.Equal(t, []*model.SnapshotRowPolicy{{RoleName: "role1", Expr: "pk > 10"}}, saved.RowPolicies)
+		assert.Equal(t, []*model.SnapshotFieldGrant{{RoleName: "role1", FieldName: "vec", Privilege: util.PrivilegeReadField}}, saved.FieldGrants)
+	})
+}
+
+func Test_dropSnapshotTask(t *testing.T) {
+	t.Run("empty name", func(t *testing.T) {
+		task := &dropSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore()),
+			Req:      &internalpb.DropSnapshotRequest{},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("snapshot not found", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(nil, errors.New("not found"))
+		task := &dropSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta))),
+			Req:      &internalpb.DropSnapshotRequest{SnapshotName: "snap"},
+		}
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("failed to delete meta", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(newTestCollectionSnapshot(), nil)
+		meta.EXPECT().DropCollectionSnapshot(mock.Anything, util.DefaultDBID, int64(1000)).Return(errors.New("mock"))
+		task := &dropSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta))),
+			Req:      &internalpb.DropSnapshotRequest{SnapshotName: "snap"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Error(t, task.Execute(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().GetCollectionSnapshot(mock.Anything, util.DefaultDBID, "snap").Return(newTestCollectionSnapshot(), nil)
+		meta.EXPECT().DropCollectionSnapshot(mock.Anything, util.DefaultDBID, int64(1000)).Return(nil)
+		broker := newMockBroker()
+		broker.DropSnapshotFunc = func(ctx context.Context, snapshotID UniqueID) error {
+			return nil
+		}
+		task := &dropSnapshotTask{
+			baseTask: newBaseTask(context.Background(), newTestCore(withMeta(meta), withBroker(broker), withStepExecutor(newMockStepExecutor()))),
+			Req:      &internalpb.DropSnapshotRequest{SnapshotName: "snap"},
+		}
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.NoError(t, task.Execute(context.Background()))
+	})
+}
+
+func Test_restoreSnapshotTask_Prepare(t *testing.T) {
+	paramtable.Init()
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.On("GetDatabaseByName",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(model.NewDefaultDatabase(), nil).Maybe()
+	meta.On("ListAllAvailCollections",
+		mock.Anything,
+	).Return(map[int64][]int64{
+		util.DefaultDBID: {1},
+	}, nil).Maybe()
+	meta.On("GetCollectionSnapshot",
+		mock.Anything,
+		util.DefaultDBID,
+		"snap",
+	).Return(newTestCollectionSnapshot(), nil).Maybe()
+	meta.On("GetCollectionSnapshot",
+		mock.Anything,
+		util.DefaultDBID,
+		"other",
+	).Return(nil, errors.New("not found")).Maybe()
+	meta.On("GetCollectionByName",
+		mock.Anything,
+		mock.Anything,
+		"src",
+		mock.Anything,
+	).Return(newTestCloneSource(), nil).Maybe()
+	meta.On("GetCollectionByName",
+		mock.Anything,
+		mock.Anything,
+		"restored",
+		mock.Anything,
+	).Return(nil, errors.New("not found")).Maybe()
+
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNum.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNum.Key)
+
+	paramtable.Get().Save(Params.QuotaConfig.MaxCollectionNumPerDB.Key, strconv.Itoa(math.MaxInt64))
+	defer paramtable.Get().Reset(Params.QuotaConfig.MaxCollectionNumPerDB.Key)
+
+	newTask := func(core *Core, req *internalpb.RestoreSnapshotRequest) *restoreSnapshotTask {
+		task := &restoreSnapshotTask{
+			cloneCollectionTask: cloneCollectionTask{
+				createCollectionTask: createCollectionTask{baseTask: newBaseTask(context.Background(), core)},
+			},
+			restoreReq: req,
+		}
+		task.SetTs(100)
+		return task
+	}
+
+	t.Run("empty name", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snap"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("snapshot not found", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "other", NewCollectionName: "restored"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("collection exists", func(t *testing.T) {
+		core := newTestCore(withMeta(meta))
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snap", NewCollectionName: "src"})
+		assert.Error(t, task.Prepare(context.Background()))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		defer cleanTestEnv()
+		ticker := newRocksMqTtSynchronizer()
+		core := newTestCore(withValidIDAllocator(), withTtSynchronizer(ticker), withMeta(meta))
+
+		task := newTask(core, &internalpb.RestoreSnapshotRequest{SnapshotName: "snap", NewCollectionName: "restored"})
+		assert.NoError(t, task.Prepare(context.Background()))
+		assert.Equal(t, int64(1000), task.snapshotID)
+		assert.Equal(t, Timestamp(50), task.snapshotTs())
+		assert.Equal(t, "restored", task.schema.GetName())
+		assert.Len(t, task.partIDs, 2)
+		assert.Len(t, task.rowPolicies, 1)
+		assert.Equal(t, task.collID, task.rowPolicies[0].GetCollectionID())
+		assert.Equal(t, "restored", task.rowPolicies[0].GetCollectionName())
+		assert.Equal(t, "pk > 10", task.rowPolicies[0].GetExpr())
+		assert.Len(t, task.fieldGrants, 1)
+		assert.Equal(t, "restored.vec", task.fieldGrants[0].GetObjectName())
+		assert.Equal(t, util.PrivilegeReadField, task.fieldGrants[0].GetGrantor().GetPrivilege().GetName())
+
+		req := task.genCloneDataRequest(nil, 100)
+		assert.Equal(t, int64(1000), req.GetSnapshotID())
+		assert.Equal(t, int64(1), req.GetSourceCollectionID())
+	})
+}
@@ -22,8 +22,11 @@ import (
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
 type stepPriority int
@@ -323,6 +326,25 @@ func (s *syncNewCreatedPartitionStep) Weight() stepPriority {
 	return stepPriorityUrgent
 }
 
+type updateSchemaStep struct {
+	baseStep
+	collectionID UniqueID
+	schema       *schemapb.CollectionSchema
+}
+
+func (s *updateSchemaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.UpdateSchema(ctx, s.collectionID, s.schema)
+	return nil, err
+}
+
+func (s *updateSchemaStep) Desc() string {
+	return fmt.Sprintf("update schema of loaded collection, collectionID=%d, fieldNum=%d", s.collectionID, len(s.schema.GetFields()))
+}
+
+func (s *updateSchemaStep) Weight() stepPriority {
+	return stepPriorityUrgent
+}
+
 type dropIndexStep struct {
 	baseStep
 	collID  UniqueID
@@ -442,6 +464,131 @@ func (b *BroadcastAlteredCollectionStep) Desc() string {
 	return fmt.Sprintf("broadcast altered collection, collectionID: %d", b.req.CollectionID)
 }
 
+type cloneCollectionDataStep struct {
+	baseStep
+	req *datapb.CloneCollectionRequest
+}
+
+func (s *cloneCollectionDataStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.CloneCollection(ctx, s.req)
+	return nil, err
+}
+
+func (s *cloneCollectionDataStep) Desc() string {
+	return fmt.Sprintf("clone collection data, source collection: %d, collection: %d, ts: %d",
+		s.req.GetSourceCollectionID(), s.req.GetCollectionID(), s.req.GetTimestamp())
+}
+
+type dropClonedCollectionDataStep struct {
+	baseStep
+	collectionID UniqueID
+}
+
+func (s *dropClonedCollectionDataStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	if err := s.core.broker.DropCollectionSegments(ctx, s.collectionID); err != nil {
+		return nil, err
+	}
+	err := s.core.broker.DropCollectionIndex(ctx, s.collectionID, nil)
+	return nil, err
+}
+
+func (s *dropClonedCollectionDataStep) Desc() string {
+	return fmt.Sprintf("drop cloned collection data, collection: %d", s.collectionID)
+}
+
+type grantClonedPoliciesStep struct {
+	baseStep
+	rowPolicies []*internalpb.RowPolicy
+	fieldGrants []*milvuspb.GrantEntity
+}
+
+func (s *grantClonedPoliciesStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	for _, policy := range s.rowPolicies {
+		if err := s.core.meta.AddRowPolicy(policy); err != nil {
+			return nil, err
+		}
+	}
+	err := s.core.operateFieldGrants(ctx, s.fieldGrants, milvuspb.OperatePrivilegeType_Grant)
+	return nil, err
+}
+
+func (s *grantClonedPoliciesStep) Desc() string {
+	return fmt.Sprintf("grant cloned policies, row policies: %d, field grants: %d", len(s.rowPolicies), len(s.fieldGrants))
+}
+
+type revokeClonedFieldGrantsStep struct {
+	baseStep
+	fieldGrants []*milvuspb.GrantEntity
+}
+
+func (s *revokeClonedFieldGrantsStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.operateFieldGrants(ctx, s.fieldGrants, milvuspb.OperatePrivilegeType_Revoke)
+	return nil, err
+}
+
+func (s *revokeClonedFieldGrantsStep) Desc() string {
+	return fmt.Sprintf("revoke cloned field grants: %d", len(s.fieldGrants))
+}
+
+type createSnapshotDataStep struct {
+	baseStep
+	req *datapb.CreateSnapshotRequest
+}
+
+func (s *createSnapshotDataStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.CreateSnapshot(ctx, s.req)
+	return nil, err
+}
+
+func (s *createSnapshotDataStep) Desc() string {
+	return fmt.Sprintf("create snapshot data, snapshot: %d, collection: %d, ts: %d",
+		s.req.GetSnapshotID(), s.req.GetCollectionID(), s.req.GetTimestamp())
+}
+
+type dropSnapshotDataStep struct {
+	baseStep
+	snapshotID UniqueID
+}
+
+func (s *dropSnapshotDataStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.DropSnapshot(ctx, s.snapshotID)
+	return nil, err
+}
+
+func (s *dropSnapshotDataStep) Desc() string {
+	return fmt.Sprintf("drop snapshot data, snapshot: %d", s.snapshotID)
+}
+
+type addSnapshotMetaStep struct {
+	baseStep
+	snapshot *model.CollectionSnapshot
+}
+
+func (s *addSnapshotMetaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.AddCollectionSnapshot(ctx, s.snapshot)
+	return nil, err
+}
+
+func (s *addSnapshotMetaStep) Desc() string {
+	return fmt.Sprintf("add snapshot meta, snapshot: %s(%d), collection: %d",
+		s.snapshot.Name, s.snapshot.ID, s.snapshot.Collection.CollectionID)
+}
+
+type deleteSnapshotMetaStep struct {
+	baseStep
+	dbID       UniqueID
+	snapshotID UniqueID
+}
+
+func (s *deleteSnapshotMetaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.meta.DropCollectionSnapshot(ctx, s.dbID, s.snapshotID)
+	return nil, err
+}
+
+func (s *deleteSnapshotMetaStep) Desc() string {
+	return fmt.Sprintf("delete snapshot meta, snapshot: %d", s.snapshotID)
+}
+
 var (
 	confirmGCInterval          = time.Minute * 20
 	allPartition      UniqueID = -1
@@ -41,6 +41,7 @@ type StepExecutor interface {
 
 type stepStack struct {
 	steps []nestedStep
+	job   *ddlJob // the job which the steps belong to, nil if they aren't tracked.
 }
 
 func (s *stepStack) totalPriority() int {
@@ -56,6 +57,7 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 	for len(steps) > 0 {
 		l := len(steps)
 		todo := steps[l-1]
+		s.job.setStep(todo.Desc())
 		childSteps, err := todo.Execute(ctx)
 
 		// TODO: maybe a interface `step.LogOnError` is better.
@@ -67,6 +69,7 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 			if !skipLog {
 				log.Warn("failed to execute step, not able to reschedule", zap.Error(err), zap.String("step", todo.Desc()))
 			}
+			s.job.stepStackDone(err)
 			return nil
 		}
 		if err != nil {
@@ -74,13 +77,14 @@ func (s *stepStack) Execute(ctx context.Context) *stepStack {
 			if !skipLog {
 				log.Warn("failed to execute step, wait for reschedule", zap.Error(err), zap.String("step", todo.Desc()))
 			}
-			return &stepStack{steps: steps}
+			return &stepStack{steps: steps, job: s.job}
 		}
 		// this step is done.
 		steps = steps[:l-1]
 		steps = append(steps, childSteps...)
 	}
 	// everything is done.
+	s.job.stepStackDone(nil)
 	return nil
 }
 
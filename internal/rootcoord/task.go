@@ -35,6 +35,9 @@ type task interface {
 	WaitToFinish() error
 	NotifyDone(err error)
 	SetInQueueDuration()
+	// GetLocks returns the locks of the objects the task reads or changes, the task without locks is executed
+	// without waiting for any other task.
+	GetLocks() []ddlLock
 }
 
 type baseTask struct {
@@ -101,3 +104,7 @@ func (b *baseTask) NotifyDone(err error) {
 func (b *baseTask) SetInQueueDuration() {
 	b.queueDur = b.tr.ElapseSpan()
 }
+
+func (b *baseTask) GetLocks() []ddlLock {
+	return nil
+}
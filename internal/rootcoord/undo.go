@@ -50,12 +50,16 @@ func (b *baseUndoTask) Execute(ctx context.Context) error {
 	}
 	for i := 0; i < len(b.todoStep); i++ {
 		todoStep := b.todoStep[i]
+		job := getDDLJob(ctx)
+		job.setStep(todoStep.Desc())
 		// no children step in normal case.
 		if _, err := todoStep.Execute(ctx); err != nil {
 			log.Warn("failed to execute step, trying to undo", zap.Error(err), zap.String("desc", todoStep.Desc()))
 			undoSteps := b.undoStep[:i]
 			b.undoStep = nil // let baseUndoTask can be collected.
-			go b.stepExecutor.AddSteps(&stepStack{undoSteps})
+			// register the stack before the task returns, so the job won't finish before the undo.
+			job.addStepStack(true)
+			go b.stepExecutor.AddSteps(&stepStack{steps: undoSteps, job: job})
 			return err
 		}
 	}
@@ -0,0 +1,87 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// undropCollectionTask restores a collection from the recycle bin. The channels and the segments of the collection
+// are kept while it's in the recycle bin, so only the meta is changed, the collection needs to be loaded again.
+type undropCollectionTask struct {
+	baseTask
+	Req *internalpb.UndropCollectionRequest
+}
+
+func (t *undropCollectionTask) GetLocks() []ddlLock {
+	return newCollectionLocks(t.Req.GetDbName(), t.Req.GetCollectionName(), true)
+}
+
+func (t *undropCollectionTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" && t.Req.GetCollectionID() == 0 {
+		return merr.WrapErrParameterInvalidMsg("undrop collection failed, neither collection name nor collection id is specified")
+	}
+	return nil
+}
+
+// pickRecycledCollection returns the recycled collection matching the request, the latest dropped one is picked
+// if several collections with the same name are in the recycle bin.
+func (t *undropCollectionTask) pickRecycledCollection(ctx context.Context) (*model.Collection, error) {
+	colls, err := t.core.meta.ListCollections(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp, false)
+	if err != nil {
+		return nil, err
+	}
+	var target *model.Collection
+	for _, coll := range colls {
+		if coll.State != pb.CollectionState_CollectionRecycled {
+			continue
+		}
+		if t.Req.GetCollectionID() != 0 && coll.CollectionID != t.Req.GetCollectionID() {
+			continue
+		}
+		if t.Req.GetCollectionName() != "" && coll.Name != t.Req.GetCollectionName() {
+			continue
+		}
+		if target == nil || coll.DropTime > target.DropTime {
+			target = coll
+		}
+	}
+	if target == nil {
+		return nil, merr.WrapErrCollectionNotFound(t.Req.GetCollectionName(), "the collection isn't in the recycle bin")
+	}
+	return target, nil
+}
+
+func (t *undropCollectionTask) Execute(ctx context.Context) error {
+	coll, err := t.pickRecycledCollection(ctx)
+	if err != nil {
+		return err
+	}
+
+	ts := t.GetTs()
+	aliases := t.core.meta.ListAliasesByID(coll.CollectionID)
+	if err := t.core.ExpireMetaCache(ctx, t.Req.GetDbName(), append(aliases, coll.Name), coll.CollectionID, ts); err != nil {
+		return err
+	}
+	return t.core.meta.UndropCollection(ctx, t.Req.GetDbName(), coll.CollectionID, ts)
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// undropPartitionTask restores a partition from the recycle bin, the partition is loaded again if the collection
+// is loaded.
+type undropPartitionTask struct {
+	baseTask
+	Req      *internalpb.UndropPartitionRequest
+	collMeta *model.Collection
+}
+
+func (t *undropPartitionTask) GetLocks() []ddlLock {
+	return []ddlLock{newCollectionLock(t.Req.GetDbName(), t.Req.GetCollectionName(), true)}
+}
+
+func (t *undropPartitionTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" || t.Req.GetPartitionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("undrop partition failed, collection name or partition name is empty")
+	}
+	coll, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	// the recycled partitions are filtered out by name, get the collection again to see them.
+	t.collMeta, err = t.core.meta.GetCollectionByID(ctx, t.Req.GetDbName(), coll.CollectionID, typeutil.MaxTimestamp, true)
+	return err
+}
+
+// pickRecycledPartition returns the latest dropped partition with the name in the recycle bin.
+func (t *undropPartitionTask) pickRecycledPartition() (*model.Partition, error) {
+	var target *model.Partition
+	availableNum := 0
+	for _, partition := range t.collMeta.Partitions {
+		if partition.Available() {
+			availableNum++
+			if partition.PartitionName == t.Req.GetPartitionName() {
+				return nil, merr.WrapErrParameterInvalidMsg("partition %s already exists in collection %s",
+					partition.PartitionName, t.collMeta.Name)
+			}
+			continue
+		}
+		if partition.State != pb.PartitionState_PartitionRecycled || partition.PartitionName != t.Req.GetPartitionName() {
+			continue
+		}
+		if target == nil || partition.DropTime > target.DropTime {
+			target = partition
+		}
+	}
+	if target == nil {
+		return nil, merr.WrapErrPartitionNotFound(t.Req.GetPartitionName(), "the partition isn't in the recycle bin")
+	}
+
+	cfgMaxPartitionNum := Params.RootCoordCfg.MaxPartitionNum.GetAsInt()
+	if availableNum >= cfgMaxPartitionNum {
+		return nil, fmt.Errorf("partition number (%d) exceeds max configuration (%d), collection: %s",
+			availableNum, cfgMaxPartitionNum, t.collMeta.Name)
+	}
+	return target, nil
+}
+
+func (t *undropPartitionTask) Execute(ctx context.Context) error {
+	partition, err := t.pickRecycledPartition()
+	if err != nil {
+		return err
+	}
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{t.collMeta.Name},
+		collectionID:    t.collMeta.CollectionID,
+		ts:              t.GetTs(),
+	}, &nullStep{})
+
+	undoTask.AddStep(&syncNewCreatedPartitionStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collMeta.CollectionID,
+		partitionID:  partition.PartitionID,
+	}, &releasePartitionsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collMeta.CollectionID,
+		partitionIDs: []int64{partition.PartitionID},
+	})
+
+	undoTask.AddStep(&changePartitionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: t.collMeta.CollectionID,
+		partitionID:  partition.PartitionID,
+		state:        pb.PartitionState_PartitionCreated,
+		ts:           t.GetTs(),
+	}, &nullStep{})
+
+	return undoTask.Execute(ctx)
+}
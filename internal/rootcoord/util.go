@@ -20,13 +20,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -201,3 +205,127 @@ func getCollectionRateLimitConfig(properties map[string]string, configKey string
 
 	return getCollectionRateLimitConfigDefaultValue(configKey)
 }
+
+// getTenantRateLimitConfig parses the rate limits of users, the keys of rules are in the form of
+// <username>.<rateKey>, the negative rates mean no limit.
+func getTenantRateLimitConfig(rules map[string]string) map[string]map[internalpb.RateType]ratelimitutil.Limit {
+	ret := make(map[string]map[internalpb.RateType]ratelimitutil.Limit)
+	for key, value := range rules {
+		name, rateKey, ok := strings.Cut(key, ".")
+		if !ok || name == "" {
+			log.Warn("invalid key of tenant rate limit", zap.String("key", key))
+			continue
+		}
+		rt, rate, ok := parseTenantRateLimit(key, rateKey, value)
+		if !ok {
+			continue
+		}
+		if ret[name] == nil {
+			ret[name] = make(map[internalpb.RateType]ratelimitutil.Limit)
+		}
+		ret[name][rt] = rate
+	}
+	return ret
+}
+
+// parseTenantRateLimit parses the rate of the rate key, false if it's invalid or means no limit.
+func parseTenantRateLimit(key, rateKey, value string) (internalpb.RateType, ratelimitutil.Limit, bool) {
+	var rt internalpb.RateType
+	var inMegaBytes bool
+	switch rateKey {
+	case common.TenantInsertRateMaxKey:
+		rt, inMegaBytes = internalpb.RateType_DMLInsert, true
+	case common.TenantUpsertRateMaxKey:
+		rt, inMegaBytes = internalpb.RateType_DMLUpsert, true
+	case common.TenantDeleteRateMaxKey:
+		rt, inMegaBytes = internalpb.RateType_DMLDelete, true
+	case common.TenantBulkLoadRateMaxKey:
+		rt, inMegaBytes = internalpb.RateType_DMLBulkLoad, true
+	case common.TenantSearchRateMaxKey:
+		rt = internalpb.RateType_DQLSearch
+	case common.TenantQueryRateMaxKey:
+		rt = internalpb.RateType_DQLQuery
+	default:
+		log.Warn("unknown rate key of tenant rate limit", zap.String("key", key))
+		return 0, 0, false
+	}
+
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Warn("invalid configuration for tenant rate limit",
+			zap.String("config item", key),
+			zap.String("config value", value))
+		return 0, 0, false
+	}
+	if rate < 0 {
+		return 0, 0, false
+	}
+	if inMegaBytes {
+		rate = rate * 1024.0 * 1024.0
+	}
+	return rt, ratelimitutil.Limit(rate), true
+}
+
+// databaseRateLimitKeys maps the rate limit properties of databases to the rate keys of tenant rate limits.
+var databaseRateLimitKeys = map[string]string{
+	common.DatabaseInsertRateMaxKey:   common.TenantInsertRateMaxKey,
+	common.DatabaseUpsertRateMaxKey:   common.TenantUpsertRateMaxKey,
+	common.DatabaseDeleteRateMaxKey:   common.TenantDeleteRateMaxKey,
+	common.DatabaseBulkLoadRateMaxKey: common.TenantBulkLoadRateMaxKey,
+	common.DatabaseQueryRateMaxKey:    common.TenantQueryRateMaxKey,
+	common.DatabaseSearchRateMaxKey:   common.TenantSearchRateMaxKey,
+}
+
+// getDatabaseRateLimits parses the rate limit properties of databases, keyed by database id
+// so that the limits follow the databases when they're renamed.
+func getDatabaseRateLimits(dbs []*model.Database) map[int64]map[internalpb.RateType]ratelimitutil.Limit {
+	ret := make(map[int64]map[internalpb.RateType]ratelimitutil.Limit)
+	for _, db := range dbs {
+		for _, prop := range db.Properties {
+			rateKey, ok := databaseRateLimitKeys[prop.GetKey()]
+			if !ok {
+				continue
+			}
+			rt, rate, ok := parseTenantRateLimit(prop.GetKey(), rateKey, prop.GetValue())
+			if !ok {
+				continue
+			}
+			if ret[db.ID] == nil {
+				ret[db.ID] = make(map[internalpb.RateType]ratelimitutil.Limit)
+			}
+			ret[db.ID][rt] = rate
+		}
+	}
+	return ret
+}
+
+// getDatabaseDiskQuota returns the disk quota of the database in bytes, false if it's not set or invalid.
+func getDatabaseDiskQuota(props []*commonpb.KeyValuePair) (float64, bool) {
+	for _, prop := range props {
+		if prop.GetKey() != common.DatabaseDiskQuotaKey {
+			continue
+		}
+		quota, err := strconv.ParseFloat(prop.GetValue(), 64)
+		if err != nil || quota < 0 {
+			log.Warn("invalid disk quota of database",
+				zap.String("config item", prop.GetKey()),
+				zap.String("config value", prop.GetValue()))
+			return 0, false
+		}
+		return quota * 1024.0 * 1024.0, true
+	}
+	return 0, false
+}
+
+// getDatabaseMaxCollections returns the max number of collections in the database, the property of the database
+// overrides quotaAndLimits.limits.maxCollectionNumPerDB.
+func getDatabaseMaxCollections(props []*commonpb.KeyValuePair) int {
+	num, ok, err := common.GetDatabaseMaxCollections(props...)
+	if err != nil {
+		log.Warn("invalid max number of collections of database, use the configured one", zap.Error(err))
+	}
+	if err != nil || !ok {
+		return Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt()
+	}
+	return num
+}
@@ -23,8 +23,13 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -292,3 +297,64 @@ func Test_getCollectionRateLimitConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_getTenantRateLimitConfig(t *testing.T) {
+	rates := getTenantRateLimitConfig(map[string]string{
+		"user1." + common.TenantInsertRateMaxKey: "5",
+		"user1." + common.TenantSearchRateMaxKey: "100",
+		"user1." + common.TenantQueryRateMaxKey:  "-1",
+		"user2." + common.TenantDeleteRateMaxKey: "0",
+		"user3." + common.TenantQueryRateMaxKey:  "invalid",
+		"user4.unknownRate.max":                  "5",
+		"user5":                                  "5",
+	})
+	assert.Equal(t, map[string]map[internalpb.RateType]ratelimitutil.Limit{
+		"user1": {
+			internalpb.RateType_DMLInsert: ratelimitutil.Limit(5 * 1024 * 1024),
+			internalpb.RateType_DQLSearch: ratelimitutil.Limit(100),
+		},
+		"user2": {
+			internalpb.RateType_DMLDelete: ratelimitutil.Limit(0),
+		},
+	}, rates)
+}
+
+func Test_getDatabaseRateLimits(t *testing.T) {
+	db1 := model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated)
+	db1.Properties = []*commonpb.KeyValuePair{
+		{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+		{Key: common.DatabaseSearchRateMaxKey, Value: "100"},
+		{Key: common.DatabaseQueryRateMaxKey, Value: "-1"},
+		{Key: common.DatabaseReplicaNumberKey, Value: "2"},
+	}
+	db2 := model.NewDatabase(3, "db2", pb.DatabaseState_DatabaseCreated)
+	db2.Properties = []*commonpb.KeyValuePair{
+		{Key: common.DatabaseDeleteRateMaxKey, Value: "invalid"},
+	}
+	db3 := model.NewDatabase(4, "db3", pb.DatabaseState_DatabaseCreated)
+	rates := getDatabaseRateLimits([]*model.Database{db1, db2, db3})
+	assert.Equal(t, map[int64]map[internalpb.RateType]ratelimitutil.Limit{
+		2: {
+			internalpb.RateType_DMLInsert: ratelimitutil.Limit(10 * 1024 * 1024),
+			internalpb.RateType_DQLSearch: ratelimitutil.Limit(100),
+		},
+	}, rates)
+}
+
+func Test_getDatabaseDiskQuota(t *testing.T) {
+	_, ok := getDatabaseDiskQuota(nil)
+	assert.False(t, ok)
+	_, ok = getDatabaseDiskQuota([]*commonpb.KeyValuePair{{Key: common.DatabaseDiskQuotaKey, Value: "invalid"}})
+	assert.False(t, ok)
+	quota, ok := getDatabaseDiskQuota([]*commonpb.KeyValuePair{{Key: common.DatabaseDiskQuotaKey, Value: "2"}})
+	assert.True(t, ok)
+	assert.Equal(t, float64(2*1024*1024), quota)
+}
+
+func Test_getDatabaseMaxCollections(t *testing.T) {
+	paramtable.Init()
+	configured := Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt()
+	assert.Equal(t, configured, getDatabaseMaxCollections(nil))
+	assert.Equal(t, configured, getDatabaseMaxCollections([]*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "invalid"}}))
+	assert.Equal(t, 3, getDatabaseMaxCollections([]*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "3"}}))
+}
@@ -456,6 +456,13 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	for _, field := range msg.FieldsData {
 		srcFields[field.FieldId] = field
 	}
+	defaultFields, err := generateMissingFieldsData(msg, collSchema)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range defaultFields {
+		srcFields[field.FieldId] = field
+	}
 
 	idata = &InsertData{
 		Data: make(map[FieldID]FieldData),
@@ -639,6 +646,28 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	return idata, nil
 }
 
+// generateMissingFieldsData generates the data of the fields which are added to the collection
+// after the message produced, filled with the default values of the fields.
+func generateMissingFieldsData(msg *msgstream.InsertMsg, collSchema *schemapb.CollectionSchema) ([]*schemapb.FieldData, error) {
+	existing := typeutil.NewSet[FieldID]()
+	for _, fieldData := range msg.GetFieldsData() {
+		existing.Insert(fieldData.GetFieldId())
+	}
+
+	var ret []*schemapb.FieldData
+	for _, field := range collSchema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetDefaultValue() == nil || existing.Contain(field.GetFieldID()) {
+			continue
+		}
+		fieldData, err := typeutil.GenerateDefaultFieldData(field, int(msg.NRows()))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, fieldData)
+	}
+	return ret, nil
+}
+
 func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
 	if msg.IsRowBased() {
 		return RowBasedInsertMsgToInsertData(msg, schema)
@@ -1176,6 +1205,11 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 	}
 
 	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
+	defaultFields, err := generateMissingFieldsData(msg, schema)
+	if err != nil {
+		return nil, err
+	}
+	insertRecord.FieldsData = append(insertRecord.FieldsData, defaultFields...)
 
 	return insertRecord, nil
 }
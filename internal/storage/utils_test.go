@@ -932,6 +932,30 @@ func TestColumnBasedInsertMsgToInsertData(t *testing.T) {
 	}
 }
 
+func TestColumnBasedInsertMsgToInsertData_AddedField(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim := 2, 2, 8, 2
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim)
+
+	// the field is added after the message produced
+	addedFieldID := int64(1000)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      addedFieldID,
+		Name:         "added",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 5}},
+	})
+
+	idata, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 5}, idata.Data[addedFieldID].(*Int64FieldData).Data)
+
+	record, err := TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msg.GetFieldsData())+1, len(record.GetFieldsData()))
+	assert.Equal(t, addedFieldID, record.GetFieldsData()[len(record.GetFieldsData())-1].GetFieldId())
+}
+
 func TestInsertMsgToInsertData(t *testing.T) {
 	numRows, fVecDim, bVecDim, f16VecDim := 10, 8, 8, 8
 	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim)
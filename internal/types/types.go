@@ -37,7 +37,7 @@ import (
 // If Limit function return true, the request will be rejected.
 // Otherwise, the request will pass. Limit also returns limit of limiter.
 type Limiter interface {
-	Check(collectionID int64, rt internalpb.RateType, n int) error
+	Check(username string, collectionID int64, rt internalpb.RateType, n int) error
 }
 
 // Component is the interface all services implement
@@ -218,6 +218,16 @@ type ProxyClient interface {
 type Proxy interface {
 	Component
 	proxypb.ProxyServer
+	proxypb.ProxyStreamServer
+	proxypb.ProxyAPIKeyServer
+	proxypb.ProxyRowPolicyServer
+	proxypb.ProxySchemaServer
+	proxypb.ProxyCloneServer
+	proxypb.ProxyRecycleBinServer
+	proxypb.ProxySnapshotServer
+	proxypb.ProxyDDLJobServer
+	proxypb.ProxyDatabaseServer
+	proxypb.ProxyAliasServer
 	milvuspb.MilvusServiceServer
 }
 
@@ -255,6 +265,10 @@ type ProxyComponent interface {
 	// GetRateLimiter returns the rateLimiter in Proxy
 	GetRateLimiter() (Limiter, error)
 
+	// QueryPages retrieves the results of the query page by page in the order of primary keys,
+	// the pages start after the cursor and are passed to send one by one.
+	QueryPages(ctx context.Context, request *milvuspb.QueryRequest, cursor string, pageSize int64, send func(*milvuspb.QueryResults) error) error
+
 	// UpdateStateCode updates state code for Proxy
 	//  `stateCode` is current statement of this proxy node, indicating whether it's healthy.
 	UpdateStateCode(stateCode commonpb.StateCode)
@@ -86,6 +86,10 @@ func (m *GrpcQueryCoordClient) SyncNewCreatedPartition(ctx context.Context, req
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcQueryCoordClient) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryCoordClient) LoadBalance(ctx context.Context, in *querypb.LoadBalanceRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
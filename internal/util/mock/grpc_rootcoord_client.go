@@ -49,10 +49,66 @@ func (m *GrpcRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.Li
 	return &milvuspb.ListDatabasesResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	return &internalpb.DescribeDatabaseResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) BatchAlterAliases(ctx context.Context, in *internalpb.BatchAlterAliasesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return merr.Success(), nil
 }
 
+func (m *GrpcRootCoordClient) AddCollectionField(ctx context.Context, in *internalpb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CloneCollection(ctx context.Context, in *internalpb.CloneCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDroppedCollections(ctx context.Context, in *internalpb.ListDroppedCollectionsRequest, opts ...grpc.CallOption) (*internalpb.ListDroppedCollectionsResponse, error) {
+	return &internalpb.ListDroppedCollectionsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) UndropCollection(ctx context.Context, in *internalpb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) UndropPartition(ctx context.Context, in *internalpb.UndropPartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CreateSnapshot(ctx context.Context, in *internalpb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DropSnapshot(ctx context.Context, in *internalpb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListSnapshots(ctx context.Context, in *internalpb.ListSnapshotsRequest, opts ...grpc.CallOption) (*internalpb.ListSnapshotsResponse, error) {
+	return &internalpb.ListSnapshotsResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RestoreSnapshot(ctx context.Context, in *internalpb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GetDDLJob(ctx context.Context, in *internalpb.GetDDLJobRequest, opts ...grpc.CallOption) (*internalpb.GetDDLJobResponse, error) {
+	return &internalpb.GetDDLJobResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListDDLJobs(ctx context.Context, in *internalpb.ListDDLJobsRequest, opts ...grpc.CallOption) (*internalpb.ListDDLJobsResponse, error) {
+	return &internalpb.ListDDLJobsResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
@@ -258,6 +314,34 @@ func (m *GrpcRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb
 	return &rootcoordpb.GetCredentialResponse{}, m.Err
 }
 
+func (m *GrpcRootCoordClient) CreateAPIKey(ctx context.Context, in *internalpb.APIKeyInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListAPIKeys(ctx context.Context, in *internalpb.ListAPIKeysRequest, opts ...grpc.CallOption) (*internalpb.ListAPIKeysResponse, error) {
+	return &internalpb.ListAPIKeysResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RevokeAPIKey(ctx context.Context, in *internalpb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ReportLoginFailure(ctx context.Context, in *internalpb.ReportLoginFailureRequest, opts ...grpc.CallOption) (*internalpb.ReportLoginFailureResponse, error) {
+	return &internalpb.ReportLoginFailureResponse{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) GrantRowPolicy(ctx context.Context, in *internalpb.GrantRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RevokeRowPolicy(ctx context.Context, in *internalpb.RevokeRowPolicyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcRootCoordClient) ListRowPolicies(ctx context.Context, in *internalpb.ListRowPoliciesRequest, opts ...grpc.CallOption) (*internalpb.ListRowPoliciesResponse, error) {
+	return &internalpb.ListRowPoliciesResponse{}, m.Err
+}
+
 func (m *GrpcRootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -0,0 +1,17 @@
+package typeutil
+
+// ServiceableResult is a partial result of the shards which carries the serviceable timestamp it reflects.
+type ServiceableResult interface {
+	GetServiceableTs() uint64
+}
+
+// MinServiceableTs returns the serviceable timestamp all the results reflect, 0 if any of them doesn't carry one.
+func MinServiceableTs[T ServiceableResult](results []T) uint64 {
+	var ts uint64
+	for i, result := range results {
+		if i == 0 || result.GetServiceableTs() < ts {
+			ts = result.GetServiceableTs()
+		}
+	}
+	return ts
+}
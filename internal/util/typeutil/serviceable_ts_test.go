@@ -0,0 +1,20 @@
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+func TestMinServiceableTs(t *testing.T) {
+	assert.Equal(t, uint64(0), MinServiceableTs([]*internalpb.SearchResults{}))
+	assert.Equal(t, uint64(90), MinServiceableTs([]*internalpb.SearchResults{
+		{ServiceableTs: 100}, {ServiceableTs: 90}, {ServiceableTs: 110},
+	}))
+	// the results without serviceable timestamp reflect nothing
+	assert.Equal(t, uint64(0), MinServiceableTs([]*internalpb.RetrieveResults{
+		{ServiceableTs: 100}, {},
+	}))
+}
@@ -18,6 +18,9 @@ package common
 
 import (
 	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
@@ -122,6 +125,56 @@ const (
 	CollectionSearchRateMaxKey   = "collection.searchRate.max.vps"
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
+
+	// CollectionPKUniquenessKey decides how to handle the inserted rows whose primary keys exist already,
+	// duplicated primary keys are allowed if it's not set. The check is best-effort, it can't prevent
+	// concurrent inserts of the same primary keys, and only checks the rows inside one request if the
+	// collection is not loaded.
+	CollectionPKUniquenessKey = "collection.insert.pkUniqueness"
+)
+
+// tenant rate limits, the limits of a user are configured by the keys <username>.<rateKey>
+// in quotaAndLimits.tenant, the limits of a database by the properties Database*RateMaxKey
+const (
+	TenantInsertRateMaxKey   = "insertRate.max.mb"
+	TenantUpsertRateMaxKey   = "upsertRate.max.mb"
+	TenantDeleteRateMaxKey   = "deleteRate.max.mb"
+	TenantBulkLoadRateMaxKey = "bulkLoadRate.max.mb"
+	TenantQueryRateMaxKey    = "queryRate.max.qps"
+	TenantSearchRateMaxKey   = "searchRate.max.vps"
+)
+
+// Database properties key, the properties of a database are set by AlterDatabase.
+
+const (
+	// DatabaseMaxCollectionsKey overrides quotaAndLimits.limits.maxCollectionNumPerDB for the database
+	DatabaseMaxCollectionsKey = "database.max.collections"
+	// DatabaseDiskQuotaKey is the disk quota shared by all the collections in the database
+	DatabaseDiskQuotaKey = "database.diskProtection.diskQuota.mb"
+
+	// rate limits of the database, shared by all the collections in the database
+	DatabaseInsertRateMaxKey   = "database." + TenantInsertRateMaxKey
+	DatabaseUpsertRateMaxKey   = "database." + TenantUpsertRateMaxKey
+	DatabaseDeleteRateMaxKey   = "database." + TenantDeleteRateMaxKey
+	DatabaseBulkLoadRateMaxKey = "database." + TenantBulkLoadRateMaxKey
+	DatabaseQueryRateMaxKey    = "database." + TenantQueryRateMaxKey
+	DatabaseSearchRateMaxKey   = "database." + TenantSearchRateMaxKey
+
+	// DatabaseReplicaNumberKey is the replica number of the loads in the database which don't indicate it
+	DatabaseReplicaNumberKey = "database.replica.number"
+	// DatabaseResourceGroupsKey is the comma separated resource groups of the loads in the database which
+	// don't indicate them
+	DatabaseResourceGroupsKey = "database.resource_groups"
+	// DatabaseConsistencyLevelKey is the consistency level inherited by the new collections of the database,
+	// which are created with the default consistency level Strong
+	DatabaseConsistencyLevelKey = "database.consistency.level"
+)
+
+const (
+	// PKUniquenessReject fails the insert request if any of the primary keys exists
+	PKUniquenessReject = "reject"
+	// PKUniquenessIgnore skips the rows whose primary keys exist and inserts the others
+	PKUniquenessIgnore = "ignore"
 )
 
 // common properties
@@ -129,11 +182,25 @@ const (
 	MmapEnabledKey = "mmap.enabled"
 )
 
+// ReadableFieldsKey is the property of the collection described to the user who can't read some of the fields,
+// the value is the comma separated names of the fields readable.
+const ReadableFieldsKey = "readable.fields"
+
+// DDLAsyncKey is the property of the msg base of the DDL request, rootcoord returns as soon as the request is
+// enqueued if it's true, the progress of the request could be checked by the DDL job.
+const DDLAsyncKey = "ddl.async"
+
 const (
 	PropertiesKey string = "properties"
 	TraceIDKey    string = "uber-trace-id"
 )
 
+// IsAsyncDDL returns whether the DDL request asks for asynchronous execution.
+func IsAsyncDDL(base *commonpb.MsgBase) bool {
+	async, err := strconv.ParseBool(base.GetProperties()[DDLAsyncKey])
+	return err == nil && async
+}
+
 func IsSystemField(fieldID int64) bool {
 	return fieldID < StartOfUserFieldID
 }
@@ -147,6 +214,117 @@ func IsMmapEnabled(kvs ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+// GetPKUniquenessPolicy returns the primary key uniqueness policy set in collection properties,
+// an empty policy means duplicated primary keys are allowed.
+func GetPKUniquenessPolicy(kvs ...*commonpb.KeyValuePair) (string, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() != CollectionPKUniquenessKey {
+			continue
+		}
+		policy := strings.ToLower(strings.TrimSpace(kv.GetValue()))
+		switch policy {
+		case "", PKUniquenessReject, PKUniquenessIgnore:
+			return policy, nil
+		default:
+			return "", fmt.Errorf("invalid value %s for %s, should be %s or %s",
+				kv.GetValue(), CollectionPKUniquenessKey, PKUniquenessReject, PKUniquenessIgnore)
+		}
+	}
+	return "", nil
+}
+
+// GetDatabaseMaxCollections returns the max number of collections in the database, false if it's not set.
+func GetDatabaseMaxCollections(kvs ...*commonpb.KeyValuePair) (int, bool, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() != DatabaseMaxCollectionsKey {
+			continue
+		}
+		num, err := strconv.Atoi(kv.GetValue())
+		if err != nil || num < 0 {
+			return 0, false, fmt.Errorf("invalid value %s for %s, should be a non-negative integer", kv.GetValue(), DatabaseMaxCollectionsKey)
+		}
+		return num, true, nil
+	}
+	return 0, false, nil
+}
+
+// GetDatabaseReplicaNumber returns the default replica number of the loads in the database, 0 if it's not set.
+func GetDatabaseReplicaNumber(kvs ...*commonpb.KeyValuePair) (int32, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() != DatabaseReplicaNumberKey {
+			continue
+		}
+		num, err := strconv.ParseInt(kv.GetValue(), 10, 32)
+		if err != nil || num <= 0 {
+			return 0, fmt.Errorf("invalid value %s for %s, should be a positive integer", kv.GetValue(), DatabaseReplicaNumberKey)
+		}
+		return int32(num), nil
+	}
+	return 0, nil
+}
+
+// GetDatabaseResourceGroups returns the default resource groups of the loads in the database, nil if it's not set.
+func GetDatabaseResourceGroups(kvs ...*commonpb.KeyValuePair) ([]string, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() != DatabaseResourceGroupsKey {
+			continue
+		}
+		rgs := make([]string, 0)
+		for _, rg := range strings.Split(kv.GetValue(), ",") {
+			rg = strings.TrimSpace(rg)
+			if rg == "" {
+				return nil, fmt.Errorf("invalid value %s for %s, should be comma separated resource group names", kv.GetValue(), DatabaseResourceGroupsKey)
+			}
+			rgs = append(rgs, rg)
+		}
+		return rgs, nil
+	}
+	return nil, nil
+}
+
+// GetDatabaseConsistencyLevel returns the default consistency level of the new collections in the database,
+// false if it's not set.
+func GetDatabaseConsistencyLevel(kvs ...*commonpb.KeyValuePair) (commonpb.ConsistencyLevel, bool, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() != DatabaseConsistencyLevelKey {
+			continue
+		}
+		for name, level := range commonpb.ConsistencyLevel_value {
+			if strings.EqualFold(name, strings.TrimSpace(kv.GetValue())) {
+				return commonpb.ConsistencyLevel(level), true, nil
+			}
+		}
+		return commonpb.ConsistencyLevel_Strong, false, fmt.Errorf("invalid value %s for %s", kv.GetValue(), DatabaseConsistencyLevelKey)
+	}
+	return commonpb.ConsistencyLevel_Strong, false, nil
+}
+
+// ValidateDatabaseProperties checks the values of the known database properties.
+func ValidateDatabaseProperties(kvs ...*commonpb.KeyValuePair) error {
+	if _, _, err := GetDatabaseMaxCollections(kvs...); err != nil {
+		return err
+	}
+	if _, err := GetDatabaseReplicaNumber(kvs...); err != nil {
+		return err
+	}
+	if _, err := GetDatabaseResourceGroups(kvs...); err != nil {
+		return err
+	}
+	if _, _, err := GetDatabaseConsistencyLevel(kvs...); err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		switch kv.GetKey() {
+		case DatabaseDiskQuotaKey, DatabaseInsertRateMaxKey, DatabaseUpsertRateMaxKey, DatabaseDeleteRateMaxKey,
+			DatabaseBulkLoadRateMaxKey, DatabaseQueryRateMaxKey, DatabaseSearchRateMaxKey:
+			if _, err := strconv.ParseFloat(kv.GetValue(), 64); err != nil {
+				return fmt.Errorf("invalid value %s for %s, should be a number", kv.GetValue(), kv.GetKey())
+			}
+		}
+	}
+	return nil
+}
+
 func IsFieldMmapEnabled(schema *schemapb.CollectionSchema, fieldID int64) bool {
 	for _, field := range schema.GetFields() {
 		if field.GetFieldID() == fieldID {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 )
 
 func TestIsSystemField(t *testing.T) {
@@ -38,3 +40,80 @@ func TestIsSystemField(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPKUniquenessPolicy(t *testing.T) {
+	policy, err := GetPKUniquenessPolicy()
+	assert.NoError(t, err)
+	assert.Equal(t, "", policy)
+
+	policy, err = GetPKUniquenessPolicy(&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "10"})
+	assert.NoError(t, err)
+	assert.Equal(t, "", policy)
+
+	policy, err = GetPKUniquenessPolicy(&commonpb.KeyValuePair{Key: CollectionPKUniquenessKey, Value: "Reject"})
+	assert.NoError(t, err)
+	assert.Equal(t, PKUniquenessReject, policy)
+
+	policy, err = GetPKUniquenessPolicy(&commonpb.KeyValuePair{Key: CollectionPKUniquenessKey, Value: "ignore"})
+	assert.NoError(t, err)
+	assert.Equal(t, PKUniquenessIgnore, policy)
+
+	_, err = GetPKUniquenessPolicy(&commonpb.KeyValuePair{Key: CollectionPKUniquenessKey, Value: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestIsAsyncDDL(t *testing.T) {
+	assert.False(t, IsAsyncDDL(nil))
+	assert.False(t, IsAsyncDDL(&commonpb.MsgBase{}))
+	assert.False(t, IsAsyncDDL(&commonpb.MsgBase{Properties: map[string]string{DDLAsyncKey: "invalid"}}))
+	assert.False(t, IsAsyncDDL(&commonpb.MsgBase{Properties: map[string]string{DDLAsyncKey: "false"}}))
+	assert.True(t, IsAsyncDDL(&commonpb.MsgBase{Properties: map[string]string{DDLAsyncKey: "true"}}))
+}
+
+func TestDatabaseProperties(t *testing.T) {
+	num, ok, err := GetDatabaseMaxCollections()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	num, ok, err = GetDatabaseMaxCollections(&commonpb.KeyValuePair{Key: DatabaseMaxCollectionsKey, Value: "10"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 10, num)
+	_, _, err = GetDatabaseMaxCollections(&commonpb.KeyValuePair{Key: DatabaseMaxCollectionsKey, Value: "-1"})
+	assert.Error(t, err)
+
+	replicaNum, err := GetDatabaseReplicaNumber()
+	assert.NoError(t, err)
+	assert.Zero(t, replicaNum)
+	replicaNum, err = GetDatabaseReplicaNumber(&commonpb.KeyValuePair{Key: DatabaseReplicaNumberKey, Value: "3"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, replicaNum)
+	_, err = GetDatabaseReplicaNumber(&commonpb.KeyValuePair{Key: DatabaseReplicaNumberKey, Value: "0"})
+	assert.Error(t, err)
+
+	rgs, err := GetDatabaseResourceGroups()
+	assert.NoError(t, err)
+	assert.Nil(t, rgs)
+	rgs, err = GetDatabaseResourceGroups(&commonpb.KeyValuePair{Key: DatabaseResourceGroupsKey, Value: "rg1, rg2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rg1", "rg2"}, rgs)
+	_, err = GetDatabaseResourceGroups(&commonpb.KeyValuePair{Key: DatabaseResourceGroupsKey, Value: "rg1,,rg2"})
+	assert.Error(t, err)
+
+	level, ok, err := GetDatabaseConsistencyLevel()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	level, ok, err = GetDatabaseConsistencyLevel(&commonpb.KeyValuePair{Key: DatabaseConsistencyLevelKey, Value: "bounded"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.ConsistencyLevel_Bounded, level)
+	_, _, err = GetDatabaseConsistencyLevel(&commonpb.KeyValuePair{Key: DatabaseConsistencyLevelKey, Value: "unknown"})
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateDatabaseProperties(
+		&commonpb.KeyValuePair{Key: DatabaseDiskQuotaKey, Value: "1024"},
+		&commonpb.KeyValuePair{Key: DatabaseInsertRateMaxKey, Value: "0.5"},
+		&commonpb.KeyValuePair{Key: "unknown", Value: "unknown"},
+	))
+	assert.Error(t, ValidateDatabaseProperties(&commonpb.KeyValuePair{Key: DatabaseSearchRateMaxKey, Value: "fast"}))
+	assert.Error(t, ValidateDatabaseProperties(&commonpb.KeyValuePair{Key: DatabaseReplicaNumberKey, Value: "many"}))
+}
@@ -64,6 +64,13 @@ const (
 	ReduceSegments = "segments"
 	ReduceShards   = "shards"
 
+	HedgeIssuedLabel    = "issued"
+	HedgeWonLabel       = "won"
+	HedgeThrottledLabel = "throttled"
+
+	DatabaseTenantLabel = "database"
+	UserTenantLabel     = "user"
+
 	nodeIDLabelName          = "node_id"
 	statusLabelName          = "status"
 	indexTaskStatusLabelName = "index_task_status"
@@ -88,6 +95,10 @@ const (
 	lockName                 = "lock_name"
 	lockSource               = "lock_source"
 	lockType                 = "lock_type"
+	pkUniquenessPolicyName   = "pk_uniqueness_policy"
+	hedgeStateLabelName      = "hedge_state"
+	tenantTypeLabelName      = "tenant_type"
+	tenantLabelName          = "tenant"
 	lockOp                   = "lock_op"
 )
 
@@ -288,6 +288,34 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	// ProxyDuplicatedPrimaryKeyRows record the number of inserted rows whose primary keys exist already.
+	ProxyDuplicatedPrimaryKeyRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "duplicated_pk_rows_count",
+			Help:      "counter of inserted rows rejected or ignored because of existing primary keys",
+		}, []string{nodeIDLabelName, pkUniquenessPolicyName})
+
+	// ProxyHedgedRequests record the number of hedged search/query sub requests.
+	ProxyHedgedRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "hedged_request_count",
+			Help:      "count of hedged sub requests issued, won the primary ones, or throttled by the budget",
+		}, []string{nodeIDLabelName, hedgeStateLabelName})
+
+	// ProxyRateLimitHits record the number of requests rejected by the database and user rate limits, the tenant
+	// is the database id or the username, only the tenants with rate limits configured are counted.
+	ProxyRateLimitHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "rate_limit_hit_count",
+			Help:      "count of requests rejected by the rate limits of databases and users",
+		}, []string{nodeIDLabelName, tenantTypeLabelName, tenantLabelName, msgTypeLabelName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -331,6 +359,9 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyWorkLoadScore)
 	registry.MustRegister(ProxyExecutingTotalNq)
+	registry.MustRegister(ProxyDuplicatedPrimaryKeyRows)
+	registry.MustRegister(ProxyHedgedRequests)
+	registry.MustRegister(ProxyRateLimitHits)
 }
 
 func CleanupCollectionMetrics(nodeID int64, collection string) {
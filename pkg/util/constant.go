@@ -59,8 +59,26 @@ const (
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
 
+	// FieldObjectType is the object type of the field level privileges, the object name is like `collection.field`.
+	// It's not one of commonpb.ObjectType, as the privileges of it are checked by proxy rather than the interceptor.
+	FieldObjectType = "Field"
+	// PrivilegeReadField permits reading the restricted field, a field is restricted once
+	// the privilege on it is granted to any role.
+	PrivilegeReadField = "PrivilegeReadField"
+
 	IdentifierKey = "identifier"
 	HeaderDBName  = "dbName"
+
+	// HeaderPartialUpdate marks an upsert request which only carries the fields to be updated
+	HeaderPartialUpdate = "partialUpdate"
+	// HeaderInsertIfMissing allows a partial upsert to insert rows whose primary keys do not exist yet
+	HeaderInsertIfMissing = "insertIfMissing"
+	// HeaderSkipResultCache makes a search or query request bypass the proxy result cache
+	HeaderSkipResultCache = "skipResultCache"
+	// HeaderAsyncDDL makes a DDL request return as soon as it's accepted, the progress could be checked by the DDL job
+	HeaderAsyncDDL = "asyncDDL"
+	// HeaderDDLJobID is the response header carrying the id of the DDL job of the request
+	HeaderDDLJobID = "ddlJobID"
 )
 
 const (
@@ -123,6 +141,9 @@ var (
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeUpdateUser.String()),
 			MetaStore2API(commonpb.ObjectPrivilege_PrivilegeSelectUser.String()),
 		},
+		FieldObjectType: {
+			MetaStore2API(PrivilegeReadField),
+		},
 	}
 )
 
@@ -150,8 +171,7 @@ func MetaStore2API(name string) string {
 }
 
 func PrivilegeNameForAPI(name string) string {
-	_, ok := commonpb.ObjectPrivilege_value[name]
-	if !ok {
+	if !isPrivilege(name) {
 		return ""
 	}
 	return MetaStore2API(name)
@@ -159,13 +179,32 @@ func PrivilegeNameForAPI(name string) string {
 
 func PrivilegeNameForMetastore(name string) string {
 	dbPrivilege := PrivilegeWord + name
-	_, ok := commonpb.ObjectPrivilege_value[dbPrivilege]
-	if !ok {
+	if !isPrivilege(dbPrivilege) {
 		return ""
 	}
 	return dbPrivilege
 }
 
+func isPrivilege(name string) bool {
+	_, ok := commonpb.ObjectPrivilege_value[name]
+	return ok || name == PrivilegeReadField
+}
+
+// IsObjectType checks whether the name is a known object type
+func IsObjectType(name string) bool {
+	_, ok := commonpb.ObjectType_value[name]
+	return ok || name == FieldObjectType
+}
+
+// ObjectTypes returns all the object types
+func ObjectTypes() []string {
+	types := make([]string, 0, len(commonpb.ObjectType_value)+1)
+	for name := range commonpb.ObjectType_value {
+		types = append(types, name)
+	}
+	return append(types, FieldObjectType)
+}
+
 func IsAnyWord(word string) bool {
 	return word == AnyWord
 }
@@ -2,9 +2,11 @@ package crypto
 
 import (
 	"crypto/md5" // #nosec
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -46,3 +48,26 @@ func MD5(str string) string {
 	data := md5.Sum([]byte(str))
 	return hex.EncodeToString(data[:])[8:24]
 }
+
+const apiKeySeparator = "."
+
+// NewAPIKey generates a random api key in the form of <id>.<secret>,
+// the id is used to look up the key and the secret is stored encrypted by sha256 only.
+func NewAPIKey() (key string, id string, secret string, err error) {
+	buf := make([]byte, 40)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", "", err
+	}
+	id = hex.EncodeToString(buf[:8])
+	secret = hex.EncodeToString(buf[8:])
+	return id + apiKeySeparator + secret, id, secret, nil
+}
+
+// ParseAPIKey splits the api key generated by NewAPIKey into the id and secret.
+func ParseAPIKey(key string) (id string, secret string, ok bool) {
+	id, secret, ok = strings.Cut(key, apiKeySeparator)
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
@@ -45,3 +45,24 @@ func TestBcryptCost(t *testing.T) {
 func TestMD5(t *testing.T) {
 	assert.Equal(t, "67f48520697662a2", MD5("These pretzels are making me thirsty."))
 }
+
+func TestAPIKey(t *testing.T) {
+	key, id, secret, err := NewAPIKey()
+	assert.NoError(t, err)
+	assert.Len(t, id, 16)
+	assert.Len(t, secret, 64)
+
+	parsedID, parsedSecret, ok := ParseAPIKey(key)
+	assert.True(t, ok)
+	assert.Equal(t, id, parsedID)
+	assert.Equal(t, secret, parsedSecret)
+
+	another, _, _, err := NewAPIKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, another)
+
+	for _, invalid := range []string{"", "abc", ".abc", "abc."} {
+		_, _, ok = ParseAPIKey(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
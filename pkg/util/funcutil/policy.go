@@ -106,10 +106,12 @@ func CombineObjectName(dbName string, objectName string) string {
 	return fmt.Sprintf("%s.%s", dbName, objectName)
 }
 
+// SplitObjectName splits the object name combined with the database name, the object name
+// may contain the dot itself, like the `collection.field` of the field level privileges.
 func SplitObjectName(objectName string) (string, string) {
 	if !strings.Contains(objectName, ".") {
 		return util.DefaultDBName, objectName
 	}
-	names := strings.Split(objectName, ".")
+	names := strings.SplitN(objectName, ".", 2)
 	return names[0], names[1]
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
 )
 
 func Test_GetPrivilegeExtObj(t *testing.T) {
@@ -72,3 +73,17 @@ func Test_PolicyForResource(t *testing.T) {
 		`COLLECTION-db.col1`,
 		PolicyForResource("db", "COLLECTION", "col1"))
 }
+
+func Test_SplitObjectName(t *testing.T) {
+	dbName, objectName := SplitObjectName("col1")
+	assert.Equal(t, util.DefaultDBName, dbName)
+	assert.Equal(t, "col1", objectName)
+
+	dbName, objectName = SplitObjectName(CombineObjectName("db1", "col1"))
+	assert.Equal(t, "db1", dbName)
+	assert.Equal(t, "col1", objectName)
+
+	dbName, objectName = SplitObjectName(CombineObjectName("db1", "col1.field1"))
+	assert.Equal(t, "db1", dbName)
+	assert.Equal(t, "col1.field1", objectName)
+}
@@ -198,6 +198,26 @@ type commonConfig struct {
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
 
+	JWTEnabled          ParamItem `refreshable:"true"`
+	JWKSURI             ParamItem `refreshable:"true"`
+	JWKSRefreshInterval ParamItem `refreshable:"true"`
+	JWTIssuer           ParamItem `refreshable:"true"`
+	JWTAudiences        ParamItem `refreshable:"true"`
+	JWTUsernameClaim    ParamItem `refreshable:"true"`
+	JWTRolesClaim       ParamItem `refreshable:"true"`
+	JWTAllowSuperUsers  ParamItem `refreshable:"true"`
+
+	PasswordRequireUppercase   ParamItem `refreshable:"true"`
+	PasswordRequireLowercase   ParamItem `refreshable:"true"`
+	PasswordRequireDigit       ParamItem `refreshable:"true"`
+	PasswordRequireSpecialChar ParamItem `refreshable:"true"`
+	PasswordHistorySize        ParamItem `refreshable:"true"`
+	PasswordMaxAge             ParamItem `refreshable:"true"`
+	PasswordForceChangeOnReset ParamItem `refreshable:"true"`
+	LoginMaxFailedAttempts     ParamItem `refreshable:"true"`
+	LoginLockoutDuration       ParamItem `refreshable:"true"`
+	LoginLockoutExemptRoot     ParamItem `refreshable:"true"`
+
 	ClusterName ParamItem `refreshable:"false"`
 
 	SessionTTL        ParamItem `refreshable:"false"`
@@ -543,6 +563,168 @@ like the old password verification when updating the credential`,
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.JWTEnabled = ParamItem{
+		Key:          "common.security.jwt.enabled",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether to accept the JWT bearer tokens issued by the identity provider, it works only when the authorization is enabled",
+		Export:       true,
+	}
+	p.JWTEnabled.Init(base.mgr)
+
+	p.JWKSURI = ParamItem{
+		Key:          "common.security.jwt.jwksUri",
+		Version:      "2.3.4",
+		DefaultValue: "",
+		Doc:          "the local file path or the http(s) url of the JWKS which verifies the signature of the tokens",
+		Export:       true,
+	}
+	p.JWKSURI.Init(base.mgr)
+
+	p.JWKSRefreshInterval = ParamItem{
+		Key:          "common.security.jwt.jwksRefreshInterval",
+		Version:      "2.3.4",
+		DefaultValue: "300",
+		Doc:          "interval in seconds to reload the JWKS, the JWKS is reloaded as well when a token is signed by an unknown key",
+		Export:       true,
+	}
+	p.JWKSRefreshInterval.Init(base.mgr)
+
+	p.JWTIssuer = ParamItem{
+		Key:          "common.security.jwt.issuer",
+		Version:      "2.3.4",
+		DefaultValue: "",
+		Doc:          "the expected iss claim of the tokens, not checked if empty",
+		Export:       true,
+	}
+	p.JWTIssuer.Init(base.mgr)
+
+	p.JWTAudiences = ParamItem{
+		Key:          "common.security.jwt.audiences",
+		Version:      "2.3.4",
+		DefaultValue: "",
+		Doc:          "the accepted aud claims of the tokens separated by comma, not checked if empty",
+		Export:       true,
+	}
+	p.JWTAudiences.Init(base.mgr)
+
+	p.JWTUsernameClaim = ParamItem{
+		Key:          "common.security.jwt.usernameClaim",
+		Version:      "2.3.4",
+		DefaultValue: "sub",
+		Doc:          "the claim mapped to the milvus user, the nested claim is separated by dot",
+		Export:       true,
+	}
+	p.JWTUsernameClaim.Init(base.mgr)
+
+	p.JWTRolesClaim = ParamItem{
+		Key:          "common.security.jwt.rolesClaim",
+		Version:      "2.3.4",
+		DefaultValue: "",
+		Doc:          "the claim mapped to the milvus roles granted besides the roles of the user, the nested claim is separated by dot",
+		Export:       true,
+	}
+	p.JWTRolesClaim.Init(base.mgr)
+
+	p.JWTAllowSuperUsers = ParamItem{
+		Key:          "common.security.jwt.allowSuperUsers",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the tokens could act as root or the super users, the tokens mapped to them are rejected if false",
+		Export:       true,
+	}
+	p.JWTAllowSuperUsers.Init(base.mgr)
+
+	p.PasswordRequireUppercase = ParamItem{
+		Key:          "common.security.passwordPolicy.requireUppercase",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the password must contain an uppercase letter",
+		Export:       true,
+	}
+	p.PasswordRequireUppercase.Init(base.mgr)
+
+	p.PasswordRequireLowercase = ParamItem{
+		Key:          "common.security.passwordPolicy.requireLowercase",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the password must contain a lowercase letter",
+		Export:       true,
+	}
+	p.PasswordRequireLowercase.Init(base.mgr)
+
+	p.PasswordRequireDigit = ParamItem{
+		Key:          "common.security.passwordPolicy.requireDigit",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the password must contain a digit",
+		Export:       true,
+	}
+	p.PasswordRequireDigit.Init(base.mgr)
+
+	p.PasswordRequireSpecialChar = ParamItem{
+		Key:          "common.security.passwordPolicy.requireSpecialChar",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the password must contain a character other than the letters and digits",
+		Export:       true,
+	}
+	p.PasswordRequireSpecialChar.Init(base.mgr)
+
+	p.PasswordHistorySize = ParamItem{
+		Key:          "common.security.passwordPolicy.historySize",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "the number of the latest passwords, including the current one, which can't be reused when updating the password, 0 means not checked",
+		Export:       true,
+	}
+	p.PasswordHistorySize.Init(base.mgr)
+
+	p.PasswordMaxAge = ParamItem{
+		Key:          "common.security.passwordPolicy.maxAge",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "days after which the password expires and must be changed before any other operation, 0 means never expire",
+		Export:       true,
+	}
+	p.PasswordMaxAge.Init(base.mgr)
+
+	p.PasswordForceChangeOnReset = ParamItem{
+		Key:          "common.security.passwordPolicy.forceChangeOnReset",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether the user must change the password created or reset by the others before any other operation",
+		Export:       true,
+	}
+	p.PasswordForceChangeOnReset.Init(base.mgr)
+
+	p.LoginMaxFailedAttempts = ParamItem{
+		Key:          "common.security.loginLockout.maxFailedAttempts",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "the user is locked out after the consecutive failed logins, 0 means never lock out",
+		Export:       true,
+	}
+	p.LoginMaxFailedAttempts.Init(base.mgr)
+
+	p.LoginLockoutDuration = ParamItem{
+		Key:          "common.security.loginLockout.duration",
+		Version:      "2.3.4",
+		DefaultValue: "600",
+		Doc:          "seconds the user is locked out, the failed logins older than it aren't counted either",
+		Export:       true,
+	}
+	p.LoginLockoutDuration.Init(base.mgr)
+
+	p.LoginLockoutExemptRoot = ParamItem{
+		Key:          "common.security.loginLockout.exemptRoot",
+		Version:      "2.3.4",
+		DefaultValue: "true",
+		Doc:          "whether root is never locked out, so that the failed logins of others can't lock the administrator out",
+		Export:       true,
+	}
+	p.LoginLockoutExemptRoot.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -803,6 +985,10 @@ type rootCoordConfig struct {
 	ImportTaskSubPath           ParamItem `refreshable:"true"`
 	EnableActiveStandby         ParamItem `refreshable:"false"`
 	MaxDatabaseNum              ParamItem `refreshable:"false"`
+	RecycleBinRetention         ParamItem `refreshable:"true"`
+	RecycleBinCheckInterval     ParamItem `refreshable:"false"`
+	DDLJobRetention             ParamItem `refreshable:"true"`
+	DDLJobCheckInterval         ParamItem `refreshable:"false"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -882,6 +1068,43 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.MaxDatabaseNum.Init(base.mgr)
+
+	p.RecycleBinRetention = ParamItem{
+		Key:          "rootCoord.recycleBin.retention",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc: `(in seconds) The dropped collections and partitions are kept in the recycle bin for the retention,
+they could be restored by undrop until it expires. 0 means they are removed immediately.`,
+		Export: true,
+	}
+	p.RecycleBinRetention.Init(base.mgr)
+
+	p.RecycleBinCheckInterval = ParamItem{
+		Key:          "rootCoord.recycleBin.checkInterval",
+		Version:      "2.3.4",
+		DefaultValue: "60",
+		Doc:          "(in seconds) The interval to remove the expired collections and partitions from the recycle bin",
+		Export:       true,
+	}
+	p.RecycleBinCheckInterval.Init(base.mgr)
+
+	p.DDLJobRetention = ParamItem{
+		Key:          "rootCoord.ddlJob.retention",
+		Version:      "2.3.4",
+		DefaultValue: "86400",
+		Doc:          "(in seconds) The progress of the finished DDL jobs is kept for the retention",
+		Export:       true,
+	}
+	p.DDLJobRetention.Init(base.mgr)
+
+	p.DDLJobCheckInterval = ParamItem{
+		Key:          "rootCoord.ddlJob.checkInterval",
+		Version:      "2.3.4",
+		DefaultValue: "60",
+		Doc:          "(in seconds) The interval to remove the expired DDL jobs",
+		Export:       true,
+	}
+	p.DDLJobCheckInterval.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -899,6 +1122,19 @@ type AccessLogConfig struct {
 	RemoteMaxTime ParamItem `refreshable:"false"`
 }
 
+type AuditLogConfig struct {
+	Enable        ParamItem `refreshable:"false"`
+	EventClasses  ParamItem `refreshable:"false"`
+	MinioEnable   ParamItem `refreshable:"false"`
+	LocalPath     ParamItem `refreshable:"false"`
+	Filename      ParamItem `refreshable:"false"`
+	MaxSize       ParamItem `refreshable:"false"`
+	RotatedTime   ParamItem `refreshable:"false"`
+	MaxBackups    ParamItem `refreshable:"false"`
+	RemotePath    ParamItem `refreshable:"false"`
+	RemoteMaxTime ParamItem `refreshable:"false"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -918,12 +1154,27 @@ type proxyConfig struct {
 	MaxRoleNum                   ParamItem `refreshable:"true"`
 	MaxTaskNum                   ParamItem `refreshable:"false"`
 	AccessLog                    AccessLogConfig
+	AuditLog                     AuditLogConfig
 	ShardLeaderCacheInterval     ParamItem `refreshable:"false"`
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
 	RetryTimesOnReplica          ParamItem `refreshable:"true"`
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
+
+	ResultCacheEnabled    ParamItem `refreshable:"true"`
+	ResultCacheMaxEntries ParamItem `refreshable:"false"`
+	ResultCacheMaxBytes   ParamItem `refreshable:"true"`
+
+	HedgeEnabled          ParamItem `refreshable:"true"`
+	HedgeDelayPercentile  ParamItem `refreshable:"true"`
+	HedgeMinDelay         ParamItem `refreshable:"true"`
+	HedgeBudgetRatio      ParamItem `refreshable:"true"`
+	HedgeLatencySampleNum ParamItem `refreshable:"false"`
+
+	QueryStreamBatchSize ParamItem `refreshable:"true"`
+
+	MaxAPIKeyNumPerUser ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1145,6 +1396,95 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.RemoteMaxTime.Init(base.mgr)
 
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether to write the hash-chained audit records of the ddl, rbac and data-access requests",
+		Export:       true,
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.EventClasses = ParamItem{
+		Key:          "proxy.auditLog.eventClasses",
+		Version:      "2.3.4",
+		DefaultValue: "ddl,rbac,dml,dql,auth",
+		Doc:          "comma separated event classes to audit, options: ddl, rbac, dml, dql, auth (the failed logins)",
+		Export:       true,
+	}
+	p.AuditLog.EventClasses.Init(base.mgr)
+
+	p.AuditLog.MinioEnable = ParamItem{
+		Key:          "proxy.auditLog.minioEnable",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether to upload the sealed audit log files to minio",
+		Export:       true,
+	}
+	p.AuditLog.MinioEnable.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:     "proxy.auditLog.localPath",
+		Version: "2.3.4",
+		Doc:     "the local directory of the audit log files, use the temp directory if empty",
+		Export:  true,
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.3.4",
+		DefaultValue: "milvus_audit_log.log",
+		Doc:          "Audit log filename, leave empty to use stdout.",
+		Export:       true,
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.3.4",
+		DefaultValue: "64",
+		Doc:          "Max size for a single audit log file, in MB.",
+		Export:       true,
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "Maximum number of old audit log files to retain locally, 0 means all of them are retained.",
+		Export:       true,
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.RotatedTime = ParamItem{
+		Key:          "proxy.auditLog.rotatedTime",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "Max time for a single audit log file in seconds, 0 means rotating by size only.",
+		Export:       true,
+	}
+	p.AuditLog.RotatedTime.Init(base.mgr)
+
+	p.AuditLog.RemotePath = ParamItem{
+		Key:          "proxy.auditLog.remotePath",
+		Version:      "2.3.4",
+		DefaultValue: "audit_log/",
+		Doc:          "File path of the audit log in minio",
+		Export:       true,
+	}
+	p.AuditLog.RemotePath.Init(base.mgr)
+
+	p.AuditLog.RemoteMaxTime = ParamItem{
+		Key:          "proxy.auditLog.remoteMaxTime",
+		Version:      "2.3.4",
+		DefaultValue: "0",
+		Doc:          "Max time for the audit log file in minio, in hours, 0 means never removed.",
+		Export:       true,
+	}
+	p.AuditLog.RemoteMaxTime.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1192,6 +1532,96 @@ please adjust in embedded Milvus: false`,
 		Doc:          "set query node unavailable on proxy when heartbeat failures reach this limit",
 	}
 	p.RetryTimesOnHealthCheck.Init(base.mgr)
+
+	p.ResultCacheEnabled = ParamItem{
+		Key:          "proxy.resultCache.enabled",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether to cache the search and query results in proxy",
+		Export:       true,
+	}
+	p.ResultCacheEnabled.Init(base.mgr)
+
+	p.ResultCacheMaxEntries = ParamItem{
+		Key:          "proxy.resultCache.maxEntries",
+		Version:      "2.3.4",
+		DefaultValue: "1024",
+		Doc:          "the maximum number of cached search and query results",
+		Export:       true,
+	}
+	p.ResultCacheMaxEntries.Init(base.mgr)
+
+	p.ResultCacheMaxBytes = ParamItem{
+		Key:          "proxy.resultCache.maxResultSize",
+		Version:      "2.3.4",
+		DefaultValue: "1048576",
+		Doc:          "bytes, results larger than this are not cached",
+		Export:       true,
+	}
+	p.ResultCacheMaxBytes.Init(base.mgr)
+
+	p.HedgeEnabled = ParamItem{
+		Key:          "proxy.hedge.enabled",
+		Version:      "2.3.4",
+		DefaultValue: "false",
+		Doc:          "whether to send the search/query sub request to another replica if the chosen one responds slowly",
+		Export:       true,
+	}
+	p.HedgeEnabled.Init(base.mgr)
+
+	p.HedgeDelayPercentile = ParamItem{
+		Key:          "proxy.hedge.delayPercentile",
+		Version:      "2.3.4",
+		DefaultValue: "0.95",
+		Doc:          "the hedged sub request is sent after the latency of this percentile of the recent sub requests",
+		Export:       true,
+	}
+	p.HedgeDelayPercentile.Init(base.mgr)
+
+	p.HedgeMinDelay = ParamItem{
+		Key:          "proxy.hedge.minDelay",
+		Version:      "2.3.4",
+		DefaultValue: "10",
+		Doc:          "ms, the minimum delay before sending the hedged sub request",
+		Export:       true,
+	}
+	p.HedgeMinDelay.Init(base.mgr)
+
+	p.HedgeBudgetRatio = ParamItem{
+		Key:          "proxy.hedge.budgetRatio",
+		Version:      "2.3.4",
+		DefaultValue: "0.05",
+		Doc:          "the maximum ratio of hedged sub requests to all the sub requests",
+		Export:       true,
+	}
+	p.HedgeBudgetRatio.Init(base.mgr)
+
+	p.HedgeLatencySampleNum = ParamItem{
+		Key:          "proxy.hedge.latencySampleNum",
+		Version:      "2.3.4",
+		DefaultValue: "1000",
+		Doc:          "the number of recent sub request latencies per collection to compute the hedge delay",
+		Export:       true,
+	}
+	p.HedgeLatencySampleNum.Init(base.mgr)
+
+	p.QueryStreamBatchSize = ParamItem{
+		Key:          "proxy.queryStream.batchSize",
+		Version:      "2.3.4",
+		DefaultValue: "1000",
+		Doc:          "the maximum number of rows in a message of the streaming query sent to the client",
+		Export:       true,
+	}
+	p.QueryStreamBatchSize.Init(base.mgr)
+
+	p.MaxAPIKeyNumPerUser = ParamItem{
+		Key:          "proxy.maxAPIKeyNumPerUser",
+		Version:      "2.3.4",
+		DefaultValue: "10",
+		Doc:          "the maximum number of api keys a user can create",
+		Export:       true,
+	}
+	p.MaxAPIKeyNumPerUser.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -101,6 +101,19 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
 
+		assert.False(t, Params.JWTEnabled.GetAsBool())
+		assert.Equal(t, 300*time.Second, Params.JWKSRefreshInterval.GetAsDuration(time.Second))
+		assert.Equal(t, "sub", Params.JWTUsernameClaim.GetValue())
+		assert.Equal(t, "", Params.JWTRolesClaim.GetValue())
+		assert.False(t, Params.JWTAllowSuperUsers.GetAsBool())
+
+		assert.False(t, Params.PasswordRequireUppercase.GetAsBool())
+		assert.Equal(t, 0, Params.PasswordHistorySize.GetAsInt())
+		assert.Equal(t, 0, Params.PasswordMaxAge.GetAsInt())
+		assert.Equal(t, 0, Params.LoginMaxFailedAttempts.GetAsInt())
+		assert.Equal(t, 600*time.Second, Params.LoginLockoutDuration.GetAsDuration(time.Second))
+		assert.True(t, Params.LoginLockoutExemptRoot.GetAsBool())
+
 		assert.Equal(t, false, Params.PreCreatedTopicEnabled.GetAsBool())
 
 		params.Save("common.preCreatedTopic.names", "topic1,topic2,topic3")
@@ -121,6 +134,10 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention.GetAsFloat())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.Equal(t, 0*time.Second, Params.RecycleBinRetention.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.RecycleBinCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 24*time.Hour, Params.DDLJobRetention.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.DDLJobCheckInterval.GetAsDuration(time.Second))
 
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
@@ -153,6 +170,12 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.False(t, Params.AuditLog.Enable.GetAsBool())
+		assert.Equal(t, []string{"ddl", "rbac", "dml", "dql", "auth"}, Params.AuditLog.EventClasses.GetAsStrings())
+		assert.Equal(t, "milvus_audit_log.log", Params.AuditLog.Filename.GetValue())
+		assert.Equal(t, "audit_log/", Params.AuditLog.RemotePath.GetValue())
+		assert.Equal(t, 0, Params.AuditLog.RemoteMaxTime.GetAsInt())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
 
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
@@ -164,6 +187,18 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.CostMetricsExpireTime.GetAsInt(), 1000)
 		assert.Equal(t, Params.RetryTimesOnReplica.GetAsInt(), 2)
 		assert.EqualValues(t, Params.HealthCheckTimeout.GetAsInt64(), 3000)
+
+		assert.False(t, Params.ResultCacheEnabled.GetAsBool())
+		assert.Equal(t, 1024, Params.ResultCacheMaxEntries.GetAsInt())
+		assert.Equal(t, 1048576, Params.ResultCacheMaxBytes.GetAsInt())
+
+		assert.False(t, Params.HedgeEnabled.GetAsBool())
+		assert.Equal(t, 0.95, Params.HedgeDelayPercentile.GetAsFloat())
+		assert.Equal(t, 10*time.Millisecond, Params.HedgeMinDelay.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0.05, Params.HedgeBudgetRatio.GetAsFloat())
+		assert.Equal(t, 1000, Params.HedgeLatencySampleNum.GetAsInt())
+		assert.Equal(t, 1000, Params.QueryStreamBatchSize.GetAsInt())
+		assert.Equal(t, 10, Params.MaxAPIKeyNumPerUser.GetAsInt())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -90,6 +90,9 @@ type quotaConfig struct {
 	DQLMaxQueryRatePerCollection  ParamItem `refreshable:"true"`
 	DQLMinQueryRatePerCollection  ParamItem `refreshable:"true"`
 
+	// tenant
+	UserRateLimits ParamItem `refreshable:"true"`
+
 	// limits
 	MaxCollectionNum      ParamItem `refreshable:"true"`
 	MaxCollectionNumPerDB ParamItem `refreshable:"true"`
@@ -818,6 +821,18 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DQLMinQueryRatePerCollection.Init(base.mgr)
 
+	// tenant
+	p.UserRateLimits = ParamItem{
+		Key:          "quotaAndLimits.tenant.userRateLimits",
+		Version:      "2.3.4",
+		DefaultValue: "{}",
+		Doc: `json map of the rate limits of users, the keys are in the form of <username>.<rateKey>,
+rate keys are insertRate.max.mb, upsertRate.max.mb, deleteRate.max.mb, bulkLoadRate.max.mb, searchRate.max.vps and queryRate.max.qps,
+default no limit`,
+		Export: true,
+	}
+	p.UserRateLimits.Init(base.mgr)
+
 	// limits
 	p.MaxCollectionNum = ParamItem{
 		Key:          "quotaAndLimits.limits.maxCollectionNum",
@@ -178,6 +178,15 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, 65536, qc.MaxCollectionNumPerDB.GetAsInt())
 	})
 
+	t.Run("test tenant", func(t *testing.T) {
+		params := ComponentParam{}
+		params.Init(NewBaseTable(SkipRemote(true)))
+		assert.Empty(t, params.QuotaConfig.UserRateLimits.GetAsJSONMap())
+
+		params.Save(params.QuotaConfig.UserRateLimits.Key, `{"User1.searchRate.max.vps": 100}`)
+		assert.Equal(t, map[string]string{"User1.searchRate.max.vps": "100"}, params.QuotaConfig.UserRateLimits.GetAsJSONMap())
+	})
+
 	t.Run("test limit writing", func(t *testing.T) {
 		assert.False(t, qc.ForceDenyWriting.GetAsBool())
 		assert.Equal(t, false, qc.TtProtectionEnabled.GetAsBool())
@@ -840,6 +840,48 @@ func GetField(schema *schemapb.CollectionSchema, fieldID int64) *schemapb.FieldS
 	})
 }
 
+// GenerateDefaultFieldData generates the field data of numRows rows filled with the default value of the field,
+// the zero value is used if the default value isn't set. Only the scalar fields except json and array are supported.
+func GenerateDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	defaultValue := field.GetDefaultValue()
+	var scalars *schemapb.ScalarField
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{
+			Data: lo.RepeatBy(numRows, func(int) bool { return defaultValue.GetBoolData() }),
+		}}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{
+			Data: lo.RepeatBy(numRows, func(int) int32 { return defaultValue.GetIntData() }),
+		}}}
+	case schemapb.DataType_Int64:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{
+			Data: lo.RepeatBy(numRows, func(int) int64 { return defaultValue.GetLongData() }),
+		}}}
+	case schemapb.DataType_Float:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{
+			Data: lo.RepeatBy(numRows, func(int) float32 { return defaultValue.GetFloatData() }),
+		}}}
+	case schemapb.DataType_Double:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{
+			Data: lo.RepeatBy(numRows, func(int) float64 { return defaultValue.GetDoubleData() }),
+		}}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{
+			Data: lo.RepeatBy(numRows, func(int) string { return defaultValue.GetStringData() }),
+		}}}
+	default:
+		return nil, fmt.Errorf("can't generate default data for field %s of type %s", field.GetName(), field.GetDataType().String())
+	}
+
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, nil
+}
+
 func IsPrimaryFieldDataExist(datas []*schemapb.FieldData, primaryFieldSchema *schemapb.FieldSchema) bool {
 	primaryFieldID := primaryFieldSchema.FieldID
 	primaryFieldName := primaryFieldSchema.Name
@@ -1323,3 +1323,36 @@ func TestMergeFieldData(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestGenerateDefaultFieldData(t *testing.T) {
+	fieldData, err := GenerateDefaultFieldData(&schemapb.FieldSchema{
+		FieldID:      101,
+		Name:         "f",
+		DataType:     schemapb.DataType_Int16,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+	}, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), fieldData.GetFieldId())
+	assert.Equal(t, schemapb.DataType_Int16, fieldData.GetType())
+	assert.Equal(t, []int32{7, 7, 7}, fieldData.GetScalars().GetIntData().GetData())
+
+	// zero value without default value
+	fieldData, err = GenerateDefaultFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", ""}, fieldData.GetScalars().GetStringData().GetData())
+
+	for _, dataType := range []schemapb.DataType{
+		schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double,
+	} {
+		fieldData, err = GenerateDefaultFieldData(&schemapb.FieldSchema{Name: "f", DataType: dataType}, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, dataType, fieldData.GetType())
+		assert.NotNil(t, fieldData.GetScalars().GetData())
+	}
+
+	_, err = GenerateDefaultFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_JSON}, 2)
+	assert.Error(t, err)
+	_, err = GenerateDefaultFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector}, 2)
+	assert.Error(t, err)
+}